@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	ExportJobKeyPrefix      = "order:export:job:"
+	ExportJobAliveKeyPrefix = "order:export:alive:"
+	ExportUserJobsKeyPrefix = "order:export:user:" // 用户执行中的任务 ID -> 最近一次存活时间
+	ExportFilesKey          = "order:export:files" // 导出文件 key -> 过期时间，用于清理存储中的文件
+
+	ExportJobPending = "pending"
+	ExportJobRunning = "running"
+	ExportJobDone    = "done"
+	ExportJobFailed  = "failed"
+)
+
+// ExportJob 后台订单导出任务
+type ExportJob struct {
+	JobID      string `json:"job_id"`
+	OwnerID    uint64 `json:"owner_id"` // 创建任务的用户，只有本人可以查询和下载
+	Status     string `json:"status"`
+	Format     string `json:"format"`
+	FileKey    string `json:"file_key"` // 结果文件在存储中的 key
+	OrderCount int64  `json:"order_count"`
+	Error      string `json:"error"`
+	CreatedAt  int64  `json:"created_at"`
+	FinishedAt int64  `json:"finished_at"`
+}
+
+// SaveExportJob 保存导出任务
+func SaveExportJob(ctx context.Context, job *ExportJob, ttl time.Duration) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return RedisClient.Set(ctx, ExportJobKeyPrefix+job.JobID, data, ttl).Err()
+}
+
+// GetExportJob 获取导出任务，不存在时返回 nil, nil
+func GetExportJob(ctx context.Context, jobID string) (*ExportJob, error) {
+	data, err := RedisClient.Get(ctx, ExportJobKeyPrefix+jobID).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var job ExportJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func exportUserJobsKey(ownerID uint64) string {
+	return ExportUserJobsKeyPrefix + strconv.FormatUint(ownerID, 10)
+}
+
+// acquireUserJobScript 清除超时未刷新的任务后，用户执行中的任务数未达上限时登记新任务，返回 1 表示成功
+var acquireUserJobScript = redis.NewScript(`
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', ARGV[1])
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[3], ARGV[4])
+redis.call('EXPIRE', KEYS[1], ARGV[5])
+return 1
+`)
+
+// AcquireExportUserSlot 登记用户的新任务，用户执行中的任务已达 limit 个时返回 false，
+// 超过 ttl 未刷新的任务不再计入
+func AcquireExportUserSlot(ctx context.Context, ownerID uint64, jobID string, limit int, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	n, err := acquireUserJobScript.Run(ctx, RedisClient, []string{exportUserJobsKey(ownerID)},
+		now.Add(-ttl).Unix(), limit, now.Unix(), jobID, int64(ttl/time.Second)).Int()
+	return n == 1, err
+}
+
+// ReleaseExportUserSlot 任务结束后移除用户的执行中任务
+func ReleaseExportUserSlot(ctx context.Context, ownerID uint64, jobID string) error {
+	return RedisClient.ZRem(ctx, exportUserJobsKey(ownerID), jobID).Err()
+}
+
+// TouchExportJob 标记任务仍在执行，执行任务的实例定期刷新，超过 ttl 未刷新说明实例已退出
+func TouchExportJob(ctx context.Context, ownerID uint64, jobID string, ttl time.Duration) error {
+	userKey := exportUserJobsKey(ownerID)
+	pipe := RedisClient.TxPipeline()
+	pipe.Set(ctx, ExportJobAliveKeyPrefix+jobID, 1, ttl)
+	pipe.ZAddXX(ctx, userKey, redis.Z{Score: float64(time.Now().Unix()), Member: jobID})
+	pipe.Expire(ctx, userKey, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ExportJobAlive 判断任务的执行实例是否仍在运行
+func ExportJobAlive(ctx context.Context, jobID string) (bool, error) {
+	n, err := RedisClient.Exists(ctx, ExportJobAliveKeyPrefix+jobID).Result()
+	return n > 0, err
+}
+
+// AddExportFile 记录导出文件及其过期时间
+func AddExportFile(ctx context.Context, key string, expireAt time.Time) error {
+	return RedisClient.ZAdd(ctx, ExportFilesKey, redis.Z{Score: float64(expireAt.Unix()), Member: key}).Err()
+}
+
+// ListExpiredExportFiles 返回 now 之前过期的导出文件，最多 limit 个
+func ListExpiredExportFiles(ctx context.Context, now time.Time, limit int64) ([]string, error) {
+	return RedisClient.ZRangeByScore(ctx, ExportFilesKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: limit,
+	}).Result()
+}
+
+// RemoveExportFile 文件删除后移除记录
+func RemoveExportFile(ctx context.Context, key string) error {
+	return RedisClient.ZRem(ctx, ExportFilesKey, key).Err()
+}
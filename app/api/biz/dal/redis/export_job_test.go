@@ -0,0 +1,94 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func setupMiniredis(t *testing.T) *miniredis.Miniredis {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	old := RedisClient
+	RedisClient = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() {
+		RedisClient.Close()
+		RedisClient = old
+	})
+	return mr
+}
+
+func TestExportJobAlive(t *testing.T) {
+	mr := setupMiniredis(t)
+	ctx := context.Background()
+
+	if alive, err := ExportJobAlive(ctx, "1"); err != nil || alive {
+		t.Fatalf("job without heartbeat: alive=%v err=%v", alive, err)
+	}
+	if err := TouchExportJob(ctx, 7, "1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if alive, err := ExportJobAlive(ctx, "1"); err != nil || !alive {
+		t.Fatalf("job with heartbeat: alive=%v err=%v", alive, err)
+	}
+	// 实例退出后不再刷新，标记随之过期
+	mr.FastForward(2 * time.Minute)
+	if alive, err := ExportJobAlive(ctx, "1"); err != nil || alive {
+		t.Fatalf("job after heartbeat expired: alive=%v err=%v", alive, err)
+	}
+}
+
+func TestAcquireExportUserSlot(t *testing.T) {
+	setupMiniredis(t)
+	ctx := context.Background()
+	const ttl = 2 * time.Minute
+
+	if ok, err := AcquireExportUserSlot(ctx, 7, "1", 1, ttl); err != nil || !ok {
+		t.Fatalf("first job: ok=%v err=%v", ok, err)
+	}
+	if ok, err := AcquireExportUserSlot(ctx, 7, "2", 1, ttl); err != nil || ok {
+		t.Fatalf("second job over limit: ok=%v err=%v", ok, err)
+	}
+	if ok, err := AcquireExportUserSlot(ctx, 8, "3", 1, ttl); err != nil || !ok {
+		t.Fatalf("other user: ok=%v err=%v", ok, err)
+	}
+	if err := ReleaseExportUserSlot(ctx, 7, "1"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := AcquireExportUserSlot(ctx, 7, "2", 1, ttl); err != nil || !ok {
+		t.Fatalf("after release: ok=%v err=%v", ok, err)
+	}
+
+	// 实例退出后任务不再刷新，超时后不再占用名额
+	stale := float64(time.Now().Add(-ttl - time.Minute).Unix())
+	RedisClient.ZAdd(ctx, exportUserJobsKey(8), redis.Z{Score: stale, Member: "3"})
+	if ok, err := AcquireExportUserSlot(ctx, 8, "4", 1, ttl); err != nil || !ok {
+		t.Fatalf("stale job should not count: ok=%v err=%v", ok, err)
+	}
+}
+
+func TestListExpiredExportFiles(t *testing.T) {
+	setupMiniredis(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	if err := AddExportFile(ctx, "1.csv", now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := AddExportFile(ctx, "2.csv", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ListExpiredExportFiles(ctx, now, 10)
+	if err != nil || len(keys) != 1 || keys[0] != "1.csv" {
+		t.Fatalf("expired files: %v, %v", keys, err)
+	}
+	if err := RemoveExportFile(ctx, "1.csv"); err != nil {
+		t.Fatal(err)
+	}
+	if keys, err := ListExpiredExportFiles(ctx, now, 10); err != nil || len(keys) != 0 {
+		t.Fatalf("after remove: %v, %v", keys, err)
+	}
+}
@@ -218,3 +218,67 @@ func RefreshToken(ctx context.Context, c *app.RequestContext) {
 
 	response.Success(c, resp)
 }
+
+// ListRoles .
+// @Summary      角色列表
+// @Description  List admin roles and their permissions (requires user:role)
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Success      200  {object}  response.Response{data=auth.ListRolesResp}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      403  {object}  response.Response{data=string}  "Forbidden"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/roles [GET]
+func ListRoles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.ListRolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListRolesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SetUserRoles .
+// @Summary      设置用户角色
+// @Description  Replace roles of a user; the user must login again (requires user:role)
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        req body auth.SetUserRolesReq true "Roles"
+// @Success      200  {object}  response.Response{data=auth.SetUserRolesResp}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      403  {object}  response.Response{data=string}  "Forbidden"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/users/{user_id}/roles [PUT]
+func SetUserRoles(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req auth.SetUserRolesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSetUserRolesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
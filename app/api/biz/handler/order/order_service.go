@@ -42,9 +42,11 @@ func PlaceOrder(ctx context.Context, c *app.RequestContext) {
 
 // ListOrder .
 // @Summary      获取订单列表
-// @Description  List current user's orders
+// @Description  List current user's orders, paging into archived history
 // @Tags         Order
-// @Param        Authorization  header    string  true  "Bearer {token}"
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        page           query     int     false  "Page number, starting from 1"
+// @Param        page_size      query     int     false  "Page size, 0 returns all orders"
 // @Success      200            {object}  response.Response{data=order.ListOrderResp}
 // @Failure      400            {object}  response.Response{data=string}  "Bad Request"
 // @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
@@ -94,3 +96,251 @@ func CancelOrder(ctx context.Context, c *app.RequestContext) {
 
 	response.Success(c, resp)
 }
+
+// GetOrder .
+// @Summary      获取订单详情
+// @Description  Get order by order_id, including orders still being processed
+// @Tags         Order
+// @Param        Authorization  header    string              true  "Bearer {token}"
+// @Param        order_id       path      string              true  "Order ID"
+// @Success      200            {object}  response.Response{data=order.GetOrderResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/:order_id [GET]
+func GetOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.GetOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetOrderService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ExportOrders .
+// @Summary      导出订单
+// @Description  Stream current user's orders as a CSV or XLSX file, one row per order item plus a totals row
+// @Tags         Order
+// @Produce      text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        format         query     string  false  "Export format: csv (default) / xlsx"
+// @Param        status         query     string  false  "Order status: placed / paid / canceled"
+// @Param        start_time     query     int     false  "Created at from, unix seconds"
+// @Param        end_time       query     int     false  "Created at to, unix seconds"
+// @Success      200            {file}    file
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/export [GET]
+func ExportOrders(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ExportOrdersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	if _, err = service.NewExportOrdersService(ctx, c).Run(&req); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// CreateExportJob .
+// @Summary      创建订单导出任务
+// @Description  Export current user's orders in the background, poll the job and download the file when done
+// @Tags         Order
+// @Param        Authorization  header    string                    true  "Bearer {token}"
+// @Param        req            body      order.CreateExportJobReq  true  "Export job request"
+// @Success      200            {object}  response.Response{data=order.CreateExportJobResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/exports [POST]
+func CreateExportJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CreateExportJobReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateExportJobService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetExportJob .
+// @Summary      查询订单导出任务
+// @Description  Get export job status
+// @Tags         Order
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        job_id         path      string  true   "Job ID"
+// @Success      200            {object}  response.Response{data=order.GetExportJobResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/exports/:job_id [GET]
+func GetExportJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.GetExportJobReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetExportJobService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// DownloadExportJob .
+// @Summary      下载订单导出文件
+// @Description  Download the file of a finished export job
+// @Tags         Order
+// @Produce      text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        job_id         path      string  true   "Job ID"
+// @Success      200            {file}    file
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/exports/:job_id/download [GET]
+func DownloadExportJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.DownloadExportJobReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	if _, err = service.NewDownloadExportJobService(ctx, c).Run(&req); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// AdminExportOrders .
+// @Summary      管理员导出订单
+// @Description  Stream orders of one user, or all users when user_id is 0, as a CSV or XLSX file
+// @Tags         Order
+// @Produce      text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        format         query     string  false  "Export format: csv (default) / xlsx"
+// @Param        user_id        query     int     false  "User ID, 0 exports all users"
+// @Param        status         query     string  false  "Order status: placed / paid / canceled"
+// @Param        start_time     query     int     false  "Created at from, unix seconds"
+// @Param        end_time       query     int     false  "Created at to, unix seconds"
+// @Success      200            {file}    file
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/orders/export [GET]
+func AdminExportOrders(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ExportOrdersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	if _, err = service.NewAdminExportOrdersService(ctx, c).Run(&req); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// AdminCreateExportJob .
+// @Summary      管理员创建订单导出任务
+// @Description  Export orders of one user, or all users when user_id is 0, in the background
+// @Tags         Order
+// @Param        Authorization  header    string                    true  "Bearer {token}"
+// @Param        req            body      order.CreateExportJobReq  true  "Export job request"
+// @Success      200            {object}  response.Response{data=order.CreateExportJobResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/orders/exports [POST]
+func AdminCreateExportJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.CreateExportJobReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminCreateExportJobService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// Reorder .
+// @Summary      再次购买
+// @Description  Add items of a past order back to the cart, reporting delisted, out-of-stock and price-changed SKUs
+// @Tags         Order
+// @Param        Authorization  header    string  true  "Bearer {token}"
+// @Param        order_id       path      string  true  "Order ID"
+// @Success      200            {object}  response.Response{data=order.ReorderResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/:order_id/reorder [POST]
+func Reorder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.ReorderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewReorderService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetInvoice .
+// @Summary      下载发票
+// @Description  Download the invoice of a paid order, issuing it on first request
+// @Tags         Order
+// @Produce      application/pdf,text/html
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        order_id       path      string  true   "Order ID"
+// @Param        format         query     string  false  "Invoice format: pdf (default) / html"
+// @Success      200            {file}    file
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /orders/:order_id/invoice [GET]
+func GetInvoice(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req order.GetInvoiceReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	if _, err = service.NewGetInvoiceService(ctx, c).Run(&req); err != nil {
+		_ = c.Error(err)
+	}
+}
@@ -140,12 +140,13 @@ func ListCategories(ctx context.Context, c *app.RequestContext) {
 
 // ListBrands .
 // @Summary      获取品牌列表
-// @Description  Get brand list with pagination
+// @Description  Get brand list with pagination, optionally only brands associated with a category
 // @Tags         Product
 // @Accept       json
 // @Produce      json
-// @Param        page       query     int32  false  "Page number"
-// @Param        page_size  query     int32  false  "Page size"
+// @Param        page         query     int32  false  "Page number"
+// @Param        page_size    query     int32  false  "Page size"
+// @Param        category_id  query     int64  false  "Only brands associated with this category or its parents/children"
 // @Success      200  {object}  response.Response{data=product.ListBrandsResponse}
 // @Failure      400  {object}  response.Response{data=string}  "Bad Request"
 // @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
@@ -255,3 +256,1373 @@ func GetHotProducts(ctx context.Context, c *app.RequestContext) {
 
 	response.Success(c, resp)
 }
+
+// AdminListProducts .
+// @Summary      后台商品列表
+// @Description  List products for admin with publish/verify status filters and recycle bin
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        page           query     int32   false  "Page number"
+// @Param        page_size      query     int32   false  "Page size"
+// @Param        keyword        query     string  false  "Search keyword"
+// @Param        category_id    query     int64   false  "Category ID"
+// @Param        brand_id       query     int64   false  "Brand ID"
+// @Param        publish_status query     int32   false  "Publish status (0:unpublished, 1:published, 2:off shelf), all if omitted"
+// @Param        verify_status  query     int32   false  "Verify status (0:unverified, 1:approved, 2:rejected), all if omitted"
+// @Param        deleted        query     bool    false  "List deleted products only"
+// @Success      200  {object}  response.Response{data=product.AdminListProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products [GET]
+func AdminListProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.AdminListProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminListProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// UpdateProduct .
+// @Summary      编辑商品
+// @Description  Update SPU basic info and detail, empty fields are left unchanged (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        spu_id  path      int64                          true  "SPU ID"
+// @Param        req     body      product.UpdateProductRequest  true  "Update Product Request"
+// @Success      200  {object}  response.Response{data=product.UpdateProductResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/{spu_id} [PUT]
+func UpdateProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateProductRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUpdateProductService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// UpdateSku .
+// @Summary      编辑SKU
+// @Description  Update a single SKU, empty fields are left unchanged; use batch API for stock (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        sku_id  path      int64                     true  "SKU ID"
+// @Param        req     body      product.UpdateSkuRequest  true  "Update SKU Request"
+// @Success      200  {object}  response.Response{data=product.UpdateSkuResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/skus/{sku_id} [PUT]
+func UpdateSku(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateSkuRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUpdateSkuService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// PublishProducts .
+// @Summary      批量上架商品
+// @Description  Publish products (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        req  body      product.PublishProductsRequest  true  "Publish Products Request"
+// @Success      200  {object}  response.Response{data=product.PublishProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/publish [POST]
+func PublishProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.PublishProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewPublishProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// UnpublishProducts .
+// @Summary      批量下架商品
+// @Description  Unpublish products (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        req  body      product.UnpublishProductsRequest  true  "Unpublish Products Request"
+// @Success      200  {object}  response.Response{data=product.UnpublishProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/unpublish [POST]
+func UnpublishProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UnpublishProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUnpublishProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// DeleteProducts .
+// @Summary      批量删除商品
+// @Description  Soft delete products, deleted products are also taken off shelf (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        req  body      product.DeleteProductsRequest  true  "Delete Products Request"
+// @Success      200  {object}  response.Response{data=product.DeleteProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/delete [POST]
+func DeleteProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewDeleteProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// RestoreProducts .
+// @Summary      批量恢复商品
+// @Description  Restore deleted products, restored products stay off shelf (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        req  body      product.RestoreProductsRequest  true  "Restore Products Request"
+// @Success      200  {object}  response.Response{data=product.RestoreProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/restore [POST]
+func RestoreProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.RestoreProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewRestoreProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SuggestQueries .
+// @Summary      搜索联想
+// @Description  Prefix completions from popular queries, product names, brands and categories
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        prefix  query     string  true   "Typed prefix"
+// @Param        limit   query     int32   false  "Limit (default 10, max 20)"
+// @Success      200  {object}  response.Response{data=product.SuggestQueriesResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /products/suggest [GET]
+func SuggestQueries(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SuggestQueriesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSuggestQueriesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetTrendingSearches .
+// @Summary      热搜榜
+// @Description  Most searched keywords in the last hour or the last 24 hours
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Param        period  query     string  false  "Window: hour or day (default day)"
+// @Param        limit   query     int32   false  "Limit (default 10, max 50)"
+// @Success      200  {object}  response.Response{data=product.GetTrendingSearchesResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /products/search/trending [GET]
+func GetTrendingSearches(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetTrendingSearchesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetTrendingSearchesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetSearchReport .
+// @Summary      搜索词报表
+// @Description  Top search keywords over the last N days with zero-result and average result counts (requires search:report)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        days   query     int32   false  "Days including today (default 7, max 90)"
+// @Param        limit  query     int32   false  "Limit (default 50, max 500)"
+// @Success      200  {object}  response.Response{data=product.GetSearchReportResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/search/report [GET]
+func GetSearchReport(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetSearchReportRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetSearchReportService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetZeroResultReport .
+// @Summary      零结果搜索词报表
+// @Description  Keywords that returned no results over the last N days, ordered by zero-result count (requires search:report)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        days   query     int32   false  "Days including today (default 7, max 90)"
+// @Param        limit  query     int32   false  "Limit (default 50, max 500)"
+// @Success      200  {object}  response.Response{data=product.GetSearchReportResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/search/zero-results [GET]
+func GetZeroResultReport(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetSearchReportRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetZeroResultReportService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AdminListCategories .
+// @Summary      后台分类树
+// @Description  Full category tree including hidden categories (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        parent_id  query     int64  false  "Parent category ID (0 for the whole tree)"
+// @Success      200  {object}  response.Response{data=product.ListCategoriesResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories [GET]
+func AdminListCategories(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListCategoriesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminListCategoriesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CreateCategory .
+// @Summary      创建分类
+// @Description  Create a category under the given parent, at most 3 levels deep (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        req  body      product.CreateCategoryRequest  true  "Create Category Request"
+// @Success      200  {object}  response.Response{data=product.CreateCategoryResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories [POST]
+func CreateCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateCategoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateCategoryService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// UpdateCategory .
+// @Summary      修改分类
+// @Description  Rename a category or change its icon and unit, empty fields are left unchanged (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64                          true  "Category ID"
+// @Param        req  body      product.UpdateCategoryRequest  true  "Update Category Request"
+// @Success      200  {object}  response.Response{data=product.UpdateCategoryResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories/{id} [PUT]
+func UpdateCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateCategoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUpdateCategoryService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SortCategories .
+// @Summary      调整分类顺序
+// @Description  Reorder sibling categories in the given id order (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        req  body      product.SortCategoriesRequest  true  "Sort Categories Request"
+// @Success      200  {object}  response.Response{data=product.SortCategoriesResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories/sort [POST]
+func SortCategories(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SortCategoriesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSortCategoriesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SetCategoryVisibility .
+// @Summary      显示/隐藏分类
+// @Description  Show or hide a category; a hidden category hides its whole subtree from the storefront (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64                                 true  "Category ID"
+// @Param        req  body      product.SetCategoryVisibilityRequest  true  "Set Category Visibility Request"
+// @Success      200  {object}  response.Response{data=product.SetCategoryVisibilityResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories/{id}/visibility [PUT]
+func SetCategoryVisibility(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SetCategoryVisibilityRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSetCategoryVisibilityService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// MoveCategory .
+// @Summary      移动分类
+// @Description  Move a category with its subtree under a new parent; levels are recomputed and may not exceed 3 (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64                        true  "Category ID"
+// @Param        req  body      product.MoveCategoryRequest  true  "Move Category Request"
+// @Success      200  {object}  response.Response{data=product.MoveCategoryResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories/{id}/move [POST]
+func MoveCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.MoveCategoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewMoveCategoryService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// DeleteCategory .
+// @Summary      删除分类
+// @Description  Delete a category; rejected while it still has child categories or products (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64  true  "Category ID"
+// @Success      200  {object}  response.Response{data=product.DeleteCategoryResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories/{id} [DELETE]
+func DeleteCategory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteCategoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewDeleteCategoryService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AdminListBrands .
+// @Summary      后台品牌列表
+// @Description  Brands including hidden ones, with their associated categories (Admin only)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        page         query     int32  false  "Page number"
+// @Param        page_size    query     int32  false  "Page size"
+// @Param        category_id  query     int64  false  "Only brands associated with this category or its parents/children"
+// @Success      200  {object}  response.Response{data=product.ListBrandsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/brands [GET]
+func AdminListBrands(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListBrandsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminListBrandsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CreateBrand .
+// @Summary      创建品牌
+// @Description  Create a brand; first_letter is derived from the name (pinyin initial for Chinese) when empty (requires brand:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        req  body      product.CreateBrandRequest  true  "Create Brand Request"
+// @Success      200  {object}  response.Response{data=product.CreateBrandResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/brands [POST]
+func CreateBrand(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateBrandRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateBrandService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// UpdateBrand .
+// @Summary      修改品牌
+// @Description  Update brand name, first letter, logo, sort or visibility; omitted fields are left unchanged (requires brand:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64                       true  "Brand ID"
+// @Param        req  body      product.UpdateBrandRequest  true  "Update Brand Request"
+// @Success      200  {object}  response.Response{data=product.UpdateBrandResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/brands/{id} [PUT]
+func UpdateBrand(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UpdateBrandRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUpdateBrandService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// DeleteBrand .
+// @Summary      删除品牌
+// @Description  Delete a brand and its category associations; rejected while products still reference it (requires brand:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64  true  "Brand ID"
+// @Success      200  {object}  response.Response{data=product.DeleteBrandResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/brands/{id} [DELETE]
+func DeleteBrand(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DeleteBrandRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewDeleteBrandService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SetBrandCategories .
+// @Summary      设置品牌关联分类
+// @Description  Replace the categories a brand is associated with; an empty list clears them (requires brand:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64                              true  "Brand ID"
+// @Param        req  body      product.SetBrandCategoriesRequest  true  "Set Brand Categories Request"
+// @Success      200  {object}  response.Response{data=product.SetBrandCategoriesResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/brands/{id}/categories [PUT]
+func SetBrandCategories(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SetBrandCategoriesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSetBrandCategoriesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetSpecTemplate .
+// @Summary      分类规格模板
+// @Description  Get the effective spec template of a category, including attributes inherited from ancestor categories
+// @Tags         Product
+// @Produce      json
+// @Param        id   path      int64  true  "Category ID"
+// @Success      200  {object}  response.Response{data=product.GetSpecTemplateResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /categories/{id}/spec-template [GET]
+func GetSpecTemplate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetSpecTemplateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetSpecTemplateService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SaveSpecTemplate .
+// @Summary      保存分类规格模板
+// @Description  Replace the category's own spec attributes; an empty list clears them (requires category:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64                            true  "Category ID"
+// @Param        req  body      product.SaveSpecTemplateRequest  true  "Save Spec Template Request"
+// @Success      200  {object}  response.Response{data=product.SaveSpecTemplateResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/categories/{id}/spec-template [PUT]
+func SaveSpecTemplate(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SaveSpecTemplateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSaveSpecTemplateService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AuditProducts .
+// @Summary      审核商品
+// @Description  Approve or reject pending products; a reason is required when rejecting, products not pending review are skipped (requires product:audit)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        req  body      product.AuditProductsRequest  true  "Audit Products Request"
+// @Success      200  {object}  response.Response{data=product.AuditProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/audits [POST]
+func AuditProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.AuditProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAuditProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListAuditQueue .
+// @Summary      待审核商品队列
+// @Description  List products pending review, oldest submission first (requires product:audit)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        page       query     int32  false  "Page number"
+// @Param        page_size  query     int32  false  "Page size"
+// @Success      200  {object}  response.Response{data=product.ListAuditQueueResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/audits/pending [GET]
+func ListAuditQueue(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListAuditQueueRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListAuditQueueService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListProductAudits .
+// @Summary      商品审核记录
+// @Description  List the audit history of a product, including every rejection reason, newest first (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        spu_id  path      int64  true  "SPU ID"
+// @Success      200  {object}  response.Response{data=product.ListProductAuditsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/{spu_id}/audits [GET]
+func ListProductAudits(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListProductAuditsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListProductAuditsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListProductHistory .
+// @Summary      商品版本历史
+// @Description  List the versioned change history of a product with the operator and field-level diff of each version, newest first (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        spu_id     path      int64  true   "SPU ID"
+// @Param        page       query     int32  false  "页码"
+// @Param        page_size  query     int32  false  "每页数量"
+// @Success      200  {object}  response.Response{data=product.ListProductHistoryResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/{spu_id}/history [GET]
+func ListProductHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListProductHistoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListProductHistoryService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// RollbackProduct .
+// @Summary      回滚商品版本
+// @Description  Restore the SPU, SKU and detail content of a product to a previous version; the rollback is recorded as a new version and the product goes back to the audit queue (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        spu_id   path      int64                           true  "SPU ID"
+// @Param        req      body      product.RollbackProductRequest  true  "Rollback Product Request"
+// @Success      200  {object}  response.Response{data=product.RollbackProductResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/{spu_id}/rollback [POST]
+func RollbackProduct(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.RollbackProductRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewRollbackProductService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CreateProductSchedule .
+// @Summary      创建商品定时任务
+// @Description  Schedule a publish, unpublish or price change on an SPU or SKU; the product service applies it at run_at and records the result (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        req  body      product.CreateProductScheduleRequest  true  "Create Product Schedule Request"
+// @Success      200  {object}  response.Response{data=product.CreateProductScheduleResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/schedules [POST]
+func CreateProductSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CreateProductScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateProductScheduleService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListProductSchedules .
+// @Summary      商品定时任务列表
+// @Description  List product schedules with their execution result and failure reason, latest run time first (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        target_type  query     string  false  "spu / sku"
+// @Param        target_id    query     int64   false  "SPU 或 SKU ID"
+// @Param        status       query     int32   false  "0:待执行, 1:执行中, 2:已执行, 3:执行失败, 4:已取消，不传表示全部"
+// @Param        page         query     int32   false  "页码"
+// @Param        page_size    query     int32   false  "每页数量"
+// @Success      200  {object}  response.Response{data=product.ListProductSchedulesResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/schedules [GET]
+func ListProductSchedules(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListProductSchedulesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListProductSchedulesService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CancelProductSchedule .
+// @Summary      取消商品定时任务
+// @Description  Cancel a schedule that has not run yet (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id  path      int64  true  "定时任务 ID"
+// @Success      200  {object}  response.Response{data=product.CancelProductScheduleResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/schedules/{id} [DELETE]
+func CancelProductSchedule(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CancelProductScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCancelProductScheduleService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ImportProducts .
+// @Summary      批量导入商品
+// @Description  Upload a CSV or XLSX file to create products in bulk. Rows sharing spu_ref form one product; every row is validated and the job runs in the background. With dry_run only the error report is produced (requires product:write)
+// @Tags         Product
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        file     formData  file    true   "CSV / XLSX 文件，最大 5MB，最多 5000 行"
+// @Param        dry_run  formData  bool    false  "true 时只校验不创建商品"
+// @Success      200  {object}  response.Response{data=product.ImportProductsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/imports [POST]
+func ImportProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ImportProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewImportProductsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetImportJob .
+// @Summary      查询商品导入任务
+// @Description  Get the progress of a product import job with its per-row error report (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id   path      int64  true  "导入任务ID"
+// @Success      200  {object}  response.Response{data=product.GetImportJobResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/imports/{id} [GET]
+func GetImportJob(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetImportJobRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetImportJobService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// DownloadImportReport .
+// @Summary      下载商品导入错误报告
+// @Description  Download the per-row error report of a product import job as a CSV or XLSX file (requires product:write)
+// @Tags         Product
+// @Produce      text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id      path      int64   true   "导入任务ID"
+// @Param        format  query     string  false  "csv (默认) / xlsx"
+// @Success      200     {file}    file
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/imports/{id}/report [GET]
+func DownloadImportReport(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.DownloadImportReportRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	if _, err = service.NewDownloadImportReportService(ctx, c).Run(&req); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// ExportProducts .
+// @Summary      导出商品
+// @Description  Stream products matching the admin list filters as a CSV or XLSX file, one row per SKU, using the same columns as the import (requires product:write)
+// @Tags         Product
+// @Produce      text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        format          query     string  false  "csv (默认) / xlsx"
+// @Param        keyword         query     string  false  "关键词"
+// @Param        category_id     query     int64   false  "分类ID"
+// @Param        brand_id        query     int64   false  "品牌ID"
+// @Param        publish_status  query     int32   false  "发布状态，不传表示全部"
+// @Param        verify_status   query     int32   false  "审核状态，不传表示全部"
+// @Param        deleted         query     bool    false  "true 时导出回收站中的商品"
+// @Success      200             {file}    file
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/products/export [GET]
+func ExportProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ExportProductsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	if _, err = service.NewExportProductsService(ctx, c).Run(&req); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// UploadMedia .
+// @Summary      上传商品图片/视频
+// @Description  Upload a product image (jpeg/png/gif) or video (mp4/webm). The type is detected from the file content. Images get a 320px JPEG thumbnail and a 1200px WebP variant. The returned asset id can be used as main_image_asset_id / image_asset_ids / video_asset_ids when creating or editing products (requires product:write)
+// @Tags         Product
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        file  formData  file  true  "图片最大 10MB、宽高不超过 8000，视频最大 50MB"
+// @Success      200  {object}  response.Response{data=product.UploadMediaResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/media [POST]
+func UploadMedia(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.UploadMediaRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUploadMediaService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListMedia .
+// @Summary      媒体库列表
+// @Description  List uploaded media assets, most recent first (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        kind       query     string  false  "image / video，不传表示全部"
+// @Param        page       query     int32   false  "页码"
+// @Param        page_size  query     int32   false  "每页数量"
+// @Success      200  {object}  response.Response{data=product.ListMediaResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/media [GET]
+func ListMedia(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListMediaRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListMediaService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// GetMedia .
+// @Summary      媒体资源详情
+// @Description  Get a media asset with its thumbnail and WebP urls (requires product:write)
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        id  path      int64  true  "媒体资源ID"
+// @Success      200  {object}  response.Response{data=product.GetMediaResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /admin/media/{id} [GET]
+func GetMedia(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.GetMediaRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewGetMediaService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListPriceHistory .
+// @Summary      商品价格走势
+// @Description  List SKU price changes of a product in chronological order. A SKU's initial price is recorded with an empty old_price
+// @Tags         Product
+// @Produce      json
+// @Param        spu_id  path      int64  true   "SPU ID"
+// @Param        sku_id  query     int64  false  "SKU ID，不传表示全部 SKU"
+// @Param        days    query     int32  false  "最近天数，默认 180，最大 365"
+// @Success      200  {object}  response.Response{data=product.ListPriceHistoryResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /products/{spu_id}/price-history [GET]
+func ListPriceHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListPriceHistoryRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListPriceHistoryService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// SubscribePriceAlert .
+// @Summary      订阅降价提醒
+// @Description  Subscribe to a SKU with a target price lower than its current price. A notification is sent once when the price drops to the target or below; subscribing again replaces the target
+// @Tags         Product
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        req  body      product.SubscribePriceAlertRequest  true  "Subscribe price alert request"
+// @Success      200  {object}  response.Response{data=product.SubscribePriceAlertResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /price-alerts [POST]
+func SubscribePriceAlert(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.SubscribePriceAlertRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewSubscribePriceAlertService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListPriceAlerts .
+// @Summary      我的降价提醒
+// @Description  List the current user's price alerts with the SKU's current price, most recently updated first
+// @Tags         Product
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        page       query     int32  false  "页码"
+// @Param        page_size  query     int32  false  "每页数量"
+// @Success      200  {object}  response.Response{data=product.ListPriceAlertsResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /price-alerts [GET]
+func ListPriceAlerts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.ListPriceAlertsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListPriceAlertsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CancelPriceAlert .
+// @Summary      取消降价提醒
+// @Description  Cancel the current user's price alert on a SKU
+// @Tags         Product
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        sku_id  path      int64  true  "SKU ID"
+// @Success      200  {object}  response.Response{data=product.CancelPriceAlertResponse}
+// @Failure      400  {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500  {object}  response.Response{data=string}  "Internal Server Error"
+// @Router       /price-alerts/{sku_id} [DELETE]
+func CancelPriceAlert(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req product.CancelPriceAlertRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCancelPriceAlertService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
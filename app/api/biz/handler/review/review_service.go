@@ -0,0 +1,400 @@
+// Code generated by hertz generator.
+
+package review
+
+import (
+	"context"
+
+	review "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	service "github.com/PiaoAdmin/pmall/app/api/biz/service/review"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/response"
+	"github.com/cloudwego/hertz/pkg/app"
+	herrors "github.com/cloudwego/hertz/pkg/common/errors"
+)
+
+// CreateReview .
+// @Summary      发表商品评价
+// @Description  Review a SKU from a paid order; each order SKU can be reviewed once
+// @Tags         Review
+// @Param        Authorization  header    string                  true  "Bearer {token}"
+// @Param        req            body      review.CreateReviewReq  true  "Create review request"
+// @Success      200            {object}  response.Response{data=review.CreateReviewResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /reviews [POST]
+func CreateReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.CreateReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateReviewService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListReviews .
+// @Summary      商品评价列表
+// @Description  Approved reviews of a product, newest first
+// @Tags         Review
+// @Param        spu_id       path      int64   true   "SPU ID"
+// @Param        page         query     int32   false  "Page (default 1)"
+// @Param        page_size    query     int32   false  "Page size (default 20, max 100)"
+// @Param        rating       query     int32   false  "Filter by rating 1-5, 0 for all"
+// @Param        with_images  query     bool    false  "Only reviews with images"
+// @Success      200          {object}  response.Response{data=review.ListReviewsResp}
+// @Failure      400          {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500          {object}  response.Response{data=string}  "Internal Server Error"
+// @router /products/{spu_id}/reviews [GET]
+func ListReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.ListReviewsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListReviewsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AdminListReviews .
+// @Summary      后台评价列表
+// @Description  Reviews filtered by moderation status (requires review:moderate)
+// @Tags         Review
+// @Security     ApiKeyAuth
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        page           query     int32   false  "Page (default 1)"
+// @Param        page_size      query     int32   false  "Page size (default 20, max 100)"
+// @Param        status         query     string  false  "pending / approved / rejected, empty for all"
+// @Param        spu_id         query     int64   false  "SPU ID"
+// @Success      200            {object}  response.Response{data=review.AdminListReviewsResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/reviews [GET]
+func AdminListReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.AdminListReviewsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminListReviewsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ModerateReviews .
+// @Summary      批量审核评价
+// @Description  Approve or reject reviews; product rating summaries are updated accordingly (requires review:moderate)
+// @Tags         Review
+// @Security     ApiKeyAuth
+// @Param        Authorization  header    string                     true  "Bearer {token}"
+// @Param        req            body      review.ModerateReviewsReq  true  "Moderate reviews request"
+// @Success      200            {object}  response.Response{data=review.ModerateReviewsResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/reviews/moderate [POST]
+func ModerateReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.ModerateReviewsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewModerateReviewsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListQuestions .
+// @Summary      商品问题列表
+// @Description  Approved questions of a product, newest first, each with its most helpful answers
+// @Tags         Review
+// @Param        spu_id     path      int64  true   "SPU ID"
+// @Param        page       query     int32  false  "Page (default 1)"
+// @Param        page_size  query     int32  false  "Page size (default 20, max 100)"
+// @Success      200        {object}  response.Response{data=review.ListQuestionsResp}
+// @Failure      400        {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500        {object}  response.Response{data=string}  "Internal Server Error"
+// @router /products/{spu_id}/questions [GET]
+func ListQuestions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.ListQuestionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListQuestionsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CreateQuestion .
+// @Summary      商品提问
+// @Description  Ask a question about a product
+// @Tags         Review
+// @Param        Authorization  header    string                    true  "Bearer {token}"
+// @Param        spu_id         path      int64                     true  "SPU ID"
+// @Param        req            body      review.CreateQuestionReq  true  "Create question request"
+// @Success      200            {object}  response.Response{data=review.CreateQuestionResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /products/{spu_id}/questions [POST]
+func CreateQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.CreateQuestionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateQuestionService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ListAnswers .
+// @Summary      问题的回答列表
+// @Description  Approved answers of a question, merchant answers and most upvoted first
+// @Tags         Review
+// @Param        question_id  path      int64  true   "Question ID"
+// @Param        page         query     int32  false  "Page (default 1)"
+// @Param        page_size    query     int32  false  "Page size (default 20, max 100)"
+// @Success      200          {object}  response.Response{data=review.ListAnswersResp}
+// @Failure      400          {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500          {object}  response.Response{data=string}  "Internal Server Error"
+// @router /questions/{question_id}/answers [GET]
+func ListAnswers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.ListAnswersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewListAnswersService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// CreateAnswer .
+// @Summary      回答问题
+// @Description  Answer a question; only merchants (product:write) or buyers of the product may answer
+// @Tags         Review
+// @Param        Authorization  header    string                  true  "Bearer {token}"
+// @Param        question_id    path      int64                   true  "Question ID"
+// @Param        req            body      review.CreateAnswerReq  true  "Create answer request"
+// @Success      200            {object}  response.Response{data=review.CreateAnswerResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /questions/{question_id}/answers [POST]
+func CreateAnswer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.CreateAnswerReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewCreateAnswerService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// UpvoteAnswer .
+// @Summary      点赞回答
+// @Description  Upvote an answer, or cancel the upvote; repeated calls are idempotent
+// @Tags         Review
+// @Param        Authorization  header    string                  true  "Bearer {token}"
+// @Param        answer_id      path      int64                   true  "Answer ID"
+// @Param        req            body      review.UpvoteAnswerReq  true  "Upvote answer request"
+// @Success      200            {object}  response.Response{data=review.UpvoteAnswerResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /answers/{answer_id}/upvote [POST]
+func UpvoteAnswer(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.UpvoteAnswerReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewUpvoteAnswerService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AdminListQuestions .
+// @Summary      后台问题列表
+// @Description  Questions filtered by moderation status (requires review:moderate)
+// @Tags         Review
+// @Security     ApiKeyAuth
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        page           query     int32   false  "Page (default 1)"
+// @Param        page_size      query     int32   false  "Page size (default 20, max 100)"
+// @Param        status         query     string  false  "pending / approved / rejected, empty for all"
+// @Param        spu_id         query     int64   false  "SPU ID"
+// @Success      200            {object}  response.Response{data=review.AdminListQuestionsResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/questions [GET]
+func AdminListQuestions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.AdminListQuestionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminListQuestionsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// AdminListAnswers .
+// @Summary      后台回答列表
+// @Description  Answers filtered by moderation status (requires review:moderate)
+// @Tags         Review
+// @Security     ApiKeyAuth
+// @Param        Authorization  header    string  true   "Bearer {token}"
+// @Param        page           query     int32   false  "Page (default 1)"
+// @Param        page_size      query     int32   false  "Page size (default 20, max 100)"
+// @Param        status         query     string  false  "pending / approved / rejected, empty for all"
+// @Param        question_id    query     int64   false  "Question ID"
+// @Success      200            {object}  response.Response{data=review.AdminListAnswersResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/answers [GET]
+func AdminListAnswers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.AdminListAnswersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewAdminListAnswersService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ModerateQuestions .
+// @Summary      批量审核问题
+// @Description  Approve or reject questions; approved questions trigger notification hooks (requires review:moderate)
+// @Tags         Review
+// @Security     ApiKeyAuth
+// @Param        Authorization  header    string                true  "Bearer {token}"
+// @Param        req            body      review.ModerateQaReq  true  "Moderate questions request"
+// @Success      200            {object}  response.Response{data=review.ModerateQaResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/questions/moderate [POST]
+func ModerateQuestions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.ModerateQaReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewModerateQuestionsService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
+
+// ModerateAnswers .
+// @Summary      批量审核回答
+// @Description  Approve or reject answers; approved answers notify the asker (requires review:moderate)
+// @Tags         Review
+// @Security     ApiKeyAuth
+// @Param        Authorization  header    string                true  "Bearer {token}"
+// @Param        req            body      review.ModerateQaReq  true  "Moderate answers request"
+// @Success      200            {object}  response.Response{data=review.ModerateQaResp}
+// @Failure      400            {object}  response.Response{data=string}  "Bad Request"
+// @Failure      500            {object}  response.Response{data=string}  "Internal Server Error"
+// @router /admin/answers/moderate [POST]
+func ModerateAnswers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req review.ModerateQaReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		_ = c.Error(err).SetType(herrors.ErrorTypeBind)
+		return
+	}
+
+	resp, err := service.NewModerateAnswersService(ctx, c).Run(&req)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	response.Success(c, resp)
+}
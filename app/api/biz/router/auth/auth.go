@@ -21,6 +21,17 @@ func Register(r *server.Hertz) {
 	root.POST("/logout", append(_logoutMw(), auth.Logout)...)
 	root.POST("/refresh", append(_refreshtokenMw(), auth.RefreshToken)...)
 	root.POST("/register", append(_registerMw(), auth.Register)...)
+	{
+		_admin := root.Group("/admin", _adminMw()...)
+		_admin.GET("/roles", append(_listrolesMw(), auth.ListRoles)...)
+		{
+			_users := _admin.Group("/users", _usersMw()...)
+			{
+				_user_id := _users.Group("/:user_id", _user_idMw()...)
+				_user_id.PUT("/roles", append(_setuserrolesMw(), auth.SetUserRoles)...)
+			}
+		}
+	}
 	{
 		_auth := root.Group("/auth", _authMw()...)
 		_auth.GET("/info", append(_getuserinfoMw(), auth.GetUserInfo)...)
@@ -4,6 +4,8 @@ package auth
 
 import (
 	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	perm "github.com/PiaoAdmin/pmall/common/rbac"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -50,3 +52,32 @@ func _refreshtokenMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return rbac.Admin()
+}
+
+func _listrolesMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermUserRole),
+	}
+}
+
+func _usersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _user_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setuserrolesMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermUserRole),
+	}
+}
@@ -4,6 +4,8 @@ package order
 
 import (
 	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	perm "github.com/PiaoAdmin/pmall/common/rbac"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -46,3 +48,97 @@ func _placeorderMw() []app.HandlerFunc {
 		jwt.JwtMiddleware.MiddlewareFunc(),
 	}
 }
+
+func _orders0Mw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _getorderMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _exportordersMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _exportsMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _createexportjobMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _job_idMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _getexportjobMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _downloadexportjobMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return rbac.Admin()
+}
+
+func _orders1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminexportordersMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermOrderExport),
+	}
+}
+
+func _admincreateexportjobMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermOrderExport),
+	}
+}
+
+func _reorderMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _getinvoiceMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
@@ -19,9 +19,27 @@ func Register(r *server.Hertz) {
 	root := r.Group("/", rootMw()...)
 	root.GET("/orders", append(_listorderMw(), order.ListOrder)...)
 	_orders := root.Group("/orders", _ordersMw()...)
+	_orders.GET("/export", append(_exportordersMw(), order.ExportOrders)...)
+	_orders.POST("/exports", append(_createexportjobMw(), order.CreateExportJob)...)
+	_exports := _orders.Group("/exports", _exportsMw()...)
+	_exports.GET("/:job_id", append(_getexportjobMw(), order.GetExportJob)...)
+	_job_id := _exports.Group("/:job_id", _job_idMw()...)
+	_job_id.GET("/download", append(_downloadexportjobMw(), order.DownloadExportJob)...)
 	{
 		_order_id := _orders.Group("/:order_id", _order_idMw()...)
 		_order_id.POST("/cancel", append(_cancelorderMw(), order.CancelOrder)...)
+		_order_id.GET("/invoice", append(_getinvoiceMw(), order.GetInvoice)...)
+		_order_id.POST("/reorder", append(_reorderMw(), order.Reorder)...)
 	}
 	root.POST("/orders", append(_placeorderMw(), order.PlaceOrder)...)
+	_orders0 := root.Group("/orders", _orders0Mw()...)
+	_orders0.GET("/:order_id", append(_getorderMw(), order.GetOrder)...)
+	{
+		_admin := root.Group("/admin", _adminMw()...)
+		{
+			_orders1 := _admin.Group("/orders", _orders1Mw()...)
+			_orders1.GET("/export", append(_adminexportordersMw(), order.AdminExportOrders)...)
+			_orders1.POST("/exports", append(_admincreateexportjobMw(), order.AdminCreateExportJob)...)
+		}
+	}
 }
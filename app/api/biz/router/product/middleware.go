@@ -3,6 +3,11 @@
 package product
 
 import (
+	"github.com/PiaoAdmin/pmall/app/api/conf"
+	"github.com/PiaoAdmin/pmall/app/api/md/bodylimit"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	perm "github.com/PiaoAdmin/pmall/common/rbac"
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -23,12 +28,14 @@ func _listcategoriesMw() []app.HandlerFunc {
 
 func _adminMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return rbac.Admin()
 }
 
 func _createproductMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
 }
 
 func _skusMw() []app.HandlerFunc {
@@ -38,12 +45,17 @@ func _skusMw() []app.HandlerFunc {
 
 func _batchupdateskuMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermSkuWrite),
+	}
 }
 
 func _productsMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	// /admin/products 分组下均为写操作
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
 }
 
 func _gethomeproductsMw() []app.HandlerFunc {
@@ -65,3 +77,360 @@ func _gethotproductsMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _adminlistproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _publishproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restoreproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unpublishproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateskuMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermSkuWrite),
+	}
+}
+
+func _products0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _suggestqueriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermSearchReport),
+	}
+}
+
+func _getsearchreportMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getzeroresultreportMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _search0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _gettrendingsearchesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _categoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminlistcategoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _movecategoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setcategoryvisibilityMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _sortcategoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcategoryMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermCategoryWrite),
+	}
+}
+
+func _brandsMw() []app.HandlerFunc {
+	// your code...
+	// /admin/brands 分组下均为写操作
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermBrandWrite),
+	}
+}
+
+func _adminlistbrandsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletebrandMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setbrandcategoriesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatebrandMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createbrandMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermBrandWrite),
+	}
+}
+
+func _id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getspectemplateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _categories0Mw() []app.HandlerFunc {
+	// your code...
+	// /admin/categories 分组下均为写操作
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermCategoryWrite),
+	}
+}
+
+func _id1Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _savespectemplateMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _id2Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditsMw() []app.HandlerFunc {
+	// your code...
+	// /admin/audits 分组下均需审核权限
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductAudit),
+	}
+}
+
+func _auditproductsMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductAudit),
+	}
+}
+
+func _listauditqueueMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _spu_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listproductauditsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listproducthistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rollbackproductMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _schedulesMw() []app.HandlerFunc {
+	// your code...
+	// /admin/schedules 分组下为取消操作
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
+}
+
+func _listproductschedulesMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
+}
+
+func _cancelproductscheduleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createproductscheduleMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
+}
+
+func _exportproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _importsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+// multipartOverhead 上传接口除文件外表单字段与分隔符的余量
+const multipartOverhead = 1 << 20
+
+func _importproductsMw() []app.HandlerFunc {
+	// your code...
+	// 商品导入文件最大 5MB
+	return []app.HandlerFunc{
+		bodylimit.Max(5<<20 + multipartOverhead),
+	}
+}
+
+func _id3Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getimportjobMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _downloadimportreportMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mediaMw() []app.HandlerFunc {
+	// your code...
+	// /admin/media 分组与商品编辑使用同一权限
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
+}
+
+func _listmediaMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+	}
+}
+
+func _getmediaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uploadmediaMw() []app.HandlerFunc {
+	// your code...
+	media := conf.GetConf().Media
+	return []app.HandlerFunc{
+		rbac.RequirePermission(perm.PermProductWrite),
+		bodylimit.Max(int(max(media.MaxImageSize(), media.MaxVideoSize())) + multipartOverhead),
+	}
+}
+
+func _price_alertsMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _listpricealertsMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _cancelpricealertMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _subscribepricealertMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _spu_id0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listpricehistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -19,19 +19,85 @@ func Register(r *server.Hertz) {
 	root := r.Group("/", rootMw()...)
 	root.GET("/brands", append(_listbrandsMw(), product.ListBrands)...)
 	root.GET("/categories", append(_listcategoriesMw(), product.ListCategories)...)
+	_categories := root.Group("/categories", _categoriesMw()...)
+	{
+		_id := _categories.Group("/:id", _idMw()...)
+		_id.GET("/spec-template", append(_getspectemplateMw(), product.GetSpecTemplate)...)
+	}
+	root.GET("/price-alerts", append(_listpricealertsMw(), product.ListPriceAlerts)...)
+	_price_alerts := root.Group("/price-alerts", _price_alertsMw()...)
+	_price_alerts.DELETE("/:sku_id", append(_cancelpricealertMw(), product.CancelPriceAlert)...)
+	root.POST("/price-alerts", append(_subscribepricealertMw(), product.SubscribePriceAlert)...)
 	{
 		_admin := root.Group("/admin", _adminMw()...)
+		_admin.POST("/audits", append(_auditproductsMw(), product.AuditProducts)...)
+		_audits := _admin.Group("/audits", _auditsMw()...)
+		_audits.GET("/pending", append(_listauditqueueMw(), product.ListAuditQueue)...)
+		_admin.GET("/brands", append(_adminlistbrandsMw(), product.AdminListBrands)...)
+		_brands := _admin.Group("/brands", _brandsMw()...)
+		_brands.DELETE("/:id", append(_deletebrandMw(), product.DeleteBrand)...)
+		_id0 := _brands.Group("/:id", _id0Mw()...)
+		_id0.PUT("/categories", append(_setbrandcategoriesMw(), product.SetBrandCategories)...)
+		_brands.PUT("/:id", append(_updatebrandMw(), product.UpdateBrand)...)
+		_admin.POST("/brands", append(_createbrandMw(), product.CreateBrand)...)
+		_admin.GET("/categories", append(_adminlistcategoriesMw(), product.AdminListCategories)...)
+		_categories0 := _admin.Group("/categories", _categories0Mw()...)
+		_categories0.DELETE("/:id", append(_deletecategoryMw(), product.DeleteCategory)...)
+		_id1 := _categories0.Group("/:id", _id1Mw()...)
+		_id1.PUT("/spec-template", append(_savespectemplateMw(), product.SaveSpecTemplate)...)
+		_categories0.PUT("/:id", append(_updatecategoryMw(), product.UpdateCategory)...)
+		_id2 := _categories0.Group("/:id", _id2Mw()...)
+		_id2.POST("/move", append(_movecategoryMw(), product.MoveCategory)...)
+		_id2.PUT("/visibility", append(_setcategoryvisibilityMw(), product.SetCategoryVisibility)...)
+		_categories0.POST("/sort", append(_sortcategoriesMw(), product.SortCategories)...)
+		_admin.POST("/categories", append(_createcategoryMw(), product.CreateCategory)...)
+		_admin.GET("/media", append(_listmediaMw(), product.ListMedia)...)
+		_media := _admin.Group("/media", _mediaMw()...)
+		_media.GET("/:id", append(_getmediaMw(), product.GetMedia)...)
+		_admin.POST("/media", append(_uploadmediaMw(), product.UploadMedia)...)
+		_admin.GET("/products", append(_adminlistproductsMw(), product.AdminListProducts)...)
+		_products := _admin.Group("/products", _productsMw()...)
+		_products.POST("/delete", append(_deleteproductsMw(), product.DeleteProducts)...)
+		_products.GET("/export", append(_exportproductsMw(), product.ExportProducts)...)
+		_products.POST("/imports", append(_importproductsMw(), product.ImportProducts)...)
+		_imports := _products.Group("/imports", _importsMw()...)
+		_imports.GET("/:id", append(_getimportjobMw(), product.GetImportJob)...)
+		_id3 := _imports.Group("/:id", _id3Mw()...)
+		_id3.GET("/report", append(_downloadimportreportMw(), product.DownloadImportReport)...)
+		_products.POST("/publish", append(_publishproductsMw(), product.PublishProducts)...)
+		_products.POST("/restore", append(_restoreproductsMw(), product.RestoreProducts)...)
+		_products.PUT("/:spu_id", append(_updateproductMw(), product.UpdateProduct)...)
+		_spu_id := _products.Group("/:spu_id", _spu_idMw()...)
+		_spu_id.GET("/audits", append(_listproductauditsMw(), product.ListProductAudits)...)
+		_spu_id.GET("/history", append(_listproducthistoryMw(), product.ListProductHistory)...)
+		_spu_id.POST("/rollback", append(_rollbackproductMw(), product.RollbackProduct)...)
+		_products.POST("/unpublish", append(_unpublishproductsMw(), product.UnpublishProducts)...)
 		_admin.POST("/products", append(_createproductMw(), product.CreateProduct)...)
+		_admin.GET("/schedules", append(_listproductschedulesMw(), product.ListProductSchedules)...)
+		_schedules := _admin.Group("/schedules", _schedulesMw()...)
+		_schedules.DELETE("/:id", append(_cancelproductscheduleMw(), product.CancelProductSchedule)...)
+		_admin.POST("/schedules", append(_createproductscheduleMw(), product.CreateProductSchedule)...)
+		{
+			_search := _admin.Group("/search", _searchMw()...)
+			_search.GET("/report", append(_getsearchreportMw(), product.GetSearchReport)...)
+			_search.GET("/zero-results", append(_getzeroresultreportMw(), product.GetZeroResultReport)...)
+		}
 		{
 			_skus := _admin.Group("/skus", _skusMw()...)
 			_skus.POST("/batch", append(_batchupdateskuMw(), product.BatchUpdateSku)...)
+			_skus.PUT("/:sku_id", append(_updateskuMw(), product.UpdateSku)...)
 		}
 	}
 	{
-		_products := root.Group("/products", _productsMw()...)
-		_products.GET("/home", append(_gethomeproductsMw(), product.GetHomeProducts)...)
-		_products.GET("/hot", append(_gethotproductsMw(), product.GetHotProducts)...)
-		_products.GET("/search", append(_searchproductsMw(), product.SearchProducts)...)
-		_products.GET("/:spu_id", append(_getproductdetailMw(), product.GetProductDetail)...)
+		_products0 := root.Group("/products", _products0Mw()...)
+		_products0.GET("/home", append(_gethomeproductsMw(), product.GetHomeProducts)...)
+		_products0.GET("/hot", append(_gethotproductsMw(), product.GetHotProducts)...)
+		_products0.GET("/search", append(_searchproductsMw(), product.SearchProducts)...)
+		_search0 := _products0.Group("/search", _search0Mw()...)
+		_search0.GET("/trending", append(_gettrendingsearchesMw(), product.GetTrendingSearches)...)
+		_products0.GET("/:spu_id", append(_getproductdetailMw(), product.GetProductDetail)...)
+		_spu_id0 := _products0.Group("/:spu_id", _spu_id0Mw()...)
+		_spu_id0.GET("/price-history", append(_listpricehistoryMw(), product.ListPriceHistory)...)
+		_products0.GET("/suggest", append(_suggestqueriesMw(), product.SuggestQueries)...)
 	}
 }
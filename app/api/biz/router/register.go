@@ -10,12 +10,15 @@ import (
 	order "github.com/PiaoAdmin/pmall/app/api/biz/router/order"
 	payment "github.com/PiaoAdmin/pmall/app/api/biz/router/payment"
 	product "github.com/PiaoAdmin/pmall/app/api/biz/router/product"
+	review "github.com/PiaoAdmin/pmall/app/api/biz/router/review"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
 // GeneratedRegister registers routers generated by IDL.
 func GeneratedRegister(r *server.Hertz) {
 	//INSERT_POINT: DO NOT DELETE THIS LINE!
+	review.Register(r)
+
 	payment.Register(r)
 
 	checkout.Register(r)
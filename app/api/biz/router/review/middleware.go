@@ -0,0 +1,138 @@
+// Code generated by hertz generator.
+
+package review
+
+import (
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	perm "github.com/PiaoAdmin/pmall/common/rbac"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+func rootMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createreviewMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _adminMw() []app.HandlerFunc {
+	// your code...
+	return rbac.Admin(perm.PermReviewModerate)
+}
+
+func _reviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminlistreviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moderatereviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _productsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _spu_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listreviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _answersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminlistanswersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moderateanswersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _questionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminlistquestionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moderatequestionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _answers0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _answer_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _upvoteanswerMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _listquestionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createquestionMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
+
+func _questions0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _question_idMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listanswersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createanswerMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by hertz generator. DO NOT EDIT.
+
+package review
+
+import (
+	review "github.com/PiaoAdmin/pmall/app/api/biz/handler/review"
+	"github.com/cloudwego/hertz/pkg/app/server"
+)
+
+/*
+ This file will register all the routes of the services in the master idl.
+ And it will update automatically when you use the "update" command for the idl.
+ So don't modify the contents of the file, or your code will be deleted when it is updated.
+*/
+
+// Register register routes based on the IDL 'api.${HTTP Method}' annotation.
+func Register(r *server.Hertz) {
+
+	root := r.Group("/", rootMw()...)
+	root.POST("/reviews", append(_createreviewMw(), review.CreateReview)...)
+	{
+		_admin := root.Group("/admin", _adminMw()...)
+		_admin.GET("/answers", append(_adminlistanswersMw(), review.AdminListAnswers)...)
+		_answers := _admin.Group("/answers", _answersMw()...)
+		_answers.POST("/moderate", append(_moderateanswersMw(), review.ModerateAnswers)...)
+		_admin.GET("/questions", append(_adminlistquestionsMw(), review.AdminListQuestions)...)
+		_questions := _admin.Group("/questions", _questionsMw()...)
+		_questions.POST("/moderate", append(_moderatequestionsMw(), review.ModerateQuestions)...)
+		_admin.GET("/reviews", append(_adminlistreviewsMw(), review.AdminListReviews)...)
+		_reviews := _admin.Group("/reviews", _reviewsMw()...)
+		_reviews.POST("/moderate", append(_moderatereviewsMw(), review.ModerateReviews)...)
+	}
+	{
+		_answers0 := root.Group("/answers", _answers0Mw()...)
+		{
+			_answer_id := _answers0.Group("/:answer_id", _answer_idMw()...)
+			_answer_id.POST("/upvote", append(_upvoteanswerMw(), review.UpvoteAnswer)...)
+		}
+	}
+	{
+		_products := root.Group("/products", _productsMw()...)
+		{
+			_spu_id := _products.Group("/:spu_id", _spu_idMw()...)
+			_spu_id.GET("/questions", append(_listquestionsMw(), review.ListQuestions)...)
+			_spu_id.POST("/questions", append(_createquestionMw(), review.CreateQuestion)...)
+			_spu_id.GET("/reviews", append(_listreviewsMw(), review.ListReviews)...)
+		}
+	}
+	{
+		_questions0 := root.Group("/questions", _questions0Mw()...)
+		{
+			_question_id := _questions0.Group("/:question_id", _question_idMw()...)
+			_question_id.GET("/answers", append(_listanswersMw(), review.ListAnswers)...)
+			_question_id.POST("/answers", append(_createanswerMw(), review.CreateAnswer)...)
+		}
+	}
+}
@@ -41,6 +41,7 @@ func (h *GetUserInfoService) Run(req *auth.GetUserInfoReq) (resp *auth.GetUserIn
 			Phone:    rpcResp.User.Phone,
 			Avatar:   rpcResp.User.Avatar,
 			Status:   rpcResp.User.Status,
+			Roles:    rpcResp.User.Roles,
 		},
 	}, nil
 }
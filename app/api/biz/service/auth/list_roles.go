@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/api/biz/model/api/auth"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListRolesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListRolesService(ctx context.Context, c *app.RequestContext) *ListRolesService {
+	return &ListRolesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListRolesService) Run(req *auth.ListRolesReq) (resp *auth.ListRolesResp, err error) {
+	rpcResp, err := rpc.UserClient.ListRoles(s.Context, &user.ListRolesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &auth.ListRolesResp{Roles: make([]*auth.RoleInfo, 0, len(rpcResp.Roles))}
+	for _, r := range rpcResp.Roles {
+		resp.Roles = append(resp.Roles, &auth.RoleInfo{
+			Name:        r.Name,
+			Description: r.Description,
+			Permissions: r.Permissions,
+		})
+	}
+	return resp, nil
+}
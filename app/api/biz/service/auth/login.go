@@ -31,7 +31,10 @@ func (s *LoginService) Run(req *auth.LoginReq) (resp *auth.LoginResp, err error)
 		return nil, err
 	}
 
-	token, expire, err := jwt.JwtMiddleware.TokenGenerator(rpcResp.User.Id)
+	token, expire, err := jwt.JwtMiddleware.TokenGenerator(jwt.Identity{
+		UserID: rpcResp.User.Id,
+		Roles:  rpcResp.User.Roles,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +47,7 @@ func (s *LoginService) Run(req *auth.LoginReq) (resp *auth.LoginResp, err error)
 			Phone:    rpcResp.User.Phone,
 			Avatar:   rpcResp.User.Avatar,
 			Status:   rpcResp.User.Status,
+			Roles:    rpcResp.User.Roles,
 		},
 		Token:    token,
 		ExpireIn: expire.Unix(),
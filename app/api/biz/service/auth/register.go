@@ -35,7 +35,10 @@ func (h *RegisterService) Run(req *auth.RegisterReq) (resp *auth.RegisterResp, e
 		return nil, err
 	}
 
-	token, expire, err := jwt.JwtMiddleware.TokenGenerator(rpcResp.User.Id)
+	token, expire, err := jwt.JwtMiddleware.TokenGenerator(jwt.Identity{
+		UserID: rpcResp.User.Id,
+		Roles:  rpcResp.User.Roles,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +51,7 @@ func (h *RegisterService) Run(req *auth.RegisterReq) (resp *auth.RegisterResp, e
 			Phone:    rpcResp.User.Phone,
 			Avatar:   rpcResp.User.Avatar,
 			Status:   rpcResp.User.Status,
+			Roles:    rpcResp.User.Roles,
 		},
 		Token:    token,
 		ExpireIn: expire.Unix(),
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/api/biz/model/api/auth"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+type SetUserRolesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSetUserRolesService(ctx context.Context, c *app.RequestContext) *SetUserRolesService {
+	return &SetUserRolesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SetUserRolesService) Run(req *auth.SetUserRolesReq) (resp *auth.SetUserRolesResp, err error) {
+	rpcResp, err := rpc.UserClient.SetUserRoles(s.Context, &user.SetUserRolesRequest{
+		UserId: req.UserId,
+		Roles:  req.Roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 角色保存在 JWT 中，使该用户已签发的 token 失效
+	if err := rbac.MarkRolesChanged(s.Context, req.UserId); err != nil {
+		hlog.CtxErrorf(s.Context, "mark roles changed for user %d failed: %v", req.UserId, err)
+	}
+
+	return &auth.SetUserRolesResp{
+		User: &auth.UserInfo{
+			Id:       rpcResp.User.Id,
+			Username: rpcResp.User.Username,
+			Email:    rpcResp.User.Email,
+			Phone:    rpcResp.User.Phone,
+			Avatar:   rpcResp.User.Avatar,
+			Status:   rpcResp.User.Status,
+			Roles:    rpcResp.User.Roles,
+		},
+	}, nil
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/api/biz/dal/redis"
+	apiOrder "github.com/PiaoAdmin/pmall/app/api/biz/model/api/order"
+	"github.com/PiaoAdmin/pmall/app/api/conf"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/blob"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/export"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/common/uniqueid"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	defaultExportJobTTL      = 24 * time.Hour
+	defaultExportConcurrency = 2
+	defaultExportJobsPerUser = 1
+	// 执行中的任务每隔 exportHeartbeatInterval 刷新一次存活标记，
+	// 超过 exportJobStaleAfter 未刷新的任务视为所在实例已退出
+	exportHeartbeatInterval = 30 * time.Second
+	exportJobStaleAfter     = 2 * time.Minute
+	exportCleanBatch        = 100
+)
+
+var (
+	exportSlots     chan struct{}
+	exportSlotsOnce sync.Once
+
+	exportStore     blob.Store
+	exportStoreOnce sync.Once
+)
+
+// getExportStore 按配置创建导出文件存储，多实例部署时需使用共享存储
+func getExportStore() blob.Store {
+	exportStoreOnce.Do(func() {
+		e := conf.GetConf().Export
+		if e.Storage == "s3" {
+			exportStore = blob.NewS3(blob.S3Config{
+				Endpoint:  e.S3.Endpoint,
+				Region:    e.S3.Region,
+				Bucket:    e.S3.Bucket,
+				AccessKey: e.S3.AccessKey,
+				SecretKey: e.S3.SecretKey,
+				PathStyle: e.S3.PathStyle,
+			})
+			return
+		}
+		exportStore = blob.NewLocal(e.LocalDir(), "")
+	})
+	return exportStore
+}
+
+// tryAcquireExportSlot 限制本实例同时执行的后台导出任务数，已满时不排队，直接返回 false
+func tryAcquireExportSlot() (release func(), ok bool) {
+	exportSlotsOnce.Do(func() {
+		n := conf.GetConf().Export.MaxConcurrentJobs
+		if n <= 0 {
+			n = defaultExportConcurrency
+		}
+		exportSlots = make(chan struct{}, n)
+	})
+	select {
+	case exportSlots <- struct{}{}:
+		return func() { <-exportSlots }, true
+	default:
+		return nil, false
+	}
+}
+
+func exportJobsPerUser() int {
+	if n := conf.GetConf().Export.MaxJobsPerUser; n > 0 {
+		return n
+	}
+	return defaultExportJobsPerUser
+}
+
+func exportJobTTL() time.Duration {
+	if h := conf.GetConf().Export.JobTTLHours; h > 0 {
+		return time.Duration(h) * time.Hour
+	}
+	return defaultExportJobTTL
+}
+
+func toExportJobDTO(job *redis.ExportJob) *apiOrder.ExportJobDTO {
+	return &apiOrder.ExportJobDTO{
+		JobId:      job.JobID,
+		Status:     job.Status,
+		Format:     job.Format,
+		OrderCount: job.OrderCount,
+		Error:      job.Error,
+		CreatedAt:  job.CreatedAt,
+		FinishedAt: job.FinishedAt,
+	}
+}
+
+// runExportJob 执行后台导出，结果以 <job_id>.<ext> 写入导出存储，结束后释放 release 对应的执行名额
+func runExportJob(job *redis.ExportJob, filter orderExportFilter, format export.Format, release func()) {
+	ctx := context.Background()
+	defer release()
+	defer func() {
+		if err := redis.ReleaseExportUserSlot(ctx, job.OwnerID, job.JobID); err != nil {
+			hlog.CtxWarnf(ctx, "release export job %s failed: %v", job.JobID, err)
+		}
+	}()
+	stop := keepExportJobAlive(ctx, job.OwnerID, job.JobID)
+	defer stop()
+
+	ttl := exportJobTTL()
+	store := getExportStore()
+	cleanExpiredExportFiles(ctx, store)
+
+	job.Status = redis.ExportJobRunning
+	if err := redis.SaveExportJob(ctx, job, ttl); err != nil {
+		hlog.CtxErrorf(ctx, "save export job %s failed: %v", job.JobID, err)
+	}
+
+	key := job.JobID + format.Ext()
+	count, err := writeExportFile(ctx, store, key, filter, format)
+	job.FinishedAt = time.Now().Unix()
+	job.OrderCount = count
+	if err != nil {
+		hlog.CtxErrorf(ctx, "export job %s failed: %v", job.JobID, err)
+		job.Status = redis.ExportJobFailed
+		job.Error = err.Error()
+	} else {
+		job.Status = redis.ExportJobDone
+		job.FileKey = key
+		if err := redis.AddExportFile(ctx, key, time.Now().Add(ttl)); err != nil {
+			hlog.CtxErrorf(ctx, "record export file %s failed: %v", key, err)
+		}
+	}
+	if err := redis.SaveExportJob(ctx, job, ttl); err != nil {
+		hlog.CtxErrorf(ctx, "save export job %s failed: %v", job.JobID, err)
+	}
+}
+
+// keepExportJobAlive 定期刷新任务的存活标记，返回的函数停止刷新
+func keepExportJobAlive(ctx context.Context, ownerID uint64, jobID string) (stop func()) {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(exportHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := redis.TouchExportJob(ctx, ownerID, jobID, exportJobStaleAfter); err != nil {
+					hlog.CtxWarnf(ctx, "refresh export job %s failed: %v", jobID, err)
+				}
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// writeExportFile 在内存中生成导出文件后整体写入存储，存储中不会出现未写完的文件
+func writeExportFile(ctx context.Context, store blob.Store, key string, filter orderExportFilter, format export.Format) (int64, error) {
+	pager := &orderPager{ctx: ctx, filter: filter}
+	first, err := pager.Next()
+	if err != nil {
+		return 0, err
+	}
+	var buf bytes.Buffer
+	count, err := writeOrderExport(pager, first, format, &buf)
+	if err != nil {
+		return count, err
+	}
+	return count, store.Put(ctx, key, format.ContentType(), buf.Bytes())
+}
+
+// cleanExpiredExportFiles 删除超过保留时长的导出文件，任务记录已随 Redis 过期
+func cleanExpiredExportFiles(ctx context.Context, store blob.Store) {
+	keys, err := redis.ListExpiredExportFiles(ctx, time.Now(), exportCleanBatch)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "list expired export files failed: %v", err)
+		return
+	}
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			hlog.CtxWarnf(ctx, "delete export file %s failed: %v", key, err)
+			continue
+		}
+		_ = redis.RemoveExportFile(ctx, key)
+	}
+}
+
+// getOwnedExportJob 查询当前用户创建的导出任务，他人任务按不存在处理
+func getOwnedExportJob(ctx context.Context, c *app.RequestContext, jobID string) (*redis.ExportJob, error) {
+	if jobID == "" {
+		return nil, errs.New(errs.ErrParam.Code, "job_id is required")
+	}
+	claims := jwt.ExtractClaims(ctx, c)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	job, err := redis.GetExportJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if job == nil || job.OwnerID != userID {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "export job not found")
+	}
+	if err := failInterruptedExportJob(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// failInterruptedExportJob 执行任务的实例已退出时，将未完成的任务标记为失败
+func failInterruptedExportJob(ctx context.Context, job *redis.ExportJob) error {
+	if job.Status != redis.ExportJobPending && job.Status != redis.ExportJobRunning {
+		return nil
+	}
+	alive, err := redis.ExportJobAlive(ctx, job.JobID)
+	if err != nil || alive {
+		return err
+	}
+	job.Status = redis.ExportJobFailed
+	job.Error = "export job was interrupted, please create a new one"
+	job.FinishedAt = time.Now().Unix()
+	return redis.SaveExportJob(ctx, job, exportJobTTL())
+}
+
+type CreateExportJobService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+	// Admin 为 true 时按请求中的 user_id 导出，为 0 时导出全部用户
+	Admin bool
+}
+
+func NewCreateExportJobService(ctx context.Context, c *app.RequestContext) *CreateExportJobService {
+	return &CreateExportJobService{RequestContext: c, Context: ctx}
+}
+
+func NewAdminCreateExportJobService(ctx context.Context, c *app.RequestContext) *CreateExportJobService {
+	return &CreateExportJobService{RequestContext: c, Context: ctx, Admin: true}
+}
+
+// Run 创建后台导出任务，立即返回任务信息；本实例没有空闲名额或用户执行中的任务已达上限时拒绝
+func (s *CreateExportJobService) Run(req *apiOrder.CreateExportJobReq) (resp *apiOrder.CreateExportJobResp, err error) {
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, err.Error())
+	}
+
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	ownerID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+	userID := ownerID
+	if s.Admin {
+		userID = req.UserId
+	}
+	filter, err := newOrderExportFilter(userID, req.Status, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &redis.ExportJob{
+		JobID:     strconv.FormatUint(uniqueid.GenId(), 10),
+		OwnerID:   ownerID,
+		Status:    redis.ExportJobPending,
+		Format:    string(format),
+		CreatedAt: time.Now().Unix(),
+	}
+	release, ok := tryAcquireExportSlot()
+	if !ok {
+		return nil, errs.New(errs.ErrParam.Code, "export service is busy, please try again later")
+	}
+	if err := s.start(job, filter, format, release); err != nil {
+		release()
+		return nil, err
+	}
+
+	return &apiOrder.CreateExportJobResp{Job: toExportJobDTO(job)}, nil
+}
+
+// start 登记用户的执行中任务并启动后台导出
+func (s *CreateExportJobService) start(job *redis.ExportJob, filter orderExportFilter, format export.Format, release func()) error {
+	ok, err := redis.AcquireExportUserSlot(s.Context, job.OwnerID, job.JobID, exportJobsPerUser(), exportJobStaleAfter)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errs.New(errs.ErrParam.Code, "too many running export jobs, please wait for them to finish")
+	}
+	err = redis.TouchExportJob(s.Context, job.OwnerID, job.JobID, exportJobStaleAfter)
+	if err == nil {
+		err = redis.SaveExportJob(s.Context, job, exportJobTTL())
+	}
+	if err != nil {
+		_ = redis.ReleaseExportUserSlot(s.Context, job.OwnerID, job.JobID)
+		return err
+	}
+	go runExportJob(job, filter, format, release)
+	return nil
+}
+
+type GetExportJobService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetExportJobService(ctx context.Context, c *app.RequestContext) *GetExportJobService {
+	return &GetExportJobService{RequestContext: c, Context: ctx}
+}
+
+func (s *GetExportJobService) Run(req *apiOrder.GetExportJobReq) (resp *apiOrder.GetExportJobResp, err error) {
+	job, err := getOwnedExportJob(s.Context, s.RequestContext, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	return &apiOrder.GetExportJobResp{Job: toExportJobDTO(job)}, nil
+}
+
+type DownloadExportJobService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDownloadExportJobService(ctx context.Context, c *app.RequestContext) *DownloadExportJobService {
+	return &DownloadExportJobService{RequestContext: c, Context: ctx}
+}
+
+// Run 以附件形式返回已完成任务的导出文件
+func (s *DownloadExportJobService) Run(req *apiOrder.DownloadExportJobReq) (resp *apiOrder.DownloadExportJobResp, err error) {
+	job, err := getOwnedExportJob(s.Context, s.RequestContext, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != redis.ExportJobDone {
+		return nil, errs.New(errs.ErrParam.Code, "export job is not finished")
+	}
+	file, err := getExportStore().Open(s.Context, job.FileKey)
+	if errors.Is(err, blob.ErrNotFound) {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "export file expired")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	format, _ := export.ParseFormat(job.Format)
+	s.RequestContext.Header("Content-Type", format.ContentType())
+	s.RequestContext.Header("Content-Disposition", `attachment; filename="orders_`+job.JobID+format.Ext()+`"`)
+	s.RequestContext.SetBodyStream(file, -1)
+	return &apiOrder.DownloadExportJobResp{}, nil
+}
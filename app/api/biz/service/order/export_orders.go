@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	apiOrder "github.com/PiaoAdmin/pmall/app/api/biz/model/api/order"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/export"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/common/orderstate"
+	orderrpc "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const exportBatchSize = 200
+
+var exportHeader = []interface{}{
+	"Order ID", "Created At", "User ID", "Email", "Status",
+	"Receiver", "City", "Street Address", "Zip Code",
+	"SKU ID", "SKU Name", "Unit Price", "Quantity", "Line Total", "Order Total",
+}
+
+// orderExportFilter 订单导出过滤条件
+type orderExportFilter struct {
+	UserID    uint64 // 为 0 时导出全部用户（仅管理员）
+	Status    string
+	StartTime int64
+	EndTime   int64
+}
+
+func newOrderExportFilter(userID uint64, status string, startTime, endTime int64) (orderExportFilter, error) {
+	switch status {
+	case "", orderstate.Placed, orderstate.Paid, orderstate.Canceled:
+	default:
+		return orderExportFilter{}, errs.New(errs.ErrParam.Code, "invalid status")
+	}
+	if startTime < 0 || endTime < 0 || (startTime > 0 && endTime > 0 && startTime > endTime) {
+		return orderExportFilter{}, errs.New(errs.ErrParam.Code, "invalid time range")
+	}
+	return orderExportFilter{UserID: userID, Status: status, StartTime: startTime, EndTime: endTime}, nil
+}
+
+// orderPager 通过订单服务 ExportOrders 游标分批拉取订单
+type orderPager struct {
+	ctx    context.Context
+	filter orderExportFilter
+	cursor string
+	done   bool
+}
+
+func (p *orderPager) Next() ([]*orderrpc.Order, error) {
+	if p.done {
+		return nil, nil
+	}
+	resp, err := rpc.OrderClient.ExportOrders(p.ctx, &orderrpc.ExportOrdersReq{
+		UserId:    p.filter.UserID,
+		Status:    p.filter.Status,
+		StartTime: p.filter.StartTime,
+		EndTime:   p.filter.EndTime,
+		Cursor:    p.cursor,
+		Limit:     exportBatchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p.cursor = resp.NextCursor
+	p.done = resp.NextCursor == ""
+	return resp.Orders, nil
+}
+
+// orderExportWriter 将订单按订单项逐行写出，并在末尾写入合计行
+type orderExportWriter struct {
+	w          export.Writer
+	orderCount int64
+	totalQty   int64
+	totalCents int64
+}
+
+func newOrderExportWriter(format export.Format, out io.Writer) (*orderExportWriter, error) {
+	w, err := export.NewWriter(format, out)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Write(exportHeader...); err != nil {
+		return nil, err
+	}
+	return &orderExportWriter{w: w}, nil
+}
+
+func (e *orderExportWriter) WriteOrders(orders []*orderrpc.Order) error {
+	for _, o := range orders {
+		if err := e.writeOrder(o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *orderExportWriter) writeOrder(o *orderrpc.Order) error {
+	e.orderCount++
+	var orderCents int64
+	for _, it := range o.Items {
+		orderCents += priceToCents(it.Price) * int64(it.Quantity)
+	}
+	e.totalCents += orderCents
+
+	createdAt := time.Unix(int64(o.CreatedAt), 0).Format("2006-01-02 15:04:05")
+	addr := o.ShippingAddress
+	if addr == nil {
+		addr = &orderrpc.Address{}
+	}
+	prefix := []interface{}{
+		o.OrderId, createdAt, o.UserId, o.Email, o.Status,
+		addr.Name, addr.City, addr.StreetAddress, addr.ZipCode,
+	}
+
+	if len(o.Items) == 0 {
+		return e.w.Write(append(prefix, nil, nil, nil, nil, nil, centsToYuan(orderCents))...)
+	}
+	for _, it := range o.Items {
+		e.totalQty += int64(it.Quantity)
+		lineCents := priceToCents(it.Price) * int64(it.Quantity)
+		row := append(append([]interface{}{}, prefix...),
+			it.SkuId, it.SkuName, centsToYuan(priceToCents(it.Price)), it.Quantity,
+			centsToYuan(lineCents), centsToYuan(orderCents))
+		if err := e.w.Write(row...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close 写入合计行并结束文件
+func (e *orderExportWriter) Close() error {
+	summary := make([]interface{}, len(exportHeader))
+	summary[0] = "TOTAL"
+	summary[1] = fmt.Sprintf("%d orders", e.orderCount)
+	summary[12] = e.totalQty
+	summary[14] = centsToYuan(e.totalCents)
+	if err := e.w.Write(summary...); err != nil {
+		return err
+	}
+	return e.w.Close()
+}
+
+// writeOrderExport 拉取全部订单写入 out，first 为已预取的第一批订单
+func writeOrderExport(pager *orderPager, first []*orderrpc.Order, format export.Format, out io.Writer) (int64, error) {
+	ew, err := newOrderExportWriter(format, out)
+	if err != nil {
+		return 0, err
+	}
+	orders := first
+	for {
+		if err := ew.WriteOrders(orders); err != nil {
+			return ew.orderCount, err
+		}
+		if pager.done {
+			break
+		}
+		if orders, err = pager.Next(); err != nil {
+			return ew.orderCount, err
+		}
+	}
+	return ew.orderCount, ew.Close()
+}
+
+func priceToCents(price string) int64 {
+	p, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(math.Round(p * 100))
+}
+
+func centsToYuan(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+func exportFileName(format export.Format) string {
+	return "orders_" + time.Now().Format("20060102150405") + format.Ext()
+}
+
+type ExportOrdersService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+	// Admin 为 true 时按请求中的 user_id 导出，为 0 时导出全部用户
+	Admin bool
+}
+
+func NewExportOrdersService(ctx context.Context, c *app.RequestContext) *ExportOrdersService {
+	return &ExportOrdersService{RequestContext: c, Context: ctx}
+}
+
+func NewAdminExportOrdersService(ctx context.Context, c *app.RequestContext) *ExportOrdersService {
+	return &ExportOrdersService{RequestContext: c, Context: ctx, Admin: true}
+}
+
+// Run 以流式响应输出导出文件
+func (s *ExportOrdersService) Run(req *apiOrder.ExportOrdersReq) (resp *apiOrder.ExportOrdersResp, err error) {
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, err.Error())
+	}
+
+	userID := req.UserId
+	if !s.Admin {
+		claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+		userID = uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+	}
+	filter, err := newOrderExportFilter(userID, req.Status, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先同步拉取第一批，订单服务不可用时仍能返回 JSON 错误
+	pager := &orderPager{ctx: s.Context, filter: filter}
+	first, err := pager.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := writeOrderExport(pager, first, format, pw)
+		pw.CloseWithError(err)
+	}()
+
+	s.RequestContext.Header("Content-Type", format.ContentType())
+	s.RequestContext.Header("Content-Disposition", `attachment; filename="`+exportFileName(format)+`"`)
+	s.RequestContext.SetBodyStream(pr, -1)
+	return &apiOrder.ExportOrdersResp{}, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	apiOrder "github.com/PiaoAdmin/pmall/app/api/biz/model/api/order"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	orderrpc "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+type GetInvoiceService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetInvoiceService(ctx context.Context, c *app.RequestContext) *GetInvoiceService {
+	return &GetInvoiceService{RequestContext: c, Context: ctx}
+}
+
+// Run 输出发票文件，PDF 以附件下载，HTML 直接在浏览器中展示
+func (s *GetInvoiceService) Run(req *apiOrder.GetInvoiceReq) (resp *apiOrder.GetInvoiceResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.OrderClient.GetInvoice(s.Context, &orderrpc.GetInvoiceReq{
+		UserId:  userID,
+		OrderId: req.OrderId,
+		Format:  req.Format,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	disposition := "inline"
+	if rpcResp.ContentType == "application/pdf" {
+		disposition = "attachment"
+	}
+	s.RequestContext.Header("Content-Disposition", disposition+`; filename="`+rpcResp.FileName+`"`)
+	s.RequestContext.Data(consts.StatusOK, rpcResp.ContentType, rpcResp.Content)
+	return &apiOrder.GetInvoiceResp{}, nil
+}
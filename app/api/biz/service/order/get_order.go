@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	apiOrder "github.com/PiaoAdmin/pmall/app/api/biz/model/api/order"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	orderrpc "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetOrderService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetOrderService(ctx context.Context, c *app.RequestContext) *GetOrderService {
+	return &GetOrderService{RequestContext: c, Context: ctx}
+}
+
+func (s *GetOrderService) Run(req *apiOrder.GetOrderReq) (resp *apiOrder.GetOrderResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.OrderClient.GetOrder(s.Context, &orderrpc.GetOrderReq{
+		UserId:  userID,
+		OrderId: req.OrderId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiOrder.GetOrderResp{Order: toOrderDTO(rpcResp.Order)}, nil
+}
@@ -23,7 +23,11 @@ func (s *ListOrderService) Run(req *apiOrder.ListOrderReq) (resp *apiOrder.ListO
 	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
 	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
 
-	rpcReq := &orderrpc.ListOrderReq{UserId: userID}
+	rpcReq := &orderrpc.ListOrderReq{
+		UserId:   userID,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
 	rpcResp, err := rpc.OrderClient.ListOrder(s.Context, rpcReq)
 	if err != nil {
 		return nil, err
@@ -31,32 +35,37 @@ func (s *ListOrderService) Run(req *apiOrder.ListOrderReq) (resp *apiOrder.ListO
 
 	out := &apiOrder.ListOrderResp{Orders: make([]*apiOrder.OrderDTO, 0, len(rpcResp.Orders))}
 	for _, o := range rpcResp.Orders {
-		dto := &apiOrder.OrderDTO{
-			OrderId:   o.OrderId,
-			UserId:    o.UserId,
-			Status:    o.Status,
-			CreatedAt: int32(o.GetCreatedAt()),
-		}
-		if o.ShippingAddress != nil {
-			dto.ShippingAddress = &apiOrder.AddressDTO{
-				Name:          o.ShippingAddress.Name,
-				StreetAddress: o.ShippingAddress.StreetAddress,
-				City:          o.ShippingAddress.City,
-				ZipCode:       o.ShippingAddress.ZipCode,
-			}
-		}
-		items := make([]*apiOrder.OrderItem, 0, len(o.Items))
-		for _, it := range o.Items {
-			items = append(items, &apiOrder.OrderItem{
-				SkuId:    it.SkuId,
-				SkuName:  it.SkuName,
-				Quantity: it.Quantity,
-				Price:    it.Price,
-			})
-		}
-		dto.Items = items
-		out.Orders = append(out.Orders, dto)
+		out.Orders = append(out.Orders, toOrderDTO(o))
 	}
 
 	return out, nil
 }
+
+// toOrderDTO 将 RPC 订单转换为网关 DTO
+func toOrderDTO(o *orderrpc.Order) *apiOrder.OrderDTO {
+	dto := &apiOrder.OrderDTO{
+		OrderId:   o.OrderId,
+		UserId:    o.UserId,
+		Status:    o.Status,
+		CreatedAt: int32(o.GetCreatedAt()),
+	}
+	if o.ShippingAddress != nil {
+		dto.ShippingAddress = &apiOrder.AddressDTO{
+			Name:          o.ShippingAddress.Name,
+			StreetAddress: o.ShippingAddress.StreetAddress,
+			City:          o.ShippingAddress.City,
+			ZipCode:       o.ShippingAddress.ZipCode,
+		}
+	}
+	items := make([]*apiOrder.OrderItem, 0, len(o.Items))
+	for _, it := range o.Items {
+		items = append(items, &apiOrder.OrderItem{
+			SkuId:    it.SkuId,
+			SkuName:  it.SkuName,
+			Quantity: it.Quantity,
+			Price:    it.Price,
+		})
+	}
+	dto.Items = items
+	return dto
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	apiOrder "github.com/PiaoAdmin/pmall/app/api/biz/model/api/order"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	cartrpc "github.com/PiaoAdmin/pmall/rpc_gen/cart"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ReorderService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewReorderService(ctx context.Context, c *app.RequestContext) *ReorderService {
+	return &ReorderService{RequestContext: c, Context: ctx}
+}
+
+func (s *ReorderService) Run(req *apiOrder.ReorderReq) (resp *apiOrder.ReorderResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.CartClient.Reorder(s.Context, &cartrpc.ReorderRequest{
+		UserId:  userID,
+		OrderId: req.OrderId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &apiOrder.ReorderResp{
+		AddedItems:        make([]*apiOrder.OrderItem, 0, len(rpcResp.AddedItems)),
+		SkippedItems:      make([]*apiOrder.ReorderSkippedItem, 0, len(rpcResp.SkippedItems)),
+		PriceChangedItems: make([]*apiOrder.ReorderPriceChange, 0, len(rpcResp.PriceChangedItems)),
+	}
+	for _, it := range rpcResp.AddedItems {
+		resp.AddedItems = append(resp.AddedItems, &apiOrder.OrderItem{
+			SkuId:    it.SkuId,
+			SkuName:  it.SkuName,
+			Quantity: it.Quantity,
+			Price:    it.Price,
+		})
+	}
+	for _, it := range rpcResp.SkippedItems {
+		resp.SkippedItems = append(resp.SkippedItems, &apiOrder.ReorderSkippedItem{
+			SkuId:    it.SkuId,
+			SkuName:  it.SkuName,
+			Quantity: it.Quantity,
+			Reason:   it.Reason,
+		})
+	}
+	for _, it := range rpcResp.PriceChangedItems {
+		resp.PriceChangedItems = append(resp.PriceChangedItems, &apiOrder.ReorderPriceChange{
+			SkuId:    it.SkuId,
+			SkuName:  it.SkuName,
+			OldPrice: it.OldPrice,
+			NewPrice: it.NewPrice,
+		})
+	}
+	return resp, nil
+}
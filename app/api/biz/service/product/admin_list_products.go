@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type AdminListProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminListProductsService(ctx context.Context, c *app.RequestContext) *AdminListProductsService {
+	return &AdminListProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *AdminListProductsService) Run(req *apiProduct.AdminListProductsRequest) (resp *apiProduct.AdminListProductsResponse, err error) {
+	// 未传状态时不筛选
+	publishStatus, verifyStatus := int32(-1), int32(-1)
+	if req.PublishStatus != nil {
+		publishStatus = *req.PublishStatus
+	}
+	if req.VerifyStatus != nil {
+		verifyStatus = *req.VerifyStatus
+	}
+
+	rpcResp, err := rpc.ProductClient.ListProducts(s.Context, &product.ListProductsRequest{
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		Keyword:       req.Keyword,
+		CategoryId:    req.CategoryId,
+		BrandId:       req.BrandId,
+		PublishStatus: publishStatus,
+		VerifyStatus:  verifyStatus,
+		Deleted:       req.Deleted,
+		MerchantId:    merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.AdminSpuDTO, 0, len(rpcResp.List))
+	for _, spu := range rpcResp.List {
+		list = append(list, &apiProduct.AdminSpuDTO{
+			SpuId:         spu.Id,
+			Name:          spu.Name,
+			SubTitle:      spu.SubTitle,
+			MainImage:     spu.MainImage,
+			CategoryId:    spu.CategoryId,
+			BrandId:       spu.BrandId,
+			LowPrice:      spu.LowPrice,
+			HighPrice:     spu.HighPrice,
+			SaleCount:     spu.SaleCount,
+			Sort:          spu.Sort,
+			PublishStatus: spu.PublishStatus,
+			VerifyStatus:  spu.VerifyStatus,
+			Version:       spu.Version,
+		})
+	}
+
+	return &apiProduct.AdminListProductsResponse{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
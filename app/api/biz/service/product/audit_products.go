@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// auditActions 审核动作与商品 verify_status 的对应关系
+var auditActions = map[string]int32{
+	"approve": 1,
+	"reject":  2,
+}
+
+type AuditProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAuditProductsService(ctx context.Context, c *app.RequestContext) *AuditProductsService {
+	return &AuditProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *AuditProductsService) Run(req *apiProduct.AuditProductsRequest) (resp *apiProduct.AuditProductsResponse, err error) {
+	status, ok := auditActions[req.Action]
+	if !ok {
+		return nil, errs.New(errs.ErrParam.Code, "action must be approve or reject")
+	}
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.AuditProducts(s.Context, &product.AuditProductsRequest{
+		Ids:          req.Ids,
+		VerifyStatus: status,
+		Reason:       req.Reason,
+		OperatorId:   operatorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.AuditProductsResponse{
+		Affected: rpcResp.Affected,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
 	"github.com/PiaoAdmin/pmall/app/api/rpc"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
@@ -24,16 +25,25 @@ func NewBatchUpdateSkuService(ctx context.Context, c *app.RequestContext) *Batch
 func (s *BatchUpdateSkuService) Run(req *apiProduct.BatchUpdateSkuRequest) (resp *apiProduct.BatchUpdateSkuResponse, err error) {
 	skus := make([]*product.ProductSKU, 0, len(req.Items))
 	for _, item := range req.Items {
+		stock := item.Stock
+		if stock == 0 {
+			stock = -1 // 0 表示不更新，RPC 以负数表示不更新
+		}
 		skus = append(skus, &product.ProductSKU{
 			Id:    item.SkuId,
 			Price: item.Price,
-			Stock: item.Stock,
+			Stock: stock,
 		})
 	}
 
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
 	// 调用 RPC BatchUpdateSku
 	rpcResp, err := rpc.ProductClient.BatchUpdateSku(s.Context, &product.BatchUpdateSkuRequest{
-		Skus: skus,
+		Skus:       skus,
+		OperatorId: operatorID,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CancelPriceAlertService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCancelPriceAlertService(ctx context.Context, c *app.RequestContext) *CancelPriceAlertService {
+	return &CancelPriceAlertService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CancelPriceAlertService) Run(req *apiProduct.CancelPriceAlertRequest) (resp *apiProduct.CancelPriceAlertResponse, err error) {
+	if req.SkuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "sku_id is required")
+	}
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.CancelPriceAlert(s.Context, &product.CancelPriceAlertRequest{
+		UserId: userID,
+		SkuId:  req.SkuId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.CancelPriceAlertResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
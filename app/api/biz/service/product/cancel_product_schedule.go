@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CancelProductScheduleService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCancelProductScheduleService(ctx context.Context, c *app.RequestContext) *CancelProductScheduleService {
+	return &CancelProductScheduleService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CancelProductScheduleService) Run(req *apiProduct.CancelProductScheduleRequest) (resp *apiProduct.CancelProductScheduleResponse, err error) {
+	rpcResp, err := rpc.ProductClient.CancelProductSchedule(s.Context, &product.CancelProductScheduleRequest{
+		Id:         req.Id,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.CancelProductScheduleResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateBrandService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateBrandService(ctx context.Context, c *app.RequestContext) *CreateBrandService {
+	return &CreateBrandService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CreateBrandService) Run(req *apiProduct.CreateBrandRequest) (resp *apiProduct.CreateBrandResponse, err error) {
+	if req.Name == "" {
+		return nil, errs.New(errs.ErrParam.Code, "name is required")
+	}
+
+	rpcResp, err := rpc.ProductClient.CreateBrand(s.Context, &product.CreateBrandRequest{
+		Name:        req.Name,
+		FirstLetter: req.FirstLetter,
+		Logo:        req.Logo,
+		Sort:        req.Sort,
+		CategoryIds: req.CategoryIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.CreateBrandResponse{
+		Id:          rpcResp.Id,
+		FirstLetter: rpcResp.FirstLetter,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateCategoryService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateCategoryService(ctx context.Context, c *app.RequestContext) *CreateCategoryService {
+	return &CreateCategoryService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CreateCategoryService) Run(req *apiProduct.CreateCategoryRequest) (resp *apiProduct.CreateCategoryResponse, err error) {
+	if req.Name == "" {
+		return nil, errs.New(errs.ErrParam.Code, "name is required")
+	}
+
+	rpcResp, err := rpc.ProductClient.CreateCategory(s.Context, &product.CreateCategoryRequest{
+		ParentId: req.ParentId,
+		Name:     req.Name,
+		Icon:     req.Icon,
+		Unit:     req.Unit,
+		Sort:     req.Sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.CreateCategoryResponse{
+		Id:    rpcResp.Id,
+		Level: rpcResp.Level,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
 	"github.com/PiaoAdmin/pmall/app/api/rpc"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
@@ -24,27 +25,29 @@ func NewCreateProductService(ctx context.Context, c *app.RequestContext) *Create
 
 func (s *CreateProductService) Run(req *apiProduct.CreateProductRequest) (resp *apiProduct.CreateProductResponse, err error) {
 	spu := &product.ProductSPU{
-		BrandId:     req.Spu.BrandId,
-		CategoryId:  req.Spu.CategoryId,
-		Name:        req.Spu.Name,
-		SubTitle:    req.Spu.SubTitle,
-		MainImage:   req.Spu.MainImage,
-		Sort:        req.Spu.Sort,
-		ServiceBits: req.Spu.ServiceBits,
+		BrandId:          req.Spu.BrandId,
+		CategoryId:       req.Spu.CategoryId,
+		Name:             req.Spu.Name,
+		SubTitle:         req.Spu.SubTitle,
+		MainImage:        req.Spu.MainImage,
+		Sort:             req.Spu.Sort,
+		ServiceBits:      req.Spu.ServiceBits,
+		MainImageAssetId: req.Spu.MainImageAssetId,
 	}
 
 	// 转换 SKUs
 	skus := make([]*product.ProductSKU, 0, len(req.Skus))
 	for _, apiSku := range req.Skus {
 		skus = append(skus, &product.ProductSKU{
-			SkuCode:     apiSku.SkuCode,
-			Name:        apiSku.Name,
-			SubTitle:    apiSku.SubTitle,
-			MainImage:   apiSku.MainImage,
-			Price:       apiSku.Price,
-			MarketPrice: apiSku.MarketPrice,
-			Stock:       apiSku.Stock,
-			SkuSpecData: apiSku.SkuSpecData,
+			SkuCode:          apiSku.SkuCode,
+			Name:             apiSku.Name,
+			SubTitle:         apiSku.SubTitle,
+			MainImage:        apiSku.MainImage,
+			Price:            apiSku.Price,
+			MarketPrice:      apiSku.MarketPrice,
+			Stock:            apiSku.Stock,
+			SkuSpecData:      apiSku.SkuSpecData,
+			MainImageAssetId: apiSku.MainImageAssetId,
 		})
 	}
 
@@ -57,14 +60,21 @@ func (s *CreateProductService) Run(req *apiProduct.CreateProductRequest) (resp *
 			Videos:        req.Detail.Videos,
 			MarketTagJson: req.Detail.MarketTagJson,
 			TechTagJson:   req.Detail.TechTagJson,
+			ImageAssetIds: req.Detail.ImageAssetIds,
+			VideoAssetIds: req.Detail.VideoAssetIds,
 		}
 	}
 
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
 	// 调用 RPC CreateProduct
 	rpcResp, err := rpc.ProductClient.CreateProduct(s.Context, &product.CreateProductRequest{
-		Spu:    spu,
-		Skus:   skus,
-		Detail: detail,
+		Spu:        spu,
+		Skus:       skus,
+		Detail:     detail,
+		OperatorId: operatorID,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
 	})
 	if err != nil {
 		fmt.Print(err.Error())
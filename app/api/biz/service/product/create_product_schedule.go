@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateProductScheduleService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateProductScheduleService(ctx context.Context, c *app.RequestContext) *CreateProductScheduleService {
+	return &CreateProductScheduleService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CreateProductScheduleService) Run(req *apiProduct.CreateProductScheduleRequest) (resp *apiProduct.CreateProductScheduleResponse, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.CreateProductSchedule(s.Context, &product.CreateProductScheduleRequest{
+		TargetType:  req.TargetType,
+		TargetId:    req.TargetId,
+		Action:      req.Action,
+		RunAt:       req.RunAt,
+		Price:       req.Price,
+		MarketPrice: req.MarketPrice,
+		OperatorId:  operatorID,
+		MerchantId:  merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.CreateProductScheduleResponse{
+		Id: rpcResp.Id,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type DeleteBrandService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDeleteBrandService(ctx context.Context, c *app.RequestContext) *DeleteBrandService {
+	return &DeleteBrandService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *DeleteBrandService) Run(req *apiProduct.DeleteBrandRequest) (resp *apiProduct.DeleteBrandResponse, err error) {
+	rpcResp, err := rpc.ProductClient.DeleteBrand(s.Context, &product.DeleteBrandRequest{
+		Id: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.DeleteBrandResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
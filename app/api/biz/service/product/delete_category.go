@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type DeleteCategoryService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDeleteCategoryService(ctx context.Context, c *app.RequestContext) *DeleteCategoryService {
+	return &DeleteCategoryService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *DeleteCategoryService) Run(req *apiProduct.DeleteCategoryRequest) (resp *apiProduct.DeleteCategoryResponse, err error) {
+	rpcResp, err := rpc.ProductClient.DeleteCategory(s.Context, &product.DeleteCategoryRequest{
+		Id: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.DeleteCategoryResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type DeleteProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDeleteProductsService(ctx context.Context, c *app.RequestContext) *DeleteProductsService {
+	return &DeleteProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *DeleteProductsService) Run(req *apiProduct.DeleteProductsRequest) (resp *apiProduct.DeleteProductsResponse, err error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+
+	rpcResp, err := rpc.ProductClient.DeleteProduct(s.Context, &product.DeleteProductRequest{
+		Ids:        req.Ids,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.DeleteProductsResponse{
+		Affected: rpcResp.Affected,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/export"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type DownloadImportReportService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewDownloadImportReportService(ctx context.Context, c *app.RequestContext) *DownloadImportReportService {
+	return &DownloadImportReportService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+// Run 输出导入任务的逐行错误报告，任务失败时报告中只有失败原因
+func (s *DownloadImportReportService) Run(req *apiProduct.DownloadImportReportRequest) (resp *apiProduct.DownloadImportReportResponse, err error) {
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, err.Error())
+	}
+	rpcResp, err := rpc.ProductClient.GetImportJob(s.Context, &product.GetImportJobRequest{
+		Id:         req.Id,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+	job := rpcResp.Job
+
+	// 报告最多几千行，直接在内存中生成
+	var buf bytes.Buffer
+	w, err := export.NewWriter(format, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Write("Row", "Column", "Message"); err != nil {
+		return nil, err
+	}
+	if job.Error != "" {
+		if err := w.Write(nil, nil, job.Error); err != nil {
+			return nil, err
+		}
+	}
+	for _, e := range job.Errors {
+		if err := w.Write(e.Row, e.Column, e.Message); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	filename := "import_" + strconv.FormatUint(job.Id, 10) + "_report" + format.Ext()
+	s.RequestContext.Header("Content-Type", format.ContentType())
+	s.RequestContext.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	s.RequestContext.SetBodyStream(&buf, buf.Len())
+	return &apiProduct.DownloadImportReportResponse{}, nil
+}
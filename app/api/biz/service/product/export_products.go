@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/export"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+const productExportBatchSize = 200
+
+// productExportHeader 与商品导入的列一致，导出的文件可修改后重新导入
+var productExportHeader = []interface{}{
+	"spu_ref", "spu_name", "spu_sub_title", "spu_main_image", "category_id", "brand_id", "service_bits",
+	"description", "images", "videos", "market_tag_json", "tech_tag_json", "faq_json",
+	"sku_code", "sku_name", "sku_sub_title", "sku_main_image", "price", "market_price", "stock", "sku_spec_data",
+}
+
+// productPager 通过商品服务 ExportProducts 游标分批拉取商品
+type productPager struct {
+	ctx    context.Context
+	req    product.ExportProductsRequest
+	cursor uint64
+	done   bool
+}
+
+func (p *productPager) Next() ([]*product.ExportedProduct, error) {
+	if p.done {
+		return nil, nil
+	}
+	req := p.req
+	req.Cursor = p.cursor
+	req.Limit = productExportBatchSize
+	resp, err := rpc.ProductClient.ExportProducts(p.ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	p.cursor = resp.NextCursor
+	p.done = resp.NextCursor == 0
+	return resp.List, nil
+}
+
+// writeProductRows 每个 SKU 一行，商品与详情字段在每行重复；没有 SKU 的商品输出一行空 SKU
+func writeProductRows(w export.Writer, p *product.ExportedProduct) error {
+	spu := p.Spu
+	detail := p.Detail
+	if detail == nil {
+		detail = &product.ProductDetail{}
+	}
+	prefix := []interface{}{
+		strconv.FormatUint(spu.Id, 10), spu.Name, spu.SubTitle, spu.MainImage,
+		strconv.FormatUint(spu.CategoryId, 10), strconv.FormatUint(spu.BrandId, 10), spu.ServiceBits,
+		detail.Description, strings.Join(detail.Images, "|"), strings.Join(detail.Videos, "|"),
+		detail.MarketTagJson, detail.TechTagJson, detail.FaqJson,
+	}
+	if len(p.Skus) == 0 {
+		return w.Write(prefix...)
+	}
+	for _, sku := range p.Skus {
+		row := append(append([]interface{}{}, prefix...),
+			sku.SkuCode, sku.Name, sku.SubTitle, sku.MainImage, sku.Price, sku.MarketPrice, sku.Stock, sku.SkuSpecData)
+		if err := w.Write(row...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeProductExport 拉取全部商品写入 out，first 为已预取的第一批商品
+func writeProductExport(pager *productPager, first []*product.ExportedProduct, format export.Format, out io.Writer) error {
+	w, err := export.NewWriter(format, out)
+	if err != nil {
+		return err
+	}
+	if err := w.Write(productExportHeader...); err != nil {
+		return err
+	}
+	list := first
+	for {
+		for _, p := range list {
+			if err := writeProductRows(w, p); err != nil {
+				return err
+			}
+		}
+		if pager.done {
+			break
+		}
+		if list, err = pager.Next(); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+type ExportProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewExportProductsService(ctx context.Context, c *app.RequestContext) *ExportProductsService {
+	return &ExportProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+// Run 以流式响应输出导出文件
+func (s *ExportProductsService) Run(req *apiProduct.ExportProductsRequest) (resp *apiProduct.ExportProductsResponse, err error) {
+	format, err := export.ParseFormat(req.Format)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, err.Error())
+	}
+	// 未传状态时不筛选
+	publishStatus, verifyStatus := int32(-1), int32(-1)
+	if req.PublishStatus != nil {
+		publishStatus = *req.PublishStatus
+	}
+	if req.VerifyStatus != nil {
+		verifyStatus = *req.VerifyStatus
+	}
+
+	// 先同步拉取第一批，商品服务不可用时仍能返回 JSON 错误
+	pager := &productPager{ctx: s.Context, req: product.ExportProductsRequest{
+		Keyword:       req.Keyword,
+		CategoryId:    req.CategoryId,
+		BrandId:       req.BrandId,
+		PublishStatus: publishStatus,
+		VerifyStatus:  verifyStatus,
+		Deleted:       req.Deleted,
+		MerchantId:    merchantScope(s.Context, s.RequestContext),
+	}}
+	first, err := pager.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeProductExport(pager, first, format, pw))
+	}()
+
+	filename := "products_" + time.Now().Format("20060102150405") + format.Ext()
+	s.RequestContext.Header("Content-Type", format.ContentType())
+	s.RequestContext.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	s.RequestContext.SetBodyStream(pr, -1)
+	return &apiProduct.ExportProductsResponse{}, nil
+}
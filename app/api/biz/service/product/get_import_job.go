@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetImportJobService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetImportJobService(ctx context.Context, c *app.RequestContext) *GetImportJobService {
+	return &GetImportJobService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *GetImportJobService) Run(req *apiProduct.GetImportJobRequest) (resp *apiProduct.GetImportJobResponse, err error) {
+	rpcResp, err := rpc.ProductClient.GetImportJob(s.Context, &product.GetImportJobRequest{
+		Id:         req.Id,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiProduct.GetImportJobResponse{
+		Job: toImportJobDTO(rpcResp.Job),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetMediaService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetMediaService(ctx context.Context, c *app.RequestContext) *GetMediaService {
+	return &GetMediaService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *GetMediaService) Run(req *apiProduct.GetMediaRequest) (resp *apiProduct.GetMediaResponse, err error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "media id is required")
+	}
+	rpcResp, err := rpc.ProductClient.GetMediaAssets(s.Context, &product.GetMediaAssetsRequest{
+		Ids:        []uint64{req.Id},
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+	asset, ok := rpcResp.Assets[req.Id]
+	if !ok {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "media not found")
+	}
+
+	return &apiProduct.GetMediaResponse{
+		Asset: toMediaAssetDTO(asset),
+	}, nil
+}
@@ -73,20 +73,39 @@ func (s *GetProductDetailService) Run(req *apiProduct.GetProductDetailRequest) (
 		}
 	}
 
+	var ratingDTO *apiProduct.RatingDTO
+	if rpcResp.Rating != nil {
+		ratingDTO = &apiProduct.RatingDTO{
+			ReviewCount:   rpcResp.Rating.ReviewCount,
+			AverageRating: rpcResp.Rating.AverageRating,
+			RatingCounts:  rpcResp.Rating.RatingCounts,
+		}
+	}
+
+	specSelectors := make([]*apiProduct.SpecSelectorDTO, 0, len(rpcResp.SpecSelectors))
+	for _, sel := range rpcResp.SpecSelectors {
+		specSelectors = append(specSelectors, &apiProduct.SpecSelectorDTO{
+			Name:   sel.Name,
+			Values: sel.Values,
+		})
+	}
+
 	return &apiProduct.GetProductDetailResponse{
 		Product: &apiProduct.ProductDetailDTO{
-			Id:          rpcResp.Spu.Id,
-			Name:        rpcResp.Spu.Name,
-			SubTitle:    rpcResp.Spu.SubTitle,
-			MainImage:   rpcResp.Spu.MainImage,
-			LowPrice:    rpcResp.Spu.LowPrice,
-			HighPrice:   rpcResp.Spu.HighPrice,
-			SaleCount:   rpcResp.Spu.SaleCount,
-			ServiceBits: rpcResp.Spu.ServiceBits,
-			Category:    categoryDTO,
-			Brand:       brandDTO,
-			Skus:        skus,
-			Detail:      detailDTO,
+			Id:            rpcResp.Spu.Id,
+			Name:          rpcResp.Spu.Name,
+			SubTitle:      rpcResp.Spu.SubTitle,
+			MainImage:     rpcResp.Spu.MainImage,
+			LowPrice:      rpcResp.Spu.LowPrice,
+			HighPrice:     rpcResp.Spu.HighPrice,
+			SaleCount:     rpcResp.Spu.SaleCount,
+			ServiceBits:   rpcResp.Spu.ServiceBits,
+			Category:      categoryDTO,
+			Brand:         brandDTO,
+			Skus:          skus,
+			Detail:        detailDTO,
+			Rating:        ratingDTO,
+			SpecSelectors: specSelectors,
 		},
 	}, nil
 }
@@ -97,11 +116,14 @@ func convertCategory(cat *product.Category) *apiProduct.CategoryDTO {
 	}
 
 	result := &apiProduct.CategoryDTO{
-		Id:       cat.Id,
-		ParentId: cat.ParentId,
-		Name:     cat.Name,
-		Level:    cat.Level,
-		Icon:     cat.Icon,
+		Id:         cat.Id,
+		ParentId:   cat.ParentId,
+		Name:       cat.Name,
+		Level:      cat.Level,
+		Icon:       cat.Icon,
+		Unit:       cat.Unit,
+		Sort:       cat.Sort,
+		ShowStatus: cat.ShowStatus,
 	}
 
 	// 递归转换子分类
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetSearchReportService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetSearchReportService(ctx context.Context, c *app.RequestContext) *GetSearchReportService {
+	return &GetSearchReportService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *GetSearchReportService) Run(req *apiProduct.GetSearchReportRequest) (resp *apiProduct.GetSearchReportResponse, err error) {
+	return getSearchReport(s.Context, req, false)
+}
+
+type GetZeroResultReportService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetZeroResultReportService(ctx context.Context, c *app.RequestContext) *GetZeroResultReportService {
+	return &GetZeroResultReportService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *GetZeroResultReportService) Run(req *apiProduct.GetSearchReportRequest) (resp *apiProduct.GetSearchReportResponse, err error) {
+	return getSearchReport(s.Context, req, true)
+}
+
+func getSearchReport(ctx context.Context, req *apiProduct.GetSearchReportRequest, zeroResultOnly bool) (*apiProduct.GetSearchReportResponse, error) {
+	rpcResp, err := rpc.ProductClient.GetSearchReport(ctx, &product.GetSearchReportRequest{
+		Days:           req.Days,
+		Limit:          req.Limit,
+		ZeroResultOnly: zeroResultOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*apiProduct.SearchQueryStatDTO, 0, len(rpcResp.Items))
+	for _, st := range rpcResp.Items {
+		items = append(items, &apiProduct.SearchQueryStatDTO{
+			Keyword:         st.Keyword,
+			SearchCount:     st.SearchCount,
+			ZeroResultCount: st.ZeroResultCount,
+			AvgResultCount:  st.AvgResultCount,
+			PeriodUserSum:   st.PeriodUserSum,
+		})
+	}
+
+	return &apiProduct.GetSearchReportResponse{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetSpecTemplateService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetSpecTemplateService(ctx context.Context, c *app.RequestContext) *GetSpecTemplateService {
+	return &GetSpecTemplateService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *GetSpecTemplateService) Run(req *apiProduct.GetSpecTemplateRequest) (resp *apiProduct.GetSpecTemplateResponse, err error) {
+	rpcResp, err := rpc.ProductClient.GetSpecTemplate(s.Context, &product.GetSpecTemplateRequest{
+		CategoryId: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]*apiProduct.SpecAttributeDTO, 0, len(rpcResp.Attributes))
+	for _, a := range rpcResp.Attributes {
+		attributes = append(attributes, &apiProduct.SpecAttributeDTO{
+			CategoryId: a.CategoryId,
+			Name:       a.Name,
+			Type:       a.Type,
+			Values:     a.Values,
+			Filterable: a.Filterable,
+			Sales:      a.Sales,
+			Sort:       a.Sort,
+		})
+	}
+
+	return &apiProduct.GetSpecTemplateResponse{
+		Attributes: attributes,
+	}, nil
+}
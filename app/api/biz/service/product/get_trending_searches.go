@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type GetTrendingSearchesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewGetTrendingSearchesService(ctx context.Context, c *app.RequestContext) *GetTrendingSearchesService {
+	return &GetTrendingSearchesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *GetTrendingSearchesService) Run(req *apiProduct.GetTrendingSearchesRequest) (resp *apiProduct.GetTrendingSearchesResponse, err error) {
+	rpcResp, err := rpc.ProductClient.GetTrendingSearches(s.Context, &product.GetTrendingSearchesRequest{
+		Period: req.Period,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*apiProduct.TrendingSearchDTO, 0, len(rpcResp.Items))
+	for _, t := range rpcResp.Items {
+		items = append(items, &apiProduct.TrendingSearchDTO{
+			Keyword:     t.Keyword,
+			SearchCount: t.SearchCount,
+		})
+	}
+
+	return &apiProduct.GetTrendingSearchesResponse{
+		Items: items,
+	}, nil
+}
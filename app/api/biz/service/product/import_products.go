@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// maxImportFileSize 与商品服务的限制一致，提前拦截避免大文件进入 RPC
+const maxImportFileSize = 5 << 20
+
+type ImportProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewImportProductsService(ctx context.Context, c *app.RequestContext) *ImportProductsService {
+	return &ImportProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ImportProductsService) Run(req *apiProduct.ImportProductsRequest) (resp *apiProduct.ImportProductsResponse, err error) {
+	fh, err := s.RequestContext.FormFile("file")
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "file is required")
+	}
+	if fh.Size > maxImportFileSize {
+		return nil, errs.New(errs.ErrParam.Code, "file must be at most 5 MB")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "read file failed: "+err.Error())
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "read file failed: "+err.Error())
+	}
+
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	// 格式按文件扩展名判断
+	rpcResp, err := rpc.ProductClient.ImportProducts(s.Context, &product.ImportProductsRequest{
+		File:       data,
+		Format:     fh.Filename,
+		DryRun:     req.DryRun,
+		OperatorId: operatorID,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.ImportProductsResponse{
+		Job: toImportJobDTO(rpcResp.Job),
+	}, nil
+}
+
+func toImportJobDTO(job *product.ImportJob) *apiProduct.ImportJobDTO {
+	if job == nil {
+		return nil
+	}
+	errors := make([]*apiProduct.ImportRowErrorDTO, 0, len(job.Errors))
+	for _, e := range job.Errors {
+		errors = append(errors, &apiProduct.ImportRowErrorDTO{
+			Row:     e.Row,
+			Column:  e.Column,
+			Message: e.Message,
+		})
+	}
+	return &apiProduct.ImportJobDTO{
+		Id:              job.Id,
+		Format:          job.Format,
+		DryRun:          job.DryRun,
+		Status:          job.Status,
+		TotalRows:       job.TotalRows,
+		ErrorRows:       job.ErrorRows,
+		TotalProducts:   job.TotalProducts,
+		ValidProducts:   job.ValidProducts,
+		CreatedProducts: job.CreatedProducts,
+		Errors:          errors,
+		Error:           job.Error,
+		OperatorId:      job.OperatorId,
+		CreatedAt:       job.CreatedAt,
+		FinishedAt:      job.FinishedAt,
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListAuditQueueService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListAuditQueueService(ctx context.Context, c *app.RequestContext) *ListAuditQueueService {
+	return &ListAuditQueueService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListAuditQueueService) Run(req *apiProduct.ListAuditQueueRequest) (resp *apiProduct.ListAuditQueueResponse, err error) {
+	rpcResp, err := rpc.ProductClient.ListAuditQueue(s.Context, &product.ListAuditQueueRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.AdminSpuDTO, 0, len(rpcResp.List))
+	for _, spu := range rpcResp.List {
+		list = append(list, &apiProduct.AdminSpuDTO{
+			SpuId:         spu.Id,
+			Name:          spu.Name,
+			SubTitle:      spu.SubTitle,
+			MainImage:     spu.MainImage,
+			CategoryId:    spu.CategoryId,
+			BrandId:       spu.BrandId,
+			LowPrice:      spu.LowPrice,
+			HighPrice:     spu.HighPrice,
+			SaleCount:     spu.SaleCount,
+			Sort:          spu.Sort,
+			PublishStatus: spu.PublishStatus,
+			VerifyStatus:  spu.VerifyStatus,
+			Version:       spu.Version,
+		})
+	}
+
+	return &apiProduct.ListAuditQueueResponse{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
@@ -22,9 +22,32 @@ func NewListBrandsService(ctx context.Context, c *app.RequestContext) *ListBrand
 }
 
 func (s *ListBrandsService) Run(req *apiProduct.ListBrandsRequest) (resp *apiProduct.ListBrandsResponse, err error) {
-	rpcResp, err := rpc.ProductClient.ListBrands(s.Context, &product.ListBrandsRequest{
-		Page:     req.Page,
-		PageSize: req.PageSize,
+	return listBrands(s.Context, req, false)
+}
+
+type AdminListBrandsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminListBrandsService(ctx context.Context, c *app.RequestContext) *AdminListBrandsService {
+	return &AdminListBrandsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+// Run 后台品牌列表，包含隐藏的品牌及其关联分类
+func (s *AdminListBrandsService) Run(req *apiProduct.ListBrandsRequest) (resp *apiProduct.ListBrandsResponse, err error) {
+	return listBrands(s.Context, req, true)
+}
+
+func listBrands(ctx context.Context, req *apiProduct.ListBrandsRequest, includeHidden bool) (*apiProduct.ListBrandsResponse, error) {
+	rpcResp, err := rpc.ProductClient.ListBrands(ctx, &product.ListBrandsRequest{
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		CategoryId:    req.CategoryId,
+		IncludeHidden: includeHidden,
 	})
 	if err != nil {
 		return nil, err
@@ -38,6 +61,9 @@ func (s *ListBrandsService) Run(req *apiProduct.ListBrandsRequest) (resp *apiPro
 			Name:        brand.Name,
 			FirstLetter: brand.FirstLetter,
 			Logo:        brand.Logo,
+			Sort:        brand.Sort,
+			ShowStatus:  brand.ShowStatus,
+			CategoryIds: brand.CategoryIds,
 		})
 	}
 
@@ -22,8 +22,30 @@ func NewListCategoriesService(ctx context.Context, c *app.RequestContext) *ListC
 }
 
 func (s *ListCategoriesService) Run(req *apiProduct.ListCategoriesRequest) (resp *apiProduct.ListCategoriesResponse, err error) {
-	rpcResp, err := rpc.ProductClient.ListCategories(s.Context, &product.ListCategoriesRequest{
-		ParentId: req.ParentId,
+	return listCategories(s.Context, req, false)
+}
+
+type AdminListCategoriesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminListCategoriesService(ctx context.Context, c *app.RequestContext) *AdminListCategoriesService {
+	return &AdminListCategoriesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+// Run 后台分类树，包含隐藏的分类
+func (s *AdminListCategoriesService) Run(req *apiProduct.ListCategoriesRequest) (resp *apiProduct.ListCategoriesResponse, err error) {
+	return listCategories(s.Context, req, true)
+}
+
+func listCategories(ctx context.Context, req *apiProduct.ListCategoriesRequest, includeHidden bool) (*apiProduct.ListCategoriesResponse, error) {
+	rpcResp, err := rpc.ProductClient.ListCategories(ctx, &product.ListCategoriesRequest{
+		ParentId:      req.ParentId,
+		IncludeHidden: includeHidden,
 	})
 	if err != nil {
 		return nil, err
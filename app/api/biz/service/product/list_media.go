@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListMediaService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListMediaService(ctx context.Context, c *app.RequestContext) *ListMediaService {
+	return &ListMediaService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListMediaService) Run(req *apiProduct.ListMediaRequest) (resp *apiProduct.ListMediaResponse, err error) {
+	rpcResp, err := rpc.ProductClient.ListMediaAssets(s.Context, &product.ListMediaAssetsRequest{
+		Kind:       req.Kind,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.MediaAssetDTO, 0, len(rpcResp.List))
+	for _, a := range rpcResp.List {
+		list = append(list, toMediaAssetDTO(a))
+	}
+
+	return &apiProduct.ListMediaResponse{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
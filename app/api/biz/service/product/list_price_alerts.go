@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListPriceAlertsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListPriceAlertsService(ctx context.Context, c *app.RequestContext) *ListPriceAlertsService {
+	return &ListPriceAlertsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListPriceAlertsService) Run(req *apiProduct.ListPriceAlertsRequest) (resp *apiProduct.ListPriceAlertsResponse, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.ListPriceAlerts(s.Context, &product.ListPriceAlertsRequest{
+		UserId:   userID,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.PriceAlertDTO, 0, len(rpcResp.List))
+	for _, a := range rpcResp.List {
+		list = append(list, toPriceAlertDTO(a))
+	}
+
+	return &apiProduct.ListPriceAlertsResponse{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
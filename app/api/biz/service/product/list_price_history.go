@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListPriceHistoryService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListPriceHistoryService(ctx context.Context, c *app.RequestContext) *ListPriceHistoryService {
+	return &ListPriceHistoryService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListPriceHistoryService) Run(req *apiProduct.ListPriceHistoryRequest) (resp *apiProduct.ListPriceHistoryResponse, err error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu_id is required")
+	}
+	days := req.Days
+	if days <= 0 {
+		days = 180
+	}
+	if days > 365 {
+		days = 365
+	}
+
+	rpcResp, err := rpc.ProductClient.ListPriceHistory(s.Context, &product.ListPriceHistoryRequest{
+		SpuId: req.SpuId,
+		SkuId: req.SkuId,
+		Since: time.Now().AddDate(0, 0, -int(days)).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 不对外暴露操作人与变动来源
+	list := make([]*apiProduct.PriceChangeDTO, 0, len(rpcResp.List))
+	for _, c := range rpcResp.List {
+		list = append(list, &apiProduct.PriceChangeDTO{
+			SkuId:          c.SkuId,
+			OldPrice:       c.OldPrice,
+			NewPrice:       c.NewPrice,
+			OldMarketPrice: c.OldMarketPrice,
+			NewMarketPrice: c.NewMarketPrice,
+			ChangedAt:      c.CreatedAt,
+		})
+	}
+
+	return &apiProduct.ListPriceHistoryResponse{
+		List: list,
+	}, nil
+}
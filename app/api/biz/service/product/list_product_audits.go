@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListProductAuditsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListProductAuditsService(ctx context.Context, c *app.RequestContext) *ListProductAuditsService {
+	return &ListProductAuditsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListProductAuditsService) Run(req *apiProduct.ListProductAuditsRequest) (resp *apiProduct.ListProductAuditsResponse, err error) {
+	rpcResp, err := rpc.ProductClient.ListProductAudits(s.Context, &product.ListProductAuditsRequest{
+		SpuId: req.SpuId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.ProductAuditDTO, 0, len(rpcResp.List))
+	for _, a := range rpcResp.List {
+		list = append(list, &apiProduct.ProductAuditDTO{
+			Id:           a.Id,
+			SpuId:        a.SpuId,
+			VerifyStatus: a.VerifyStatus,
+			Reason:       a.Reason,
+			OperatorId:   a.OperatorId,
+			CreatedAt:    a.CreatedAt,
+		})
+	}
+
+	return &apiProduct.ListProductAuditsResponse{
+		List: list,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListProductHistoryService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListProductHistoryService(ctx context.Context, c *app.RequestContext) *ListProductHistoryService {
+	return &ListProductHistoryService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListProductHistoryService) Run(req *apiProduct.ListProductHistoryRequest) (resp *apiProduct.ListProductHistoryResponse, err error) {
+	rpcResp, err := rpc.ProductClient.ListProductHistory(s.Context, &product.ListProductHistoryRequest{
+		SpuId:      req.SpuId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.ProductVersionDTO, 0, len(rpcResp.List))
+	for _, v := range rpcResp.List {
+		changes := make([]*apiProduct.FieldChangeDTO, 0, len(v.Changes))
+		for _, c := range v.Changes {
+			changes = append(changes, &apiProduct.FieldChangeDTO{
+				Field:    c.Field,
+				OldValue: c.OldValue,
+				NewValue: c.NewValue,
+			})
+		}
+		list = append(list, &apiProduct.ProductVersionDTO{
+			Version:       v.Version,
+			OperatorId:    v.OperatorId,
+			Action:        v.Action,
+			Changes:       changes,
+			SourceVersion: v.SourceVersion,
+			CreatedAt:     v.CreatedAt,
+		})
+	}
+
+	return &apiProduct.ListProductHistoryResponse{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
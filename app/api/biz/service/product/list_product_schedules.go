@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListProductSchedulesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListProductSchedulesService(ctx context.Context, c *app.RequestContext) *ListProductSchedulesService {
+	return &ListProductSchedulesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListProductSchedulesService) Run(req *apiProduct.ListProductSchedulesRequest) (resp *apiProduct.ListProductSchedulesResponse, err error) {
+	// 未传状态时不筛选
+	status := int32(-1)
+	if req.Status != nil {
+		status = *req.Status
+	}
+
+	rpcResp, err := rpc.ProductClient.ListProductSchedules(s.Context, &product.ListProductSchedulesRequest{
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Status:     status,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.ProductScheduleDTO, 0, len(rpcResp.List))
+	for _, sch := range rpcResp.List {
+		list = append(list, &apiProduct.ProductScheduleDTO{
+			Id:          sch.Id,
+			TargetType:  sch.TargetType,
+			TargetId:    sch.TargetId,
+			Action:      sch.Action,
+			Price:       sch.Price,
+			MarketPrice: sch.MarketPrice,
+			RunAt:       sch.RunAt,
+			Status:      sch.Status,
+			Error:       sch.Error,
+			ExecutedAt:  sch.ExecutedAt,
+			OperatorId:  sch.OperatorId,
+			CreatedAt:   sch.CreatedAt,
+		})
+	}
+
+	return &apiProduct.ListProductSchedulesResponse{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"sync"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/conf"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/blob"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+var (
+	mediaStore     blob.Store
+	mediaStoreOnce sync.Once
+)
+
+// getMediaStore 按配置创建媒体文件存储
+func getMediaStore() blob.Store {
+	mediaStoreOnce.Do(func() {
+		m := conf.GetConf().Media
+		if m.Storage == "s3" {
+			mediaStore = blob.NewS3(blob.S3Config{
+				Endpoint:  m.S3.Endpoint,
+				Region:    m.S3.Region,
+				Bucket:    m.S3.Bucket,
+				AccessKey: m.S3.AccessKey,
+				SecretKey: m.S3.SecretKey,
+				PathStyle: m.S3.PathStyle,
+				PublicURL: m.S3.PublicURL,
+			})
+			return
+		}
+		mediaStore = blob.NewLocal(m.LocalDir(), m.LocalBaseURL())
+	})
+	return mediaStore
+}
+
+func toMediaAssetDTO(a *product.MediaAsset) *apiProduct.MediaAssetDTO {
+	if a == nil {
+		return nil
+	}
+	return &apiProduct.MediaAssetDTO{
+		Id:           a.Id,
+		Kind:         a.Kind,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		Width:        a.Width,
+		Height:       a.Height,
+		Url:          a.Url,
+		ThumbnailUrl: a.ThumbnailUrl,
+		WebpUrl:      a.WebpUrl,
+		OriginalName: a.OriginalName,
+		OperatorId:   a.OperatorId,
+		CreatedAt:    a.CreatedAt,
+	}
+}
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	perm "github.com/PiaoAdmin/pmall/common/rbac"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// merchantScope 返回商品后台接口的商家范围：拥有 product:any 权限时为 0，可查看和管理所有商品；
+// 否则为当前用户 ID，只能查看和管理自己的商品、导入任务与媒体资源，新建的商品也归属当前用户
+func merchantScope(ctx context.Context, c *app.RequestContext) uint64 {
+	if rbac.HasPermission(ctx, c, perm.PermProductAny) {
+		return 0
+	}
+	claims := jwt.ExtractClaims(ctx, c)
+	return uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type MoveCategoryService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewMoveCategoryService(ctx context.Context, c *app.RequestContext) *MoveCategoryService {
+	return &MoveCategoryService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *MoveCategoryService) Run(req *apiProduct.MoveCategoryRequest) (resp *apiProduct.MoveCategoryResponse, err error) {
+	rpcResp, err := rpc.ProductClient.MoveCategory(s.Context, &product.MoveCategoryRequest{
+		Id:       req.Id,
+		ParentId: req.ParentId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.MoveCategoryResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type PublishProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewPublishProductsService(ctx context.Context, c *app.RequestContext) *PublishProductsService {
+	return &PublishProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *PublishProductsService) Run(req *apiProduct.PublishProductsRequest) (resp *apiProduct.PublishProductsResponse, err error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+
+	rpcResp, err := rpc.ProductClient.UpdateProductStatus(s.Context, &product.UpdateProductStatusRequest{
+		Ids:           req.Ids,
+		PublishStatus: 1, // 上架
+		MerchantId:    merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.PublishProductsResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type RestoreProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewRestoreProductsService(ctx context.Context, c *app.RequestContext) *RestoreProductsService {
+	return &RestoreProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *RestoreProductsService) Run(req *apiProduct.RestoreProductsRequest) (resp *apiProduct.RestoreProductsResponse, err error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+
+	rpcResp, err := rpc.ProductClient.RestoreProduct(s.Context, &product.RestoreProductRequest{
+		Ids:        req.Ids,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.RestoreProductsResponse{
+		Affected: rpcResp.Affected,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type RollbackProductService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewRollbackProductService(ctx context.Context, c *app.RequestContext) *RollbackProductService {
+	return &RollbackProductService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *RollbackProductService) Run(req *apiProduct.RollbackProductRequest) (resp *apiProduct.RollbackProductResponse, err error) {
+	if req.Version <= 0 {
+		return nil, errs.New(errs.ErrParam.Code, "version is required")
+	}
+
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.RollbackProduct(s.Context, &product.RollbackProductRequest{
+		SpuId:      req.SpuId,
+		Version:    req.Version,
+		OperatorId: operatorID,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.RollbackProductResponse{
+		Version: rpcResp.Version,
+	}, nil
+}
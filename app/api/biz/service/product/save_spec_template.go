@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SaveSpecTemplateService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSaveSpecTemplateService(ctx context.Context, c *app.RequestContext) *SaveSpecTemplateService {
+	return &SaveSpecTemplateService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SaveSpecTemplateService) Run(req *apiProduct.SaveSpecTemplateRequest) (resp *apiProduct.SaveSpecTemplateResponse, err error) {
+	attributes := make([]*product.SpecAttribute, 0, len(req.Attributes))
+	for _, a := range req.Attributes {
+		attributes = append(attributes, &product.SpecAttribute{
+			Name:       a.Name,
+			Type:       a.Type,
+			Values:     a.Values,
+			Filterable: a.Filterable,
+			Sales:      a.Sales,
+			Sort:       a.Sort,
+		})
+	}
+
+	rpcResp, err := rpc.ProductClient.SaveSpecTemplate(s.Context, &product.SaveSpecTemplateRequest{
+		CategoryId: req.Id,
+		Attributes: attributes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.SaveSpecTemplateResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
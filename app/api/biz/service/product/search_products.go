@@ -4,6 +4,7 @@ import (
 	"context"
 
 	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
 	"github.com/PiaoAdmin/pmall/app/api/rpc"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/hertz/pkg/app"
@@ -31,6 +32,7 @@ func (s *SearchProductsService) Run(req *apiProduct.SearchProductsRequest) (resp
 		MinPrice:   req.MinPrice,
 		MaxPrice:   req.MaxPrice,
 		SortType:   req.SortType,
+		UserId:     jwt.OptionalUserID(s.Context, s.RequestContext),
 	})
 	if err != nil {
 		return nil, err
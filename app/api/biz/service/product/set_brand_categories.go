@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SetBrandCategoriesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSetBrandCategoriesService(ctx context.Context, c *app.RequestContext) *SetBrandCategoriesService {
+	return &SetBrandCategoriesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SetBrandCategoriesService) Run(req *apiProduct.SetBrandCategoriesRequest) (resp *apiProduct.SetBrandCategoriesResponse, err error) {
+	rpcResp, err := rpc.ProductClient.SetBrandCategories(s.Context, &product.SetBrandCategoriesRequest{
+		BrandId:     req.Id,
+		CategoryIds: req.CategoryIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.SetBrandCategoriesResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SetCategoryVisibilityService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSetCategoryVisibilityService(ctx context.Context, c *app.RequestContext) *SetCategoryVisibilityService {
+	return &SetCategoryVisibilityService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SetCategoryVisibilityService) Run(req *apiProduct.SetCategoryVisibilityRequest) (resp *apiProduct.SetCategoryVisibilityResponse, err error) {
+	rpcResp, err := rpc.ProductClient.SetCategoryVisibility(s.Context, &product.SetCategoryVisibilityRequest{
+		Id:         req.Id,
+		ShowStatus: req.ShowStatus,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.SetCategoryVisibilityResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SortCategoriesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSortCategoriesService(ctx context.Context, c *app.RequestContext) *SortCategoriesService {
+	return &SortCategoriesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SortCategoriesService) Run(req *apiProduct.SortCategoriesRequest) (resp *apiProduct.SortCategoriesResponse, err error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+
+	rpcResp, err := rpc.ProductClient.SortCategories(s.Context, &product.SortCategoriesRequest{
+		ParentId: req.ParentId,
+		Ids:      req.Ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.SortCategoriesResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
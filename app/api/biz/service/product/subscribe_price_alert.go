@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SubscribePriceAlertService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSubscribePriceAlertService(ctx context.Context, c *app.RequestContext) *SubscribePriceAlertService {
+	return &SubscribePriceAlertService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SubscribePriceAlertService) Run(req *apiProduct.SubscribePriceAlertRequest) (resp *apiProduct.SubscribePriceAlertResponse, err error) {
+	if req.SkuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "sku_id is required")
+	}
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.SubscribePriceAlert(s.Context, &product.SubscribePriceAlertRequest{
+		UserId:      userID,
+		SkuId:       req.SkuId,
+		TargetPrice: req.TargetPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.SubscribePriceAlertResponse{
+		Alert: toPriceAlertDTO(rpcResp.Alert),
+	}, nil
+}
+
+func toPriceAlertDTO(a *product.PriceAlert) *apiProduct.PriceAlertDTO {
+	if a == nil {
+		return nil
+	}
+	return &apiProduct.PriceAlertDTO{
+		Id:            a.Id,
+		SkuId:         a.SkuId,
+		SpuId:         a.SpuId,
+		SkuName:       a.SkuName,
+		MainImage:     a.MainImage,
+		CurrentPrice:  a.CurrentPrice,
+		TargetPrice:   a.TargetPrice,
+		Status:        a.Status,
+		NotifiedPrice: a.NotifiedPrice,
+		NotifiedAt:    a.NotifiedAt,
+		CreatedAt:     a.CreatedAt,
+	}
+}
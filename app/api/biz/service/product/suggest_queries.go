@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type SuggestQueriesService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewSuggestQueriesService(ctx context.Context, c *app.RequestContext) *SuggestQueriesService {
+	return &SuggestQueriesService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *SuggestQueriesService) Run(req *apiProduct.SuggestQueriesRequest) (resp *apiProduct.SuggestQueriesResponse, err error) {
+	rpcResp, err := rpc.ProductClient.SuggestQueries(s.Context, &product.SuggestQueriesRequest{
+		Prefix: req.Prefix,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiProduct.QuerySuggestionDTO, 0, len(rpcResp.Suggestions))
+	for _, sg := range rpcResp.Suggestions {
+		list = append(list, &apiProduct.QuerySuggestionDTO{
+			Text: sg.Text,
+			Type: sg.Type,
+		})
+	}
+
+	return &apiProduct.SuggestQueriesResponse{
+		Suggestions: list,
+	}, nil
+}
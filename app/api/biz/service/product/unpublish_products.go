@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UnpublishProductsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUnpublishProductsService(ctx context.Context, c *app.RequestContext) *UnpublishProductsService {
+	return &UnpublishProductsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *UnpublishProductsService) Run(req *apiProduct.UnpublishProductsRequest) (resp *apiProduct.UnpublishProductsResponse, err error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+
+	rpcResp, err := rpc.ProductClient.UpdateProductStatus(s.Context, &product.UpdateProductStatusRequest{
+		Ids:           req.Ids,
+		PublishStatus: 2, // 下架
+		MerchantId:    merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.UnpublishProductsResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpdateBrandService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateBrandService(ctx context.Context, c *app.RequestContext) *UpdateBrandService {
+	return &UpdateBrandService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *UpdateBrandService) Run(req *apiProduct.UpdateBrandRequest) (resp *apiProduct.UpdateBrandResponse, err error) {
+	sort := int32(-1) // -1 表示不变更
+	if req.Sort != nil {
+		if *req.Sort < 0 {
+			return nil, errs.New(errs.ErrParam.Code, "sort must not be negative")
+		}
+		sort = *req.Sort
+	}
+	showStatus := int32(0) // 0 表示不变更
+	if req.ShowStatus != nil {
+		showStatus = 2
+		if *req.ShowStatus {
+			showStatus = 1
+		}
+	}
+
+	rpcResp, err := rpc.ProductClient.UpdateBrand(s.Context, &product.UpdateBrandRequest{
+		Id:          req.Id,
+		Name:        req.Name,
+		FirstLetter: req.FirstLetter,
+		Logo:        req.Logo,
+		Sort:        sort,
+		ShowStatus:  showStatus,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.UpdateBrandResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpdateCategoryService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateCategoryService(ctx context.Context, c *app.RequestContext) *UpdateCategoryService {
+	return &UpdateCategoryService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *UpdateCategoryService) Run(req *apiProduct.UpdateCategoryRequest) (resp *apiProduct.UpdateCategoryResponse, err error) {
+	rpcResp, err := rpc.ProductClient.UpdateCategory(s.Context, &product.UpdateCategoryRequest{
+		Id:   req.Id,
+		Name: req.Name,
+		Icon: req.Icon,
+		Unit: req.Unit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.UpdateCategoryResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpdateProductService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateProductService(ctx context.Context, c *app.RequestContext) *UpdateProductService {
+	return &UpdateProductService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *UpdateProductService) Run(req *apiProduct.UpdateProductRequest) (resp *apiProduct.UpdateProductResponse, err error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu_id is required")
+	}
+	if req.Spu == nil && req.Detail == nil {
+		return nil, errs.New(errs.ErrParam.Code, "nothing to update")
+	}
+
+	// 只传递非空字段，RPC 忽略零值
+	spu := &product.ProductSPU{Id: req.SpuId}
+	if req.Spu != nil {
+		spu.BrandId = req.Spu.BrandId
+		spu.CategoryId = req.Spu.CategoryId
+		spu.Name = req.Spu.Name
+		spu.SubTitle = req.Spu.SubTitle
+		spu.MainImage = req.Spu.MainImage
+		spu.Sort = req.Spu.Sort
+		spu.ServiceBits = req.Spu.ServiceBits
+		spu.MainImageAssetId = req.Spu.MainImageAssetId
+	}
+
+	var detail *product.ProductDetail
+	if req.Detail != nil {
+		detail = &product.ProductDetail{
+			Description:   req.Detail.Description,
+			Images:        req.Detail.Images,
+			Videos:        req.Detail.Videos,
+			MarketTagJson: req.Detail.MarketTagJson,
+			TechTagJson:   req.Detail.TechTagJson,
+			ImageAssetIds: req.Detail.ImageAssetIds,
+			VideoAssetIds: req.Detail.VideoAssetIds,
+		}
+	}
+
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.UpdateProduct(s.Context, &product.UpdateProductRequest{
+		Spu:        spu,
+		Detail:     detail,
+		OperatorId: operatorID,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.UpdateProductResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
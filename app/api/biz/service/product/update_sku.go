@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpdateSkuService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpdateSkuService(ctx context.Context, c *app.RequestContext) *UpdateSkuService {
+	return &UpdateSkuService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *UpdateSkuService) Run(req *apiProduct.UpdateSkuRequest) (resp *apiProduct.UpdateSkuResponse, err error) {
+	if req.SkuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "sku_id is required")
+	}
+
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ProductClient.BatchUpdateSku(s.Context, &product.BatchUpdateSkuRequest{
+		Skus: []*product.ProductSKU{{
+			Id:               req.SkuId,
+			Name:             req.Name,
+			SubTitle:         req.SubTitle,
+			MainImage:        req.MainImage,
+			Price:            req.Price,
+			MarketPrice:      req.MarketPrice,
+			SkuSpecData:      req.SkuSpecData,
+			Stock:            -1, // 库存不在此接口修改
+			MainImageAssetId: req.MainImageAssetId,
+		}},
+		OperatorId: operatorID,
+		MerchantId: merchantScope(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiProduct.UpdateSkuResponse{
+		Success: rpcResp.Success,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"time"
+
+	apiProduct "github.com/PiaoAdmin/pmall/app/api/biz/model/api/product"
+	"github.com/PiaoAdmin/pmall/app/api/conf"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/imaging"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/common/uniqueid"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+)
+
+const (
+	// maxImageDimension 限制图片宽高，避免小文件解码出超大位图
+	maxImageDimension = 8000
+	thumbnailSize     = 320
+	webpSize          = 1200
+)
+
+// mediaTypes 允许上传的类型，按文件内容识别，不信任客户端声明的 Content-Type
+var mediaTypes = map[string]struct {
+	kind string
+	ext  string
+}{
+	"image/jpeg": {"image", ".jpg"},
+	"image/png":  {"image", ".png"},
+	"image/gif":  {"image", ".gif"},
+	"video/mp4":  {"video", ".mp4"},
+	"video/webm": {"video", ".webm"},
+}
+
+type UploadMediaService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUploadMediaService(ctx context.Context, c *app.RequestContext) *UploadMediaService {
+	return &UploadMediaService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+// Run 保存上传的文件，图片额外生成缩略图与 WebP 版本，全部写入存储后再登记到商品服务
+func (s *UploadMediaService) Run(req *apiProduct.UploadMediaRequest) (resp *apiProduct.UploadMediaResponse, err error) {
+	fh, err := s.RequestContext.FormFile("file")
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "file is required")
+	}
+	cfg := conf.GetConf().Media
+	if fh.Size > cfg.MaxVideoSize() && fh.Size > cfg.MaxImageSize() {
+		return nil, errs.New(errs.ErrParam.Code, "file too large")
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "read file failed: "+err.Error())
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "read file failed: "+err.Error())
+	}
+
+	contentType := http.DetectContentType(data)
+	mt, ok := mediaTypes[contentType]
+	if !ok {
+		return nil, errs.New(errs.ErrParam.Code, "unsupported file type: "+contentType)
+	}
+	limit := cfg.MaxImageSize()
+	if mt.kind == "video" {
+		limit = cfg.MaxVideoSize()
+	}
+	if int64(len(data)) > limit {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("%s must be at most %d MB", mt.kind, limit>>20))
+	}
+
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	operatorID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	// key 形如 images/2024/05/<id>.jpg，变体与原文件同名
+	base := fmt.Sprintf("%ss/%s/%d", mt.kind, time.Now().Format("2006/01"), uniqueid.GenId())
+	asset := &product.MediaAsset{
+		Kind:         mt.kind,
+		ContentType:  contentType,
+		Size:         int64(len(data)),
+		StorageKey:   base + mt.ext,
+		OriginalName: fh.Filename,
+		OperatorId:   operatorID,
+	}
+	files := []mediaFile{{key: asset.StorageKey, contentType: contentType, data: data}}
+	if mt.kind == "image" {
+		variants, width, height, err := imageVariants(data, base)
+		if err != nil {
+			return nil, err
+		}
+		asset.Width, asset.Height = int32(width), int32(height)
+		files = append(files, variants...)
+	}
+
+	store := getMediaStore()
+	var written []string
+	cleanup := func() {
+		for _, key := range written {
+			if err := store.Delete(context.Background(), key); err != nil {
+				hlog.CtxWarnf(s.Context, "delete media %s failed: %v", key, err)
+			}
+		}
+	}
+	for _, file := range files {
+		if err := store.Put(s.Context, file.key, file.contentType, file.data); err != nil {
+			cleanup()
+			return nil, errs.New(errs.ErrInternal.Code, "store media failed: "+err.Error())
+		}
+		written = append(written, file.key)
+	}
+	asset.Url = store.URL(asset.StorageKey)
+	if mt.kind == "image" {
+		asset.ThumbnailUrl = store.URL(base + "_thumb.jpg")
+		asset.WebpUrl = store.URL(base + ".webp")
+	}
+
+	rpcResp, err := rpc.ProductClient.CreateMediaAsset(s.Context, &product.CreateMediaAssetRequest{Asset: asset})
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	return &apiProduct.UploadMediaResponse{
+		Asset: toMediaAssetDTO(rpcResp.Asset),
+	}, nil
+}
+
+type mediaFile struct {
+	key         string
+	contentType string
+	data        []byte
+}
+
+// imageVariants 解码图片并生成缩略图 (<base>_thumb.jpg) 与 WebP (<base>.webp)
+func imageVariants(data []byte, base string) ([]mediaFile, int, int, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, errs.New(errs.ErrParam.Code, "invalid image: "+err.Error())
+	}
+	if cfg.Width > maxImageDimension || cfg.Height > maxImageDimension {
+		return nil, 0, 0, errs.New(errs.ErrParam.Code, fmt.Sprintf("image must be at most %dx%d", maxImageDimension, maxImageDimension))
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, errs.New(errs.ErrParam.Code, "invalid image: "+err.Error())
+	}
+
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, imaging.Fit(img, thumbnailSize, thumbnailSize), &jpeg.Options{Quality: 80}); err != nil {
+		return nil, 0, 0, errs.New(errs.ErrInternal.Code, "encode thumbnail failed: "+err.Error())
+	}
+	var webp bytes.Buffer
+	if err := imaging.EncodeWebP(&webp, imaging.Fit(img, webpSize, webpSize)); err != nil {
+		return nil, 0, 0, errs.New(errs.ErrInternal.Code, "encode webp failed: "+err.Error())
+	}
+	return []mediaFile{
+		{key: base + "_thumb.jpg", contentType: "image/jpeg", data: thumb.Bytes()},
+		{key: base + ".webp", contentType: "image/webp", data: webp.Bytes()},
+	}, cfg.Width, cfg.Height, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type AdminListAnswersService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminListAnswersService(ctx context.Context, c *app.RequestContext) *AdminListAnswersService {
+	return &AdminListAnswersService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *AdminListAnswersService) Run(req *apiReview.AdminListAnswersReq) (resp *apiReview.AdminListAnswersResp, err error) {
+	status, err := parseStatusFilter(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := rpc.ReviewClient.AdminListAnswers(s.Context, &review.AdminListAnswersRequest{
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		Status:     status,
+		QuestionId: req.QuestionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiReview.AdminAnswerDTO, 0, len(rpcResp.List))
+	for _, a := range rpcResp.List {
+		list = append(list, &apiReview.AdminAnswerDTO{
+			Id:           a.Id,
+			QuestionId:   a.QuestionId,
+			SpuId:        a.SpuId,
+			UserId:       a.UserId,
+			Content:      a.Content,
+			IsMerchant:   a.IsMerchant,
+			Upvotes:      a.Upvotes,
+			Status:       a.Status,
+			RejectReason: a.RejectReason,
+			CreatedAt:    a.CreatedAt,
+		})
+	}
+
+	return &apiReview.AdminListAnswersResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type AdminListQuestionsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminListQuestionsService(ctx context.Context, c *app.RequestContext) *AdminListQuestionsService {
+	return &AdminListQuestionsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *AdminListQuestionsService) Run(req *apiReview.AdminListQuestionsReq) (resp *apiReview.AdminListQuestionsResp, err error) {
+	status, err := parseStatusFilter(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := rpc.ReviewClient.AdminListQuestions(s.Context, &review.AdminListQuestionsRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Status:   status,
+		SpuId:    req.SpuId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiReview.AdminQuestionDTO, 0, len(rpcResp.List))
+	for _, q := range rpcResp.List {
+		list = append(list, &apiReview.AdminQuestionDTO{
+			Id:           q.Id,
+			SpuId:        q.SpuId,
+			UserId:       q.UserId,
+			Content:      q.Content,
+			Status:       q.Status,
+			RejectReason: q.RejectReason,
+			AnswerCount:  q.AnswerCount,
+			CreatedAt:    q.CreatedAt,
+		})
+	}
+
+	return &apiReview.AdminListQuestionsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type AdminListReviewsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewAdminListReviewsService(ctx context.Context, c *app.RequestContext) *AdminListReviewsService {
+	return &AdminListReviewsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *AdminListReviewsService) Run(req *apiReview.AdminListReviewsReq) (resp *apiReview.AdminListReviewsResp, err error) {
+	status, err := parseStatusFilter(req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := rpc.ReviewClient.AdminListReviews(s.Context, &review.AdminListReviewsRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Status:   status,
+		SpuId:    req.SpuId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiReview.AdminReviewDTO, 0, len(rpcResp.List))
+	for _, r := range rpcResp.List {
+		list = append(list, &apiReview.AdminReviewDTO{
+			Id:           r.Id,
+			SpuId:        r.SpuId,
+			SkuId:        r.SkuId,
+			SkuName:      r.SkuName,
+			UserId:       r.UserId,
+			OrderId:      r.OrderId,
+			Rating:       r.Rating,
+			Content:      r.Content,
+			Images:       r.Images,
+			Tags:         r.Tags,
+			Status:       r.Status,
+			RejectReason: r.RejectReason,
+			CreatedAt:    r.CreatedAt,
+		})
+	}
+
+	return &apiReview.AdminListReviewsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
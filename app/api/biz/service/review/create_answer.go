@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/md/rbac"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	perm "github.com/PiaoAdmin/pmall/common/rbac"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateAnswerService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateAnswerService(ctx context.Context, c *app.RequestContext) *CreateAnswerService {
+	return &CreateAnswerService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CreateAnswerService) Run(req *apiReview.CreateAnswerReq) (resp *apiReview.CreateAnswerResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	// 可管理所有商品的运营以官方身份回答；商家只有回答自己的商品时才以商家身份回答，由评价服务校验归属
+	answerReq := &review.CreateAnswerRequest{
+		UserId:     userID,
+		QuestionId: req.QuestionId,
+		Content:    req.Content,
+		IsMerchant: rbac.HasPermission(s.Context, s.RequestContext, perm.PermProductAny),
+	}
+	if !answerReq.IsMerchant && rbac.HasPermission(s.Context, s.RequestContext, perm.PermProductWrite) {
+		answerReq.MerchantId = userID
+	}
+	rpcResp, err := rpc.ReviewClient.CreateAnswer(s.Context, answerReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.CreateAnswerResp{
+		AnswerId: rpcResp.AnswerId,
+		Status:   rpcResp.Status,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateQuestionService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateQuestionService(ctx context.Context, c *app.RequestContext) *CreateQuestionService {
+	return &CreateQuestionService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CreateQuestionService) Run(req *apiReview.CreateQuestionReq) (resp *apiReview.CreateQuestionResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ReviewClient.CreateQuestion(s.Context, &review.CreateQuestionRequest{
+		UserId:  userID,
+		SpuId:   req.SpuId,
+		Content: req.Content,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.CreateQuestionResp{
+		QuestionId: rpcResp.QuestionId,
+		Status:     rpcResp.Status,
+	}, nil
+}
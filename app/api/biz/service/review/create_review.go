@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type CreateReviewService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewCreateReviewService(ctx context.Context, c *app.RequestContext) *CreateReviewService {
+	return &CreateReviewService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *CreateReviewService) Run(req *apiReview.CreateReviewReq) (resp *apiReview.CreateReviewResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ReviewClient.CreateReview(s.Context, &review.CreateReviewRequest{
+		UserId:  userID,
+		OrderId: req.OrderId,
+		SkuId:   req.SkuId,
+		Rating:  req.Rating,
+		Content: req.Content,
+		Images:  req.Images,
+		Tags:    req.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.CreateReviewResp{
+		ReviewId: rpcResp.ReviewId,
+		Status:   rpcResp.Status,
+	}, nil
+}
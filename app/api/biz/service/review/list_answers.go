@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListAnswersService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListAnswersService(ctx context.Context, c *app.RequestContext) *ListAnswersService {
+	return &ListAnswersService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListAnswersService) Run(req *apiReview.ListAnswersReq) (resp *apiReview.ListAnswersResp, err error) {
+	rpcResp, err := rpc.ReviewClient.ListAnswers(s.Context, &review.ListAnswersRequest{
+		QuestionId: req.QuestionId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		UserId:     jwt.OptionalUserID(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.ListAnswersResp{
+		List:  convertAnswers(rpcResp.List),
+		Total: rpcResp.Total,
+	}, nil
+}
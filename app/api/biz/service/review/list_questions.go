@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListQuestionsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListQuestionsService(ctx context.Context, c *app.RequestContext) *ListQuestionsService {
+	return &ListQuestionsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListQuestionsService) Run(req *apiReview.ListQuestionsReq) (resp *apiReview.ListQuestionsResp, err error) {
+	rpcResp, err := rpc.ReviewClient.ListQuestions(s.Context, &review.ListQuestionsRequest{
+		SpuId:    req.SpuId,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		UserId:   jwt.OptionalUserID(s.Context, s.RequestContext),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiReview.QuestionDTO, 0, len(rpcResp.List))
+	for _, q := range rpcResp.List {
+		list = append(list, &apiReview.QuestionDTO{
+			Id:          q.Id,
+			SpuId:       q.SpuId,
+			Content:     q.Content,
+			AnswerCount: q.AnswerCount,
+			CreatedAt:   q.CreatedAt,
+			TopAnswers:  convertAnswers(q.TopAnswers),
+		})
+	}
+
+	return &apiReview.ListQuestionsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
+
+func convertAnswers(answers []*review.Answer) []*apiReview.AnswerDTO {
+	list := make([]*apiReview.AnswerDTO, 0, len(answers))
+	for _, a := range answers {
+		list = append(list, &apiReview.AnswerDTO{
+			Id:         a.Id,
+			QuestionId: a.QuestionId,
+			Content:    a.Content,
+			IsMerchant: a.IsMerchant,
+			Upvotes:    a.Upvotes,
+			Upvoted:    a.Upvoted,
+			CreatedAt:  a.CreatedAt,
+		})
+	}
+	return list
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ListReviewsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewListReviewsService(ctx context.Context, c *app.RequestContext) *ListReviewsService {
+	return &ListReviewsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ListReviewsService) Run(req *apiReview.ListReviewsReq) (resp *apiReview.ListReviewsResp, err error) {
+	rpcResp, err := rpc.ReviewClient.ListReviews(s.Context, &review.ListReviewsRequest{
+		SpuId:      req.SpuId,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		Rating:     req.Rating,
+		WithImages: req.WithImages,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*apiReview.ReviewDTO, 0, len(rpcResp.List))
+	for _, r := range rpcResp.List {
+		list = append(list, &apiReview.ReviewDTO{
+			Id:        r.Id,
+			SpuId:     r.SpuId,
+			SkuId:     r.SkuId,
+			SkuName:   r.SkuName,
+			Rating:    r.Rating,
+			Content:   r.Content,
+			Images:    r.Images,
+			Tags:      r.Tags,
+			CreatedAt: r.CreatedAt,
+		})
+	}
+
+	return &apiReview.ListReviewsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ModerateAnswersService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewModerateAnswersService(ctx context.Context, c *app.RequestContext) *ModerateAnswersService {
+	return &ModerateAnswersService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ModerateAnswersService) Run(req *apiReview.ModerateQaReq) (resp *apiReview.ModerateQaResp, err error) {
+	status, err := parseModerateAction(req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := rpc.ReviewClient.ModerateAnswers(s.Context, &review.ModerateAnswersRequest{
+		Ids:          req.Ids,
+		Status:       status,
+		RejectReason: req.RejectReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.ModerateQaResp{Affected: rpcResp.Affected}, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ModerateQuestionsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewModerateQuestionsService(ctx context.Context, c *app.RequestContext) *ModerateQuestionsService {
+	return &ModerateQuestionsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ModerateQuestionsService) Run(req *apiReview.ModerateQaReq) (resp *apiReview.ModerateQaResp, err error) {
+	status, err := parseModerateAction(req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := rpc.ReviewClient.ModerateQuestions(s.Context, &review.ModerateQuestionsRequest{
+		Ids:          req.Ids,
+		Status:       status,
+		RejectReason: req.RejectReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.ModerateQaResp{Affected: rpcResp.Affected}, nil
+}
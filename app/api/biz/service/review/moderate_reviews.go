@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type ModerateReviewsService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewModerateReviewsService(ctx context.Context, c *app.RequestContext) *ModerateReviewsService {
+	return &ModerateReviewsService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *ModerateReviewsService) Run(req *apiReview.ModerateReviewsReq) (resp *apiReview.ModerateReviewsResp, err error) {
+	status, err := parseModerateAction(req.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := rpc.ReviewClient.ModerateReviews(s.Context, &review.ModerateReviewsRequest{
+		Ids:          req.Ids,
+		Status:       status,
+		RejectReason: req.RejectReason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.ModerateReviewsResp{Affected: rpcResp.Affected}, nil
+}
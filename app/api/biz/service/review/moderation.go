@@ -0,0 +1,36 @@
+package service
+
+import (
+	perrors "github.com/PiaoAdmin/pmall/common/errs"
+)
+
+// reviewStatus 审核状态名称与 RPC 状态值的对应关系，评价与问答共用
+var reviewStatus = map[string]int32{
+	"pending":  0,
+	"approved": 1,
+	"rejected": 2,
+}
+
+// parseStatusFilter 将状态名称转换为 RPC 过滤值，为空时返回 -1 (全部)
+func parseStatusFilter(name string) (int32, error) {
+	if name == "" {
+		return -1, nil
+	}
+	v, ok := reviewStatus[name]
+	if !ok {
+		return 0, perrors.New(perrors.ErrParam.Code, "status must be pending, approved or rejected")
+	}
+	return v, nil
+}
+
+// parseModerateAction 将审核动作转换为目标状态
+func parseModerateAction(action string) (int32, error) {
+	switch action {
+	case "approve":
+		return reviewStatus["approved"], nil
+	case "reject":
+		return reviewStatus["rejected"], nil
+	default:
+		return 0, perrors.New(perrors.ErrParam.Code, "action must be approve or reject")
+	}
+}
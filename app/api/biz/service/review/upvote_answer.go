@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	apiReview "github.com/PiaoAdmin/pmall/app/api/biz/model/api/review"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+type UpvoteAnswerService struct {
+	RequestContext *app.RequestContext
+	Context        context.Context
+}
+
+func NewUpvoteAnswerService(ctx context.Context, c *app.RequestContext) *UpvoteAnswerService {
+	return &UpvoteAnswerService{
+		RequestContext: c,
+		Context:        ctx,
+	}
+}
+
+func (s *UpvoteAnswerService) Run(req *apiReview.UpvoteAnswerReq) (resp *apiReview.UpvoteAnswerResp, err error) {
+	claims := jwt.ExtractClaims(s.Context, s.RequestContext)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+
+	rpcResp, err := rpc.ReviewClient.UpvoteAnswer(s.Context, &review.UpvoteAnswerRequest{
+		UserId:   userID,
+		AnswerId: req.AnswerId,
+		Cancel:   req.Cancel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiReview.UpvoteAnswerResp{Upvotes: rpcResp.Upvotes}, nil
+}
@@ -19,10 +19,83 @@ var (
 type Config struct {
 	Env string
 
-	Hertz Hertz `yaml:"hertz"`
-	MySQL MySQL `yaml:"mysql"`
-	Redis Redis `yaml:"redis"`
-	JWT   JWT   `yaml:"jwt"`
+	Hertz  Hertz  `yaml:"hertz"`
+	MySQL  MySQL  `yaml:"mysql"`
+	Redis  Redis  `yaml:"redis"`
+	JWT    JWT    `yaml:"jwt"`
+	Export Export `yaml:"export"`
+	Media  Media  `yaml:"media"`
+}
+
+// Media 商品图片/视频上传配置
+type Media struct {
+	Storage        string  `yaml:"storage"`           // local(默认) / s3
+	Dir            string  `yaml:"dir"`               // local 存储目录
+	BaseURL        string  `yaml:"base_url"`          // local 存储的访问路径前缀，默认 /media
+	MaxImageSizeMB int     `yaml:"max_image_size_mb"` // 默认 10
+	MaxVideoSizeMB int     `yaml:"max_video_size_mb"` // 默认 50
+	S3             MediaS3 `yaml:"s3"`
+}
+
+type MediaS3 struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	PathStyle bool   `yaml:"path_style"` // MinIO 等自建存储通常需要开启
+	PublicURL string `yaml:"public_url"` // 对外访问地址，如 CDN 域名，为空时使用存储地址
+}
+
+// LocalDir local 存储目录
+func (m Media) LocalDir() string {
+	if m.Dir != "" {
+		return m.Dir
+	}
+	return "media"
+}
+
+// LocalBaseURL local 存储的访问路径前缀
+func (m Media) LocalBaseURL() string {
+	if m.BaseURL != "" {
+		return m.BaseURL
+	}
+	return "/media"
+}
+
+// MaxImageSize 图片上传大小上限（字节）
+func (m Media) MaxImageSize() int64 {
+	if m.MaxImageSizeMB > 0 {
+		return int64(m.MaxImageSizeMB) << 20
+	}
+	return 10 << 20
+}
+
+// MaxVideoSize 视频上传大小上限（字节）
+func (m Media) MaxVideoSize() int64 {
+	if m.MaxVideoSizeMB > 0 {
+		return int64(m.MaxVideoSizeMB) << 20
+	}
+	return 50 << 20
+}
+
+// Export 订单导出配置。多实例部署时结果文件需要放在共享存储，
+// 文件包含用户信息，S3 存储应使用不公开访问的 bucket
+type Export struct {
+	Storage           string  `yaml:"storage"`             // local(默认) / s3
+	Dir               string  `yaml:"dir"`                 // local 存储目录
+	JobTTLHours       int     `yaml:"job_ttl_hours"`       // 任务及结果保留时长
+	MaxConcurrentJobs int     `yaml:"max_concurrent_jobs"` // 每个实例同时执行的后台导出任务数，已满时拒绝新任务
+	MaxJobsPerUser    int     `yaml:"max_jobs_per_user"`   // 每个用户同时执行的后台导出任务数
+	S3                MediaS3 `yaml:"s3"`
+}
+
+// LocalDir local 存储目录，默认 export
+func (e Export) LocalDir() string {
+	if e.Dir != "" {
+		return e.Dir
+	}
+	return "export"
 }
 
 type JWT struct {
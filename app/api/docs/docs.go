@@ -80,28 +80,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/products": {
-            "post": {
-                "description": "Create a new product with SPU, SKUs and detail info (Admin only)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+        "/admin/answers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Answers filtered by moderation status (requires review:moderate)",
                 "tags": [
-                    "Product"
+                    "Review"
                 ],
-                "summary": "创建商品",
+                "summary": "后台回答列表",
                 "parameters": [
                     {
-                        "description": "Create Product Request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductRequest"
-                        }
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending / approved / rejected, empty for all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -116,7 +140,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductResponse"
+                                            "$ref": "#/definitions/review.AdminListAnswersResp"
                                         }
                                     }
                                 }
@@ -162,27 +186,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/admin/skus/batch": {
+        "/admin/answers/moderate": {
             "post": {
-                "description": "Batch update SKU price and stock (Admin only)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Approve or reject answers; approved answers notify the asker (requires review:moderate)",
                 "tags": [
-                    "Product"
+                    "Review"
                 ],
-                "summary": "批量更新SKU",
+                "summary": "批量审核回答",
                 "parameters": [
                     {
-                        "description": "Batch Update SKU Request",
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderate answers request",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuRequest"
+                            "$ref": "#/definitions/review.ModerateQaReq"
                         }
                     }
                 ],
@@ -198,7 +228,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuResponse"
+                                            "$ref": "#/definitions/review.ModerateQaResp"
                                         }
                                     }
                                 }
@@ -244,14 +274,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/info": {
-            "get": {
+        "/admin/audits": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get current logged-in user's profile",
+                "description": "Approve or reject pending products; a reason is required when rejecting, products not pending review are skipped (requires product:audit)",
                 "consumes": [
                     "application/json"
                 ],
@@ -259,9 +289,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Product"
                 ],
-                "summary": "获取用户信息",
+                "summary": "审核商品",
                 "parameters": [
                     {
                         "type": "string",
@@ -269,6 +299,15 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Audit Products Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.AuditProductsRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -283,7 +322,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/auth.GetUserInfoResp"
+                                            "$ref": "#/definitions/product.AuditProductsResponse"
                                         }
                                     }
                                 }
@@ -329,14 +368,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/password": {
-            "post": {
+        "/admin/audits/pending": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update account password",
+                "description": "List products pending review, oldest submission first (requires product:audit)",
                 "consumes": [
                     "application/json"
                 ],
@@ -344,9 +383,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Product"
                 ],
-                "summary": "修改密码",
+                "summary": "待审核商品队列",
                 "parameters": [
                     {
                         "type": "string",
@@ -356,13 +395,18 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Update Password Request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.UpdatePasswordReq"
-                        }
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -377,7 +421,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/auth.Empty"
+                                            "$ref": "#/definitions/product.ListAuditQueueResponse"
                                         }
                                     }
                                 }
@@ -423,14 +467,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/update": {
-            "post": {
+        "/admin/brands": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update user profile (email, phone, etc.)",
+                "description": "Brands including hidden ones, with their associated categories (Admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -438,9 +482,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Product"
                 ],
-                "summary": "更新用户信息",
+                "summary": "后台品牌列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -450,13 +494,25 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Update Request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.UpdateUserReq"
-                        }
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Only brands associated with this category or its parents/children",
+                        "name": "category_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -471,7 +527,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/auth.Empty"
+                                            "$ref": "#/definitions/product.ListBrandsResponse"
                                         }
                                     }
                                 }
@@ -515,11 +571,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/brands": {
-            "get": {
-                "description": "Get brand list with pagination",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a brand; first_letter is derived from the name (pinyin initial for Chinese) when empty (requires brand:write)",
                 "consumes": [
                     "application/json"
                 ],
@@ -529,21 +588,23 @@ const docTemplate = `{
                 "tags": [
                     "Product"
                 ],
-                "summary": "获取品牌列表",
+                "summary": "创建品牌",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "Create Brand Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.CreateBrandRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -558,7 +619,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListBrandsResponse"
+                                            "$ref": "#/definitions/product.CreateBrandResponse"
                                         }
                                     }
                                 }
@@ -604,13 +665,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/cart": {
-            "get": {
-                "description": "Get current user's cart details",
+        "/admin/brands/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update brand name, first letter, logo, sort or visibility; omitted fields are left unchanged (requires brand:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Cart"
+                    "Product"
                 ],
-                "summary": "获取购物车详情",
+                "summary": "修改品牌",
                 "parameters": [
                     {
                         "type": "string",
@@ -618,6 +690,23 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Brand ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Brand Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.UpdateBrandRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -632,7 +721,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/cart.GetCartDetailsResp"
+                                            "$ref": "#/definitions/product.UpdateBrandResponse"
                                         }
                                     }
                                 }
@@ -676,15 +765,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/cart/add": {
-            "post": {
-                "description": "Add sku to cart",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a brand and its category associations; rejected while products still reference it (requires brand:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Cart"
+                    "Product"
                 ],
-                "summary": "添加商品到购物车",
+                "summary": "删除品牌",
                 "parameters": [
                     {
                         "type": "string",
@@ -694,13 +792,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Add to cart request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/cart.AddToCartReq"
-                        }
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Brand ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -715,7 +812,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/cart.AddToCartResp"
+                                            "$ref": "#/definitions/product.DeleteBrandResponse"
                                         }
                                     }
                                 }
@@ -761,20 +858,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/cart/clear": {
-            "post": {
-                "description": "Clear all items in cart",
-                "tags": [
-                    "Cart"
-                ],
-                "summary": "清空购物车",
-                "parameters": [
+        "/admin/brands/{id}/categories": {
+            "put": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Bearer {token}",
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the categories a brand is associated with; an empty list clears them (requires brand:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "设置品牌关联分类",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Brand ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Brand Categories Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.SetBrandCategoriesRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -789,7 +914,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/cart.ClearCartResp"
+                                            "$ref": "#/definitions/product.SetBrandCategoriesResponse"
                                         }
                                     }
                                 }
@@ -835,13 +960,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/cart/remove": {
-            "post": {
-                "description": "Remove skus from cart",
+        "/admin/categories": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Full category tree including hidden categories (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Cart"
+                    "Product"
                 ],
-                "summary": "从购物车移除商品",
+                "summary": "后台分类树",
                 "parameters": [
                     {
                         "type": "string",
@@ -851,13 +987,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Remove from cart request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/cart.RemoveFromCartReq"
-                        }
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Parent category ID (0 for the whole tree)",
+                        "name": "parent_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -872,7 +1006,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/cart.RemoveFromCartResp"
+                                            "$ref": "#/definitions/product.ListCategoriesResponse"
                                         }
                                     }
                                 }
@@ -916,11 +1050,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/categories": {
-            "get": {
-                "description": "Get category list with optional parent filter",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a category under the given parent, at most 3 levels deep (requires category:write)",
                 "consumes": [
                     "application/json"
                 ],
@@ -930,14 +1067,23 @@ const docTemplate = `{
                 "tags": [
                     "Product"
                 ],
-                "summary": "获取分类列表",
+                "summary": "创建分类",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "Parent category ID (0 for root categories)",
-                        "name": "parent_id",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Category Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.CreateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -952,7 +1098,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListCategoriesResponse"
+                                            "$ref": "#/definitions/product.CreateCategoryResponse"
                                         }
                                     }
                                 }
@@ -998,13 +1144,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/checkout": {
+        "/admin/categories/sort": {
             "post": {
-                "description": "Checkout with cart items, create order and pay",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reorder sibling categories in the given id order (requires category:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Checkout"
+                    "Product"
                 ],
-                "summary": "结算下单",
+                "summary": "调整分类顺序",
                 "parameters": [
                     {
                         "type": "string",
@@ -1014,12 +1171,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Checkout request",
+                        "description": "Sort Categories Request",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/checkout.CheckoutReq"
+                            "$ref": "#/definitions/product.SortCategoriesRequest"
                         }
                     }
                 ],
@@ -1035,7 +1192,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/checkout.CheckoutResp"
+                                            "$ref": "#/definitions/product.SortCategoriesResponse"
                                         }
                                     }
                                 }
@@ -1081,9 +1238,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
-            "post": {
-                "description": "Login with username and password to get JWT token",
+        "/admin/categories/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Rename a category or change its icon and unit, empty fields are left unchanged (requires category:write)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1091,17 +1253,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Product"
                 ],
-                "summary": "用户登录",
+                "summary": "修改分类",
                 "parameters": [
                     {
-                        "description": "Login Request",
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Category Request",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/auth.LoginReq"
+                            "$ref": "#/definitions/product.UpdateCategoryRequest"
                         }
                     }
                 ],
@@ -1117,7 +1294,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/auth.LoginResp"
+                                            "$ref": "#/definitions/product.UpdateCategoryResponse"
                                         }
                                     }
                                 }
@@ -1161,11 +1338,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/logout": {
-            "post": {
-                "description": "Logout current user (Clear cookie if enabled)",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a category; rejected while it still has child categories or products (requires category:write)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1173,9 +1353,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Product"
                 ],
-                "summary": "用户登出",
+                "summary": "删除分类",
                 "parameters": [
                     {
                         "type": "string",
@@ -1183,13 +1363,33 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.DeleteCategoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1231,13 +1431,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders": {
-            "get": {
-                "description": "List current user's orders",
+        "/admin/categories/{id}/move": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Move a category with its subtree under a new parent; levels are recomputed and may not exceed 3 (requires category:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Order"
+                    "Product"
                 ],
-                "summary": "获取订单列表",
+                "summary": "移动分类",
                 "parameters": [
                     {
                         "type": "string",
@@ -1245,6 +1456,23 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Move Category Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.MoveCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1259,7 +1487,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.ListOrderResp"
+                                            "$ref": "#/definitions/product.MoveCategoryResponse"
                                         }
                                     }
                                 }
@@ -1303,13 +1531,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Place order from cart items",
+            }
+        },
+        "/admin/categories/{id}/spec-template": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace the category's own spec attributes; an empty list clears them (requires category:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Order"
+                    "Product"
                 ],
-                "summary": "下单",
+                "summary": "保存分类规格模板",
                 "parameters": [
                     {
                         "type": "string",
@@ -1319,12 +1560,20 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Place order request",
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Save Spec Template Request",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderReq"
+                            "$ref": "#/definitions/product.SaveSpecTemplateRequest"
                         }
                     }
                 ],
@@ -1340,7 +1589,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderResp"
+                                            "$ref": "#/definitions/product.SaveSpecTemplateResponse"
                                         }
                                     }
                                 }
@@ -1386,13 +1635,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/:order_id/cancel": {
-            "post": {
-                "description": "Cancel order by order_id",
+        "/admin/categories/{id}/visibility": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Show or hide a category; a hidden category hides its whole subtree from the storefront (requires category:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Order"
+                    "Product"
                 ],
-                "summary": "取消订单",
+                "summary": "显示/隐藏分类",
                 "parameters": [
                     {
                         "type": "string",
@@ -1402,11 +1662,21 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Order ID",
-                        "name": "order_id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Category Visibility Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.SetCategoryVisibilityRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1421,7 +1691,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.CancelOrderResp"
+                                            "$ref": "#/definitions/product.SetCategoryVisibilityResponse"
                                         }
                                     }
                                 }
@@ -1467,13 +1737,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/payment/pay": {
+        "/admin/media": {
             "post": {
-                "description": "Pay for an order",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a product image (jpeg/png/gif) or video (mp4/webm). The type is detected from the file content. Images get a 320px JPEG thumbnail and a 1200px WebP variant. The returned asset id can be used as main_image_asset_id / image_asset_ids / video_asset_ids when creating or editing products (requires product:write)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Payment"
+                    "Product"
                 ],
-                "summary": "订单支付",
+                "summary": "上传商品图片/视频",
                 "parameters": [
                     {
                         "type": "string",
@@ -1483,13 +1764,11 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Pay request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/payment.PayReq"
-                        }
+                        "type": "file",
+                        "description": "图片最大 10MB、宽高不超过 8000，视频最大 50MB",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1504,7 +1783,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/payment.PayResp"
+                                            "$ref": "#/definitions/product.UploadMediaResponse"
                                         }
                                     }
                                 }
@@ -1548,11 +1827,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/products/home": {
+            },
             "get": {
-                "description": "Get home page product list (SPU) with pagination and filters",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List uploaded media assets, most recent first (requires product:write)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1562,34 +1844,33 @@ const docTemplate = `{
                 "tags": [
                     "Product"
                 ],
-                "summary": "获取首页商品列表",
+                "summary": "媒体库列表",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Page size",
-                        "name": "page_size",
+                        "type": "string",
+                        "description": "image / video，不传表示全部",
+                        "name": "kind",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "format": "int64",
-                        "description": "Category ID",
-                        "name": "category_id",
+                        "format": "int32",
+                        "description": "页码",
+                        "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "format": "int64",
-                        "description": "Brand ID",
-                        "name": "brand_id",
+                        "format": "int32",
+                        "description": "每页数量",
+                        "name": "page_size",
                         "in": "query"
                     }
                 ],
@@ -1605,7 +1886,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/product.GetHomeProductsResponse"
+                                            "$ref": "#/definitions/product.ListMediaResponse"
                                         }
                                     }
                                 }
@@ -1651,9 +1932,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/search": {
+        "/admin/media/{id}": {
             "get": {
-                "description": "Search products (SKU) with keyword, filters and sorting",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get a media asset with its thumbnail and WebP urls (requires product:write)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1663,62 +1949,22 @@ const docTemplate = `{
                 "tags": [
                     "Product"
                 ],
-                "summary": "搜索商品",
+                "summary": "媒体资源详情",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Search keyword",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "Category ID",
-                        "name": "category_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "Brand ID",
-                        "name": "brand_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "Minimum price",
-                        "name": "min_price",
-                        "in": "query"
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
                     },
                     {
                         "type": "integer",
                         "format": "int64",
-                        "description": "Maximum price",
-                        "name": "max_price",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "format": "int32",
-                        "description": "Sort type (0:default, 1:price asc, 2:price desc, 3:sales)",
-                        "name": "sort_type",
-                        "in": "query"
+                        "description": "媒体资源ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1733,7 +1979,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.SearchProductsResponse"
+                                            "$ref": "#/definitions/product.GetMediaResponse"
                                         }
                                     }
                                 }
@@ -1779,46 +2025,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{spu_id}": {
+        "/admin/orders/export": {
             "get": {
-                "description": "Get product detail including SPU, SKUs, category, brand and detail info",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stream orders of one user, or all users when user_id is 0, as a CSV or XLSX file",
                 "produces": [
-                    "application/json"
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
                 ],
                 "tags": [
-                    "Product"
+                    "Order"
                 ],
-                "summary": "获取商品详情",
+                "summary": "管理员导出订单",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "format": "int64",
-                        "description": "Product SPU ID",
-                        "name": "spu_id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Export format: csv (default) / xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID, 0 exports all users",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order status: placed / paid / canceled",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at from, unix seconds",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at to, unix seconds",
+                        "name": "end_time",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetProductDetailResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
                         }
                     },
                     "400": {
@@ -1860,19 +2121,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/refresh": {
+        "/admin/orders/exports": {
             "post": {
-                "description": "Refresh expired token to get a new one",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Export orders of one user, or all users when user_id is 0, in the background",
                 "tags": [
-                    "Auth"
+                    "Order"
                 ],
-                "summary": "刷新 Token",
+                "summary": "管理员创建订单导出任务",
                 "parameters": [
                     {
                         "type": "string",
@@ -1880,6 +2135,15 @@ const docTemplate = `{
                         "name": "Authorization",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "Export job request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.CreateExportJobReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -1894,15 +2158,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/auth.RefreshResp"
+                                            "$ref": "#/definitions/order.CreateExportJobResp"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "allOf": [
                                 {
@@ -1918,37 +2182,9 @@ const docTemplate = `{
                                 }
                             ]
                         }
-                    }
-                }
-            }
-        },
-        "/register": {
-            "post": {
-                "description": "Create a new user account with username, password, email etc.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "用户注册",
-                "parameters": [
-                    {
-                        "description": "Register Request",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/auth.RegisterReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "allOf": [
                                 {
@@ -1958,21 +2194,274 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/auth.RegisterResp"
+                                            "type": "string"
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/response.Response"
-                                },
-                                {
+                    }
+                }
+            }
+        },
+        "/admin/products": {
+            "get": {
+                "description": "List products for admin with publish/verify status filters and recycle bin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "后台商品列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Publish status (0:unpublished, 1:published, 2:off shelf), all if omitted",
+                        "name": "publish_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Verify status (0:unverified, 1:approved, 2:rejected), all if omitted",
+                        "name": "verify_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List deleted products only",
+                        "name": "deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.AdminListProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new product with SPU, SKUs and detail info (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "创建商品",
+                "parameters": [
+                    {
+                        "description": "Create Product Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/delete": {
+            "post": {
+                "description": "Soft delete products, deleted products are also taken off shelf (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "批量删除商品",
+                "parameters": [
+                    {
+                        "description": "Delete Products Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.DeleteProductsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.DeleteProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
                                     "type": "object",
                                     "properties": {
                                         "data": {
@@ -2003,158 +2492,7817 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "auth.Empty": {
-            "type": "object"
         },
-        "auth.GetUserInfoResp": {
+        "/admin/products/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stream products matching the admin list filters as a CSV or XLSX file, one row per SKU, using the same columns as the import (requires product:write)",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "导出商品",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv (默认) / xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "分类ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "品牌ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "发布状态，不传表示全部",
+                        "name": "publish_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "审核状态，不传表示全部",
+                        "name": "verify_status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "true 时导出回收站中的商品",
+                        "name": "deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/imports": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Upload a CSV or XLSX file to create products in bulk. Rows sharing spu_ref form one product; every row is validated and the job runs in the background. With dry_run only the error report is produced (requires product:write)",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "批量导入商品",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV / XLSX 文件，最大 5MB，最多 5000 行",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "true 时只校验不创建商品",
+                        "name": "dry_run",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.ImportProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/imports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the progress of a product import job with its per-row error report (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "查询商品导入任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "导入任务ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.GetImportJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/imports/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Download the per-row error report of a product import job as a CSV or XLSX file (requires product:write)",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "下载商品导入错误报告",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "导入任务ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv (默认) / xlsx",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/publish": {
+            "post": {
+                "description": "Publish products (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "批量上架商品",
+                "parameters": [
+                    {
+                        "description": "Publish Products Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.PublishProductsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.PublishProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/restore": {
+            "post": {
+                "description": "Restore deleted products, restored products stay off shelf (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "批量恢复商品",
+                "parameters": [
+                    {
+                        "description": "Restore Products Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.RestoreProductsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.RestoreProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/unpublish": {
+            "post": {
+                "description": "Unpublish products (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "批量下架商品",
+                "parameters": [
+                    {
+                        "description": "Unpublish Products Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.UnpublishProductsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.UnpublishProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{spu_id}": {
+            "put": {
+                "description": "Update SPU basic info and detail, empty fields are left unchanged (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "编辑商品",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Product Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.UpdateProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{spu_id}/audits": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the audit history of a product, including every rejection reason, newest first (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品审核记录",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.ListProductAuditsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{spu_id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the versioned change history of a product with the operator and field-level diff of each version, newest first (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品版本历史",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "每页数量",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.ListProductHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products/{spu_id}/rollback": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Restore the SPU, SKU and detail content of a product to a previous version; the rollback is recorded as a new version and the product goes back to the audit queue (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "回滚商品版本",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rollback Product Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.RollbackProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.RollbackProductResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/questions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Questions filtered by moderation status (requires review:moderate)",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "后台问题列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending / approved / rejected, empty for all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.AdminListQuestionsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/questions/moderate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Approve or reject questions; approved questions trigger notification hooks (requires review:moderate)",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "批量审核问题",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderate questions request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.ModerateQaReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.ModerateQaResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Reviews filtered by moderation status (requires review:moderate)",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "后台评价列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending / approved / rejected, empty for all",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.AdminListReviewsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/reviews/moderate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Approve or reject reviews; product rating summaries are updated accordingly (requires review:moderate)",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "批量审核评价",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Moderate reviews request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.ModerateReviewsReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.ModerateReviewsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/roles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List admin roles and their permissions (requires user:role)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "角色列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.ListRolesResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/schedules": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List product schedules with their execution result and failure reason, latest run time first (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品定时任务列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "spu / sku",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU 或 SKU ID",
+                        "name": "target_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "0:待执行, 1:执行中, 2:已执行, 3:执行失败, 4:已取消，不传表示全部",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "每页数量",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.ListProductSchedulesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Schedule a publish, unpublish or price change on an SPU or SKU; the product service applies it at run_at and records the result (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "创建商品定时任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Product Schedule Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.CreateProductScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.CreateProductScheduleResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/schedules/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel a schedule that has not run yet (requires product:write)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "取消商品定时任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "定时任务 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.CancelProductScheduleResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/search/report": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Top search keywords over the last N days with zero-result and average result counts (requires search:report)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "搜索词报表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Days including today (default 7, max 90)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Limit (default 50, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetSearchReportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/search/zero-results": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Keywords that returned no results over the last N days, ordered by zero-result count (requires search:report)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "零结果搜索词报表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Days including today (default 7, max 90)",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Limit (default 50, max 500)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetSearchReportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/skus/batch": {
+            "post": {
+                "description": "Batch update SKU price and stock (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "批量更新SKU",
+                "parameters": [
+                    {
+                        "description": "Batch Update SKU Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/skus/{sku_id}": {
+            "put": {
+                "description": "Update a single SKU, empty fields are left unchanged; use batch API for stock (Admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "编辑SKU",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SKU ID",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update SKU Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.UpdateSkuRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.UpdateSkuResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{user_id}/roles": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replace roles of a user; the user must login again (requires user:role)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "设置用户角色",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Roles",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.SetUserRolesReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.SetUserRolesResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answers/{answer_id}/upvote": {
+            "post": {
+                "description": "Upvote an answer, or cancel the upvote; repeated calls are idempotent",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "点赞回答",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Answer ID",
+                        "name": "answer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Upvote answer request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.UpvoteAnswerReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.UpvoteAnswerResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get current logged-in user's profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "获取用户信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.GetUserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/password": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update account password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "修改密码",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Password Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.UpdatePasswordReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.Empty"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/update": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update user profile (email, phone, etc.)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "更新用户信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Update Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.UpdateUserReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.Empty"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/brands": {
+            "get": {
+                "description": "Get brand list with pagination, optionally only brands associated with a category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "获取品牌列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Only brands associated with this category or its parents/children",
+                        "name": "category_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListBrandsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/cart": {
+            "get": {
+                "description": "Get current user's cart details",
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "获取购物车详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/cart.GetCartDetailsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/add": {
+            "post": {
+                "description": "Add sku to cart",
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "添加商品到购物车",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Add to cart request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cart.AddToCartReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/cart.AddToCartResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/clear": {
+            "post": {
+                "description": "Clear all items in cart",
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "清空购物车",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/cart.ClearCartResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/cart/remove": {
+            "post": {
+                "description": "Remove skus from cart",
+                "tags": [
+                    "Cart"
+                ],
+                "summary": "从购物车移除商品",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Remove from cart request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cart.RemoveFromCartReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/cart.RemoveFromCartResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "description": "Get category list with optional parent filter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "获取分类列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Parent category ID (0 for root categories)",
+                        "name": "parent_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListCategoriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}/spec-template": {
+            "get": {
+                "description": "Get the effective spec template of a category, including attributes inherited from ancestor categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "分类规格模板",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.GetSpecTemplateResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/checkout": {
+            "post": {
+                "description": "Checkout with cart items, create order and pay",
+                "tags": [
+                    "Checkout"
+                ],
+                "summary": "结算下单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Checkout request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/checkout.CheckoutReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/checkout.CheckoutResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Login with username and password to get JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "用户登录",
+                "parameters": [
+                    {
+                        "description": "Login Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.LoginReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.LoginResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/logout": {
+            "post": {
+                "description": "Logout current user (Clear cookie if enabled)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "用户登出",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "description": "List current user's orders, paging into archived history",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "获取订单列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 0 returns all orders",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.ListOrderResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Place order from cart items",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "下单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Place order request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/:order_id": {
+            "get": {
+                "description": "Get order by order_id, including orders still being processed",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "获取订单详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.GetOrderResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/:order_id/cancel": {
+            "post": {
+                "description": "Cancel order by order_id",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "取消订单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.CancelOrderResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/:order_id/invoice": {
+            "get": {
+                "description": "Download the invoice of a paid order, issuing it on first request",
+                "produces": [
+                    "application/pdf",
+                    "text/html"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "下载发票",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice format: pdf (default) / html",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/:order_id/reorder": {
+            "post": {
+                "description": "Add items of a past order back to the cart, reporting delisted, out-of-stock and price-changed SKUs",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "再次购买",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/order.ReorderResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/export": {
+            "get": {
+                "description": "Stream current user's orders as a CSV or XLSX file, one row per order item plus a totals row",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "导出订单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Export format: csv (default) / xlsx",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Order status: placed / paid / canceled",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at from, unix seconds",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Created at to, unix seconds",
+                        "name": "end_time",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/exports": {
+            "post": {
+                "description": "Export current user's orders in the background, poll the job and download the file when done",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "创建订单导出任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Export job request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/order.CreateExportJobReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/order.CreateExportJobResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/exports/:job_id": {
+            "get": {
+                "description": "Get export job status",
+                "tags": [
+                    "Order"
+                ],
+                "summary": "查询订单导出任务",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/order.GetExportJobResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/exports/:job_id/download": {
+            "get": {
+                "description": "Download the file of a finished export job",
+                "produces": [
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "下载订单导出文件",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job ID",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/payment/pay": {
+            "post": {
+                "description": "Pay for an order",
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "订单支付",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Pay request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payment.PayReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/payment.PayResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/price-alerts": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Subscribe to a SKU with a target price lower than its current price. A notification is sent once when the price drops to the target or below; subscribing again replaces the target",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "订阅降价提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Subscribe price alert request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/product.SubscribePriceAlertRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.SubscribePriceAlertResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "List the current user's price alerts with the SKU's current price, most recently updated first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "我的降价提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "每页数量",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.ListPriceAlertsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/price-alerts/{sku_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel the current user's price alert on a SKU",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "取消降价提醒",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SKU ID",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.CancelPriceAlertResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/home": {
+            "get": {
+                "description": "Get home page product list (SPU) with pagination and filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "获取首页商品列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.GetHomeProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/hot": {
+            "get": {
+                "description": "Get hot products list sorted by sales count",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "获取热门商品列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Limit (default 10, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetHotProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/search": {
+            "get": {
+                "description": "Search products (SKU) with keyword, filters and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "搜索商品",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search keyword",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Category ID",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Brand ID",
+                        "name": "brand_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Minimum price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Maximum price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Sort type (0:default, 1:price asc, 2:price desc, 3:sales)",
+                        "name": "sort_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.SearchProductsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/search/trending": {
+            "get": {
+                "description": "Most searched keywords in the last hour or the last 24 hours",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "热搜榜",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Window: hour or day (default day)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Limit (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetTrendingSearchesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/suggest": {
+            "get": {
+                "description": "Prefix completions from popular queries, product names, brands and categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "搜索联想",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Typed prefix",
+                        "name": "prefix",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Limit (default 10, max 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.SuggestQueriesResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{spu_id}": {
+            "get": {
+                "description": "Get product detail including SPU, SKUs, category, brand and detail info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "获取商品详情",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Product SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetProductDetailResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{spu_id}/price-history": {
+            "get": {
+                "description": "List SKU price changes of a product in chronological order. A SKU's initial price is recorded with an empty old_price",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "商品价格走势",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SKU ID，不传表示全部 SKU",
+                        "name": "sku_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "最近天数，默认 180，最大 365",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/product.ListPriceHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{spu_id}/questions": {
+            "get": {
+                "description": "Approved questions of a product, newest first, each with its most helpful answers",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "商品问题列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.ListQuestionsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Ask a question about a product",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "商品提问",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create question request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.CreateQuestionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.CreateQuestionResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{spu_id}/reviews": {
+            "get": {
+                "description": "Approved reviews of a product, newest first",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "商品评价列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "SPU ID",
+                        "name": "spu_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Filter by rating 1-5, 0 for all",
+                        "name": "rating",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only reviews with images",
+                        "name": "with_images",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.ListReviewsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/questions/{question_id}/answers": {
+            "get": {
+                "description": "Approved answers of a question, merchant answers and most upvoted first",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "问题的回答列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int32",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.ListAnswersResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Answer a question; only merchants (product:write) or buyers of the product may answer",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "回答问题",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "format": "int64",
+                        "description": "Question ID",
+                        "name": "question_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create answer request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.CreateAnswerReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.CreateAnswerResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/refresh": {
+            "post": {
+                "description": "Refresh expired token to get a new one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "刷新 Token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.RefreshResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/register": {
+            "post": {
+                "description": "Create a new user account with username, password, email etc.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "用户注册",
+                "parameters": [
+                    {
+                        "description": "Register Request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/auth.RegisterReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/auth.RegisterResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/reviews": {
+            "post": {
+                "description": "Review a SKU from a paid order; each order SKU can be reviewed once",
+                "tags": [
+                    "Review"
+                ],
+                "summary": "发表商品评价",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer {token}",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Create review request",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/review.CreateReviewReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/review.CreateReviewResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auth.Empty": {
+            "type": "object"
+        },
+        "auth.GetUserInfoResp": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/auth.UserInfo"
+                }
+            }
+        },
+        "auth.ListRolesResp": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.RoleInfo"
+                    }
+                }
+            }
+        },
+        "auth.LoginReq": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.LoginResp": {
+            "type": "object",
+            "properties": {
+                "expire_in": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/auth.UserInfo"
+                }
+            }
+        },
+        "auth.RefreshResp": {
+            "type": "object",
+            "properties": {
+                "expire_in": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RegisterReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "password_confirm": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.RegisterResp": {
+            "type": "object",
+            "properties": {
+                "expire_in": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/auth.UserInfo"
+                }
+            }
+        },
+        "auth.RoleInfo": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "auth.SetUserRolesReq": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "auth.SetUserRolesResp": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/auth.UserInfo"
+                }
+            }
+        },
+        "auth.UpdatePasswordReq": {
+            "type": "object",
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "old_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.UpdateUserReq": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "auth.UserInfo": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "roles": {
+                    "description": "后台角色，普通用户为空",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "cart.AddToCartReq": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "cart.AddToCartResp": {
+            "type": "object",
+            "properties": {
+                "cart_item": {
+                    "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_cart.CartItem"
+                }
+            }
+        },
+        "cart.ClearCartResp": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "cart.GetCartDetailsResp": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_cart.CartItem"
+                    }
+                },
+                "total_amount": {
+                    "type": "string"
+                },
+                "total_quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "cart.RemoveFromCartReq": {
+            "type": "object",
+            "properties": {
+                "sku_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "cart.RemoveFromCartResp": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "checkout.AddressDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "street_address": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "integer"
+                }
+            }
+        },
+        "checkout.CheckoutItemDTO": {
+            "type": "object",
+            "properties": {
+                "market_price": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_image": {
+                    "type": "string"
+                },
+                "sku_name": {
+                    "type": "string"
+                },
+                "sku_spec_data": {
+                    "type": "string"
+                },
+                "spu_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "checkout.CheckoutReq": {
+            "type": "object",
+            "properties": {
+                "credit_card": {
+                    "type": "string"
+                },
+                "shipping_address": {
+                    "$ref": "#/definitions/checkout.AddressDTO"
+                }
+            }
+        },
+        "checkout.CheckoutResp": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/checkout.CheckoutItemDTO"
+                    }
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "total_amount": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_cart.CartItem": {
+            "type": "object",
+            "properties": {
+                "market_price": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_image": {
+                    "type": "string"
+                },
+                "sku_name": {
+                    "type": "string"
+                },
+                "sku_spec_data": {
+                    "type": "string"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "spu_name": {
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.CancelOrderResp": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.GetOrderResp": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "$ref": "#/definitions/order.OrderDTO"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.ListOrderResp": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.OrderDTO"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderReq": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "shipping_address": {
+                    "$ref": "#/definitions/order.AddressDTO"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderResp": {
+            "type": "object",
+            "properties": {
+                "order": {
+                    "$ref": "#/definitions/order.OrderResultDTO"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.UpdateSkuItem"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "updated_count": {
+                    "description": "实际更新数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductRequest": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "$ref": "#/definitions/product.CreateProductDetail"
+                },
+                "skus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.CreateProductSKU"
+                    }
+                },
+                "spu": {
+                    "$ref": "#/definitions/product.CreateProductSPU"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "spu_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetHotProductsResponse": {
+            "type": "object",
+            "properties": {
+                "products": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.HotProductDTO"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetProductDetailResponse": {
+            "type": "object",
+            "properties": {
+                "product": {
+                    "$ref": "#/definitions/product.ProductDetailDTO"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetSearchReportResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.SearchQueryStatDTO"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetTrendingSearchesResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.TrendingSearchDTO"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListBrandsResponse": {
+            "type": "object",
+            "properties": {
+                "brands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.BrandDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListCategoriesResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.CategoryDTO"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.SearchProductsResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.SearchSkuDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.SuggestQueriesResponse": {
+            "type": "object",
+            "properties": {
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.QuerySuggestionDTO"
+                    }
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.UpdateProductRequest": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "$ref": "#/definitions/product.CreateProductDetail"
+                },
+                "spu": {
+                    "$ref": "#/definitions/product.CreateProductSPU"
+                },
+                "spu_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.UpdateProductResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "home.Empty": {
+            "type": "object"
+        },
+        "order.AddressDTO": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "street_address": {
+                    "type": "string"
+                },
+                "zip_code": {
+                    "type": "integer"
+                }
+            }
+        },
+        "order.CreateExportJobReq": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "仅管理员导出生效",
+                    "type": "integer"
+                }
+            }
+        },
+        "order.CreateExportJobResp": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/order.ExportJobDTO"
+                }
+            }
+        },
+        "order.ExportJobDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "order_count": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "pending / running / done / failed",
+                    "type": "string"
+                }
+            }
+        },
+        "order.GetExportJobResp": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/order.ExportJobDTO"
+                }
+            }
+        },
+        "order.OrderDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.OrderItem"
+                    }
+                },
+                "order_id": {
+                    "type": "string"
+                },
+                "shipping_address": {
+                    "$ref": "#/definitions/order.AddressDTO"
+                },
+                "status": {
+                    "description": "placed / paid / canceled，processing 表示已下单但尚未落库",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "order.OrderItem": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.OrderResultDTO": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.ReorderPriceChange": {
+            "type": "object",
+            "properties": {
+                "new_price": {
+                    "type": "string"
+                },
+                "old_price": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "order.ReorderResp": {
+            "type": "object",
+            "properties": {
+                "added_items": {
+                    "description": "库存不足时按剩余库存加入，price 为当前价格",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.OrderItem"
+                    }
+                },
+                "price_changed_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.ReorderPriceChange"
+                    }
+                },
+                "skipped_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/order.ReorderSkippedItem"
+                    }
+                }
+            }
+        },
+        "order.ReorderSkippedItem": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "description": "delisted / out_of_stock",
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "payment.PayReq": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "credit_card": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "payment.PayResp": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                },
+                "trade_no": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.AdminListProductsResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.AdminSpuDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.AdminSpuDTO": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "high_price": {
+                    "type": "string"
+                },
+                "low_price": {
+                    "type": "string"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "publish_status": {
+                    "description": "0:未发布, 1:上架, 2:下架",
+                    "type": "integer"
+                },
+                "sale_count": {
+                    "type": "integer"
+                },
+                "sort": {
+                    "type": "integer"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
+                },
+                "verify_status": {
+                    "description": "0:未审核, 1:审核通过, 2:审核不通过",
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.AuditProductsRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "approve / reject",
+                    "type": "string"
+                },
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reason": {
+                    "description": "驳回时必填",
+                    "type": "string"
+                }
+            }
+        },
+        "product.AuditProductsResponse": {
+            "type": "object",
+            "properties": {
+                "affected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.BrandDTO": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "description": "关联的分类，仅后台接口返回",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "first_letter": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "logo": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "show_status": {
+                    "description": "是否显示，仅后台接口返回隐藏品牌",
+                    "type": "boolean"
+                },
+                "sort": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.CancelPriceAlertResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.CancelProductScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.CategoryDTO": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "description": "子分类",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.CategoryDTO"
+                    }
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "show_status": {
+                    "description": "是否显示，仅后台接口返回隐藏分类",
+                    "type": "boolean"
+                },
+                "sort": {
+                    "type": "integer"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.CreateBrandRequest": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "first_letter": {
+                    "type": "string"
+                },
+                "logo": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sort": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.CreateBrandResponse": {
+            "type": "object",
+            "properties": {
+                "first_letter": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "0 表示一级分类",
+                    "type": "integer"
+                },
+                "sort": {
+                    "type": "integer"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.CreateCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.CreateProductDetail": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "富文本描述",
+                    "type": "string"
+                },
+                "image_asset_ids": {
+                    "description": "图片媒体资源ID，非空时代替 images",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "images": {
+                    "description": "商品图",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "market_tag_json": {
+                    "description": "营销标签 JSON",
+                    "type": "string"
+                },
+                "tech_tag_json": {
+                    "description": "技术参数 JSON",
+                    "type": "string"
+                },
+                "video_asset_ids": {
+                    "description": "视频媒体资源ID，非空时代替 videos",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "videos": {
+                    "description": "商品视频",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "product.CreateProductSKU": {
+            "type": "object",
+            "properties": {
+                "main_image": {
+                    "type": "string"
+                },
+                "main_image_asset_id": {
+                    "description": "媒体资源ID，非 0 时代替 main_image",
+                    "type": "integer"
+                },
+                "market_price": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "sku_code": {
+                    "type": "string"
+                },
+                "sku_spec_data": {
+                    "description": "JSON 字符串",
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.CreateProductSPU": {
+            "type": "object",
+            "properties": {
+                "brand_id": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "main_image_asset_id": {
+                    "description": "媒体资源ID，非 0 时代替 main_image",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "service_bits": {
+                    "type": "integer"
+                },
+                "sort": {
+                    "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.CreateProductScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "publish / unpublish / price",
+                    "type": "string"
+                },
+                "market_price": {
+                    "description": "为空不修改",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "action=price 时必填",
+                    "type": "string"
+                },
+                "run_at": {
+                    "description": "unix 秒，必须晚于当前时间",
+                    "type": "integer"
+                },
+                "target_id": {
+                    "type": "integer"
+                },
+                "target_type": {
+                    "description": "spu / sku",
+                    "type": "string"
+                }
+            }
+        },
+        "product.CreateProductScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.DeleteBrandResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.DeleteCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.DeleteProductsRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "product.DeleteProductsResponse": {
+            "type": "object",
+            "properties": {
+                "affected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.DetailInfoDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "富文本描述",
+                    "type": "string"
+                },
+                "images": {
+                    "description": "商品图",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "market_tag_json": {
+                    "description": "营销标签 JSON",
+                    "type": "string"
+                },
+                "tech_tag_json": {
+                    "description": "技术参数 JSON",
+                    "type": "string"
+                },
+                "videos": {
+                    "description": "商品视频",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "product.FieldChangeDTO": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "new_value": {
+                    "type": "string"
+                },
+                "old_value": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.GetHomeProductsResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.HomeSpuDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.GetImportJobResponse": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/product.ImportJobDTO"
+                }
+            }
+        },
+        "product.GetMediaResponse": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/product.MediaAssetDTO"
+                }
+            }
+        },
+        "product.GetSpecTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.SpecAttributeDTO"
+                    }
+                }
+            }
+        },
+        "product.HomeSpuDTO": {
+            "type": "object",
+            "properties": {
+                "high_price": {
+                    "type": "string"
+                },
+                "low_price": {
+                    "type": "string"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sale_count": {
+                    "type": "integer"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.HotProductDTO": {
+            "type": "object",
+            "properties": {
+                "low_price": {
+                    "description": "使用 string 传输价格",
+                    "type": "string"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sale_count": {
+                    "type": "integer"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.ImportJobDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "created_products": {
+                    "type": "integer"
+                },
+                "dry_run": {
+                    "description": "true 时只校验不创建商品",
+                    "type": "boolean"
+                },
+                "error": {
+                    "description": "任务失败原因，如文件无法解析",
+                    "type": "string"
+                },
+                "error_rows": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.ImportRowErrorDTO"
+                    }
+                },
+                "finished_at": {
+                    "description": "未结束时为 0",
+                    "type": "integer"
+                },
+                "format": {
+                    "description": "csv / xlsx",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "operator_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "0:待执行, 1:执行中, 2:已完成, 3:失败",
+                    "type": "integer"
+                },
+                "total_products": {
+                    "description": "按 spu_ref 分组后的商品数",
+                    "type": "integer"
+                },
+                "total_rows": {
+                    "description": "数据行数，不含表头",
+                    "type": "integer"
+                },
+                "valid_products": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.ImportProductsResponse": {
             "type": "object",
             "properties": {
-                "user": {
-                    "$ref": "#/definitions/auth.UserInfo"
+                "job": {
+                    "$ref": "#/definitions/product.ImportJobDTO"
                 }
             }
         },
-        "auth.LoginReq": {
+        "product.ImportRowErrorDTO": {
             "type": "object",
             "properties": {
-                "password": {
+                "column": {
+                    "description": "为空表示整行或整个商品的错误",
                     "type": "string"
                 },
-                "username": {
+                "message": {
                     "type": "string"
+                },
+                "row": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.LoginResp": {
+        "product.ListAuditQueueResponse": {
             "type": "object",
             "properties": {
-                "expire_in": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.AdminSpuDTO"
+                    }
+                },
+                "total": {
                     "type": "integer"
+                }
+            }
+        },
+        "product.ListMediaResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.MediaAssetDTO"
+                    }
                 },
-                "token": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "product.ListPriceAlertsResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.PriceAlertDTO"
+                    }
                 },
-                "user": {
-                    "$ref": "#/definitions/auth.UserInfo"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.RefreshResp": {
+        "product.ListPriceHistoryResponse": {
             "type": "object",
             "properties": {
-                "expire_in": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.PriceChangeDTO"
+                    }
+                }
+            }
+        },
+        "product.ListProductAuditsResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.ProductAuditDTO"
+                    }
+                }
+            }
+        },
+        "product.ListProductHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.ProductVersionDTO"
+                    }
+                },
+                "total": {
                     "type": "integer"
+                }
+            }
+        },
+        "product.ListProductSchedulesResponse": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.ProductScheduleDTO"
+                    }
                 },
-                "token": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.RegisterReq": {
+        "product.MediaAssetDTO": {
             "type": "object",
             "properties": {
-                "email": {
+                "content_type": {
                     "type": "string"
                 },
-                "password": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "image / video",
                     "type": "string"
                 },
-                "password_confirm": {
+                "operator_id": {
+                    "type": "integer"
+                },
+                "original_name": {
                     "type": "string"
                 },
-                "username": {
+                "size": {
+                    "description": "原文件字节数",
+                    "type": "integer"
+                },
+                "thumbnail_url": {
+                    "description": "最长边 320 的 JPEG，视频为空",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "webp_url": {
+                    "description": "最长边 1200 的 WebP，视频为空",
                     "type": "string"
+                },
+                "width": {
+                    "description": "视频为 0",
+                    "type": "integer"
                 }
             }
         },
-        "auth.RegisterResp": {
+        "product.MoveCategoryRequest": {
             "type": "object",
             "properties": {
-                "expire_in": {
+                "id": {
                     "type": "integer"
                 },
-                "token": {
+                "parent_id": {
+                    "description": "0 表示移为一级分类",
+                    "type": "integer"
+                }
+            }
+        },
+        "product.MoveCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.PriceAlertDTO": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "current_price": {
+                    "description": "SKU 已删除时为空",
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/auth.UserInfo"
+                "id": {
+                    "type": "integer"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "notified_at": {
+                    "type": "integer"
+                },
+                "notified_price": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_name": {
+                    "type": "string"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "0:等待降价, 1:已通知",
+                    "type": "integer"
+                },
+                "target_price": {
+                    "type": "string"
                 }
             }
         },
-        "auth.UpdatePasswordReq": {
+        "product.PriceChangeDTO": {
             "type": "object",
             "properties": {
-                "new_password": {
+                "changed_at": {
+                    "type": "integer"
+                },
+                "new_market_price": {
                     "type": "string"
                 },
-                "old_password": {
+                "new_price": {
                     "type": "string"
+                },
+                "old_market_price": {
+                    "type": "string"
+                },
+                "old_price": {
+                    "description": "商品创建时的初始价格记录为空",
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "integer"
                 }
             }
         },
-        "auth.UpdateUserReq": {
+        "product.ProductAuditDTO": {
             "type": "object",
             "properties": {
-                "avatar": {
+                "created_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "operator_id": {
+                    "type": "integer"
+                },
+                "reason": {
                     "type": "string"
                 },
-                "email": {
+                "spu_id": {
+                    "type": "integer"
+                },
+                "verify_status": {
+                    "description": "0:提交审核, 1:审核通过, 2:审核不通过",
+                    "type": "integer"
+                }
+            }
+        },
+        "product.ProductDetailDTO": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "$ref": "#/definitions/product.BrandDTO"
+                },
+                "category": {
+                    "$ref": "#/definitions/product.CategoryDTO"
+                },
+                "detail": {
+                    "$ref": "#/definitions/product.DetailInfoDTO"
+                },
+                "high_price": {
                     "type": "string"
                 },
-                "phone": {
+                "id": {
+                    "type": "integer"
+                },
+                "low_price": {
+                    "type": "string"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 },
-                "username": {
+                "rating": {
+                    "$ref": "#/definitions/product.RatingDTO"
+                },
+                "sale_count": {
+                    "type": "integer"
+                },
+                "service_bits": {
+                    "type": "integer"
+                },
+                "skus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.SkuDTO"
+                    }
+                },
+                "spec_selectors": {
+                    "description": "规格选择器，分类未配置规格模板时为空",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.SpecSelectorDTO"
+                    }
+                },
+                "sub_title": {
                     "type": "string"
                 }
             }
         },
-        "auth.UserInfo": {
+        "product.ProductScheduleDTO": {
             "type": "object",
             "properties": {
-                "avatar": {
+                "action": {
+                    "description": "publish / unpublish / price",
                     "type": "string"
                 },
-                "email": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "error": {
+                    "description": "执行失败原因",
                     "type": "string"
                 },
+                "executed_at": {
+                    "description": "unix 秒，未执行时为 0",
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "phone": {
+                "market_price": {
+                    "type": "string"
+                },
+                "operator_id": {
+                    "type": "integer"
+                },
+                "price": {
                     "type": "string"
                 },
+                "run_at": {
+                    "description": "unix 秒",
+                    "type": "integer"
+                },
                 "status": {
+                    "description": "0:待执行, 1:执行中, 2:已执行, 3:执行失败, 4:已取消",
                     "type": "integer"
                 },
-                "username": {
+                "target_id": {
+                    "type": "integer"
+                },
+                "target_type": {
+                    "description": "spu / sku",
                     "type": "string"
                 }
             }
         },
-        "cart.AddToCartReq": {
+        "product.ProductVersionDTO": {
             "type": "object",
             "properties": {
-                "quantity": {
+                "action": {
+                    "description": "baseline(开始记录前的状态) / create / update / update_sku / rollback",
+                    "type": "string"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.FieldChangeDTO"
+                    }
+                },
+                "created_at": {
+                    "description": "unix 秒",
                     "type": "integer"
                 },
-                "sku_id": {
+                "operator_id": {
+                    "type": "integer"
+                },
+                "source_version": {
+                    "description": "回滚时为回滚到的版本",
+                    "type": "integer"
+                },
+                "version": {
                     "type": "integer"
                 }
             }
         },
-        "cart.AddToCartResp": {
+        "product.PublishProductsRequest": {
             "type": "object",
             "properties": {
-                "cart_item": {
-                    "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_cart.CartItem"
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "cart.ClearCartResp": {
+        "product.PublishProductsResponse": {
             "type": "object",
             "properties": {
                 "success": {
@@ -2162,27 +10310,39 @@ const docTemplate = `{
                 }
             }
         },
-        "cart.GetCartDetailsResp": {
+        "product.QuerySuggestionDTO": {
             "type": "object",
             "properties": {
-                "items": {
+                "text": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "query(历史搜索) / product / brand / category",
+                    "type": "string"
+                }
+            }
+        },
+        "product.RatingDTO": {
+            "type": "object",
+            "properties": {
+                "average_rating": {
+                    "type": "number"
+                },
+                "rating_counts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_PiaoAdmin_pmall_app_api_biz_model_api_cart.CartItem"
+                        "type": "integer"
                     }
                 },
-                "total_amount": {
-                    "type": "string"
-                },
-                "total_quantity": {
+                "review_count": {
                     "type": "integer"
                 }
             }
         },
-        "cart.RemoveFromCartReq": {
+        "product.RestoreProductsRequest": {
             "type": "object",
             "properties": {
-                "sku_ids": {
+                "ids": {
                     "type": "array",
                     "items": {
                         "type": "integer"
@@ -2190,110 +10350,117 @@ const docTemplate = `{
                 }
             }
         },
-        "cart.RemoveFromCartResp": {
+        "product.RestoreProductsResponse": {
             "type": "object",
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "affected": {
+                    "type": "integer"
                 }
             }
         },
-        "checkout.AddressDTO": {
+        "product.RollbackProductRequest": {
             "type": "object",
             "properties": {
-                "city": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "street_address": {
-                    "type": "string"
+                "spu_id": {
+                    "type": "integer"
                 },
-                "zip_code": {
+                "version": {
                     "type": "integer"
                 }
             }
         },
-        "checkout.CheckoutItemDTO": {
+        "product.RollbackProductResponse": {
             "type": "object",
             "properties": {
-                "market_price": {
-                    "type": "string"
-                },
-                "price": {
-                    "type": "string"
-                },
-                "quantity": {
-                    "type": "integer"
-                },
-                "sku_id": {
+                "version": {
+                    "description": "回滚后生成的新版本号",
                     "type": "integer"
+                }
+            }
+        },
+        "product.SaveSpecTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "description": "为空表示清除",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/product.SpecAttributeDTO"
+                    }
                 },
-                "sku_image": {
-                    "type": "string"
-                },
-                "sku_name": {
-                    "type": "string"
-                },
-                "sku_spec_data": {
-                    "type": "string"
-                },
-                "spu_id": {
+                "id": {
                     "type": "integer"
                 }
             }
         },
-        "checkout.CheckoutReq": {
+        "product.SaveSpecTemplateResponse": {
             "type": "object",
             "properties": {
-                "credit_card": {
-                    "type": "string"
-                },
-                "shipping_address": {
-                    "$ref": "#/definitions/checkout.AddressDTO"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "checkout.CheckoutResp": {
+        "product.SearchQueryStatDTO": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/checkout.CheckoutItemDTO"
-                    }
+                "avg_result_count": {
+                    "description": "平均结果数",
+                    "type": "number"
                 },
-                "order_id": {
+                "keyword": {
                     "type": "string"
                 },
-                "total_amount": {
-                    "type": "string"
+                "period_user_sum": {
+                    "description": "各统计周期内登录用户去重数之和，同一用户在多个周期搜索会重复计入，不是去重用户数",
+                    "type": "integer"
+                },
+                "search_count": {
+                    "description": "搜索次数（只统计首页）",
+                    "type": "integer"
+                },
+                "zero_result_count": {
+                    "description": "零结果次数",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_cart.CartItem": {
+        "product.SearchSkuDTO": {
             "type": "object",
             "properties": {
+                "brand_id": {
+                    "type": "integer"
+                },
+                "brand_name": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "category_name": {
+                    "type": "string"
+                },
+                "main_image": {
+                    "type": "string"
+                },
                 "market_price": {
                     "type": "string"
                 },
                 "price": {
                     "type": "string"
                 },
-                "quantity": {
+                "sale_count": {
+                    "description": "SPU 销量",
                     "type": "integer"
                 },
                 "sku_id": {
                     "type": "integer"
                 },
-                "sku_image": {
-                    "type": "string"
-                },
                 "sku_name": {
                     "type": "string"
                 },
                 "sku_spec_data": {
+                    "description": "规格 JSON",
                     "type": "string"
                 },
                 "spu_id": {
@@ -2304,10 +10471,28 @@ const docTemplate = `{
                 },
                 "stock": {
                     "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.SetBrandCategoriesRequest": {
+            "type": "object",
+            "properties": {
+                "category_ids": {
+                    "description": "为空表示清除全部关联",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.CancelOrderResp": {
+        "product.SetBrandCategoriesResponse": {
             "type": "object",
             "properties": {
                 "success": {
@@ -2315,388 +10500,555 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.ListOrderResp": {
+        "product.SetCategoryVisibilityRequest": {
             "type": "object",
             "properties": {
-                "orders": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.OrderDTO"
-                    }
+                "id": {
+                    "type": "integer"
+                },
+                "show_status": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderReq": {
+        "product.SetCategoryVisibilityResponse": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "shipping_address": {
-                    "$ref": "#/definitions/order.AddressDTO"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_order.PlaceOrderResp": {
+        "product.SkuDTO": {
             "type": "object",
             "properties": {
-                "order": {
-                    "$ref": "#/definitions/order.OrderResultDTO"
+                "id": {
+                    "type": "integer"
+                },
+                "main_image": {
+                    "type": "string"
+                },
+                "market_price": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "sku_spec_data": {
+                    "description": "规格 JSON",
+                    "type": "string"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "sub_title": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuRequest": {
+        "product.SortCategoriesRequest": {
             "type": "object",
             "properties": {
-                "items": {
+                "ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/product.UpdateSkuItem"
+                        "type": "integer"
                     }
+                },
+                "parent_id": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.BatchUpdateSkuResponse": {
+        "product.SortCategoriesResponse": {
             "type": "object",
             "properties": {
-                "message": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.SpecAttributeDTO": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "description": "定义该属性的分类，继承自上级分类时与所查询的分类不同",
+                    "type": "integer"
+                },
+                "filterable": {
+                    "description": "是否作为搜索筛选项",
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "success": {
+                "sales": {
+                    "description": "是否销售属性，每个 SKU 必填",
                     "type": "boolean"
                 },
-                "updated_count": {
-                    "description": "实际更新数量",
+                "sort": {
                     "type": "integer"
+                },
+                "type": {
+                    "description": "enum(枚举) / text(文本) / number(数值)",
+                    "type": "string"
+                },
+                "values": {
+                    "description": "可选值，enum 类型必填",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductRequest": {
+        "product.SpecSelectorDTO": {
             "type": "object",
-            "properties": {
-                "detail": {
-                    "$ref": "#/definitions/product.CreateProductDetail"
+            "properties": {
+                "name": {
+                    "type": "string"
                 },
-                "skus": {
+                "values": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/product.CreateProductSKU"
+                        "type": "string"
                     }
-                },
-                "spu": {
-                    "$ref": "#/definitions/product.CreateProductSPU"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.CreateProductResponse": {
+        "product.SubscribePriceAlertRequest": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
-                },
-                "spu_id": {
+                "sku_id": {
                     "type": "integer"
+                },
+                "target_price": {
+                    "description": "需低于当前售价",
+                    "type": "string"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.GetProductDetailResponse": {
+        "product.SubscribePriceAlertResponse": {
             "type": "object",
             "properties": {
-                "product": {
-                    "$ref": "#/definitions/product.ProductDetailDTO"
+                "alert": {
+                    "$ref": "#/definitions/product.PriceAlertDTO"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListBrandsResponse": {
+        "product.TrendingSearchDTO": {
             "type": "object",
             "properties": {
-                "brands": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/product.BrandDTO"
-                    }
+                "keyword": {
+                    "type": "string"
                 },
-                "total": {
+                "search_count": {
                     "type": "integer"
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.ListCategoriesResponse": {
+        "product.UnpublishProductsRequest": {
             "type": "object",
             "properties": {
-                "categories": {
+                "ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/product.CategoryDTO"
+                        "type": "integer"
                     }
                 }
             }
         },
-        "github_com_PiaoAdmin_pmall_app_api_biz_model_api_product.SearchProductsResponse": {
+        "product.UnpublishProductsResponse": {
             "type": "object",
             "properties": {
-                "list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/product.SearchSkuDTO"
-                    }
-                },
-                "total": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "home.Empty": {
-            "type": "object"
-        },
-        "order.AddressDTO": {
+        "product.UpdateBrandRequest": {
             "type": "object",
             "properties": {
-                "city": {
+                "first_letter": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
+                    "type": "integer"
+                },
+                "logo": {
                     "type": "string"
                 },
-                "street_address": {
+                "name": {
+                    "description": "重命名且未指定首字母时重新推算首字母",
                     "type": "string"
                 },
-                "zip_code": {
+                "show_status": {
+                    "type": "boolean"
+                },
+                "sort": {
                     "type": "integer"
                 }
             }
         },
-        "order.OrderDTO": {
+        "product.UpdateBrandResponse": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.UpdateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "icon": {
+                    "type": "string"
                 },
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/order.OrderItem"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "order_id": {
+                "name": {
                     "type": "string"
                 },
-                "shipping_address": {
-                    "$ref": "#/definitions/order.AddressDTO"
-                },
-                "status": {
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "product.UpdateCategoryResponse": {
+            "type": "object",
+            "properties": {
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "product.UpdateSkuItem": {
+            "type": "object",
+            "properties": {
+                "price": {
+                    "description": "为空则不更新",
                     "type": "string"
                 },
-                "user_id": {
+                "sku_id": {
+                    "type": "integer"
+                },
+                "stock": {
+                    "description": "0 表示不更新",
                     "type": "integer"
                 }
             }
         },
-        "order.OrderItem": {
+        "product.UpdateSkuRequest": {
             "type": "object",
             "properties": {
-                "price": {
+                "main_image": {
                     "type": "string"
                 },
-                "quantity": {
+                "main_image_asset_id": {
+                    "description": "媒体资源ID，非 0 时代替 main_image",
                     "type": "integer"
                 },
+                "market_price": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
                 "sku_id": {
                     "type": "integer"
                 },
-                "sku_name": {
+                "sku_spec_data": {
+                    "description": "JSON 字符串",
+                    "type": "string"
+                },
+                "sub_title": {
                     "type": "string"
                 }
             }
         },
-        "order.OrderResultDTO": {
+        "product.UpdateSkuResponse": {
             "type": "object",
             "properties": {
-                "order_id": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "payment.PayReq": {
+        "product.UploadMediaResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "string"
-                },
-                "credit_card": {
-                    "type": "string"
-                },
-                "order_id": {
-                    "type": "string"
+                "asset": {
+                    "$ref": "#/definitions/product.MediaAssetDTO"
                 }
             }
         },
-        "payment.PayResp": {
+        "response.Response": {
             "type": "object",
             "properties": {
-                "success": {
-                    "type": "boolean"
+                "code": {
+                    "type": "integer"
                 },
-                "trade_no": {
+                "data": {},
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "product.BrandDTO": {
+        "review.AdminAnswerDTO": {
             "type": "object",
             "properties": {
-                "first_letter": {
+                "content": {
                     "type": "string"
                 },
+                "created_at": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "logo": {
-                    "type": "string"
+                "is_merchant": {
+                    "type": "boolean"
                 },
-                "name": {
+                "question_id": {
+                    "type": "integer"
+                },
+                "reject_reason": {
                     "type": "string"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "upvotes": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "product.CategoryDTO": {
+        "review.AdminListAnswersResp": {
             "type": "object",
             "properties": {
-                "children": {
-                    "description": "子分类",
+                "list": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/product.CategoryDTO"
+                        "$ref": "#/definitions/review.AdminAnswerDTO"
                     }
                 },
-                "icon": {
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "review.AdminListQuestionsResp": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.AdminQuestionDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "review.AdminListReviewsResp": {
+            "type": "object",
+            "properties": {
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.AdminReviewDTO"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "review.AdminQuestionDTO": {
+            "type": "object",
+            "properties": {
+                "answer_count": {
+                    "type": "integer"
+                },
+                "content": {
                     "type": "string"
                 },
-                "id": {
+                "created_at": {
                     "type": "integer"
                 },
-                "level": {
+                "id": {
                     "type": "integer"
                 },
-                "name": {
+                "reject_reason": {
                     "type": "string"
                 },
-                "parent_id": {
+                "spu_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "user_id": {
                     "type": "integer"
                 }
             }
         },
-        "product.CreateProductDetail": {
+        "review.AdminReviewDTO": {
             "type": "object",
             "properties": {
-                "description": {
-                    "description": "富文本描述",
+                "content": {
                     "type": "string"
                 },
+                "created_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
                 "images": {
-                    "description": "商品图",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "market_tag_json": {
-                    "description": "营销标签 JSON",
+                "order_id": {
                     "type": "string"
                 },
-                "tech_tag_json": {
-                    "description": "技术参数 JSON",
+                "rating": {
+                    "type": "integer"
+                },
+                "reject_reason": {
                     "type": "string"
                 },
-                "videos": {
-                    "description": "商品视频",
+                "sku_id": {
+                    "type": "integer"
+                },
+                "sku_name": {
+                    "type": "string"
+                },
+                "spu_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "tags": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "product.CreateProductSKU": {
+        "review.AnswerDTO": {
             "type": "object",
             "properties": {
-                "main_image": {
+                "content": {
                     "type": "string"
                 },
-                "market_price": {
-                    "type": "string"
+                "created_at": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "price": {
-                    "type": "string"
+                "is_merchant": {
+                    "type": "boolean"
                 },
-                "sku_code": {
-                    "type": "string"
+                "question_id": {
+                    "type": "integer"
                 },
-                "sku_spec_data": {
-                    "description": "JSON 字符串",
-                    "type": "string"
+                "upvoted": {
+                    "type": "boolean"
                 },
-                "stock": {
+                "upvotes": {
                     "type": "integer"
-                },
-                "sub_title": {
+                }
+            }
+        },
+        "review.CreateAnswerReq": {
+            "type": "object",
+            "properties": {
+                "content": {
                     "type": "string"
+                },
+                "question_id": {
+                    "type": "integer"
                 }
             }
         },
-        "product.CreateProductSPU": {
+        "review.CreateAnswerResp": {
             "type": "object",
             "properties": {
-                "brand_id": {
+                "answer_id": {
                     "type": "integer"
                 },
-                "category_id": {
+                "status": {
                     "type": "integer"
-                },
-                "main_image": {
-                    "type": "string"
-                },
-                "name": {
+                }
+            }
+        },
+        "review.CreateQuestionReq": {
+            "type": "object",
+            "properties": {
+                "content": {
                     "type": "string"
                 },
-                "service_bits": {
+                "spu_id": {
                     "type": "integer"
-                },
-                "sort": {
+                }
+            }
+        },
+        "review.CreateQuestionResp": {
+            "type": "object",
+            "properties": {
+                "question_id": {
                     "type": "integer"
                 },
-                "sub_title": {
-                    "type": "string"
+                "status": {
+                    "type": "integer"
                 }
             }
         },
-        "product.DetailInfoDTO": {
+        "review.CreateReviewReq": {
             "type": "object",
             "properties": {
-                "description": {
-                    "description": "富文本描述",
+                "content": {
                     "type": "string"
                 },
                 "images": {
-                    "description": "商品图",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "market_tag_json": {
-                    "description": "营销标签 JSON",
+                "order_id": {
                     "type": "string"
                 },
-                "tech_tag_json": {
-                    "description": "技术参数 JSON",
-                    "type": "string"
+                "rating": {
+                    "type": "integer"
                 },
-                "videos": {
-                    "description": "商品视频",
+                "sku_id": {
+                    "type": "integer"
+                },
+                "tags": {
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -2704,13 +11056,24 @@ const docTemplate = `{
                 }
             }
         },
-        "product.GetHomeProductsResponse": {
+        "review.CreateReviewResp": {
+            "type": "object",
+            "properties": {
+                "review_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "review.ListAnswersResp": {
             "type": "object",
             "properties": {
                 "list": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/product.HomeSpuDTO"
+                        "$ref": "#/definitions/review.AnswerDTO"
                     }
                 },
                 "total": {
@@ -2718,183 +11081,164 @@ const docTemplate = `{
                 }
             }
         },
-        "product.HomeSpuDTO": {
+        "review.ListQuestionsResp": {
             "type": "object",
             "properties": {
-                "high_price": {
-                    "type": "string"
-                },
-                "low_price": {
-                    "type": "string"
-                },
-                "main_image": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "sale_count": {
-                    "type": "integer"
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.QuestionDTO"
+                    }
                 },
-                "spu_id": {
+                "total": {
                     "type": "integer"
-                },
-                "sub_title": {
-                    "type": "string"
                 }
             }
         },
-        "product.ProductDetailDTO": {
+        "review.ListReviewsResp": {
             "type": "object",
             "properties": {
-                "brand": {
-                    "$ref": "#/definitions/product.BrandDTO"
-                },
-                "category": {
-                    "$ref": "#/definitions/product.CategoryDTO"
-                },
-                "detail": {
-                    "$ref": "#/definitions/product.DetailInfoDTO"
-                },
-                "high_price": {
-                    "type": "string"
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.ReviewDTO"
+                    }
                 },
-                "id": {
+                "total": {
                     "type": "integer"
-                },
-                "low_price": {
+                }
+            }
+        },
+        "review.ModerateQaReq": {
+            "type": "object",
+            "properties": {
+                "action": {
                     "type": "string"
                 },
-                "main_image": {
-                    "type": "string"
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "name": {
+                "reject_reason": {
                     "type": "string"
-                },
-                "sale_count": {
-                    "type": "integer"
-                },
-                "service_bits": {
+                }
+            }
+        },
+        "review.ModerateQaResp": {
+            "type": "object",
+            "properties": {
+                "affected": {
                     "type": "integer"
+                }
+            }
+        },
+        "review.ModerateReviewsReq": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
                 },
-                "skus": {
+                "ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/product.SkuDTO"
+                        "type": "integer"
                     }
                 },
-                "sub_title": {
+                "reject_reason": {
                     "type": "string"
                 }
             }
         },
-        "product.SearchSkuDTO": {
+        "review.ModerateReviewsResp": {
             "type": "object",
             "properties": {
-                "brand_id": {
+                "affected": {
                     "type": "integer"
-                },
-                "brand_name": {
-                    "type": "string"
-                },
-                "category_id": {
+                }
+            }
+        },
+        "review.QuestionDTO": {
+            "type": "object",
+            "properties": {
+                "answer_count": {
                     "type": "integer"
                 },
-                "category_name": {
-                    "type": "string"
-                },
-                "main_image": {
-                    "type": "string"
-                },
-                "market_price": {
+                "content": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "string"
-                },
-                "sale_count": {
-                    "description": "SPU 销量",
+                "created_at": {
                     "type": "integer"
                 },
-                "sku_id": {
+                "id": {
                     "type": "integer"
                 },
-                "sku_name": {
-                    "type": "string"
-                },
-                "sku_spec_data": {
-                    "description": "规格 JSON",
-                    "type": "string"
-                },
                 "spu_id": {
                     "type": "integer"
                 },
-                "spu_name": {
-                    "type": "string"
-                },
-                "stock": {
-                    "type": "integer"
-                },
-                "sub_title": {
-                    "type": "string"
+                "top_answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/review.AnswerDTO"
+                    }
                 }
             }
         },
-        "product.SkuDTO": {
+        "review.ReviewDTO": {
             "type": "object",
             "properties": {
-                "id": {
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
                     "type": "integer"
                 },
-                "main_image": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "market_price": {
-                    "type": "string"
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "rating": {
+                    "type": "integer"
                 },
-                "price": {
-                    "type": "string"
+                "sku_id": {
+                    "type": "integer"
                 },
-                "sku_spec_data": {
-                    "description": "规格 JSON",
+                "sku_name": {
                     "type": "string"
                 },
-                "stock": {
+                "spu_id": {
                     "type": "integer"
                 },
-                "sub_title": {
-                    "type": "string"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "product.UpdateSkuItem": {
+        "review.UpvoteAnswerReq": {
             "type": "object",
             "properties": {
-                "price": {
-                    "description": "为空则不更新",
-                    "type": "string"
-                },
-                "sku_id": {
+                "answer_id": {
                     "type": "integer"
                 },
-                "stock": {
-                    "description": "0 表示不更新",
-                    "type": "integer"
+                "cancel": {
+                    "type": "boolean"
                 }
             }
         },
-        "response.Response": {
+        "review.UpvoteAnswerResp": {
             "type": "object",
             "properties": {
-                "code": {
+                "upvotes": {
                     "type": "integer"
-                },
-                "data": {},
-                "message": {
-                    "type": "string"
                 }
             }
         }
@@ -9,6 +9,7 @@ import (
 	"github.com/PiaoAdmin/pmall/app/api/biz/dal"
 	"github.com/PiaoAdmin/pmall/app/api/conf"
 	_ "github.com/PiaoAdmin/pmall/app/api/docs"
+	"github.com/PiaoAdmin/pmall/app/api/md/bodylimit"
 	mwError "github.com/PiaoAdmin/pmall/app/api/md/error"
 	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
 	"github.com/PiaoAdmin/pmall/app/api/rpc"
@@ -36,8 +37,14 @@ func main() {
 	defer f.Close()
 	fileWriter := io.MultiWriter(f, os.Stdout)
 	hlog.SetOutput(fileWriter)
-	h := server.New(server.WithHostPorts(address))
-	h.Use(mwError.GlobalErrorHandler())
+	// 请求体以流式读取，全局按默认上限校验，上传与导入接口在路由中间件中单独放宽
+	h := server.New(
+		server.WithHostPorts(address),
+		server.WithMaxRequestBodySize(bodylimit.DefaultMaxBodySize),
+		server.WithStreamBody(true),
+		server.WithDisablePreParseMultipartForm(true),
+	)
+	h.Use(mwError.GlobalErrorHandler(), bodylimit.New(bodylimit.DefaultMaxBodySize))
 
 	// Swagger API docs route
 	h.GET("/swagger/*any", adaptor.HertzHandler(
@@ -0,0 +1,109 @@
+// Package bodylimit 限制请求体大小。
+// 服务端以流式读取请求体：普通请求在进入业务处理前按默认上限读入内存，超过直接拒绝；
+// multipart 上传边读边校验，默认同样使用全局上限，上传、导入接口通过 Max 单独放宽。
+package bodylimit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+
+	"github.com/PiaoAdmin/pmall/app/api/pkg/response"
+	perrors "github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// DefaultMaxBodySize 全局请求体上限，与 Hertz 默认值一致
+const DefaultMaxBodySize = 4 << 20
+
+const limiterKey = "bodylimit.limiter"
+
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// limiter 限制 multipart 请求体的读取量，超过上限时返回 ErrBodyTooLarge
+type limiter struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *limiter) Read(p []byte) (int, error) {
+	if l.read > l.limit {
+		return 0, ErrBodyTooLarge
+	}
+	if remain := l.limit - l.read + 1; int64(len(p)) > remain {
+		p = p[:remain]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n, ErrBodyTooLarge
+	}
+	return n, err
+}
+
+// Close 关闭底层的请求体流，使服务端能跳过未读完的内容
+func (l *limiter) Close() error {
+	if c, ok := l.r.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// New 全局中间件，limit 为默认上限，需配合 server.WithStreamBody 与 WithDisablePreParseMultipartForm 使用
+func New(limit int) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		req := &c.Request
+		multipart := bytes.HasPrefix(req.Header.ContentType(), []byte("multipart/form-data"))
+		if !multipart && req.Header.ContentLength() > limit {
+			tooLarge(c)
+			return
+		}
+		if !req.IsBodyStream() {
+			c.Next(ctx)
+			return
+		}
+
+		if multipart {
+			l := &limiter{r: req.BodyStream(), limit: int64(limit)}
+			req.ConstructBodyStream(req.BodyBuffer(), l)
+			c.Set(limiterKey, l)
+			c.Next(ctx)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(req.BodyStream(), int64(limit)+1))
+		if err != nil {
+			response.FailWithErrorType(c, consts.StatusBadRequest, perrors.ErrParam.Code, "read request body failed")
+			c.Abort()
+			return
+		}
+		if len(body) > limit {
+			tooLarge(c)
+			return
+		}
+		req.SetBody(body)
+		c.Next(ctx)
+	}
+}
+
+// Max 路由中间件，将该路由 multipart 请求体的上限放宽为 limit
+func Max(limit int) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if c.Request.Header.ContentLength() > limit {
+			tooLarge(c)
+			return
+		}
+		if v, ok := c.Get(limiterKey); ok {
+			v.(*limiter).limit = int64(limit)
+		}
+		c.Next(ctx)
+	}
+}
+
+func tooLarge(c *app.RequestContext) {
+	response.FailWithErrorType(c, consts.StatusRequestEntityTooLarge, perrors.ErrParam.Code, ErrBodyTooLarge.Error())
+	c.Abort()
+}
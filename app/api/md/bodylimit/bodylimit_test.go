@@ -0,0 +1,72 @@
+package bodylimit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+func newStreamContext(contentType string, body []byte, contentLength int) *app.RequestContext {
+	c := app.NewContext(0)
+	c.Request.Header.SetMethod(consts.MethodPost)
+	c.Request.Header.SetContentTypeBytes([]byte(contentType))
+	c.Request.SetBodyStream(bytes.NewReader(body), contentLength)
+	return c
+}
+
+func TestNew(t *testing.T) {
+	mw := New(8)
+
+	c := newStreamContext("application/json", []byte(`{"a":1}`), -1)
+	mw(context.Background(), c)
+	if c.IsAborted() || string(c.Request.Body()) != `{"a":1}` {
+		t.Fatalf("small body: aborted=%v body=%q", c.IsAborted(), c.Request.Body())
+	}
+
+	c = newStreamContext("application/json", []byte(`{"a":12345}`), -1)
+	mw(context.Background(), c)
+	if !c.IsAborted() || c.Response.StatusCode() != consts.StatusRequestEntityTooLarge {
+		t.Fatalf("chunked large body: aborted=%v status=%d", c.IsAborted(), c.Response.StatusCode())
+	}
+
+	c = newStreamContext("application/json", []byte(`{"a":12345}`), 11)
+	mw(context.Background(), c)
+	if !c.IsAborted() || c.Response.StatusCode() != consts.StatusRequestEntityTooLarge {
+		t.Fatalf("large content length: aborted=%v status=%d", c.IsAborted(), c.Response.StatusCode())
+	}
+}
+
+func TestMaxRaisesMultipartLimit(t *testing.T) {
+	body := bytes.Repeat([]byte("x"), 16)
+	const contentType = "multipart/form-data; boundary=b"
+
+	// 未放宽时按全局上限边读边校验
+	c := newStreamContext(contentType, body, -1)
+	New(8)(context.Background(), c)
+	if c.IsAborted() {
+		t.Fatal("multipart body should be checked while reading")
+	}
+	if _, err := io.ReadAll(c.Request.BodyStream()); !errors.Is(err, ErrBodyTooLarge) {
+		t.Fatalf("expected ErrBodyTooLarge, got %v", err)
+	}
+
+	c = newStreamContext(contentType, body, -1)
+	New(8)(context.Background(), c)
+	Max(32)(context.Background(), c)
+	data, err := io.ReadAll(c.Request.BodyStream())
+	if err != nil || len(data) != len(body) {
+		t.Fatalf("raised limit: read %d bytes, err %v", len(data), err)
+	}
+
+	c = newStreamContext(contentType, body, len(body))
+	New(8)(context.Background(), c)
+	Max(10)(context.Background(), c)
+	if !c.IsAborted() || c.Response.StatusCode() != consts.StatusRequestEntityTooLarge {
+		t.Fatalf("content length over route limit: aborted=%v status=%d", c.IsAborted(), c.Response.StatusCode())
+	}
+}
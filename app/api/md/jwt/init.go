@@ -15,6 +15,15 @@ var (
 	JwtMiddleware *HertzJWTMiddleware
 )
 
+// RolesKey JWT 中保存用户后台角色的字段
+const RolesKey = "roles"
+
+// Identity 登录身份，作为 TokenGenerator 的参数写入 JWT
+type Identity struct {
+	UserID uint64
+	Roles  []string
+}
+
 func Init() {
 	var err error
 	// 检查 Redis 是否已初始化
@@ -32,7 +41,13 @@ func Init() {
 		DisabledAbort:     false,
 		SendAuthorization: true,
 		PayloadFunc: func(data interface{}) MapClaims { // 负载
-			if v, ok := data.(uint64); ok {
+			switch v := data.(type) {
+			case Identity:
+				return MapClaims{
+					jwtConf.IdentityKey: v.UserID,
+					RolesKey:            v.Roles,
+				}
+			case uint64:
 				return MapClaims{
 					jwtConf.IdentityKey: v,
 				}
@@ -58,3 +73,26 @@ func Init() {
 		panic("JWT Init Error: JwtMiddleware is nil after New()")
 	}
 }
+
+// ExtractRoles 从 JWT 中读取用户角色，需在 JWT 中间件之后调用
+func ExtractRoles(ctx context.Context, c *app.RequestContext) []string {
+	raw, _ := ExtractClaims(ctx, c)[RolesKey].([]interface{})
+	roles := make([]string, 0, len(raw))
+	for _, r := range raw {
+		if s, ok := r.(string); ok {
+			roles = append(roles, s)
+		}
+	}
+	return roles
+}
+
+// OptionalUserID 用于无需登录的接口，请求携带有效 token 时返回用户 ID，否则返回 0。
+// 不校验黑名单，仅可用于统计等非鉴权场景
+func OptionalUserID(ctx context.Context, c *app.RequestContext) uint64 {
+	token, err := JwtMiddleware.ParseToken(ctx, c)
+	if err != nil || !token.Valid {
+		return 0
+	}
+	id, _ := ExtractClaimsFromToken(token)[JwtMiddleware.IdentityKey].(float64)
+	return uint64(id)
+}
@@ -0,0 +1,173 @@
+// Package rbac 管理后台接口的权限校验中间件。
+// 角色来自 JWT，角色对应的权限从用户服务加载并在本地缓存，修改角色权限后最多 permissionTTL 生效。
+package rbac
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/api/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/api/conf"
+	"github.com/PiaoAdmin/pmall/app/api/md/jwt"
+	"github.com/PiaoAdmin/pmall/app/api/pkg/response"
+	"github.com/PiaoAdmin/pmall/app/api/rpc"
+	perrors "github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/common/rbac"
+	"github.com/PiaoAdmin/pmall/rpc_gen/user"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/hlog"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	permissionTTL = time.Minute
+	// rolesChangedKeyPrefix 记录用户角色的修改时间，早于该时间签发的 token 不再被接受
+	rolesChangedKeyPrefix = "rbac:roles:changed:"
+)
+
+var (
+	mu          sync.RWMutex
+	rolePerms   map[string][]string
+	loadedAt    time.Time
+	loadRoles   = fetchRolePermissions
+	timeNowFunc = time.Now
+)
+
+// Admin 管理后台路由组中间件：登录认证，并要求拥有 admin:access 及 perms 中的全部权限
+func Admin(perms ...string) []app.HandlerFunc {
+	return []app.HandlerFunc{
+		jwt.JwtMiddleware.MiddlewareFunc(),
+		RequirePermission(append([]string{rbac.PermAdminAccess}, perms...)...),
+	}
+}
+
+// RequirePermission 要求当前用户的角色拥有全部 perms，需在 JWT 中间件之后使用
+func RequirePermission(perms ...string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		claims := jwt.ExtractClaims(ctx, c)
+		userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+		issuedAt, _ := claims["orig_iat"].(float64)
+
+		changed, err := rolesChangedAt(ctx, userID)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "get roles changed time failed: %v", err)
+		} else if changed > 0 && int64(issuedAt) <= changed {
+			response.FailWithErrorType(c, consts.StatusUnauthorized, perrors.ErrAuthFailed.Code, "roles changed, please login again")
+			c.Abort()
+			return
+		}
+
+		table, err := permissionTable(ctx)
+		if err != nil {
+			hlog.CtxErrorf(ctx, "load role permissions failed: %v", err)
+			response.FailWithErrorType(c, consts.StatusInternalServerError, perrors.ErrInternal.Code, "load role permissions failed")
+			c.Abort()
+			return
+		}
+
+		roles := jwt.ExtractRoles(ctx, c)
+		for _, p := range perms {
+			if !allowed(table, roles, p) {
+				response.FailWithErrorType(c, consts.StatusForbidden, perrors.ErrPermission.Code, "permission denied: "+p)
+				c.Abort()
+				return
+			}
+		}
+	}
+}
+
+// HasPermission 判断当前用户是否拥有 perm，用于按权限调整业务逻辑而不拦截请求，需在 JWT 中间件之后使用。
+// 角色已变更或权限加载失败时视为无权限
+func HasPermission(ctx context.Context, c *app.RequestContext, perm string) bool {
+	claims := jwt.ExtractClaims(ctx, c)
+	userID := uint64(claims[jwt.JwtMiddleware.IdentityKey].(float64))
+	issuedAt, _ := claims["orig_iat"].(float64)
+	if changed, err := rolesChangedAt(ctx, userID); err != nil || (changed > 0 && int64(issuedAt) <= changed) {
+		return false
+	}
+
+	table, err := permissionTable(ctx)
+	if err != nil {
+		hlog.CtxWarnf(ctx, "load role permissions failed: %v", err)
+		return false
+	}
+	return allowed(table, jwt.ExtractRoles(ctx, c), perm)
+}
+
+// MarkRolesChanged 记录用户角色已修改，使其现有 token 访问管理接口时要求重新登录；
+// 在 MaxRefresh 的最后时刻刷新的 token 还能再用 Timeout，记录需保留两者之和
+func MarkRolesChanged(ctx context.Context, userID uint64) error {
+	jwtConf := conf.GetConf().JWT
+	ttl := time.Minute * time.Duration(jwtConf.Timeout+jwtConf.MaxRefresh)
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+	now := timeNowFunc().Unix()
+	return redis.RedisClient.Set(ctx, rolesChangedKeyPrefix+strconv.FormatUint(userID, 10), now, ttl).Err()
+}
+
+// InvalidatePermissions 清空本地权限缓存，下次校验时重新加载
+func InvalidatePermissions() {
+	mu.Lock()
+	defer mu.Unlock()
+	loadedAt = time.Time{}
+}
+
+func rolesChangedAt(ctx context.Context, userID uint64) (int64, error) {
+	v, err := redis.RedisClient.Get(ctx, rolesChangedKeyPrefix+strconv.FormatUint(userID, 10)).Int64()
+	if err == goredis.Nil {
+		return 0, nil
+	}
+	return v, err
+}
+
+// permissionTable 返回角色到权限的映射，缓存过期后重新加载；加载失败时沿用旧数据
+func permissionTable(ctx context.Context) (map[string][]string, error) {
+	mu.RLock()
+	table, fresh := rolePerms, timeNowFunc().Sub(loadedAt) < permissionTTL
+	mu.RUnlock()
+	if table != nil && fresh {
+		return table, nil
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if rolePerms != nil && timeNowFunc().Sub(loadedAt) < permissionTTL {
+		return rolePerms, nil
+	}
+	loaded, err := loadRoles(ctx)
+	if err != nil {
+		if rolePerms != nil {
+			hlog.CtxWarnf(ctx, "reload role permissions failed, using cached: %v", err)
+			return rolePerms, nil
+		}
+		return nil, err
+	}
+	rolePerms, loadedAt = loaded, timeNowFunc()
+	return rolePerms, nil
+}
+
+func fetchRolePermissions(ctx context.Context) (map[string][]string, error) {
+	resp, err := rpc.UserClient.ListRoles(ctx, &user.ListRolesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	table := make(map[string][]string, len(resp.Roles))
+	for _, r := range resp.Roles {
+		table[r.Name] = r.Permissions
+	}
+	return table, nil
+}
+
+// allowed 任一角色拥有 perm 即通过
+func allowed(table map[string][]string, roles []string, perm string) bool {
+	for _, r := range roles {
+		if rbac.Allowed(table[r], perm) {
+			return true
+		}
+	}
+	return false
+}
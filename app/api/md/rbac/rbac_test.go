@@ -0,0 +1,71 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/common/rbac"
+)
+
+func TestAllowed(t *testing.T) {
+	table := map[string][]string{
+		rbac.RoleAdmin:    {rbac.PermAll},
+		rbac.RoleMerchant: {rbac.PermAdminAccess, rbac.PermProductWrite},
+	}
+	if !allowed(table, []string{rbac.RoleAdmin}, rbac.PermUserRole) {
+		t.Error("admin should have all permissions")
+	}
+	if !allowed(table, []string{"unknown", rbac.RoleMerchant}, rbac.PermProductWrite) {
+		t.Error("any role granting the permission should pass")
+	}
+	if allowed(table, []string{rbac.RoleMerchant}, rbac.PermOrderExport) {
+		t.Error("merchant should not export orders")
+	}
+	if allowed(table, nil, rbac.PermAdminAccess) {
+		t.Error("user without roles should be denied")
+	}
+}
+
+func TestPermissionTableCache(t *testing.T) {
+	now := time.Unix(1000, 0)
+	calls := 0
+	var loadErr error
+	timeNowFunc = func() time.Time { return now }
+	loadRoles = func(ctx context.Context) (map[string][]string, error) {
+		calls++
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		return map[string][]string{"r": {"p"}}, nil
+	}
+	defer func() {
+		timeNowFunc = time.Now
+		loadRoles = fetchRolePermissions
+		rolePerms, loadedAt = nil, time.Time{}
+	}()
+
+	ctx := context.Background()
+	if _, err := permissionTable(ctx); err != nil || calls != 1 {
+		t.Fatalf("first load: err %v, calls %d", err, calls)
+	}
+	_, _ = permissionTable(ctx)
+	if calls != 1 {
+		t.Fatalf("expected cached table, calls %d", calls)
+	}
+
+	// 过期后重新加载，失败时沿用旧数据
+	now = now.Add(permissionTTL)
+	loadErr = errors.New("rpc down")
+	table, err := permissionTable(ctx)
+	if err != nil || calls != 2 || len(table["r"]) != 1 {
+		t.Fatalf("stale fallback: err %v, calls %d, table %v", err, calls, table)
+	}
+
+	InvalidatePermissions()
+	rolePerms = nil
+	if _, err := permissionTable(ctx); err == nil {
+		t.Fatal("expected error without cached table")
+	}
+}
@@ -0,0 +1,42 @@
+// Package blob 媒体文件与导出文件存储，默认写入本地目录，也可以使用 S3 兼容的对象存储。
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+)
+
+// Store 按 key 存取文件，key 为 "/" 分隔的相对路径
+type Store interface {
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// Open 读取文件，文件不存在时返回 ErrNotFound，调用方负责关闭
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除文件，文件不存在时不返回错误
+	Delete(ctx context.Context, key string) error
+	// URL 返回文件的访问地址
+	URL(key string) string
+}
+
+var (
+	ErrNotFound   = errors.New("blob: not found")
+	errInvalidKey = errors.New("blob: invalid key")
+)
+
+// checkKey 拒绝绝对路径和包含 ".." 的 key，避免写到存储目录之外
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return errInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return errInvalidKey
+		}
+	}
+	return nil
+}
+
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + key
+}
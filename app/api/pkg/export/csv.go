@@ -0,0 +1,36 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	// 写入 UTF-8 BOM，Excel 打开时中文不乱码
+	if _, err := w.Write([]byte("\xEF\xBB\xBF")); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Write(row ...interface{}) error {
+	record := make([]string, len(row))
+	for i, v := range row {
+		record[i], _ = formatCell(v)
+	}
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+	// 逐行刷新，保证流式下载时客户端能持续收到数据
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
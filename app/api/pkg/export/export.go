@@ -0,0 +1,77 @@
+// Package export 提供 CSV / XLSX 表格的流式写入，行数据逐行写出，不在内存中缓存整张表。
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormat 解析导出格式，为空时默认 csv
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q", s)
+	}
+}
+
+// ContentType 下载时的 Content-Type
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Ext 文件扩展名
+func (f Format) Ext() string {
+	return "." + string(f)
+}
+
+// Writer 表格写入器。行中的 string 写为文本单元格，整数和浮点数写为数值单元格。
+// 订单号等超长数字应以 string 传入，避免被表格软件转换丢失精度。
+type Writer interface {
+	Write(row ...interface{}) error
+	// Close 写出文件尾，不关闭底层 io.Writer
+	Close() error
+}
+
+// NewWriter 按格式创建写入器
+func NewWriter(f Format, w io.Writer) (Writer, error) {
+	switch f {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", f)
+	}
+}
+
+func formatCell(v interface{}) (string, bool) {
+	switch val := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return val, false
+	case int, int32, int64, uint, uint32, uint64:
+		return fmt.Sprintf("%d", val), true
+	case float32:
+		return fmt.Sprintf("%.2f", val), true
+	case float64:
+		return fmt.Sprintf("%.2f", val), true
+	default:
+		return fmt.Sprint(val), false
+	}
+}
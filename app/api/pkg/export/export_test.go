@@ -0,0 +1,94 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestParseFormat(t *testing.T) {
+	cases := map[string]Format{"": FormatCSV, "csv": FormatCSV, "XLSX": FormatXLSX}
+	for in, want := range cases {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseFormat("pdf"); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatCSV, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write("order_id", "price", "quantity")
+	w.Write("1234567890123456789", 9.9, int32(2))
+	w.Write("a,b", "", nil)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "\xEF\xBB\xBForder_id,price,quantity\n1234567890123456789,9.90,2\n\"a,b\",,\n"
+	if buf.String() != want {
+		t.Errorf("unexpected csv:\n%q\nwant:\n%q", buf.String(), want)
+	}
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatXLSX, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write("order_id", "sku_name")
+	w.Write("1234567890123456789", "<Tea & Coffee>", 19.5)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("xlsx is not a valid zip: %v", err)
+	}
+	var sheet string
+	names := make(map[string]bool)
+	for _, f := range zr.File {
+		names[f.Name] = true
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, _ := f.Open()
+			data, _ := io.ReadAll(rc)
+			rc.Close()
+			sheet = string(data)
+		}
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels"} {
+		if !names[name] {
+			t.Errorf("missing %s", name)
+		}
+	}
+	for _, want := range []string{
+		`<c r="A2" t="inlineStr"><is><t xml:space="preserve">1234567890123456789</t></is></c>`,
+		`&lt;Tea &amp; Coffee&gt;`,
+		`<c r="C2"><v>19.50</v></c>`,
+		`</sheetData></worksheet>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet missing %s", want)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	cases := map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"}
+	for in, want := range cases {
+		if got := columnName(in); got != want {
+			t.Errorf("columnName(%d) = %s, want %s", in, got, want)
+		}
+	}
+}
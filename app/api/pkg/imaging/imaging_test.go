@@ -0,0 +1,145 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+func TestFit(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1200, 800))
+	tests := []struct {
+		maxW, maxH int
+		wantW      int
+		wantH      int
+	}{
+		{320, 320, 320, 213},
+		{100, 50, 75, 50},
+		{2000, 2000, 1200, 800},
+	}
+	for _, tt := range tests {
+		b := Fit(img, tt.maxW, tt.maxH).Bounds()
+		if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+			t.Errorf("Fit(%d, %d) = %dx%d, want %dx%d", tt.maxW, tt.maxH, b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+		}
+	}
+}
+
+func TestFitAverages(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{200, 0, 0, 255})
+	img.Set(1, 0, color.RGBA{100, 0, 0, 255})
+	got := Fit(img, 1, 1).(*image.RGBA).RGBAAt(0, 0)
+	if got.R != 150 || got.A != 255 {
+		t.Errorf("got %v, want R=150 A=255", got)
+	}
+}
+
+func TestPrefixEncode(t *testing.T) {
+	// 解码：symbol < 4 时值为 symbol+1，否则为 ((2+symbol&1)<<extraBits) + extra + 1
+	for v := 1; v < 1<<20; v = v*3/2 + 1 {
+		symbol, extraBits, extra := prefixEncode(v)
+		got := symbol + 1
+		if symbol >= 4 {
+			if extraBits != (symbol-2)>>1 {
+				t.Fatalf("value %d: extra bits %d for symbol %d", v, extraBits, symbol)
+			}
+			got = (2+symbol&1)<<extraBits + extra + 1
+		}
+		if got != v {
+			t.Fatalf("value %d decodes to %d", v, got)
+		}
+	}
+}
+
+func TestHuffmanLengthsLimit(t *testing.T) {
+	hist := make([]int, 19)
+	for i := range hist {
+		hist[i] = 1 << i
+	}
+	lengths := huffmanLengths(hist, 7)
+	kraft := 0.0
+	for _, l := range lengths {
+		if l > 7 || l == 0 {
+			t.Fatalf("invalid lengths %v", lengths)
+		}
+		kraft += 1 / float64(int(1)<<l)
+	}
+	if kraft != 1 {
+		t.Errorf("code is not complete: kraft sum %v", kraft)
+	}
+}
+
+func TestEncodeWebPHeader(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 33, 17))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(i)
+	}
+	var buf bytes.Buffer
+	if err := EncodeWebP(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if string(data[0:4]) != "RIFF" || string(data[8:16]) != "WEBPVP8L" {
+		t.Fatalf("bad header %q", data[:16])
+	}
+	if size := binary.LittleEndian.Uint32(data[4:8]); int(size) != len(data)-8 {
+		t.Errorf("riff size %d, file size %d", size, len(data))
+	}
+	if data[20] != 0x2f {
+		t.Fatalf("bad vp8l signature %#x", data[20])
+	}
+	header := binary.LittleEndian.Uint32(data[21:25])
+	w, h, alpha := header&0x3fff+1, header>>14&0x3fff+1, header>>28&1
+	if w != 33 || h != 17 || alpha != 1 {
+		t.Errorf("got %dx%d alpha=%d", w, h, alpha)
+	}
+}
+
+// TestEncodeWebPRoundTrip 用 x/image/webp 解码，无损编码后像素应完全一致
+func TestEncodeWebPRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	noise := image.NewNRGBA(image.Rect(0, 0, 67, 41))
+	rng.Read(noise.Pix)
+
+	gradient := image.NewNRGBA(image.Rect(0, 0, 256, 64))
+	for y := 0; y < 64; y++ {
+		for x := 0; x < 256; x++ {
+			gradient.SetNRGBA(x, y, color.NRGBA{uint8(x), uint8(y * 4), uint8(255 - x), 255})
+		}
+	}
+
+	solid := image.NewNRGBA(image.Rect(0, 0, 40, 30))
+	for i := 0; i < len(solid.Pix); i += 4 {
+		copy(solid.Pix[i:], []uint8{12, 200, 80, 255})
+	}
+
+	tests := map[string]*image.NRGBA{"noise": noise, "gradient": gradient, "solid": solid}
+	for name, img := range tests {
+		var buf bytes.Buffer
+		if err := EncodeWebP(&buf, img); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		decoded, err := webp.Decode(&buf)
+		if err != nil {
+			t.Fatalf("%s: decode: %v", name, err)
+		}
+		if decoded.Bounds() != img.Bounds() {
+			t.Fatalf("%s: bounds %v, want %v", name, decoded.Bounds(), img.Bounds())
+		}
+		b := img.Bounds()
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+				if want := img.NRGBAAt(x, y); got != want {
+					t.Fatalf("%s: pixel (%d, %d) = %v, want %v", name, x, y, got, want)
+				}
+			}
+		}
+	}
+}
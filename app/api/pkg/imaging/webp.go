@@ -0,0 +1,577 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"math/bits"
+	"sort"
+)
+
+// 无损 WebP (VP8L) 编码。使用减绿与预测变换、贪心反向引用和熵编码，不使用颜色缓存，
+// 压缩率低于 libwebp，但不依赖 cgo。
+
+const maxWebPDimension = 1 << 14
+
+// 码长码的写入顺序，见 VP8L 规范
+var codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// bitWriter 按 VP8L 的约定从低位开始写入
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (w *bitWriter) write(v uint32, n uint) {
+	w.acc |= uint64(v) << w.nbits
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nbits -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nbits > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nbits = 0, 0
+	}
+	return w.buf
+}
+
+// prefixCode 规范前缀码，codes 已按位反转以便从低位写入
+type prefixCode struct {
+	lengths []int
+	codes   []uint32
+}
+
+func newPrefixCode(lengths []int) *prefixCode {
+	c := &prefixCode{lengths: lengths, codes: canonicalCodes(lengths)}
+	used := 0
+	for _, l := range lengths {
+		if l > 0 {
+			used++
+		}
+	}
+	// 只有一个符号时解码器按 0 位读取
+	if used == 1 {
+		c.codes = make([]uint32, len(lengths))
+		c.lengths = make([]int, len(lengths))
+	}
+	return c
+}
+
+func (c *prefixCode) write(w *bitWriter, symbol int) {
+	if n := c.lengths[symbol]; n > 0 {
+		w.write(c.codes[symbol], uint(n))
+	}
+}
+
+// EncodeWebP 将图片编码为无损 WebP
+func EncodeWebP(out io.Writer, img image.Image) error {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width <= 0 || height <= 0 || width > maxWebPDimension || height > maxWebPDimension {
+		return errors.New("webp: invalid image size")
+	}
+	nrgba := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(nrgba, nrgba.Bounds(), img, b.Min, draw.Src)
+	pix := nrgba.Pix
+
+	hasAlpha := false
+	for i := 0; i < len(pix); i += 4 {
+		pix[i] -= pix[i+1]
+		pix[i+2] -= pix[i+1]
+		if pix[i+3] != 0xff {
+			hasAlpha = true
+		}
+	}
+
+	w := &bitWriter{}
+	w.write(0x2f, 8)
+	w.write(uint32(width-1), 14)
+	w.write(uint32(height-1), 14)
+	if hasAlpha {
+		w.write(1, 1)
+	} else {
+		w.write(0, 1)
+	}
+	w.write(0, 3) // version
+
+	// 编码端按写入顺序应用变换：先减绿，再预测
+	w.write(1, 1)
+	w.write(transformSubtractGreen, 2)
+	w.write(1, 1)
+	w.write(transformPredictor, 2)
+	w.write(predictorTileBits-2, 3)
+	modes := choosePredictors(pix, width, height)
+	writeEntropyImage(w, modes, false)
+	residuals := predict(pix, width, height, modes)
+	w.write(0, 1) // 变换结束
+
+	writeEntropyImage(w, residuals, true)
+	data := w.bytes()
+
+	var buf bytes.Buffer
+	size := 4 + 8 + len(data) + len(data)&1
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(size))
+	buf.WriteString("WEBPVP8L")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	if len(data)&1 == 1 {
+		buf.WriteByte(0)
+	}
+	_, err := out.Write(buf.Bytes())
+	return err
+}
+
+const (
+	transformPredictor     = 0
+	transformSubtractGreen = 2
+
+	predictorTileBits = 5 // 每 32x32 像素选择一种预测模式
+)
+
+// 候选预测模式：L、T、Select、ClampAddSubtractFull
+var predictorModes = []uint8{1, 2, 11, 12}
+
+// modeImage 预测模式子图，模式存放在绿色通道
+type modeImage struct {
+	pix           []uint8
+	width, height int
+}
+
+// choosePredictors 为每个分块选择残差绝对值之和最小的预测模式
+func choosePredictors(pix []uint8, width, height int) *modeImage {
+	tile := 1 << predictorTileBits
+	tw, th := (width+tile-1)/tile, (height+tile-1)/tile
+	modes := &modeImage{pix: make([]uint8, tw*th*4), width: tw, height: th}
+	for ty := 0; ty < th; ty++ {
+		for tx := 0; tx < tw; tx++ {
+			best, bestCost := predictorModes[0], -1
+			for _, mode := range predictorModes {
+				cost := 0
+				for y := ty * tile; y < (ty+1)*tile && y < height; y++ {
+					for x := tx * tile; x < (tx+1)*tile && x < width; x++ {
+						if x == 0 || y == 0 {
+							continue
+						}
+						p := (y*width + x) * 4
+						pred := predictPixel(pix, p, width*4, mode)
+						for c := 0; c < 4; c++ {
+							r := int(int8(pix[p+c] - pred[c]))
+							if r < 0 {
+								r = -r
+							}
+							cost += r
+						}
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			modes.pix[(ty*tw+tx)*4+1] = best
+		}
+	}
+	return modes
+}
+
+// predict 计算残差，首行使用 L、首列使用 T、左上角像素以不透明黑色预测，与解码端一致
+func predict(pix []uint8, width, height int, modes *modeImage) *modeImage {
+	stride := width * 4
+	res := make([]uint8, len(pix))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			p := y*stride + x*4
+			var pred [4]uint8
+			switch {
+			case x == 0 && y == 0:
+				pred[3] = 0xff
+			case y == 0:
+				pred = predictPixel(pix, p, stride, 1)
+			case x == 0:
+				pred = predictPixel(pix, p, stride, 2)
+			default:
+				m := modes.pix[((y>>predictorTileBits)*modes.width+(x>>predictorTileBits))*4+1]
+				pred = predictPixel(pix, p, stride, m)
+			}
+			for c := 0; c < 4; c++ {
+				res[p+c] = pix[p+c] - pred[c]
+			}
+		}
+	}
+	return &modeImage{pix: res, width: width, height: height}
+}
+
+// predictPixel 返回 p 处像素的预测值，pix 为 RGBA 字节序
+func predictPixel(pix []uint8, p, stride int, mode uint8) [4]uint8 {
+	var out [4]uint8
+	switch mode {
+	case 1:
+		copy(out[:], pix[p-4:p])
+		return out
+	case 2:
+		copy(out[:], pix[p-stride:p-stride+4])
+		return out
+	}
+	l := pix[p-4 : p]
+	t := pix[p-stride : p-stride+4]
+	tl := pix[p-stride-4 : p-stride]
+	switch mode {
+	case 11:
+		var pl, pt int
+		for c := 0; c < 4; c++ {
+			pl += absInt(int(tl[c]) - int(t[c]))
+			pt += absInt(int(tl[c]) - int(l[c]))
+		}
+		if pl < pt {
+			copy(out[:], l)
+		} else {
+			copy(out[:], t)
+		}
+	case 12:
+		for c := 0; c < 4; c++ {
+			v := int(l[c]) + int(t[c]) - int(tl[c])
+			if v < 0 {
+				v = 0
+			} else if v > 255 {
+				v = 255
+			}
+			out[c] = uint8(v)
+		}
+	}
+	return out
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// 反向引用参数
+const (
+	minMatch      = 3
+	maxMatch      = 4096
+	maxDistance   = 1 << 16
+	matchHashBits = 16
+	matchChain    = 32  // 每个位置最多比较的候选数
+	distanceBias  = 120 // 距离码 0-119 表示二维邻域，线性距离需要加上该偏移
+)
+
+// token 熵编码图像中的一项：字面像素或 (长度, 距离) 反向引用
+type token struct {
+	pixel  uint32 // ARGB
+	length int    // 大于 0 表示反向引用
+	dist   int
+}
+
+// findMatches 贪心查找反向引用，候选位置通过两个相邻像素的哈希链获取
+func findMatches(px []uint32) []token {
+	n := len(px)
+	head := make([]int32, 1<<matchHashBits)
+	for i := range head {
+		head[i] = -1
+	}
+	prev := make([]int32, n)
+	hash := func(i int) uint32 {
+		return (px[i]*0x1e35a7bd ^ px[i+1]*0x9e3779b1) >> (32 - matchHashBits)
+	}
+	insert := func(i int) {
+		if i+1 < n {
+			h := hash(i)
+			prev[i] = head[h]
+			head[h] = int32(i)
+		}
+	}
+
+	tokens := make([]token, 0, n/2)
+	for i := 0; i < n; {
+		bestLen, bestDist := 0, 0
+		if i+minMatch <= n {
+			limit := n - i
+			if limit > maxMatch {
+				limit = maxMatch
+			}
+			for c, depth := head[hash(i)], 0; c >= 0 && i-int(c) <= maxDistance && depth < matchChain; c, depth = prev[c], depth+1 {
+				j := int(c)
+				l := 0
+				for l < limit && px[j+l] == px[i+l] {
+					l++
+				}
+				if l > bestLen {
+					bestLen, bestDist = l, i-j
+					if l == limit {
+						break
+					}
+				}
+			}
+		}
+		if bestLen >= minMatch {
+			tokens = append(tokens, token{length: bestLen, dist: bestDist})
+			for k := 0; k < bestLen; k++ {
+				insert(i + k)
+			}
+			i += bestLen
+			continue
+		}
+		tokens = append(tokens, token{pixel: px[i]})
+		insert(i)
+		i++
+	}
+	return tokens
+}
+
+// prefixEncode 将长度或距离值 (>=1) 转为前缀符号与额外位
+func prefixEncode(v int) (symbol, extraBits, extra int) {
+	d := v - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	h := bits.Len(uint(d)) - 1
+	second := (d >> (h - 1)) & 1
+	return 2*h + second, h - 1, d & (1<<(h-1) - 1)
+}
+
+// writeEntropyImage 写入熵编码图像，主图像需要额外写出元前缀码标志
+func writeEntropyImage(w *bitWriter, img *modeImage, main bool) {
+	pix := img.pix
+	px := make([]uint32, len(pix)/4)
+	for i := range px {
+		p := pix[i*4 : i*4+4]
+		px[i] = uint32(p[3])<<24 | uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])
+	}
+	tokens := findMatches(px)
+
+	var hist [5][]int
+	hist[0] = make([]int, 256+24) // 绿色通道字母表之后是 24 个长度前缀
+	for i := 1; i < 4; i++ {
+		hist[i] = make([]int, 256)
+	}
+	hist[4] = make([]int, 40)
+	for _, t := range tokens {
+		if t.length > 0 {
+			ls, _, _ := prefixEncode(t.length)
+			ds, _, _ := prefixEncode(t.dist + distanceBias)
+			hist[0][256+ls]++
+			hist[4][ds]++
+			continue
+		}
+		hist[0][t.pixel>>8&0xff]++
+		hist[1][t.pixel>>16&0xff]++
+		hist[2][t.pixel&0xff]++
+		hist[3][t.pixel>>24]++
+	}
+
+	w.write(0, 1) // 不使用颜色缓存
+	if main {
+		w.write(0, 1) // 不使用元前缀码
+	}
+	var codes [5]*prefixCode
+	for i := range hist {
+		codes[i] = writePrefixCode(w, hist[i])
+	}
+
+	for _, t := range tokens {
+		if t.length > 0 {
+			ls, lbits, lextra := prefixEncode(t.length)
+			codes[0].write(w, 256+ls)
+			w.write(uint32(lextra), uint(lbits))
+			ds, dbits, dextra := prefixEncode(t.dist + distanceBias)
+			codes[4].write(w, ds)
+			w.write(uint32(dextra), uint(dbits))
+			continue
+		}
+		codes[0].write(w, int(t.pixel>>8&0xff))
+		codes[1].write(w, int(t.pixel>>16&0xff))
+		codes[2].write(w, int(t.pixel&0xff))
+		codes[3].write(w, int(t.pixel>>24))
+	}
+}
+
+// writePrefixCode 按直方图构建前缀码并写入码表，返回用于写入像素的编码
+func writePrefixCode(w *bitWriter, hist []int) *prefixCode {
+	var used []int
+	for s, c := range hist {
+		if c > 0 {
+			used = append(used, s)
+		}
+	}
+	// 只有一个符号（或没有符号）时使用简单码，该符号编码为 0 位
+	if len(used) <= 1 && (len(used) == 0 || used[0] < 256) {
+		symbol := 0
+		if len(used) == 1 {
+			symbol = used[0]
+		}
+		w.write(1, 1) // simple code
+		w.write(0, 1) // 1 个符号
+		if symbol < 2 {
+			w.write(0, 1)
+			w.write(uint32(symbol), 1)
+		} else {
+			w.write(1, 1)
+			w.write(uint32(symbol), 8)
+		}
+		return &prefixCode{lengths: make([]int, len(hist)), codes: make([]uint32, len(hist))}
+	}
+
+	lengths := huffmanLengths(hist, 15)
+	code := newPrefixCode(lengths)
+
+	// 码长序列：0-15 为字面值，17/18 表示连续的 0
+	type token struct{ symbol, extra, extraBits int }
+	var tokens []token
+	clHist := make([]int, 19)
+	for i := 0; i < len(lengths); {
+		if lengths[i] != 0 {
+			tokens = append(tokens, token{symbol: lengths[i]})
+			clHist[lengths[i]]++
+			i++
+			continue
+		}
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == 0 && run < 138 {
+			run++
+		}
+		switch {
+		case run >= 11:
+			tokens = append(tokens, token{symbol: 18, extra: run - 11, extraBits: 7})
+			clHist[18]++
+		case run >= 3:
+			tokens = append(tokens, token{symbol: 17, extra: run - 3, extraBits: 3})
+			clHist[17]++
+		default:
+			run = 1
+			tokens = append(tokens, token{symbol: 0})
+			clHist[0]++
+		}
+		i += run
+	}
+
+	clLengths := huffmanLengths(clHist, 7)
+	clCode := newPrefixCode(clLengths)
+	numCodes := len(codeLengthCodeOrder)
+	for numCodes > 4 && clLengths[codeLengthCodeOrder[numCodes-1]] == 0 {
+		numCodes--
+	}
+
+	w.write(0, 1) // normal code
+	w.write(uint32(numCodes-4), 4)
+	for i := 0; i < numCodes; i++ {
+		w.write(uint32(clLengths[codeLengthCodeOrder[i]]), 3)
+	}
+	w.write(0, 1) // 码长覆盖整个字母表
+	for _, t := range tokens {
+		clCode.write(w, t.symbol)
+		if t.extraBits > 0 {
+			w.write(uint32(t.extra), uint(t.extraBits))
+		}
+	}
+	return code
+}
+
+// huffmanLengths 计算码长不超过 maxLen 的 Huffman 码长，超长时把频次减半后重试
+func huffmanLengths(hist []int, maxLen int) []int {
+	counts := append([]int(nil), hist...)
+	for {
+		lengths := buildHuffmanLengths(counts)
+		ok := true
+		for _, l := range lengths {
+			if l > maxLen {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return lengths
+		}
+		for i, c := range counts {
+			if c > 0 {
+				counts[i] = (c + 1) / 2
+			}
+		}
+	}
+}
+
+func buildHuffmanLengths(counts []int) []int {
+	type node struct {
+		count       int
+		symbol      int // 叶子节点的符号，内部节点为 -1
+		left, right int
+	}
+	lengths := make([]int, len(counts))
+	var nodes []node
+	for s, c := range counts {
+		if c > 0 {
+			nodes = append(nodes, node{count: c, symbol: s, left: -1, right: -1})
+		}
+	}
+	switch len(nodes) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[nodes[0].symbol] = 1
+		return lengths
+	}
+
+	// 叶子数不超过 280，按频次排序后两两合并即可
+	queue := make([]int, len(nodes))
+	for i := range queue {
+		queue[i] = i
+	}
+	for len(queue) > 1 {
+		sort.SliceStable(queue, func(i, j int) bool { return nodes[queue[i]].count < nodes[queue[j]].count })
+		a, b := queue[0], queue[1]
+		nodes = append(nodes, node{count: nodes[a].count + nodes[b].count, symbol: -1, left: a, right: b})
+		queue = append(queue[2:], len(nodes)-1)
+	}
+
+	var walk func(i, depth int)
+	walk = func(i, depth int) {
+		if nodes[i].symbol >= 0 {
+			lengths[nodes[i].symbol] = depth
+			return
+		}
+		walk(nodes[i].left, depth+1)
+		walk(nodes[i].right, depth+1)
+	}
+	walk(queue[0], 0)
+	return lengths
+}
+
+// canonicalCodes 由码长生成规范前缀码，并按位反转
+func canonicalCodes(lengths []int) []uint32 {
+	var count [16]int
+	for _, l := range lengths {
+		count[l]++
+	}
+	count[0] = 0
+	var next [16]uint32
+	code := uint32(0)
+	for l := 1; l < 16; l++ {
+		code = (code + uint32(count[l-1])) << 1
+		next[l] = code
+	}
+	codes := make([]uint32, len(lengths))
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		c := next[l]
+		next[l]++
+		var r uint32
+		for i := 0; i < l; i++ {
+			r = r<<1 | (c>>i)&1
+		}
+		codes[s] = r
+	}
+	return codes
+}
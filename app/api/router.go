@@ -3,7 +3,11 @@
 package main
 
 import (
+	"strings"
+
 	handler "github.com/PiaoAdmin/pmall/app/api/biz/handler"
+	"github.com/PiaoAdmin/pmall/app/api/conf"
+	"github.com/cloudwego/hertz/pkg/app"
 	"github.com/cloudwego/hertz/pkg/app/server"
 )
 
@@ -12,4 +16,12 @@ func customizedRegister(r *server.Hertz) {
 	r.GET("/ping", handler.Ping)
 
 	// your code ...
+	// 本地存储的媒体文件由网关直接提供，base_url 为完整地址时（如 CDN）不注册
+	if m := conf.GetConf().Media; m.Storage != "s3" && strings.HasPrefix(m.LocalBaseURL(), "/") {
+		prefix := "/" + strings.Trim(m.LocalBaseURL(), "/")
+		r.StaticFS(prefix, &app.FS{
+			Root:        m.LocalDir(),
+			PathRewrite: app.NewPathSlashesStripper(strings.Count(prefix, "/")),
+		})
+	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/PiaoAdmin/pmall/rpc_gen/order/orderservice"
 	"github.com/PiaoAdmin/pmall/rpc_gen/payment/paymentservice"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product/productservice"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review/reviewservice"
 	"github.com/PiaoAdmin/pmall/rpc_gen/user/userservice"
 	"github.com/cloudwego/hertz/pkg/common/hlog"
 	"github.com/cloudwego/kitex/client"
@@ -24,6 +25,7 @@ var (
 	OrderClient    orderservice.Client
 	CheckoutClient checkoutservice.Client
 	PaymentClient  paymentservice.Client
+	ReviewClient   reviewservice.Client
 	once           sync.Once
 	err            error
 	commonSuite    client.Option
@@ -38,6 +40,7 @@ func Init() {
 			initOrderClientDirect("127.0.0.1:9902")
 			initCheckoutClientDirect("127.0.0.1:9903")
 			initPaymentClientDirect("127.0.0.1:9904")
+			initReviewClientDirect("127.0.0.1:9905")
 			return
 		}
 		registryAddr := conf.GetConf().Hertz.RegistryAddr
@@ -51,6 +54,7 @@ func Init() {
 		initOrderClient()
 		initCheckoutClient()
 		initPaymentClient()
+		initReviewClient()
 	})
 }
 
@@ -115,6 +119,16 @@ func initPaymentClientDirect(addr string) {
 	}
 }
 
+func initReviewClientDirect(addr string) {
+	ReviewClient, err = reviewservice.NewClient("review",
+		client.WithHostPorts(addr),
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+		client.WithTransportProtocol(transport.GRPC))
+	if err != nil {
+		hlog.Fatal(err)
+	}
+}
+
 func initUserClient() {
 	UserClient, err = userservice.NewClient("user", commonSuite)
 	if err != nil {
@@ -156,3 +170,10 @@ func initPaymentClient() {
 		hlog.Fatal(err)
 	}
 }
+
+func initReviewClient() {
+	ReviewClient, err = reviewservice.NewClient("review", commonSuite)
+	if err != nil {
+		hlog.Fatal(err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/PiaoAdmin/pmall/app/cart/biz/dal/redis"
 	"github.com/cloudwego/kitex/pkg/klog"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 const (
@@ -33,6 +34,29 @@ func AddToCart(ctx context.Context, userID, skuID uint64, quantity int32) error
 	return nil
 }
 
+// AddItemsToCart 在一个事务中批量加入购物车，返回各 SKU 加入后的数量
+func AddItemsToCart(ctx context.Context, userID uint64, items map[uint64]int32) (map[uint64]int32, error) {
+	if len(items) == 0 {
+		return map[uint64]int32{}, nil
+	}
+	key := GetCartKey(userID)
+	pipe := redis.RedisClient.TxPipeline()
+	cmds := make(map[uint64]*goredis.IntCmd, len(items))
+	for skuID, quantity := range items {
+		cmds[skuID] = pipe.HIncrBy(ctx, key, strconv.FormatUint(skuID, 10), int64(quantity))
+	}
+	pipe.Expire(ctx, key, CartExpiration)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	totals := make(map[uint64]int32, len(cmds))
+	for skuID, cmd := range cmds {
+		totals[skuID] = int32(cmd.Val())
+	}
+	return totals, nil
+}
+
 func RemoveFromCart(ctx context.Context, userID uint64, skuIDs []uint64) error {
 	removeCount := make(map[uint64]int64)
 	for _, skuID := range skuIDs {
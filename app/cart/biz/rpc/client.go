@@ -5,6 +5,7 @@ import (
 
 	"github.com/PiaoAdmin/pmall/app/cart/conf"
 	"github.com/PiaoAdmin/pmall/common/clientsuite"
+	"github.com/PiaoAdmin/pmall/rpc_gen/order/orderservice"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product/productservice"
 	"github.com/cloudwego/kitex/client"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -14,6 +15,7 @@ import (
 
 var (
 	ProductClient productservice.Client
+	OrderClient   orderservice.Client
 	once          sync.Once
 	err           error
 	registryAddr  string
@@ -24,11 +26,13 @@ func Init() {
 	once.Do(func() {
 		if conf.GetConf().Env == "test" {
 			initProductClientDirect("127.0.0.1:9900")
+			initOrderClientDirect("127.0.0.1:9902")
 			return
 		}
 		registryAddr = conf.GetConf().Registry.RegistryAddress[0]
 		serviceName = conf.GetConf().Kitex.Service
 		initProductClient()
+		initOrderClient()
 	})
 }
 
@@ -56,3 +60,28 @@ func initProductClient() {
 		panic(err)
 	}
 }
+
+func initOrderClientDirect(addr string) {
+	OrderClient, err = orderservice.NewClient("order",
+		client.WithHostPorts(addr),
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+		client.WithTransportProtocol(transport.GRPC))
+	if err != nil {
+		klog.Fatal(err)
+	}
+}
+
+func initOrderClient() {
+	opts := []client.Option{
+		client.WithSuite(clientsuite.CommonGrpcClientSuite{
+			RegistryAddr:       registryAddr,
+			CurrentServiceName: serviceName,
+		}),
+	}
+
+	OrderClient, err = orderservice.NewClient("order", opts...)
+	if err != nil {
+		klog.Fatalf(err.Error())
+		panic(err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/PiaoAdmin/pmall/app/cart/biz/model"
 	"github.com/PiaoAdmin/pmall/app/cart/biz/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
 	cart "github.com/PiaoAdmin/pmall/rpc_gen/cart"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -26,7 +27,19 @@ func (s *AddToCartService) Run(req *cart.AddToCartRequest) (*cart.AddToCartRespo
 		quantity = 1
 	}
 
-	err := model.AddToCart(s.ctx, req.UserId, req.SkuId, quantity)
+	// 只允许加购已上架且审核通过的 SKU
+	skuDetail, err := s.getSkuDetail(req.SkuId)
+	if err != nil {
+		return nil, err
+	}
+	if skuDetail == nil {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "sku not found")
+	}
+	if !skuDetail.OnSale {
+		return nil, errs.New(errs.ErrParam.Code, "sku is not on sale")
+	}
+
+	err = model.AddToCart(s.ctx, req.UserId, req.SkuId, quantity)
 	if err != nil {
 		klog.CtxErrorf(s.ctx, "AddToCart failed: %v", err)
 		return nil, err
@@ -39,22 +52,16 @@ func (s *AddToCartService) Run(req *cart.AddToCartRequest) (*cart.AddToCartRespo
 	}
 
 	cartItem := &cart.CartItem{
-		UserId:   req.UserId,
-		SkuId:    req.SkuId,
-		Quantity: totalQuantity,
-	}
-
-	skuDetail, err := s.getSkuDetail(req.SkuId)
-	if err != nil {
-		klog.CtxWarnf(s.ctx, "GetSkuDetail failed: %v, return basic info only", err)
-	} else if skuDetail != nil {
-		cartItem.SkuName = skuDetail.Name
-		cartItem.SkuImage = skuDetail.MainImage
-		cartItem.Price = skuDetail.Price
-		cartItem.MarketPrice = skuDetail.MarketPrice
-		cartItem.Stock = skuDetail.Stock
-		cartItem.SpuId = skuDetail.SpuId
-		cartItem.SkuSpecData = skuDetail.SkuSpecData
+		UserId:      req.UserId,
+		SkuId:       req.SkuId,
+		Quantity:    totalQuantity,
+		SkuName:     skuDetail.Name,
+		SkuImage:    skuDetail.MainImage,
+		Price:       skuDetail.Price,
+		MarketPrice: skuDetail.MarketPrice,
+		Stock:       skuDetail.Stock,
+		SpuId:       skuDetail.SpuId,
+		SkuSpecData: skuDetail.SkuSpecData,
 	}
 
 	return &cart.AddToCartResponse{
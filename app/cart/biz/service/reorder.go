@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/PiaoAdmin/pmall/app/cart/biz/model"
+	"github.com/PiaoAdmin/pmall/app/cart/biz/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	cart "github.com/PiaoAdmin/pmall/rpc_gen/cart"
+	"github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	ReorderSkipDelisted   = "delisted"
+	ReorderSkipOutOfStock = "out_of_stock"
+)
+
+type ReorderService struct {
+	ctx context.Context
+}
+
+func NewReorderService(ctx context.Context) *ReorderService {
+	return &ReorderService{ctx: ctx}
+}
+
+// reorderLine 历史订单中的一个 SKU，同一 SKU 多行时数量合并
+type reorderLine struct {
+	SkuID    uint64
+	SkuName  string
+	Price    string
+	Quantity int32
+}
+
+// reorderPlan 再次购买计划：要加入购物车的数量及跳过、价格变化的商品
+type reorderPlan struct {
+	Add          []reorderLine
+	Skipped      []*cart.ReorderSkippedItem
+	PriceChanged []*cart.ReorderPriceChange
+}
+
+func (s *ReorderService) Run(req *cart.ReorderRequest) (*cart.ReorderResponse, error) {
+	klog.CtxInfof(s.ctx, "Reorder: userID=%d, orderID=%s", req.UserId, req.OrderId)
+	if req.OrderId == "" {
+		return nil, errs.New(errs.ErrParam.Code, "order_id is required")
+	}
+
+	orderResp, err := rpc.OrderClient.GetOrder(s.ctx, &order.GetOrderReq{UserId: req.UserId, OrderId: req.OrderId})
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "GetOrder RPC call failed: %v", err)
+		return nil, err
+	}
+	if orderResp.Order == nil || len(orderResp.Order.Items) == 0 {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "order not found")
+	}
+
+	lines := mergeReorderLines(orderResp.Order.Items)
+	skuIDs := make([]uint64, 0, len(lines))
+	for _, l := range lines {
+		skuIDs = append(skuIDs, l.SkuID)
+	}
+
+	skuResp, err := rpc.ProductClient.GetSkusByIds(s.ctx, &product.GetSkusByIdsRequest{SkuIds: skuIDs})
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "GetSkusByIds RPC call failed: %v", err)
+		return nil, err
+	}
+
+	plan := planReorder(lines, skuResp.Skus)
+
+	// 全部商品在一个事务中加入购物车，不会出现部分加入
+	quantities := make(map[uint64]int32, len(plan.Add))
+	for _, l := range plan.Add {
+		quantities[l.SkuID] = l.Quantity
+	}
+	totals, err := model.AddItemsToCart(s.ctx, req.UserId, quantities)
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "AddItemsToCart failed: %v", err)
+		return nil, err
+	}
+
+	added := make([]*cart.CartItem, 0, len(plan.Add))
+	for _, l := range plan.Add {
+		sku := skuResp.Skus[l.SkuID]
+		added = append(added, &cart.CartItem{
+			UserId:      req.UserId,
+			SkuId:       l.SkuID,
+			Quantity:    totals[l.SkuID],
+			SkuName:     sku.Name,
+			SkuImage:    sku.MainImage,
+			Price:       sku.Price,
+			MarketPrice: sku.MarketPrice,
+			Stock:       sku.Stock,
+			SpuId:       sku.SpuId,
+			SkuSpecData: sku.SkuSpecData,
+		})
+	}
+
+	return &cart.ReorderResponse{
+		AddedItems:        added,
+		SkippedItems:      plan.Skipped,
+		PriceChangedItems: plan.PriceChanged,
+	}, nil
+}
+
+// mergeReorderLines 按 SKU 合并订单项，保持原订单顺序
+func mergeReorderLines(items []*order.CartItem) []reorderLine {
+	lines := make([]reorderLine, 0, len(items))
+	index := make(map[uint64]int, len(items))
+	for _, it := range items {
+		if it.Quantity <= 0 {
+			continue
+		}
+		if i, ok := index[it.SkuId]; ok {
+			lines[i].Quantity += it.Quantity
+			continue
+		}
+		index[it.SkuId] = len(lines)
+		lines = append(lines, reorderLine{
+			SkuID:    it.SkuId,
+			SkuName:  it.SkuName,
+			Price:    it.Price,
+			Quantity: it.Quantity,
+		})
+	}
+	return lines
+}
+
+// planReorder 根据当前商品状态决定每个 SKU 的加购数量。
+// SKU 已删除或不可售（商品已删除、未上架、未审核通过）视为下架；库存为 0 跳过，库存不足时按剩余库存加入。
+func planReorder(lines []reorderLine, skus map[uint64]*product.ProductSKU) *reorderPlan {
+	plan := &reorderPlan{}
+	for _, l := range lines {
+		sku, ok := skus[l.SkuID]
+		if !ok || !sku.OnSale {
+			plan.Skipped = append(plan.Skipped, skippedItem(l, ReorderSkipDelisted))
+			continue
+		}
+		if sku.Stock <= 0 {
+			plan.Skipped = append(plan.Skipped, skippedItem(l, ReorderSkipOutOfStock))
+			continue
+		}
+
+		quantity := l.Quantity
+		if quantity > sku.Stock {
+			quantity = sku.Stock
+		}
+		plan.Add = append(plan.Add, reorderLine{SkuID: l.SkuID, SkuName: sku.Name, Price: sku.Price, Quantity: quantity})
+
+		if priceToCents(l.Price) != priceToCents(sku.Price) {
+			plan.PriceChanged = append(plan.PriceChanged, &cart.ReorderPriceChange{
+				SkuId:    l.SkuID,
+				SkuName:  sku.Name,
+				OldPrice: l.Price,
+				NewPrice: sku.Price,
+			})
+		}
+	}
+	return plan
+}
+
+func skippedItem(l reorderLine, reason string) *cart.ReorderSkippedItem {
+	return &cart.ReorderSkippedItem{
+		SkuId:    l.SkuID,
+		SkuName:  l.SkuName,
+		Quantity: l.Quantity,
+		Reason:   reason,
+	}
+}
+
+func priceToCents(price string) int64 {
+	p, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(math.Round(p * 100))
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+func TestMergeReorderLines(t *testing.T) {
+	lines := mergeReorderLines([]*order.CartItem{
+		{SkuId: 2, SkuName: "b", Price: "5.00", Quantity: 1},
+		{SkuId: 1, SkuName: "a", Price: "3.00", Quantity: 2},
+		{SkuId: 2, SkuName: "b", Price: "5.00", Quantity: 3},
+		{SkuId: 3, SkuName: "c", Price: "1.00", Quantity: 0},
+	})
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	if lines[0].SkuID != 2 || lines[0].Quantity != 4 {
+		t.Errorf("unexpected first line: %+v", lines[0])
+	}
+	if lines[1].SkuID != 1 || lines[1].Quantity != 2 {
+		t.Errorf("unexpected second line: %+v", lines[1])
+	}
+}
+
+func TestPlanReorder(t *testing.T) {
+	lines := []reorderLine{
+		{SkuID: 1, SkuName: "same", Price: "10.00", Quantity: 2},
+		{SkuID: 2, SkuName: "changed", Price: "10.00", Quantity: 1},
+		{SkuID: 3, SkuName: "deleted", Price: "10.00", Quantity: 1},
+		{SkuID: 4, SkuName: "unpublished", Price: "10.00", Quantity: 1},
+		{SkuID: 7, SkuName: "pending audit", Price: "10.00", Quantity: 1},
+		{SkuID: 5, SkuName: "sold out", Price: "10.00", Quantity: 1},
+		{SkuID: 6, SkuName: "low stock", Price: "10.00", Quantity: 5},
+	}
+	skus := map[uint64]*product.ProductSKU{
+		1: {Id: 1, SpuId: 100, Name: "same", Price: "10.0", Stock: 10, OnSale: true},
+		2: {Id: 2, SpuId: 100, Name: "changed", Price: "12.50", Stock: 10, OnSale: true},
+		4: {Id: 4, SpuId: 200, Name: "unpublished", Price: "10.00", Stock: 10},
+		5: {Id: 5, SpuId: 100, Name: "sold out", Price: "10.00", Stock: 0, OnSale: true},
+		6: {Id: 6, SpuId: 100, Name: "low stock", Price: "10.00", Stock: 3, OnSale: true},
+		7: {Id: 7, SpuId: 300, Name: "pending audit", Price: "10.00", Stock: 10},
+	}
+
+	plan := planReorder(lines, skus)
+
+	added := make(map[uint64]int32)
+	for _, l := range plan.Add {
+		added[l.SkuID] = l.Quantity
+	}
+	want := map[uint64]int32{1: 2, 2: 1, 6: 3}
+	if len(added) != len(want) {
+		t.Fatalf("unexpected added items: %+v", plan.Add)
+	}
+	for id, q := range want {
+		if added[id] != q {
+			t.Errorf("sku %d: expected quantity %d, got %d", id, q, added[id])
+		}
+	}
+
+	reasons := make(map[uint64]string)
+	for _, s := range plan.Skipped {
+		reasons[s.SkuId] = s.Reason
+	}
+	wantReasons := map[uint64]string{3: ReorderSkipDelisted, 4: ReorderSkipDelisted, 5: ReorderSkipOutOfStock, 7: ReorderSkipDelisted}
+	if len(reasons) != len(wantReasons) {
+		t.Fatalf("unexpected skipped items: %+v", plan.Skipped)
+	}
+	for id, r := range wantReasons {
+		if reasons[id] != r {
+			t.Errorf("sku %d: expected reason %s, got %s", id, r, reasons[id])
+		}
+	}
+
+	if len(plan.PriceChanged) != 1 || plan.PriceChanged[0].SkuId != 2 ||
+		plan.PriceChanged[0].OldPrice != "10.00" || plan.PriceChanged[0].NewPrice != "12.50" {
+		t.Errorf("unexpected price changes: %+v", plan.PriceChanged)
+	}
+}
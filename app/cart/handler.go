@@ -29,3 +29,8 @@ func (s *CartServiceImpl) GetCartDetails(ctx context.Context, req *cart.GetCartD
 func (s *CartServiceImpl) ClearCart(ctx context.Context, req *cart.ClearCartRequest) (resp *cart.ClearCartResponse, err error) {
 	return service.NewClearCartService(ctx).Run(req)
 }
+
+// Reorder implements the CartServiceImpl interface.
+func (s *CartServiceImpl) Reorder(ctx context.Context, req *cart.ReorderRequest) (resp *cart.ReorderResponse, err error) {
+	return service.NewReorderService(ctx).Run(req)
+}
@@ -65,6 +65,9 @@ func (s *CheckoutService) Run(req *checkout.CheckoutRequest) (*checkout.Checkout
 		if !ok || sku == nil {
 			return nil, errs.New(errs.ErrRecordNotFound.Code, "sku not found")
 		}
+		if !sku.OnSale {
+			return nil, errs.New(errs.ErrParam.Code, "sku is not on sale")
+		}
 		if sku.Stock < qty {
 			return nil, errs.New(errs.ErrParam.Code, "stock not enough")
 		}
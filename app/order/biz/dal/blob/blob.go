@@ -0,0 +1,54 @@
+// Package blob 订单服务生成文件（如发票）的存储，通过 driver 配置切换实现。
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/PiaoAdmin/pmall/app/order/conf"
+)
+
+// ErrNotFound 文件不存在
+var ErrNotFound = errors.New("blob not found")
+
+// Store 文件存储接口，key 为以 / 分隔的相对路径
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// Factory 根据配置创建存储实现
+type Factory func(cfg conf.Blob) (Store, error)
+
+var (
+	factories = map[string]Factory{}
+	// Default 全局文件存储
+	Default Store
+)
+
+// Register 注册存储驱动，新增实现（如对象存储）时在 init 中调用
+func Register(driver string, f Factory) {
+	factories[driver] = f
+}
+
+// New 按配置的 driver 创建存储，driver 为空时使用 local
+func New(cfg conf.Blob) (Store, error) {
+	driver := cfg.Driver
+	if driver == "" {
+		driver = "local"
+	}
+	f, ok := factories[driver]
+	if !ok {
+		return nil, fmt.Errorf("unknown blob driver %q", driver)
+	}
+	return f(cfg)
+}
+
+func Init() {
+	store, err := New(conf.GetConf().Blob)
+	if err != nil {
+		panic(err)
+	}
+	Default = store
+}
@@ -0,0 +1,34 @@
+package blob
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := s.Get(ctx, "invoices/a.pdf"); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if err := s.Put(ctx, "invoices/a.pdf", []byte("v1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put(ctx, "invoices/a.pdf", []byte("v2")); err != nil {
+		t.Fatal(err)
+	}
+	data, err := s.Get(ctx, "invoices/a.pdf")
+	if err != nil || string(data) != "v2" {
+		t.Fatalf("unexpected content %q, err %v", data, err)
+	}
+
+	for _, key := range []string{"", "../escape", "a/../../b"} {
+		if err := s.Put(ctx, key, []byte("x")); err == nil {
+			t.Errorf("expected error for key %q", key)
+		}
+	}
+}
@@ -1,13 +1,17 @@
 package dal
 
 import (
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/blob"
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/rabbitmq"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/redis"
 )
 
 func Init() {
 	mysql.Init()
+	redis.Init()
 	rabbitmq.Init()
+	blob.Init()
 }
 
 func Close() {
@@ -8,20 +8,49 @@ import (
 	"gorm.io/gorm"
 )
 
-var DB *gorm.DB
+// Shards 按 user_id 路由的订单分库，下标即分片号
+var Shards []*gorm.DB
 
 func Init() {
-	dsn := conf.GetConf().MySQL.DSN
-	var err error
-	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
-	if err != nil {
+	if err := SetOrderIDNode(conf.GetConf().MySQL.OrderIDNode); err != nil {
 		panic(err)
 	}
+	dsns := ShardDSNs()
+	Shards = make([]*gorm.DB, 0, len(dsns))
+	for _, dsn := range dsns {
+		db, err := Open(dsn)
+		if err != nil {
+			panic(err)
+		}
+		Shards = append(Shards, db)
+	}
+	klog.Infof("Successfully connected to MySQL with %d shards", len(Shards))
+}
+
+// ShardDSNs 返回分片 DSN 列表，未配置 shards 时使用单库 dsn
+func ShardDSNs() []string {
+	cfg := conf.GetConf().MySQL
+	if len(cfg.Shards) > 0 {
+		return cfg.Shards
+	}
+	return []string{cfg.DSN}
+}
+
+// Open 打开单个分片连接
+func Open(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
 	if conf.GetEnv() == "test" {
-		DB.AutoMigrate(
+		db.AutoMigrate(
 			&model.OrderItem{},
 			&model.Order{},
+			&model.ArchivedOrderItem{},
+			&model.ArchivedOrder{},
+			&model.Invoice{},
+			&model.InvoiceSequence{},
 		)
 	}
-	klog.Info("Successfully connected to MySQL")
+	return db, nil
 }
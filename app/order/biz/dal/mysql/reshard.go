@@ -0,0 +1,219 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	DefaultReshardBatchSize = 500
+)
+
+// ReshardOptions 重新分片参数
+type ReshardOptions struct {
+	// Sources 额外扫描的数据源，如即将下线的旧分片或分片前的单库
+	Sources   []*gorm.DB
+	BatchSize int
+	DryRun    bool
+}
+
+// ReshardStats 重新分片统计
+type ReshardStats struct {
+	Scanned int64
+	Moved   int64
+	Skipped int64 // 目标分片已存在的订单
+}
+
+// Reshard 扫描所有分片及额外数据源，将不在 user_id 所属分片上的订单（含归档订单）连同订单项迁移过去。
+// 先在目标分片写入、再从源分片删除，目标已存在的订单直接跳过，可重复执行。
+func Reshard(ctx context.Context, opts ReshardOptions) (*ReshardStats, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultReshardBatchSize
+	}
+
+	stats := &ReshardStats{}
+	sources := append(append([]*gorm.DB{}, Shards...), opts.Sources...)
+	for i, src := range sources {
+		klog.Infof("Resharding source %d/%d", i+1, len(sources))
+		if err := reshardSource(ctx, src, batchSize, opts.DryRun, stats); err != nil {
+			return stats, err
+		}
+		if err := reshardArchiveSource(ctx, src, batchSize, opts.DryRun, stats); err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+func reshardSource(ctx context.Context, src *gorm.DB, batchSize int, dryRun bool, stats *ReshardStats) error {
+	var lastID uint64
+	for {
+		var orders []model.Order
+		if err := src.WithContext(ctx).Unscoped().
+			Where("id > ?", lastID).
+			Order("id asc").
+			Limit(batchSize).
+			Find(&orders).Error; err != nil {
+			return err
+		}
+		if len(orders) == 0 {
+			return nil
+		}
+		lastID = orders[len(orders)-1].ID
+
+		for i := range orders {
+			stats.Scanned++
+			target := ShardByUserID(orders[i].UserId)
+			if target == src {
+				continue
+			}
+			if dryRun {
+				stats.Moved++
+				continue
+			}
+			moved, err := moveOrder(ctx, src, target, &orders[i])
+			if err != nil {
+				return err
+			}
+			if moved {
+				stats.Moved++
+			} else {
+				stats.Skipped++
+			}
+		}
+
+		if len(orders) < batchSize {
+			return nil
+		}
+	}
+}
+
+// moveOrder 将订单及订单项从源分片迁移到目标分片，目标已存在时只删除源数据
+func moveOrder(ctx context.Context, src, target *gorm.DB, order *model.Order) (bool, error) {
+	var items []model.OrderItem
+	if err := src.WithContext(ctx).Where("order_id = ?", order.OrderId).Find(&items).Error; err != nil {
+		return false, err
+	}
+
+	moved := false
+	err := target.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(order)
+		if result.Error != nil {
+			return result.Error
+		}
+		// 订单与订单项在同一事务写入，订单已存在说明订单项也已迁移
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		moved = true
+		for i := range items {
+			items[i].ID = 0 // 由目标分片重新分配自增 ID
+		}
+		if len(items) > 0 {
+			return tx.CreateInBatches(items, len(items)).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = src.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("order_id = ?", order.OrderId).Delete(&model.OrderItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("order_id = ?", order.OrderId).Delete(&model.Order{}).Error
+	})
+	return moved, err
+}
+
+func reshardArchiveSource(ctx context.Context, src *gorm.DB, batchSize int, dryRun bool, stats *ReshardStats) error {
+	// 分片前的旧库可能还没有归档表
+	if !src.Migrator().HasTable(&model.ArchivedOrder{}) {
+		return nil
+	}
+
+	var lastID uint64
+	for {
+		var orders []model.ArchivedOrder
+		if err := src.WithContext(ctx).
+			Where("id > ?", lastID).
+			Order("id asc").
+			Limit(batchSize).
+			Find(&orders).Error; err != nil {
+			return err
+		}
+		if len(orders) == 0 {
+			return nil
+		}
+		lastID = orders[len(orders)-1].ID
+
+		for i := range orders {
+			stats.Scanned++
+			target := ShardByUserID(orders[i].UserId)
+			if target == src {
+				continue
+			}
+			if dryRun {
+				stats.Moved++
+				continue
+			}
+			moved, err := moveArchivedOrder(ctx, src, target, &orders[i])
+			if err != nil {
+				return err
+			}
+			if moved {
+				stats.Moved++
+			} else {
+				stats.Skipped++
+			}
+		}
+
+		if len(orders) < batchSize {
+			return nil
+		}
+	}
+}
+
+// moveArchivedOrder 迁移归档订单及其订单项
+func moveArchivedOrder(ctx context.Context, src, target *gorm.DB, order *model.ArchivedOrder) (bool, error) {
+	var items []model.ArchivedOrderItem
+	if err := src.WithContext(ctx).Where("order_id = ?", order.OrderId).Find(&items).Error; err != nil {
+		return false, err
+	}
+
+	moved := false
+	err := target.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(order)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		moved = true
+		for i := range items {
+			items[i].ID = 0
+		}
+		if len(items) > 0 {
+			return tx.CreateInBatches(items, len(items)).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = src.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("order_id = ?", order.OrderId).Delete(&model.ArchivedOrderItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("order_id = ?", order.OrderId).Delete(&model.ArchivedOrder{}).Error
+	})
+	return moved, err
+}
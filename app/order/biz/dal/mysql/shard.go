@@ -0,0 +1,147 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"gorm.io/gorm"
+)
+
+// 订单号编码：1 位符号 | 41 位毫秒时间戳 | 10 位分片槽位（user_id % SlotCount）| 4 位节点号 | 8 位序列号，
+// 仅凭 order_id 即可定位分片。槽位数固定，分片数变化时只需重新映射槽位到分片。
+// 槽位位置与早期写入 snowflake 机器码位的订单号一致，时间戳纪元相同，新旧订单号不会重复；
+// 多实例部署时每个实例必须配置不同的节点号（mysql.order_id_node）
+const (
+	SlotBits  = 10
+	SlotCount = 1 << SlotBits
+	NodeBits  = 4
+	NodeCount = 1 << NodeBits
+
+	seqBits   = 8
+	seqMax    = 1<<seqBits - 1
+	slotShift = NodeBits + seqBits
+	timeShift = slotShift + SlotBits
+	slotMask  = uint64(SlotCount-1) << slotShift
+
+	orderIDEpoch = 1288834974657 // 与 snowflake 相同的纪元（毫秒）
+)
+
+var orderIDGen = struct {
+	sync.Mutex
+	node   uint64
+	lastMs [SlotCount]int64
+	seq    [SlotCount]uint64
+}{}
+
+// SetOrderIDNode 设置本实例的订单号节点号
+func SetOrderIDNode(node int) error {
+	if node < 0 || node >= NodeCount {
+		return fmt.Errorf("order id node %d out of range [0, %d)", node, NodeCount)
+	}
+	orderIDGen.Lock()
+	orderIDGen.node = uint64(node)
+	orderIDGen.Unlock()
+	return nil
+}
+
+// GenOrderID 为用户生成携带分片槽位的订单号，同一槽位每毫秒最多 256 个，超出时等待下一毫秒
+func GenOrderID(userID uint64) string {
+	slot := SlotOfUser(userID)
+
+	orderIDGen.Lock()
+	defer orderIDGen.Unlock()
+	now := time.Now().UnixMilli() - orderIDEpoch
+	// 时钟回拨时沿用上次的时间戳，保证单调
+	if now < orderIDGen.lastMs[slot] {
+		now = orderIDGen.lastMs[slot]
+	}
+	if now == orderIDGen.lastMs[slot] {
+		if orderIDGen.seq[slot] >= seqMax {
+			for now <= orderIDGen.lastMs[slot] {
+				time.Sleep(100 * time.Microsecond)
+				now = time.Now().UnixMilli() - orderIDEpoch
+			}
+			orderIDGen.seq[slot] = 0
+		} else {
+			orderIDGen.seq[slot]++
+		}
+	} else {
+		orderIDGen.seq[slot] = 0
+	}
+	orderIDGen.lastMs[slot] = now
+
+	id := uint64(now)<<timeShift | slot<<slotShift | orderIDGen.node<<seqBits | orderIDGen.seq[slot]
+	return strconv.FormatUint(id, 10)
+}
+
+// SlotOfUser 用户所属槽位
+func SlotOfUser(userID uint64) uint64 {
+	return userID % SlotCount
+}
+
+// SlotOfOrderID 从订单号中解析槽位
+func SlotOfOrderID(orderID string) (uint64, bool) {
+	id, err := strconv.ParseUint(orderID, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return (id & slotMask) >> slotShift, true
+}
+
+// ShardIndex 槽位映射到分片号
+func ShardIndex(slot uint64, shardCount int) int {
+	if shardCount <= 1 {
+		return 0
+	}
+	return int(slot % uint64(shardCount))
+}
+
+// ShardByUserID 按 user_id 路由分片
+func ShardByUserID(userID uint64) *gorm.DB {
+	return Shards[ShardIndex(SlotOfUser(userID), len(Shards))]
+}
+
+// ShardByOrderID 按订单号中编码的槽位路由分片
+func ShardByOrderID(orderID string) *gorm.DB {
+	slot, ok := SlotOfOrderID(orderID)
+	if !ok {
+		return Shards[0]
+	}
+	return Shards[ShardIndex(slot, len(Shards))]
+}
+
+// FindOrder 按订单号查询订单并返回所在分片。
+// 分片前生成的订单号不含槽位，按编码未找到时依次查询其余分片。
+func FindOrder(ctx context.Context, orderID string, dest *model.Order, preloads ...string) (*gorm.DB, error) {
+	primary := ShardByOrderID(orderID)
+	err := findOrderIn(ctx, primary, orderID, dest, preloads)
+	if err != gorm.ErrRecordNotFound {
+		return primary, err
+	}
+	for _, db := range Shards {
+		if db == primary {
+			continue
+		}
+		if err := findOrderIn(ctx, db, orderID, dest, preloads); err != gorm.ErrRecordNotFound {
+			return db, err
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func findOrderIn(ctx context.Context, db *gorm.DB, orderID string, dest *model.Order, preloads []string) error {
+	query := db.WithContext(ctx)
+	for _, p := range preloads {
+		query = query.Preload(p)
+	}
+	return query.Where("order_id = ?", orderID).First(dest).Error
+}
+
+// GlobalDB 不按用户分片的全局数据（如发票及其序列）固定存放在第一个分片，重新分片时不迁移
+func GlobalDB() *gorm.DB {
+	return Shards[0]
+}
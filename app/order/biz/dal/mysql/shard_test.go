@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"strconv"
+	"testing"
+)
+
+// TestGenOrderIDSlot 测试订单号中编码的槽位与用户槽位一致
+func TestGenOrderIDSlot(t *testing.T) {
+	for _, userID := range []uint64{1, 7, 1023, 1024, 1025, 987654321} {
+		orderID := GenOrderID(userID)
+		slot, ok := SlotOfOrderID(orderID)
+		if !ok {
+			t.Fatalf("parse order id %s failed", orderID)
+		}
+		if slot != SlotOfUser(userID) {
+			t.Errorf("user %d: expected slot %d, got %d", userID, SlotOfUser(userID), slot)
+		}
+		for _, n := range []int{1, 2, 4, 16} {
+			if ShardIndex(slot, n) != ShardIndex(SlotOfUser(userID), n) {
+				t.Errorf("user %d: shard mismatch with %d shards", userID, n)
+			}
+		}
+	}
+}
+
+// TestGenOrderIDUnique 测试同一用户连续生成的订单号不重复且为正数
+func TestGenOrderIDUnique(t *testing.T) {
+	seen := make(map[string]struct{}, 10000)
+	for i := 0; i < 10000; i++ {
+		id := GenOrderID(42)
+		if _, ok := seen[id]; ok {
+			t.Fatalf("duplicated order id %s", id)
+		}
+		seen[id] = struct{}{}
+		if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+			t.Fatalf("order id %s overflows int64: %v", id, err)
+		}
+	}
+}
+
+// TestGenOrderIDNodes 测试不同节点在同一槽位生成的订单号不重复
+func TestGenOrderIDNodes(t *testing.T) {
+	defer SetOrderIDNode(0)
+
+	seen := make(map[string]struct{}, 4000)
+	for _, node := range []int{0, 1, NodeCount - 1} {
+		if err := SetOrderIDNode(node); err != nil {
+			t.Fatalf("set node %d failed: %v", node, err)
+		}
+		for i := 0; i < 1000; i++ {
+			id := GenOrderID(7)
+			if _, ok := seen[id]; ok {
+				t.Fatalf("node %d: duplicated order id %s", node, id)
+			}
+			seen[id] = struct{}{}
+			if slot, _ := SlotOfOrderID(id); slot != SlotOfUser(7) {
+				t.Fatalf("node %d: expected slot %d, got %d", node, SlotOfUser(7), slot)
+			}
+		}
+	}
+
+	for _, node := range []int{-1, NodeCount} {
+		if err := SetOrderIDNode(node); err == nil {
+			t.Errorf("expected error for node %d", node)
+		}
+	}
+}
+
+// TestSlotOfOrderIDInvalid 测试非法订单号
+func TestSlotOfOrderIDInvalid(t *testing.T) {
+	if _, ok := SlotOfOrderID("not-a-number"); ok {
+		t.Error("expected invalid order id")
+	}
+}
@@ -0,0 +1,205 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/cloudwego/kitex/pkg/klog"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultBatchTimeout = 200 * time.Millisecond // 默认批量等待时间
+)
+
+// batchTimeout 将配置的毫秒数转换为等待时间，未配置时使用默认值
+func batchTimeout(ms int) time.Duration {
+	if ms <= 0 {
+		return DefaultBatchTimeout
+	}
+	return time.Duration(ms) * time.Millisecond
+}
+
+// consumeBatch 批量消费循环：攒够 batchSize 条或等待超过 timeout 后统一落库
+func (c *Consumer) consumeBatch(ctx context.Context, workerID int, msgs <-chan amqp.Delivery, batchSize int, timeout time.Duration) {
+	batch := make([]amqp.Delivery, 0, batchSize)
+	timer := time.NewTimer(timeout)
+	timer.Stop()
+	defer timer.Stop()
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		c.handleBatch(ctx, workerID, batch)
+		batch = make([]amqp.Delivery, 0, batchSize)
+	}
+
+	for {
+		select {
+		case <-c.stopChan:
+			klog.Infof("Worker %d: Received stop signal", workerID)
+			flush()
+			return
+		case <-ctx.Done():
+			klog.Infof("Worker %d: Context cancelled", workerID)
+			flush()
+			return
+		case <-timer.C:
+			flush()
+		case msg, ok := <-msgs:
+			if !ok {
+				klog.Warnf("Worker %d: Message channel closed", workerID)
+				return
+			}
+			batch = append(batch, msg)
+			if len(batch) == 1 {
+				timer.Reset(timeout)
+			}
+			if len(batch) >= batchSize {
+				if !timer.Stop() {
+					select {
+					case <-timer.C:
+					default:
+					}
+				}
+				flush()
+			}
+		}
+	}
+}
+
+// handleBatch 批量处理消息，失败时回退为逐条处理
+func (c *Consumer) handleBatch(ctx context.Context, workerID int, deliveries []amqp.Delivery) {
+	startTime := time.Now()
+
+	orderMsgs := make([]*OrderMessage, 0, len(deliveries))
+	valid := make([]amqp.Delivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		var orderMsg OrderMessage
+		if err := json.Unmarshal(d.Body, &orderMsg); err != nil {
+			klog.Errorf("Worker %d: Failed to unmarshal message: %v", workerID, err)
+			// 解析失败，直接拒绝不重试
+			d.Reject(false)
+			continue
+		}
+		orderMsgs = append(orderMsgs, &orderMsg)
+		valid = append(valid, d)
+	}
+	if len(valid) == 0 {
+		return
+	}
+
+	if err := processOrdersToDBBatch(ctx, orderMsgs); err != nil {
+		klog.Warnf("Worker %d: Batch of %d orders failed, falling back to single processing: %v", workerID, len(valid), err)
+		for _, d := range valid {
+			c.handleMessage(ctx, workerID, d)
+		}
+		return
+	}
+
+	// 同一 channel 上的投递标签递增，确认最后一条即可批量确认本批次
+	if err := valid[len(valid)-1].Ack(true); err != nil {
+		klog.Errorf("Worker %d: Failed to ack batch: %v", workerID, err)
+	}
+
+	klog.Infof("Worker %d: Batch of %d orders processed successfully in %v", workerID, len(valid), time.Since(startTime))
+}
+
+// processOrdersToDBBatch 按分片分组，每个分片在一个事务中批量写入订单和订单项
+func processOrdersToDBBatch(ctx context.Context, msgs []*OrderMessage) error {
+	groups := make(map[*gorm.DB][]*OrderMessage)
+	for _, msg := range msgs {
+		db := mysql.ShardByUserID(msg.UserID)
+		groups[db] = append(groups[db], msg)
+	}
+	// 任一分片失败时整体回退为逐条处理，已写入的订单由幂等检查跳过
+	for db, group := range groups {
+		if err := processShardBatch(ctx, db, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// processShardBatch 在单个分片的事务中批量写入订单和订单项
+func processShardBatch(ctx context.Context, db *gorm.DB, msgs []*OrderMessage) error {
+	orderIDs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		orderIDs = append(orderIDs, msg.OrderID)
+	}
+
+	// 幂等性检查：过滤掉已落库的订单
+	var existingIDs []string
+	if err := db.WithContext(ctx).Model(&model.Order{}).
+		Where("order_id IN ?", orderIDs).
+		Pluck("order_id", &existingIDs).Error; err != nil {
+		return err
+	}
+	existing := make(map[string]struct{}, len(existingIDs))
+	for _, id := range existingIDs {
+		existing[id] = struct{}{}
+	}
+
+	orders, items := buildBatchOrderModels(msgs, existing)
+	if len(orders) == 0 {
+		removePendingOrders(ctx, msgs)
+		return nil
+	}
+	for _, order := range orders {
+		order.Status = claimOrderStatus(ctx, order.OrderId)
+	}
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(orders, len(orders)).Error; err != nil {
+			return err
+		}
+		if len(items) > 0 {
+			if err := tx.CreateInBatches(items, len(items)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		for _, order := range orders {
+			unclaimPendingOrder(ctx, order.OrderId)
+		}
+		return err
+	}
+
+	removePendingOrders(ctx, msgs)
+	return nil
+}
+
+// removePendingOrders 批量删除已落库订单的待落库视图
+func removePendingOrders(ctx context.Context, msgs []*OrderMessage) {
+	for _, msg := range msgs {
+		removePendingOrder(ctx, msg)
+	}
+}
+
+// buildBatchOrderModels 构建批量写入的订单及订单项，跳过已存在和批次内重复的订单
+func buildBatchOrderModels(msgs []*OrderMessage, existing map[string]struct{}) ([]*model.Order, []*model.OrderItem) {
+	orders := make([]*model.Order, 0, len(msgs))
+	items := make([]*model.OrderItem, 0, len(msgs))
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := existing[msg.OrderID]; ok {
+			continue
+		}
+		if _, ok := seen[msg.OrderID]; ok {
+			continue
+		}
+		seen[msg.OrderID] = struct{}{}
+
+		order, orderItems := buildOrderModel(msg)
+		orders = append(orders, order)
+		items = append(items, orderItems...)
+	}
+	return orders, items
+}
@@ -0,0 +1,43 @@
+package rabbitmq
+
+import (
+	"testing"
+	"time"
+)
+
+// TestBuildBatchOrderModels 测试批量构建订单：跳过已存在及批次内重复的订单
+func TestBuildBatchOrderModels(t *testing.T) {
+	msgs := []*OrderMessage{
+		createTestOrderMessage("1001", 1),
+		createTestOrderMessage("1002", 2),
+		createTestOrderMessage("1001", 1), // 批次内重复
+		createTestOrderMessage("1003", 3), // 已落库
+	}
+	existing := map[string]struct{}{"1003": {}}
+
+	orders, items := buildBatchOrderModels(msgs, existing)
+	if len(orders) != 2 {
+		t.Fatalf("expected 2 orders, got %d", len(orders))
+	}
+	if orders[0].OrderId != "1001" || orders[1].OrderId != "1002" {
+		t.Fatalf("unexpected order ids: %s, %s", orders[0].OrderId, orders[1].OrderId)
+	}
+	if len(items) != 4 {
+		t.Fatalf("expected 4 items, got %d", len(items))
+	}
+	for _, item := range items {
+		if item.OrderId != "1001" && item.OrderId != "1002" {
+			t.Errorf("unexpected item order id: %s", item.OrderId)
+		}
+	}
+}
+
+// TestBatchTimeout 测试批量等待时间配置
+func TestBatchTimeout(t *testing.T) {
+	if got := batchTimeout(0); got != DefaultBatchTimeout {
+		t.Errorf("expected default timeout, got %v", got)
+	}
+	if got := batchTimeout(500); got != 500*time.Millisecond {
+		t.Errorf("expected 500ms, got %v", got)
+	}
+}
@@ -156,7 +156,8 @@ func (c *CancelConsumer) handleCancelMessage(ctx context.Context, workerID int,
 func cancelOrderIfUnpaid(ctx context.Context, orderID string) error {
 	// 1. 查询订单状态
 	var order model.Order
-	if err := mysql.DB.Preload("Items").Where("order_id = ?", orderID).First(&order).Error; err != nil {
+	db, err := mysql.FindOrder(ctx, orderID, &order, "Items")
+	if err != nil {
 		klog.Infof("Order %s not found, may already be deleted", orderID)
 		return nil // 订单不存在，视为已处理
 	}
@@ -207,7 +208,7 @@ func cancelOrderIfUnpaid(ctx context.Context, orderID string) error {
 	}
 
 	// 4. 更新订单状态为已取消
-	if err := mysql.DB.Model(&model.Order{}).
+	if err := db.Model(&model.Order{}).
 		Where("order_id = ? AND status = ?", orderID, model.OrderStatePlaced).
 		Update("status", model.OrderStateCanceled).Error; err != nil {
 		klog.Errorf("Failed to update order status: %v", err)
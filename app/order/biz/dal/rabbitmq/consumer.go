@@ -8,8 +8,11 @@ import (
 	"time"
 
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/PiaoAdmin/pmall/app/order/biz/rpc"
 	"github.com/PiaoAdmin/pmall/app/order/conf"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/kitex/pkg/klog"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"gorm.io/gorm"
@@ -113,6 +116,12 @@ func (c *Consumer) consume(ctx context.Context, workerID int) {
 
 	klog.Infof("Worker %d: Started consuming from queue %s", workerID, cfg.OrderQueue)
 
+	// 开启批量模式时，由批量循环接管消费
+	if cfg.BatchSize > 1 {
+		c.consumeBatch(ctx, workerID, msgs, cfg.BatchSize, batchTimeout(cfg.BatchTimeoutMs))
+		return
+	}
+
 	for {
 		select {
 		case <-c.stopChan:
@@ -159,6 +168,7 @@ func (c *Consumer) handleMessage(ctx context.Context, workerID int, msg amqp.Del
 			}
 		} else {
 			klog.Errorf("Worker %d: Order %s exceeded max retries, sending to DLQ", workerID, orderMsg.OrderID)
+			failPendingOrder(ctx, &orderMsg)
 		}
 		// 拒绝消息，不重新入队（会进入死信队列）
 		msg.Reject(false)
@@ -177,51 +187,132 @@ func (c *Consumer) handleMessage(ctx context.Context, workerID int, msg amqp.Del
 // processOrderToDB 将订单数据写入数据库
 func processOrderToDB(ctx context.Context, msg *OrderMessage) error {
 	// 先检查订单是否已存在（幂等性检查）
+	db := mysql.ShardByUserID(msg.UserID)
 	var existingOrder model.Order
-	if err := mysql.DB.Where("order_id = ?", msg.OrderID).First(&existingOrder).Error; err == nil {
+	if err := db.Where("order_id = ?", msg.OrderID).First(&existingOrder).Error; err == nil {
 		klog.Infof("Order %s already exists, skipping", msg.OrderID)
+		removePendingOrder(ctx, msg)
 		return nil // 订单已存在，视为成功
 	} else if err != gorm.ErrRecordNotFound {
 		return err
 	}
 
-	// 构建订单模型
-	order := &model.Order{
-		OrderId: msg.OrderID,
-		UserId:  msg.UserID,
-		Email:   msg.Email,
-		Status:  model.OrderStatePlaced,
-		ShippingAddress: model.Address{
-			Name:          msg.Address.Name,
-			StreetAddress: msg.Address.StreetAddress,
-			City:          msg.Address.City,
-			ZipCode:       msg.Address.ZipCode,
-		},
-	}
+	order, items := buildOrderModel(msg)
+	order.Status = claimOrderStatus(ctx, msg.OrderID)
 
 	// 使用事务写入订单和订单项
-	return mysql.DB.Transaction(func(tx *gorm.DB) error {
+	err := db.Transaction(func(tx *gorm.DB) error {
 		// 创建订单
 		if err := tx.Create(order).Error; err != nil {
 			return err
 		}
 
 		// 创建订单项
-		for _, item := range msg.Items {
-			orderItem := &model.OrderItem{
-				OrderId:  msg.OrderID,
-				SkuId:    item.SkuID,
-				SkuName:  item.SkuName,
-				Price:    item.Price,
-				Quantity: item.Quantity,
-			}
-			if err := tx.Create(orderItem).Error; err != nil {
+		for _, item := range items {
+			if err := tx.Create(item).Error; err != nil {
 				return err
 			}
 		}
 
 		return nil
 	})
+	if err != nil {
+		unclaimPendingOrder(ctx, msg.OrderID)
+		return err
+	}
+
+	removePendingOrder(ctx, msg)
+	return nil
+}
+
+// claimOrderStatus 认领待落库视图并返回落库状态，落库前已被用户取消的订单直接写入取消状态
+func claimOrderStatus(ctx context.Context, orderID string) string {
+	status, err := redis.ClaimPendingOrder(ctx, orderID)
+	if err != nil {
+		klog.Warnf("Failed to claim pending order %s: %v", orderID, err)
+		return model.OrderStatePlaced
+	}
+	if status == redis.PendingStatusCanceled {
+		return model.OrderStateCanceled
+	}
+	return model.OrderStatePlaced
+}
+
+// unclaimPendingOrder 落库事务回滚后撤销认领，重试期间用户仍可取消订单
+func unclaimPendingOrder(ctx context.Context, orderID string) {
+	if err := redis.UnclaimPendingOrder(ctx, orderID); err != nil {
+		klog.Warnf("Failed to unclaim pending order %s: %v", orderID, err)
+	}
+}
+
+// failPendingOrder 订单超过最大重试次数仍未落库时标记失败并释放库存；
+// 已被用户取消的订单库存已在取消时释放
+func failPendingOrder(ctx context.Context, msg *OrderMessage) {
+	status, err := redis.FailPendingOrder(ctx, msg.OrderID)
+	if err != nil {
+		klog.Errorf("Failed to mark pending order %s failed: %v", msg.OrderID, err)
+		return
+	}
+	if status != redis.PendingStatusProcessing {
+		return
+	}
+
+	releaseItems := make([]*product.SkuDeductItem, 0, len(msg.Items))
+	for _, item := range msg.Items {
+		if item.SkuID == 0 || item.Quantity <= 0 {
+			continue
+		}
+		releaseItems = append(releaseItems, &product.SkuDeductItem{
+			SkuId: item.SkuID,
+			Count: item.Quantity,
+		})
+	}
+	if len(releaseItems) == 0 {
+		return
+	}
+	if _, err := rpc.ProductClient.ReleaseStock(ctx, &product.ReleaseStockRequest{
+		OrderSn: msg.OrderID,
+		Items:   releaseItems,
+	}); err != nil {
+		klog.Errorf("Failed to release stock for failed order %s: %v", msg.OrderID, err)
+		return
+	}
+	klog.Infof("Stock released for failed order %s", msg.OrderID)
+}
+
+// removePendingOrder 订单落库后删除待落库视图
+func removePendingOrder(ctx context.Context, msg *OrderMessage) {
+	if err := redis.DeletePendingOrder(ctx, msg.OrderID, msg.UserID); err != nil {
+		klog.Warnf("Failed to remove pending order %s: %v", msg.OrderID, err)
+	}
+}
+
+// buildOrderModel 根据消息构建订单及订单项模型
+func buildOrderModel(msg *OrderMessage) (*model.Order, []*model.OrderItem) {
+	order := &model.Order{
+		OrderId: msg.OrderID,
+		UserId:  msg.UserID,
+		Email:   msg.Email,
+		Status:  model.OrderStatePlaced,
+		ShippingAddress: model.Address{
+			Name:          msg.Address.Name,
+			StreetAddress: msg.Address.StreetAddress,
+			City:          msg.Address.City,
+			ZipCode:       msg.Address.ZipCode,
+		},
+	}
+
+	items := make([]*model.OrderItem, 0, len(msg.Items))
+	for _, item := range msg.Items {
+		items = append(items, &model.OrderItem{
+			OrderId:  msg.OrderID,
+			SkuId:    item.SkuID,
+			SkuName:  item.SkuName,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+		})
+	}
+	return order, items
 }
 
 // GetConsumerStats 获取消费者统计信息
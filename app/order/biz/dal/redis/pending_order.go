@@ -0,0 +1,249 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// 待落库订单视图：PlaceOrder 发布消息时写入，消费者落库后删除，
+// 用于在订单异步写库完成前提供"读己之写"
+const (
+	PendingOrderKeyPrefix      = "order:pending:"
+	PendingUserOrdersKeyPrefix = "order:pending:user:"
+	PendingOrderExpire         = 24 * time.Hour
+
+	// 待落库订单状态，区别于已落库的 placed
+	PendingStatusProcessing = "processing"
+	PendingStatusCanceled   = "canceled"
+	PendingStatusFailed     = "failed" // 多次重试仍无法落库，库存已释放
+
+	pendingFieldData    = "data"
+	pendingFieldStatus  = "status"
+	pendingFieldClaimed = "claimed"
+)
+
+// CancelPendingResult 取消待落库订单的结果
+type CancelPendingResult int
+
+const (
+	CancelPendingNotFound        CancelPendingResult = iota // 不存在待落库订单
+	CancelPendingOK                                         // 已标记为取消
+	CancelPendingAlreadyCanceled                            // 之前已取消或落库失败，库存已释放
+	CancelPendingClaimed                                    // 消费者正在落库，无法在缓存中取消
+)
+
+// PendingOrder 待落库订单
+type PendingOrder struct {
+	OrderID   string              `json:"order_id"`
+	UserID    uint64              `json:"user_id"`
+	Email     string              `json:"email"`
+	Address   PendingAddress      `json:"address"`
+	Items     []*PendingOrderItem `json:"items"`
+	Status    string              `json:"-"`
+	CreatedAt int64               `json:"created_at"`
+}
+
+type PendingAddress struct {
+	Name          string `json:"name"`
+	StreetAddress string `json:"street_address"`
+	City          string `json:"city"`
+	ZipCode       int32  `json:"zip_code"`
+}
+
+type PendingOrderItem struct {
+	SkuID    uint64  `json:"sku_id"`
+	SkuName  string  `json:"sku_name"`
+	Price    float64 `json:"price"`
+	Quantity int32   `json:"quantity"`
+}
+
+// claimScript 消费者落库前认领订单，返回当前状态；订单不存在时返回空串
+var claimScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return ''
+end
+redis.call('HSET', KEYS[1], 'claimed', '1')
+return redis.call('HGET', KEYS[1], 'status')
+`)
+
+// unclaimScript 落库事务失败后撤销认领，使订单在重试前仍可被取消
+var unclaimScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'claimed', '0')
+return 1
+`)
+
+// failScript 落库最终失败时将处理中的订单标记为失败并撤销认领，返回原状态；订单不存在时返回空串。
+// 只有从 processing 转为 failed 的调用方负责释放库存，保证库存只释放一次
+var failScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return ''
+end
+local status = redis.call('HGET', KEYS[1], 'status')
+if status == ARGV[1] then
+	redis.call('HSET', KEYS[1], 'status', ARGV[2], 'claimed', '0')
+end
+return status
+`)
+
+// cancelScript 在订单未被消费者认领时将其标记为取消
+// 返回值: 0 不存在, 1 取消成功, 2 已取消或落库失败, 3 已被认领
+var cancelScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+local status = redis.call('HGET', KEYS[1], 'status')
+if status == ARGV[1] or status == ARGV[2] then
+	return 2
+end
+if redis.call('HGET', KEYS[1], 'claimed') == '1' then
+	return 3
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[1])
+return 1
+`)
+
+func pendingOrderKey(orderID string) string {
+	return PendingOrderKeyPrefix + orderID
+}
+
+func pendingUserOrdersKey(userID uint64) string {
+	return fmt.Sprintf("%s%d", PendingUserOrdersKeyPrefix, userID)
+}
+
+// SetPendingOrder 写入待落库订单
+func SetPendingOrder(ctx context.Context, order *PendingOrder) error {
+	data, err := json.Marshal(order)
+	if err != nil {
+		return err
+	}
+
+	key := pendingOrderKey(order.OrderID)
+	userKey := pendingUserOrdersKey(order.UserID)
+	pipe := RedisClient.TxPipeline()
+	pipe.HSet(ctx, key,
+		pendingFieldData, data,
+		pendingFieldStatus, PendingStatusProcessing,
+		pendingFieldClaimed, "0",
+	)
+	pipe.Expire(ctx, key, PendingOrderExpire)
+	pipe.ZAdd(ctx, userKey, redis.Z{Score: float64(order.CreatedAt), Member: order.OrderID})
+	pipe.Expire(ctx, userKey, PendingOrderExpire)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetPendingOrder 获取待落库订单，不存在时返回 nil, nil
+func GetPendingOrder(ctx context.Context, orderID string) (*PendingOrder, error) {
+	fields, err := RedisClient.HGetAll(ctx, pendingOrderKey(orderID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	return parsePendingOrder(fields)
+}
+
+// ListPendingOrders 获取用户的待落库订单，按创建时间倒序
+func ListPendingOrders(ctx context.Context, userID uint64) ([]*PendingOrder, error) {
+	userKey := pendingUserOrdersKey(userID)
+	orderIDs, err := RedisClient.ZRevRange(ctx, userKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(orderIDs) == 0 {
+		return nil, nil
+	}
+
+	pipe := RedisClient.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, 0, len(orderIDs))
+	for _, id := range orderIDs {
+		cmds = append(cmds, pipe.HGetAll(ctx, pendingOrderKey(id)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	orders := make([]*PendingOrder, 0, len(orderIDs))
+	stale := make([]interface{}, 0)
+	for i, cmd := range cmds {
+		order, err := parsePendingOrder(cmd.Val())
+		if err != nil {
+			klog.Warnf("Failed to parse pending order %s: %v", orderIDs[i], err)
+			continue
+		}
+		if order == nil {
+			// 订单详情已过期或已删除，清理索引
+			stale = append(stale, orderIDs[i])
+			continue
+		}
+		orders = append(orders, order)
+	}
+	if len(stale) > 0 {
+		if err := RedisClient.ZRem(ctx, userKey, stale...).Err(); err != nil {
+			klog.Warnf("Failed to clean stale pending orders for user %d: %v", userID, err)
+		}
+	}
+	return orders, nil
+}
+
+// ClaimPendingOrder 消费者落库前认领订单，返回缓存中的状态；订单不存在时返回空串
+func ClaimPendingOrder(ctx context.Context, orderID string) (string, error) {
+	return claimScript.Run(ctx, RedisClient, []string{pendingOrderKey(orderID)}).Text()
+}
+
+// UnclaimPendingOrder 落库事务失败后撤销认领
+func UnclaimPendingOrder(ctx context.Context, orderID string) error {
+	return unclaimScript.Run(ctx, RedisClient, []string{pendingOrderKey(orderID)}).Err()
+}
+
+// FailPendingOrder 落库最终失败时标记订单失败，返回标记前的状态；
+// 返回 PendingStatusProcessing 表示本次调用完成了标记，需要由调用方释放库存
+func FailPendingOrder(ctx context.Context, orderID string) (string, error) {
+	return failScript.Run(ctx, RedisClient, []string{pendingOrderKey(orderID)}, PendingStatusProcessing, PendingStatusFailed).Text()
+}
+
+// CancelPendingOrder 取消尚未落库的订单
+func CancelPendingOrder(ctx context.Context, orderID string) (CancelPendingResult, error) {
+	res, err := cancelScript.Run(ctx, RedisClient, []string{pendingOrderKey(orderID)}, PendingStatusCanceled, PendingStatusFailed).Int()
+	if err != nil {
+		return CancelPendingNotFound, err
+	}
+	switch res {
+	case 1:
+		return CancelPendingOK, nil
+	case 2:
+		return CancelPendingAlreadyCanceled, nil
+	case 3:
+		return CancelPendingClaimed, nil
+	default:
+		return CancelPendingNotFound, nil
+	}
+}
+
+// DeletePendingOrder 订单落库后删除待落库视图
+func DeletePendingOrder(ctx context.Context, orderID string, userID uint64) error {
+	pipe := RedisClient.TxPipeline()
+	pipe.Del(ctx, pendingOrderKey(orderID))
+	pipe.ZRem(ctx, pendingUserOrdersKey(userID), orderID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func parsePendingOrder(fields map[string]string) (*PendingOrder, error) {
+	data, ok := fields[pendingFieldData]
+	if !ok {
+		return nil, nil
+	}
+	var order PendingOrder
+	if err := json.Unmarshal([]byte(data), &order); err != nil {
+		return nil, err
+	}
+	order.Status = fields[pendingFieldStatus]
+	return &order, nil
+}
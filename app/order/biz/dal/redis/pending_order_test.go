@@ -0,0 +1,30 @@
+package redis
+
+import "testing"
+
+// TestParsePendingOrder 测试待落库订单解析
+func TestParsePendingOrder(t *testing.T) {
+	order, err := parsePendingOrder(map[string]string{})
+	if err != nil || order != nil {
+		t.Fatalf("expected nil order for missing key, got %v, %v", order, err)
+	}
+
+	order, err = parsePendingOrder(map[string]string{
+		pendingFieldData:    `{"order_id":"1001","user_id":7,"items":[{"sku_id":1,"sku_name":"sku","price":9.9,"quantity":2}],"created_at":1700000000}`,
+		pendingFieldStatus:  PendingStatusCanceled,
+		pendingFieldClaimed: "0",
+	})
+	if err != nil {
+		t.Fatalf("parse pending order failed: %v", err)
+	}
+	if order.OrderID != "1001" || order.UserID != 7 || order.Status != PendingStatusCanceled {
+		t.Errorf("unexpected pending order: %+v", order)
+	}
+	if len(order.Items) != 1 || order.Items[0].Quantity != 2 {
+		t.Errorf("unexpected pending order items: %+v", order.Items)
+	}
+
+	if _, err := parsePendingOrder(map[string]string{pendingFieldData: "{"}); err == nil {
+		t.Error("expected error for invalid json")
+	}
+}
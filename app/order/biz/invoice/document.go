@@ -0,0 +1,84 @@
+// Package invoice 发票单据的金额计算与渲染（HTML / PDF），不依赖数据库。
+package invoice
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Party 开票方或购买方信息
+type Party struct {
+	Name    string `json:"name"`
+	Email   string `json:"email,omitempty"`
+	Address string `json:"address,omitempty"`
+	TaxID   string `json:"tax_id,omitempty"`
+}
+
+// Line 发票明细行，金额单位为分，单价为含税价
+type Line struct {
+	SkuID     uint64 `json:"sku_id"`
+	Name      string `json:"name"`
+	Quantity  int32  `json:"quantity"`
+	UnitPrice int64  `json:"unit_price"`
+	Amount    int64  `json:"amount"`
+}
+
+// Document 发票快照，开具后不随订单、商品或配置变化
+type Document struct {
+	No       string
+	OrderID  string
+	IssuedAt time.Time
+	Seller   Party
+	Buyer    Party
+	Lines    []Line
+	TaxRate  float64
+	Net      int64 // 不含税金额
+	Tax      int64 // 税额
+	Total    int64 // 价税合计
+}
+
+// NewLine 构建明细行，price 为订单中的成交价（元）
+func NewLine(skuID uint64, name string, quantity int32, price float64) Line {
+	unit := int64(math.Round(price * 100))
+	return Line{
+		SkuID:     skuID,
+		Name:      name,
+		Quantity:  quantity,
+		UnitPrice: unit,
+		Amount:    unit * int64(quantity),
+	}
+}
+
+// SplitTax 将含税总额按税率拆分为不含税金额与税额
+func SplitTax(total int64, rate float64) (net, tax int64) {
+	if rate <= 0 {
+		return total, 0
+	}
+	net = int64(math.Round(float64(total) / (1 + rate)))
+	return net, total - net
+}
+
+// Compute 根据明细行计算合计与税额
+func (d *Document) Compute() {
+	d.Total = 0
+	for _, l := range d.Lines {
+		d.Total += l.Amount
+	}
+	d.Net, d.Tax = SplitTax(d.Total, d.TaxRate)
+}
+
+// FormatCents 分转为两位小数的元
+func FormatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// FormatRate 税率显示为百分比
+func FormatRate(rate float64) string {
+	return fmt.Sprintf("%g%%", math.Round(rate*10000)/100)
+}
@@ -0,0 +1,71 @@
+package invoice
+
+import (
+	"bytes"
+	"html/template"
+)
+
+var htmlTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"money": FormatCents,
+	"rate":  FormatRate,
+}).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<title>发票 {{.No}}</title>
+<style>
+body { font-family: sans-serif; margin: 40px; color: #222; }
+h1 { font-size: 22px; margin-bottom: 4px; }
+table { width: 100%; border-collapse: collapse; margin-top: 16px; }
+th, td { border-bottom: 1px solid #ddd; padding: 6px 8px; text-align: left; }
+td.num, th.num { text-align: right; }
+.parties { display: flex; gap: 48px; margin-top: 16px; }
+.summary td { border: none; }
+</style>
+</head>
+<body>
+<h1>发票 / Invoice</h1>
+<div>发票号码：{{.No}}</div>
+<div>订单号：{{.OrderID}}</div>
+<div>开票日期：{{.IssuedAt.Format "2006-01-02 15:04:05"}}</div>
+<div class="parties">
+  <div>
+    <strong>销售方</strong>
+    <div>{{.Seller.Name}}</div>
+    {{if .Seller.TaxID}}<div>税号：{{.Seller.TaxID}}</div>{{end}}
+    {{if .Seller.Address}}<div>{{.Seller.Address}}</div>{{end}}
+  </div>
+  <div>
+    <strong>购买方</strong>
+    <div>{{.Buyer.Name}}</div>
+    {{if .Buyer.Email}}<div>{{.Buyer.Email}}</div>{{end}}
+    {{if .Buyer.Address}}<div>{{.Buyer.Address}}</div>{{end}}
+  </div>
+</div>
+<table>
+  <thead>
+    <tr><th>SKU</th><th>商品名称</th><th class="num">数量</th><th class="num">单价</th><th class="num">金额</th></tr>
+  </thead>
+  <tbody>
+  {{range .Lines}}
+    <tr><td>{{.SkuID}}</td><td>{{.Name}}</td><td class="num">{{.Quantity}}</td><td class="num">{{money .UnitPrice}}</td><td class="num">{{money .Amount}}</td></tr>
+  {{end}}
+  </tbody>
+</table>
+<table class="summary">
+  <tr><td class="num">不含税金额</td><td class="num">{{money .Net}}</td></tr>
+  <tr><td class="num">税额（{{rate .TaxRate}}）</td><td class="num">{{money .Tax}}</td></tr>
+  <tr><td class="num"><strong>价税合计</strong></td><td class="num"><strong>{{money .Total}}</strong></td></tr>
+</table>
+</body>
+</html>
+`))
+
+// RenderHTML 渲染 HTML 发票
+func RenderHTML(d *Document) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, d); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
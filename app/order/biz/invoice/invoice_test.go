@@ -0,0 +1,129 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testDocument(lines int) *Document {
+	d := &Document{
+		No:       "INV-2026-000001",
+		OrderID:  "123456789",
+		IssuedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local),
+		Seller:   Party{Name: "PMall", TaxID: "91110000TEST"},
+		Buyer:    Party{Name: "张三", Email: "a@b.com", Address: "北京 长安街 1 号"},
+		TaxRate:  0.13,
+	}
+	for i := 0; i < lines; i++ {
+		d.Lines = append(d.Lines, NewLine(uint64(i+1), fmt.Sprintf("商品 <%d>", i), 2, 9.99))
+	}
+	d.Compute()
+	return d
+}
+
+func TestCompute(t *testing.T) {
+	d := testDocument(3)
+	if d.Total != 5994 {
+		t.Fatalf("expected total 5994, got %d", d.Total)
+	}
+	if d.Net+d.Tax != d.Total {
+		t.Errorf("net %d + tax %d != total %d", d.Net, d.Tax, d.Total)
+	}
+	if d.Net != 5304 {
+		t.Errorf("expected net 5304, got %d", d.Net)
+	}
+
+	net, tax := SplitTax(1000, 0)
+	if net != 1000 || tax != 0 {
+		t.Errorf("zero rate: got net %d tax %d", net, tax)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	cases := map[int64]string{0: "0.00", 5: "0.05", 1999: "19.99", -250: "-2.50"}
+	for in, want := range cases {
+		if got := FormatCents(in); got != want {
+			t.Errorf("FormatCents(%d) = %s, want %s", in, got, want)
+		}
+	}
+	if got := FormatRate(0.13); got != "13%" {
+		t.Errorf("FormatRate(0.13) = %s", got)
+	}
+	if got := FormatRate(0.065); got != "6.5%" {
+		t.Errorf("FormatRate(0.065) = %s", got)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	out, err := RenderHTML(testDocument(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	html := string(out)
+	for _, want := range []string{"INV-2026-000001", "张三", "商品 &lt;0&gt;", "19.98", "39.96"} {
+		if !strings.Contains(html, want) {
+			t.Errorf("html missing %q", want)
+		}
+	}
+}
+
+func TestRenderPDF(t *testing.T) {
+	out, err := RenderPDF(testDocument(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("missing pdf header or trailer")
+	}
+
+	// 100 行需要分页
+	m := regexp.MustCompile(`/Count (\d+)`).FindSubmatch(out)
+	if m == nil {
+		t.Fatal("missing page count")
+	}
+	if n, _ := strconv.Atoi(string(m[1])); n < 2 {
+		t.Errorf("expected multiple pages, got %d", n)
+	}
+
+	// 交叉引用表中的偏移量指向对应对象
+	xref := regexp.MustCompile(`startxref\n(\d+)`).FindSubmatch(out)
+	if xref == nil {
+		t.Fatal("missing startxref")
+	}
+	pos, _ := strconv.Atoi(string(xref[1]))
+	entries := strings.Split(string(out[pos:]), "\n")
+	for i, e := range entries[3:] {
+		if !strings.HasSuffix(e, " n ") {
+			break
+		}
+		off, _ := strconv.Atoi(e[:10])
+		if !bytes.HasPrefix(out[off:], []byte(fmt.Sprintf("%d 0 obj", i+1))) {
+			t.Errorf("xref entry %d points to wrong offset %d", i+1, off)
+		}
+	}
+}
+
+func TestEncodeUCS2(t *testing.T) {
+	if got := encodeUCS2("A中"); got != "00414E2D" {
+		t.Errorf("got %s", got)
+	}
+	if got := encodeUCS2("😀"); got != "003F" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	s := strings.Repeat("中", 50)
+	got := truncate(s, 10, 100)
+	if textWidth(got, 10) > 100 || !strings.HasSuffix(got, "...") {
+		t.Errorf("truncate result too wide: %s", got)
+	}
+	if truncate("short", 10, 100) != "short" {
+		t.Error("short string should not be truncated")
+	}
+}
@@ -0,0 +1,226 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+// PDF 使用 PDF 规范预置的 STSong-Light 中文字体（UniGB-UCS2-H 编码），阅读器自带字形，
+// 无需嵌入字体文件即可显示中英文。ASCII 字符按半角宽度、其余按全角宽度排版。
+const (
+	pageWidth    = 595.0 // A4
+	pageHeight   = 842.0
+	marginLeft   = 50.0
+	marginRight  = 545.0
+	marginBottom = 80.0
+	rowHeight    = 18.0
+	nameMaxWidth = 230.0
+)
+
+// pdfPage 单页内容流
+type pdfPage struct {
+	buf bytes.Buffer
+}
+
+func (p *pdfPage) text(x, y, size float64, s string) {
+	fmt.Fprintf(&p.buf, "BT /F1 %s Tf %s %s Td <%s> Tj ET\n", num(size), num(x), num(y), encodeUCS2(s))
+}
+
+// textRight 右对齐输出，x 为右边界
+func (p *pdfPage) textRight(x, y, size float64, s string) {
+	p.text(x-textWidth(s, size), y, size, s)
+}
+
+func (p *pdfPage) line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.buf, "%s %s m %s %s l S\n", num(x1), num(y1), num(x2), num(y2))
+}
+
+// pdfLayout 按行向下排版，空间不足时换页
+type pdfLayout struct {
+	pages []*pdfPage
+	cur   *pdfPage
+	y     float64
+}
+
+func (l *pdfLayout) newPage() {
+	l.cur = &pdfPage{}
+	l.cur.buf.WriteString("0.5 w\n")
+	l.pages = append(l.pages, l.cur)
+	l.y = pageHeight - 60
+}
+
+// ensure 剩余高度不足 h 时换页，返回是否换页
+func (l *pdfLayout) ensure(h float64) bool {
+	if l.y-h >= marginBottom {
+		return false
+	}
+	l.newPage()
+	return true
+}
+
+// RenderPDF 渲染 PDF 发票
+func RenderPDF(d *Document) ([]byte, error) {
+	l := &pdfLayout{}
+	l.newPage()
+
+	l.cur.text(marginLeft, l.y, 20, "发票 / Invoice")
+	l.y -= 30
+	for _, s := range []string{
+		"发票号码：" + d.No,
+		"订单号：" + d.OrderID,
+		"开票日期：" + d.IssuedAt.Format("2006-01-02 15:04:05"),
+	} {
+		l.cur.text(marginLeft, l.y, 10, s)
+		l.y -= 15
+	}
+	l.y -= 10
+
+	top := l.y
+	sellerY := writeParty(l.cur, marginLeft, top, "销售方", d.Seller.Name, taxIDLine(d.Seller.TaxID), d.Seller.Address)
+	buyerY := writeParty(l.cur, 300, top, "购买方", d.Buyer.Name, d.Buyer.Email, d.Buyer.Address)
+	l.y = min(sellerY, buyerY) - 15
+
+	writeTableHeader(l)
+	for _, line := range d.Lines {
+		if l.ensure(rowHeight) {
+			writeTableHeader(l)
+		}
+		l.cur.text(marginLeft, l.y, 9, strconv.FormatUint(line.SkuID, 10))
+		l.cur.text(150, l.y, 9, truncate(line.Name, 9, nameMaxWidth))
+		l.cur.textRight(410, l.y, 9, strconv.Itoa(int(line.Quantity)))
+		l.cur.textRight(480, l.y, 9, FormatCents(line.UnitPrice))
+		l.cur.textRight(marginRight, l.y, 9, FormatCents(line.Amount))
+		l.y -= rowHeight
+	}
+
+	l.ensure(rowHeight * 4)
+	l.cur.line(marginLeft, l.y+rowHeight-4, marginRight, l.y+rowHeight-4)
+	for _, row := range [][2]string{
+		{"不含税金额", FormatCents(d.Net)},
+		{"税额（" + FormatRate(d.TaxRate) + "）", FormatCents(d.Tax)},
+		{"价税合计", FormatCents(d.Total)},
+	} {
+		l.cur.textRight(450, l.y, 10, row[0])
+		l.cur.textRight(marginRight, l.y, 10, row[1])
+		l.y -= rowHeight
+	}
+
+	return assemblePDF(l.pages), nil
+}
+
+func writeParty(p *pdfPage, x, y float64, title string, lines ...string) float64 {
+	p.text(x, y, 11, title)
+	y -= 15
+	for _, s := range lines {
+		if s == "" {
+			continue
+		}
+		p.text(x, y, 9, truncate(s, 9, 230))
+		y -= 13
+	}
+	return y
+}
+
+func writeTableHeader(l *pdfLayout) {
+	l.cur.text(marginLeft, l.y, 9, "SKU")
+	l.cur.text(150, l.y, 9, "商品名称")
+	l.cur.textRight(410, l.y, 9, "数量")
+	l.cur.textRight(480, l.y, 9, "单价")
+	l.cur.textRight(marginRight, l.y, 9, "金额")
+	l.cur.line(marginLeft, l.y-5, marginRight, l.y-5)
+	l.y -= rowHeight + 2
+}
+
+func taxIDLine(taxID string) string {
+	if taxID == "" {
+		return ""
+	}
+	return "税号：" + taxID
+}
+
+// assemblePDF 组装 PDF 对象与交叉引用表
+func assemblePDF(pages []*pdfPage) []byte {
+	var out bytes.Buffer
+	var offsets []int
+	obj := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1 目录，2 页树，3-5 字体，之后每页占用页对象与内容流两个对象
+	kids := bytes.Buffer{}
+	for i := range pages {
+		fmt.Fprintf(&kids, "%d 0 R ", 6+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", bytes.TrimSpace(kids.Bytes()), len(pages)))
+	obj("<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [4 0 R] >>")
+	obj("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light " +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> " +
+		"/FontDescriptor 5 0 R /DW 1000 /W [1 95 500] >>")
+	obj("<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] " +
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>")
+	for i, p := range pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			num(pageWidth), num(pageHeight), 7+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.buf.Len(), p.buf.String()))
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes()
+}
+
+// encodeUCS2 编码为 UCS-2 大端十六进制串，超出 BMP 的字符替换为 '?'
+func encodeUCS2(s string) string {
+	var b bytes.Buffer
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
+
+func runeWidth(r rune) float64 {
+	if r < 0x80 {
+		return 0.5
+	}
+	return 1
+}
+
+func textWidth(s string, size float64) float64 {
+	var w float64
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w * size
+}
+
+// truncate 超出宽度时截断并追加省略号
+func truncate(s string, size, maxWidth float64) string {
+	if textWidth(s, size) <= maxWidth {
+		return s
+	}
+	limit := maxWidth - textWidth("...", size)
+	var w float64
+	for i, r := range s {
+		w += runeWidth(r) * size
+		if w > limit {
+			return s[:i] + "..."
+		}
+	}
+	return s
+}
+
+func num(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
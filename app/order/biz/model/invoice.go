@@ -0,0 +1,110 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Invoice 订单发票，开具时快照买卖双方、明细与金额，之后不再变化
+type Invoice struct {
+	ID            uint64    `gorm:"primaryKey;autoIncrement"`
+	InvoiceNo     string    `gorm:"column:invoice_no;type:varchar(64);uniqueIndex;not null"`
+	OrderId       string    `gorm:"column:order_id;type:varchar(64);uniqueIndex;not null"`
+	UserId        uint64    `gorm:"column:user_id;type:bigint unsigned;index;not null"`
+	SellerName    string    `gorm:"column:seller_name;type:varchar(255);not null;default:''"`
+	SellerTaxID   string    `gorm:"column:seller_tax_id;type:varchar(64);not null;default:''"`
+	SellerAddress string    `gorm:"column:seller_address;type:varchar(255);not null;default:''"`
+	BuyerName     string    `gorm:"column:buyer_name;type:varchar(255);not null;default:''"`
+	BuyerEmail    string    `gorm:"column:buyer_email;type:varchar(255);not null;default:''"`
+	BuyerAddress  string    `gorm:"column:buyer_address;type:varchar(512);not null;default:''"`
+	Items         string    `gorm:"column:items;type:json"` // 明细快照 JSON，金额单位为分
+	TaxRate       float64   `gorm:"column:tax_rate;type:decimal(6,4);not null;default:0"`
+	NetAmount     int64     `gorm:"column:net_amount;not null;default:0"`   // 不含税金额（分）
+	TaxAmount     int64     `gorm:"column:tax_amount;not null;default:0"`   // 税额（分）
+	TotalAmount   int64     `gorm:"column:total_amount;not null;default:0"` // 价税合计（分）
+	IssuedAt      time.Time `gorm:"column:issued_at;not null"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+}
+
+func (Invoice) TableName() string {
+	return "invoices"
+}
+
+// InvoiceSequence 发票号序列，每个年份一行
+type InvoiceSequence struct {
+	Name  string `gorm:"primaryKey;column:name;type:varchar(32)"`
+	Value uint64 `gorm:"column:value;not null;default:0"`
+}
+
+func (InvoiceSequence) TableName() string {
+	return "invoice_sequences"
+}
+
+// FormatInvoiceNo 发票号：<前缀>-<年份>-<六位序号>
+func FormatInvoiceNo(prefix string, year int, seq uint64) string {
+	return fmt.Sprintf("%s-%d-%06d", prefix, year, seq)
+}
+
+// GetInvoiceByOrderID 查询订单发票，不存在时返回 nil, nil
+func GetInvoiceByOrderID(ctx context.Context, db *gorm.DB, orderID string) (*Invoice, error) {
+	var inv Invoice
+	err := db.WithContext(ctx).Where("order_id = ?", orderID).First(&inv).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// CreateInvoice 分配发票号并写入发票，返回最终保存的发票。
+// 序列行加锁与发票写入在同一事务中，号码连续且不重复；订单已开票时直接返回已有发票。
+func CreateInvoice(ctx context.Context, db *gorm.DB, inv *Invoice, prefix string) (*Invoice, error) {
+	year := inv.IssuedAt.Year()
+	seqName := fmt.Sprintf("%s-%d", prefix, year)
+
+	var result *Invoice
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&InvoiceSequence{Name: seqName}).Error; err != nil {
+			return err
+		}
+		var seq InvoiceSequence
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("name = ?", seqName).First(&seq).Error; err != nil {
+			return err
+		}
+
+		// 持有序列锁后再检查，避免并发请求为同一订单重复开票
+		existing, err := GetInvoiceByOrderID(ctx, tx, inv.OrderId)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			result = existing
+			return nil
+		}
+
+		seq.Value++
+		inv.InvoiceNo = FormatInvoiceNo(prefix, year, seq.Value)
+		if err := tx.Create(inv).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&InvoiceSequence{}).Where("name = ?", seqName).
+			Update("value", seq.Value).Error; err != nil {
+			return err
+		}
+		result = inv
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
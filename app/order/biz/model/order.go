@@ -3,13 +3,14 @@ package model
 import (
 	"strconv"
 
+	"github.com/PiaoAdmin/pmall/common/orderstate"
 	"gorm.io/gorm"
 )
 
 const (
-	OrderStatePlaced   string = "placed"
-	OrderStatePaid     string = "paid"
-	OrderStateCanceled string = "canceled"
+	OrderStatePlaced   string = orderstate.Placed
+	OrderStatePaid     string = orderstate.Paid
+	OrderStateCanceled string = orderstate.Canceled
 )
 
 type Address struct {
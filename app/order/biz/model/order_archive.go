@@ -0,0 +1,251 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ArchivedOrder 归档订单，结构与 orders 一致，额外记录归档时间
+type ArchivedOrder struct {
+	ID              uint64              `gorm:"primarykey"`
+	OrderId         string              `gorm:"column:order_id;type:varchar(64);uniqueIndex;not null"`
+	UserId          uint64              `gorm:"column:user_id;type:bigint unsigned;index;not null"`
+	Email           string              `gorm:"column:email;type:varchar(255);not null;default:''"`
+	ShippingAddress Address             `gorm:"embedded"`
+	Status          string              `gorm:"column:status;type:varchar(32);not null;default:''"`
+	CreatedAt       time.Time           `gorm:"column:created_at;index"`
+	UpdatedAt       time.Time           `gorm:"column:updated_at"`
+	IsDeleted       bool                `gorm:"column:is_deleted;not null;default:false"`
+	ArchivedAt      time.Time           `gorm:"column:archived_at"`
+	Items           []ArchivedOrderItem `gorm:"foreignKey:OrderId;references:OrderId"`
+}
+
+func (ArchivedOrder) TableName() string {
+	return "orders_archive"
+}
+
+// ArchivedOrderItem 归档订单项
+type ArchivedOrderItem struct {
+	ID       uint64  `gorm:"primaryKey;autoIncrement"`
+	OrderId  string  `gorm:"column:order_id;type:varchar(64);not null;index"`
+	SkuId    uint64  `gorm:"column:sku_id;type:bigint unsigned;not null"`
+	SkuName  string  `gorm:"column:sku_name;type:varchar(255);not null;default:''"`
+	Price    float64 `gorm:"column:price;type:decimal(10,2);not null;default:0.00"`
+	Quantity int32   `gorm:"column:quantity;type:int;not null;default:1"`
+}
+
+func (ArchivedOrderItem) TableName() string {
+	return "order_items_archive"
+}
+
+// ToOrder 转换为订单模型，便于与在线订单统一处理
+func (a *ArchivedOrder) ToOrder() *Order {
+	o := &Order{
+		Model: Model{
+			ID:        a.ID,
+			CreatedAt: a.CreatedAt,
+			UpdatedAt: a.UpdatedAt,
+			IsDeleted: a.IsDeleted,
+		},
+		OrderId:         a.OrderId,
+		UserId:          a.UserId,
+		Email:           a.Email,
+		ShippingAddress: a.ShippingAddress,
+		Status:          a.Status,
+		Items:           make([]OrderItem, 0, len(a.Items)),
+	}
+	for _, it := range a.Items {
+		o.Items = append(o.Items, OrderItem{
+			OrderId:  it.OrderId,
+			SkuId:    it.SkuId,
+			SkuName:  it.SkuName,
+			Price:    it.Price,
+			Quantity: it.Quantity,
+		})
+	}
+	return o
+}
+
+// newArchivedOrder 由在线订单构建归档订单
+func newArchivedOrder(o *Order, archivedAt time.Time) *ArchivedOrder {
+	return &ArchivedOrder{
+		ID:              o.ID,
+		OrderId:         o.OrderId,
+		UserId:          o.UserId,
+		Email:           o.Email,
+		ShippingAddress: o.ShippingAddress,
+		Status:          o.Status,
+		CreatedAt:       o.CreatedAt,
+		UpdatedAt:       o.UpdatedAt,
+		IsDeleted:       o.IsDeleted,
+		ArchivedAt:      archivedAt,
+	}
+}
+
+// ArchiveOrders 将创建时间早于 cutoff 的已支付、已取消订单迁移到归档表，返回本批迁移数量。
+// 归档表写入与在线表删除在同一事务中完成，归档表已存在的订单直接跳过写入。
+func ArchiveOrders(ctx context.Context, db *gorm.DB, cutoff time.Time, limit int) (int, error) {
+	var orders []Order
+	if err := db.WithContext(ctx).Unscoped().Preload("Items").
+		Where("status IN ? AND created_at < ?", []string{OrderStatePaid, OrderStateCanceled}, cutoff).
+		Order("id asc").
+		Limit(limit).
+		Find(&orders).Error; err != nil {
+		return 0, err
+	}
+	if len(orders) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	archived := make([]*ArchivedOrder, 0, len(orders))
+	items := make([]*ArchivedOrderItem, 0, len(orders))
+	orderIDs := make([]string, 0, len(orders))
+	for i := range orders {
+		archived = append(archived, newArchivedOrder(&orders[i], now))
+		orderIDs = append(orderIDs, orders[i].OrderId)
+		for _, it := range orders[i].Items {
+			items = append(items, &ArchivedOrderItem{
+				OrderId:  it.OrderId,
+				SkuId:    it.SkuId,
+				SkuName:  it.SkuName,
+				Price:    it.Price,
+				Quantity: it.Quantity,
+			})
+		}
+	}
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 已归档的订单（上次归档中断）不重复写入订单项
+		var existing []string
+		if err := tx.Model(&ArchivedOrder{}).Where("order_id IN ?", orderIDs).Pluck("order_id", &existing).Error; err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			skip := make(map[string]struct{}, len(existing))
+			for _, id := range existing {
+				skip[id] = struct{}{}
+			}
+			archived = filterArchivedOrders(archived, skip)
+			items = filterArchivedItems(items, skip)
+		}
+
+		if len(archived) > 0 {
+			if err := tx.Omit(clause.Associations).CreateInBatches(archived, len(archived)).Error; err != nil {
+				return err
+			}
+		}
+		if len(items) > 0 {
+			if err := tx.CreateInBatches(items, len(items)).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("order_id IN ?", orderIDs).Delete(&OrderItem{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("order_id IN ?", orderIDs).Delete(&Order{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(orders), nil
+}
+
+func filterArchivedOrders(orders []*ArchivedOrder, skip map[string]struct{}) []*ArchivedOrder {
+	out := orders[:0]
+	for _, o := range orders {
+		if _, ok := skip[o.OrderId]; !ok {
+			out = append(out, o)
+		}
+	}
+	return out
+}
+
+func filterArchivedItems(items []*ArchivedOrderItem, skip map[string]struct{}) []*ArchivedOrderItem {
+	out := items[:0]
+	for _, it := range items {
+		if _, ok := skip[it.OrderId]; !ok {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// ListArchivedOrders 分页查询用户归档订单，limit <= 0 时不分页
+func ListArchivedOrders(ctx context.Context, db *gorm.DB, userID uint64, offset, limit int) ([]ArchivedOrder, error) {
+	var orders []ArchivedOrder
+	query := db.WithContext(ctx).Preload("Items").
+		Where("user_id = ? AND is_deleted = ?", userID, false).
+		Order("created_at desc, order_id desc")
+	if limit > 0 {
+		query = query.Offset(offset).Limit(limit)
+	}
+	if err := query.Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+// ListUserOrders 按创建时间倒序分页查询用户的在线与归档订单，limit <= 0 时不分页。
+// 只有已支付、已取消的订单会归档，未支付的旧订单留在在线表，可能早于归档订单，
+// 因此两表各取前 offset+limit 条，按创建时间合并后再分页
+func ListUserOrders(ctx context.Context, db *gorm.DB, userID uint64, offset, limit int) ([]*Order, error) {
+	n := 0
+	if limit > 0 {
+		n = offset + limit
+	}
+	var hot []Order
+	query := db.WithContext(ctx).Preload("Items").
+		Where("user_id = ?", userID).
+		Order("created_at desc, order_id desc")
+	if n > 0 {
+		query = query.Limit(n)
+	}
+	if err := query.Find(&hot).Error; err != nil {
+		return nil, err
+	}
+	archived, err := ListArchivedOrders(ctx, db, userID, 0, n)
+	if err != nil {
+		return nil, err
+	}
+	return mergeOrders(hot, archived, offset, limit), nil
+}
+
+// mergeOrders 合并在线与归档订单，按创建时间倒序排列后返回 [offset, offset+limit) 段，limit <= 0 时返回全部
+func mergeOrders(hot []Order, archived []ArchivedOrder, offset, limit int) []*Order {
+	merged := make([]*Order, 0, len(hot)+len(archived))
+	for i := range hot {
+		merged = append(merged, &hot[i])
+	}
+	for i := range archived {
+		merged = append(merged, archived[i].ToOrder())
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if !merged[i].CreatedAt.Equal(merged[j].CreatedAt) {
+			return merged[i].CreatedAt.After(merged[j].CreatedAt)
+		}
+		return merged[i].OrderId > merged[j].OrderId
+	})
+	if limit <= 0 {
+		return merged
+	}
+	if offset >= len(merged) {
+		return nil
+	}
+	return merged[offset:min(offset+limit, len(merged))]
+}
+
+// GetArchivedOrder 查询归档订单
+func GetArchivedOrder(ctx context.Context, db *gorm.DB, orderID string, userID uint64) (*ArchivedOrder, error) {
+	var order ArchivedOrder
+	if err := db.WithContext(ctx).Preload("Items").
+		Where("order_id = ? AND user_id = ? AND is_deleted = ?", orderID, userID, false).
+		First(&order).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
@@ -0,0 +1,87 @@
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestArchivedOrderRoundTrip 测试在线订单与归档订单互相转换
+func TestArchivedOrderRoundTrip(t *testing.T) {
+	created := time.Now().Add(-200 * 24 * time.Hour)
+	o := &Order{
+		Model:   Model{ID: 1001, CreatedAt: created},
+		OrderId: "1001",
+		UserId:  7,
+		Status:  OrderStatePaid,
+		ShippingAddress: Address{
+			Name: "Test User",
+			City: "Test City",
+		},
+	}
+
+	archived := newArchivedOrder(o, time.Now())
+	archived.Items = []ArchivedOrderItem{{OrderId: "1001", SkuId: 1, Price: 9.9, Quantity: 2}}
+
+	back := archived.ToOrder()
+	if back.ID != o.ID || back.OrderId != o.OrderId || back.UserId != o.UserId || back.Status != o.Status {
+		t.Errorf("unexpected order after round trip: %+v", back)
+	}
+	if !back.CreatedAt.Equal(created) || back.ShippingAddress != o.ShippingAddress {
+		t.Errorf("created_at or address not preserved: %+v", back)
+	}
+	if len(back.Items) != 1 || back.Items[0].SkuId != 1 || back.Items[0].Quantity != 2 {
+		t.Errorf("unexpected items: %+v", back.Items)
+	}
+}
+
+// TestFilterArchived 测试跳过已归档订单
+func TestFilterArchived(t *testing.T) {
+	skip := map[string]struct{}{"2": {}}
+	orders := filterArchivedOrders([]*ArchivedOrder{{OrderId: "1"}, {OrderId: "2"}, {OrderId: "3"}}, skip)
+	if len(orders) != 2 || orders[0].OrderId != "1" || orders[1].OrderId != "3" {
+		t.Errorf("unexpected orders: %+v", orders)
+	}
+	items := filterArchivedItems([]*ArchivedOrderItem{{OrderId: "2"}, {OrderId: "3"}}, skip)
+	if len(items) != 1 || items[0].OrderId != "3" {
+		t.Errorf("unexpected items: %+v", items)
+	}
+}
+
+// TestMergeOrders 测试未支付的旧订单留在在线表时，分页仍按创建时间排列
+func TestMergeOrders(t *testing.T) {
+	now := time.Now()
+	day := 24 * time.Hour
+	hot := []Order{
+		{Model: Model{CreatedAt: now}, OrderId: "new"},
+		{Model: Model{CreatedAt: now.Add(-300 * day)}, OrderId: "old-placed", Status: OrderStatePlaced},
+	}
+	archived := []ArchivedOrder{
+		{OrderId: "archived-1", CreatedAt: now.Add(-200 * day)},
+		{OrderId: "archived-2", CreatedAt: now.Add(-250 * day)},
+	}
+	want := []string{"new", "archived-1", "archived-2", "old-placed"}
+
+	ids := func(orders []*Order) []string {
+		out := make([]string, 0, len(orders))
+		for _, o := range orders {
+			out = append(out, o.OrderId)
+		}
+		return out
+	}
+	if got := ids(mergeOrders(hot, archived, 0, 0)); !slices.Equal(got, want) {
+		t.Errorf("all orders: expected %v, got %v", want, got)
+	}
+	for _, pageSize := range []int{1, 2, 3} {
+		var got []string
+		for offset := 0; offset < len(want)+pageSize; offset += pageSize {
+			// ListUserOrders 每张表只取前 offset+pageSize 条
+			n := offset + pageSize
+			page := mergeOrders(hot[:min(n, len(hot))], archived[:min(n, len(archived))], offset, pageSize)
+			got = append(got, ids(page)...)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("page size %d: expected %v, got %v", pageSize, want, got)
+		}
+	}
+}
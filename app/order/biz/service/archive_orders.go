@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/PiaoAdmin/pmall/app/order/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	defaultArchiveAfterDays = 180
+	defaultArchiveInterval  = 60 * time.Minute
+	defaultArchiveBatchSize = 200
+)
+
+// OrderArchiveService 订单归档服务
+type OrderArchiveService struct {
+	ctx context.Context
+}
+
+func NewOrderArchiveService(ctx context.Context) *OrderArchiveService {
+	return &OrderArchiveService{ctx: ctx}
+}
+
+// Run 将所有分片中超过归档期限的已支付、已取消订单迁移到归档表，返回迁移总数
+func (s *OrderArchiveService) Run() (int, error) {
+	cfg := conf.GetConf().Archive
+	afterDays := cfg.AfterDays
+	if afterDays <= 0 {
+		afterDays = defaultArchiveAfterDays
+	}
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultArchiveBatchSize
+	}
+	cutoff := time.Now().AddDate(0, 0, -afterDays)
+
+	total := 0
+	for i, db := range mysql.Shards {
+		for {
+			select {
+			case <-s.ctx.Done():
+				return total, s.ctx.Err()
+			default:
+			}
+
+			n, err := model.ArchiveOrders(s.ctx, db, cutoff, batchSize)
+			if err != nil {
+				klog.Errorf("Archive orders on shard %d failed: %v", i, err)
+				return total, err
+			}
+			total += n
+			if n < batchSize {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+// StartOrderArchiveTask 启动定时归档任务
+func StartOrderArchiveTask(ctx context.Context) {
+	cfg := conf.GetConf().Archive
+	if !cfg.Enabled {
+		klog.Info("Order archive task disabled")
+		return
+	}
+	interval := defaultArchiveInterval
+	if cfg.IntervalMinutes > 0 {
+		interval = time.Duration(cfg.IntervalMinutes) * time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				klog.Info("Order archive task stopped")
+				return
+			case <-ticker.C:
+				n, err := NewOrderArchiveService(ctx).Run()
+				if err != nil {
+					klog.Errorf("Periodic order archive failed after archiving %d orders: %v", n, err)
+					continue
+				}
+				klog.Infof("Periodic order archive completed, archived %d orders", n)
+			}
+		}
+	}()
+	klog.Infof("Order archive task started, interval=%v", interval)
+}
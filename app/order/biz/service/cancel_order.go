@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/order/biz/model"
 	"github.com/PiaoAdmin/pmall/app/order/biz/rpc"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	order "github.com/PiaoAdmin/pmall/rpc_gen/order"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
 )
 
 type CancelOrderService struct {
@@ -25,7 +27,12 @@ func (s *CancelOrderService) Run(req *order.CancelOrderReq) (*order.CancelOrderR
 	}
 
 	var ord model.Order
-	if err := mysql.DB.Preload("Items").Where("order_id = ?", req.OrderId).First(&ord).Error; err != nil {
+	db, err := mysql.FindOrder(s.ctx, req.OrderId, &ord, "Items")
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// 订单可能尚未落库，尝试取消待落库订单
+			return s.cancelPendingOrder(req.OrderId)
+		}
 		return nil, errs.New(errs.ErrRecordNotFound.Code, err.Error())
 	}
 
@@ -36,30 +43,79 @@ func (s *CancelOrderService) Run(req *order.CancelOrderReq) (*order.CancelOrderR
 		return nil, errs.New(errs.ErrParam.Code, "order already paid")
 	}
 
-	if len(ord.Items) > 0 {
-		releaseItems := make([]*product.SkuDeductItem, 0, len(ord.Items))
-		for _, it := range ord.Items {
-			if it.SkuId == 0 || it.Quantity <= 0 {
-				continue
-			}
-			releaseItems = append(releaseItems, &product.SkuDeductItem{
-				SkuId: it.SkuId,
-				Count: it.Quantity,
-			})
-		}
-		if len(releaseItems) > 0 {
-			if _, err := rpc.ProductClient.ReleaseStock(s.ctx, &product.ReleaseStockRequest{
-				OrderSn: req.OrderId,
-				Items:   releaseItems,
-			}); err != nil {
-				return nil, errs.New(errs.ErrInternal.Code, "release stock failed: "+err.Error())
-			}
-		}
+	releaseItems := make([]*product.SkuDeductItem, 0, len(ord.Items))
+	for _, it := range ord.Items {
+		releaseItems = append(releaseItems, &product.SkuDeductItem{
+			SkuId: it.SkuId,
+			Count: it.Quantity,
+		})
+	}
+	if err := s.releaseStock(req.OrderId, releaseItems); err != nil {
+		return nil, err
 	}
 
-	if err := mysql.DB.Model(&model.Order{}).Where("order_id = ?", req.OrderId).Update("status", model.OrderStateCanceled).Error; err != nil {
+	if err := db.Model(&model.Order{}).Where("order_id = ?", req.OrderId).Update("status", model.OrderStateCanceled).Error; err != nil {
 		return nil, errs.New(errs.ErrInternal.Code, "cancel order failed: "+err.Error())
 	}
 
 	return &order.CancelOrderResp{Success: true}, nil
 }
+
+// cancelPendingOrder 取消尚未落库的订单：在待落库视图中标记取消，消费者落库时直接写入取消状态
+func (s *CancelOrderService) cancelPendingOrder(orderID string) (*order.CancelOrderResp, error) {
+	pending, err := redis.GetPendingOrder(s.ctx, orderID)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get pending order failed: "+err.Error())
+	}
+	if pending == nil {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "order not found")
+	}
+
+	result, err := redis.CancelPendingOrder(s.ctx, orderID)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "cancel pending order failed: "+err.Error())
+	}
+	switch result {
+	case redis.CancelPendingAlreadyCanceled:
+		return &order.CancelOrderResp{Success: true}, nil
+	case redis.CancelPendingClaimed:
+		return nil, errs.New(errs.ErrParam.Code, "order is being processed, please retry later")
+	case redis.CancelPendingNotFound:
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "order not found")
+	}
+
+	releaseItems := make([]*product.SkuDeductItem, 0, len(pending.Items))
+	for _, it := range pending.Items {
+		releaseItems = append(releaseItems, &product.SkuDeductItem{
+			SkuId: it.SkuID,
+			Count: it.Quantity,
+		})
+	}
+	if err := s.releaseStock(orderID, releaseItems); err != nil {
+		return nil, err
+	}
+
+	return &order.CancelOrderResp{Success: true}, nil
+}
+
+// releaseStock 释放订单占用的库存
+func (s *CancelOrderService) releaseStock(orderID string, items []*product.SkuDeductItem) error {
+	releaseItems := make([]*product.SkuDeductItem, 0, len(items))
+	for _, it := range items {
+		if it.SkuId == 0 || it.Count <= 0 {
+			continue
+		}
+		releaseItems = append(releaseItems, it)
+	}
+	if len(releaseItems) == 0 {
+		return nil
+	}
+
+	if _, err := rpc.ProductClient.ReleaseStock(s.ctx, &product.ReleaseStockRequest{
+		OrderSn: orderID,
+		Items:   releaseItems,
+	}); err != nil {
+		return errs.New(errs.ErrInternal.Code, "release stock failed: "+err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	order "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultExportLimit = 200
+	maxExportLimit     = 1000
+
+	exportPhaseOrders  = 0 // 在线订单
+	exportPhaseArchive = 1 // 归档订单
+)
+
+// exportCursor 导出游标：分片号、阶段（在线/归档）与该阶段已导出的最大 ID
+type exportCursor struct {
+	Shard  int
+	Phase  int
+	LastID uint64
+}
+
+func (c exportCursor) String() string {
+	return fmt.Sprintf("%d:%d:%d", c.Shard, c.Phase, c.LastID)
+}
+
+func parseExportCursor(s string) (exportCursor, error) {
+	var c exportCursor
+	if s == "" {
+		return c, nil
+	}
+	if _, err := fmt.Sscanf(s, "%d:%d:%d", &c.Shard, &c.Phase, &c.LastID); err != nil {
+		return c, err
+	}
+	if c.Shard < 0 || c.Phase < exportPhaseOrders || c.Phase > exportPhaseArchive {
+		return c, fmt.Errorf("invalid cursor %q", s)
+	}
+	return c, nil
+}
+
+type ExportOrdersService struct {
+	ctx context.Context
+}
+
+func NewExportOrdersService(ctx context.Context) *ExportOrdersService {
+	return &ExportOrdersService{ctx: ctx}
+}
+
+func (s *ExportOrdersService) Run(req *order.ExportOrdersReq) (*order.ExportOrdersResp, error) {
+	if req == nil {
+		return nil, errs.New(errs.ErrParam.Code, "invalid request")
+	}
+	if req.StartTime > 0 && req.EndTime > 0 && req.StartTime > req.EndTime {
+		return nil, errs.New(errs.ErrParam.Code, "start_time after end_time")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultExportLimit
+	}
+	if limit > maxExportLimit {
+		limit = maxExportLimit
+	}
+	cursor, err := parseExportCursor(req.Cursor)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, "invalid cursor")
+	}
+
+	// 指定用户时只扫描其所在分片
+	shards := mysql.Shards
+	if req.UserId != 0 {
+		shards = []*gorm.DB{mysql.ShardByUserID(req.UserId)}
+	}
+
+	resp := &order.ExportOrdersResp{Orders: make([]*order.Order, 0, limit)}
+	for cursor.Shard < len(shards) {
+		db := shards[cursor.Shard]
+		remain := limit - len(resp.Orders)
+		orders, lastID, err := s.fetch(db, req, cursor, remain)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "export orders failed: "+err.Error())
+		}
+		resp.Orders = append(resp.Orders, orders...)
+
+		if len(orders) == remain {
+			// 本批已满，下一批从当前位置继续
+			cursor.LastID = lastID
+			resp.NextCursor = cursor.String()
+			return resp, nil
+		}
+
+		// 当前阶段已导出完毕，进入下一阶段或下一个分片
+		if cursor.Phase == exportPhaseOrders {
+			cursor = exportCursor{Shard: cursor.Shard, Phase: exportPhaseArchive}
+		} else {
+			cursor = exportCursor{Shard: cursor.Shard + 1, Phase: exportPhaseOrders}
+		}
+	}
+	return resp, nil
+}
+
+// fetch 按 ID 升序读取当前阶段的一批订单
+func (s *ExportOrdersService) fetch(db *gorm.DB, req *order.ExportOrdersReq, cursor exportCursor, limit int) ([]*order.Order, uint64, error) {
+	query := db.WithContext(s.ctx).Preload("Items").Where("id > ?", cursor.LastID)
+	if req.UserId != 0 {
+		query = query.Where("user_id = ?", req.UserId)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+	if req.StartTime > 0 {
+		query = query.Where("created_at >= ?", time.Unix(req.StartTime, 0))
+	}
+	if req.EndTime > 0 {
+		query = query.Where("created_at <= ?", time.Unix(req.EndTime, 0))
+	}
+	query = query.Order("id asc").Limit(limit)
+
+	out := make([]*order.Order, 0, limit)
+	var lastID uint64
+	if cursor.Phase == exportPhaseOrders {
+		var orders []model.Order
+		if err := query.Find(&orders).Error; err != nil {
+			return nil, 0, err
+		}
+		for i := range orders {
+			out = append(out, toProtoOrder(&orders[i]))
+			lastID = orders[i].ID
+		}
+		return out, lastID, nil
+	}
+
+	var archived []model.ArchivedOrder
+	if err := query.Where("is_deleted = ?", false).Find(&archived).Error; err != nil {
+		return nil, 0, err
+	}
+	for i := range archived {
+		out = append(out, toProtoOrder(archived[i].ToOrder()))
+		lastID = archived[i].ID
+	}
+	return out, lastID, nil
+}
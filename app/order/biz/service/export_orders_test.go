@@ -0,0 +1,23 @@
+package service
+
+import "testing"
+
+// TestExportCursor 测试导出游标编解码
+func TestExportCursor(t *testing.T) {
+	c, err := parseExportCursor("")
+	if err != nil || c != (exportCursor{}) {
+		t.Fatalf("expected zero cursor, got %+v, %v", c, err)
+	}
+
+	want := exportCursor{Shard: 2, Phase: exportPhaseArchive, LastID: 123456789}
+	got, err := parseExportCursor(want.String())
+	if err != nil || got != want {
+		t.Fatalf("expected %+v, got %+v, %v", want, got, err)
+	}
+
+	for _, s := range []string{"abc", "1:5:0", "-1:0:0"} {
+		if _, err := parseExportCursor(s); err == nil {
+			t.Errorf("expected error for cursor %q", s)
+		}
+	}
+}
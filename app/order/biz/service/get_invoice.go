@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/blob"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/invoice"
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/PiaoAdmin/pmall/app/order/conf"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	order "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	InvoiceFormatPDF  = "pdf"
+	InvoiceFormatHTML = "html"
+
+	defaultInvoicePrefix = "INV"
+)
+
+type GetInvoiceService struct {
+	ctx context.Context
+}
+
+func NewGetInvoiceService(ctx context.Context) *GetInvoiceService {
+	return &GetInvoiceService{ctx: ctx}
+}
+
+// Run 返回订单发票文件。首次请求时开具发票并快照订单，文件按快照渲染后写入文件存储，之后直接读取。
+func (s *GetInvoiceService) Run(req *order.GetInvoiceReq) (*order.GetInvoiceResp, error) {
+	if req == nil || req.UserId == 0 || req.OrderId == "" {
+		return nil, errs.New(errs.ErrParam.Code, "user_id or order_id empty")
+	}
+	format := strings.ToLower(req.Format)
+	if format == "" {
+		format = InvoiceFormatPDF
+	}
+	if format != InvoiceFormatPDF && format != InvoiceFormatHTML {
+		return nil, errs.New(errs.ErrParam.Code, "unsupported invoice format: "+req.Format)
+	}
+
+	inv, err := model.GetInvoiceByOrderID(s.ctx, mysql.GlobalDB(), req.OrderId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get invoice failed: "+err.Error())
+	}
+	if inv != nil && inv.UserId != req.UserId {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "order not found")
+	}
+	if inv == nil {
+		if inv, err = s.issue(req); err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := s.load(inv, format)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := "application/pdf"
+	if format == InvoiceFormatHTML {
+		contentType = "text/html; charset=utf-8"
+	}
+	return &order.GetInvoiceResp{
+		InvoiceNo:   inv.InvoiceNo,
+		FileName:    inv.InvoiceNo + "." + format,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
+
+// issue 为已支付订单开具发票
+func (s *GetInvoiceService) issue(req *order.GetInvoiceReq) (*model.Invoice, error) {
+	orderResp, err := NewGetOrderService(s.ctx).Run(&order.GetOrderReq{UserId: req.UserId, OrderId: req.OrderId})
+	if err != nil {
+		return nil, err
+	}
+	o := orderResp.Order
+	if o.Status != model.OrderStatePaid {
+		return nil, errs.New(errs.ErrParam.Code, "only paid orders can be invoiced")
+	}
+
+	cfg := conf.GetConf().Invoice
+	doc := &invoice.Document{TaxRate: cfg.TaxRate}
+	for _, it := range o.Items {
+		price, _ := strconv.ParseFloat(it.Price, 64)
+		doc.Lines = append(doc.Lines, invoice.NewLine(it.SkuId, it.SkuName, it.Quantity, price))
+	}
+	doc.Compute()
+
+	items, err := json.Marshal(doc.Lines)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "marshal invoice items failed: "+err.Error())
+	}
+	inv := &model.Invoice{
+		OrderId:       o.OrderId,
+		UserId:        o.UserId,
+		SellerName:    cfg.SellerName,
+		SellerTaxID:   cfg.SellerTaxID,
+		SellerAddress: cfg.SellerAddress,
+		BuyerEmail:    o.Email,
+		Items:         string(items),
+		TaxRate:       doc.TaxRate,
+		NetAmount:     doc.Net,
+		TaxAmount:     doc.Tax,
+		TotalAmount:   doc.Total,
+		IssuedAt:      time.Now(),
+	}
+	if addr := o.ShippingAddress; addr != nil {
+		inv.BuyerName = addr.Name
+		inv.BuyerAddress = formatAddress(addr)
+	}
+
+	prefix := cfg.NumberPrefix
+	if prefix == "" {
+		prefix = defaultInvoicePrefix
+	}
+	inv, err = model.CreateInvoice(s.ctx, mysql.GlobalDB(), inv, prefix)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create invoice failed: "+err.Error())
+	}
+	klog.CtxInfof(s.ctx, "Invoice %s issued for order %s", inv.InvoiceNo, inv.OrderId)
+	return inv, nil
+}
+
+// load 读取发票文件，不存在时按快照渲染并写入存储
+func (s *GetInvoiceService) load(inv *model.Invoice, format string) ([]byte, error) {
+	key := "invoices/" + inv.InvoiceNo + "." + format
+	content, err := blob.Default.Get(s.ctx, key)
+	if err == nil {
+		return content, nil
+	}
+	if err != blob.ErrNotFound {
+		return nil, errs.New(errs.ErrInternal.Code, "read invoice file failed: "+err.Error())
+	}
+
+	doc, err := invoiceDocument(inv)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "decode invoice failed: "+err.Error())
+	}
+	if format == InvoiceFormatHTML {
+		content, err = invoice.RenderHTML(doc)
+	} else {
+		content, err = invoice.RenderPDF(doc)
+	}
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "render invoice failed: "+err.Error())
+	}
+	if err := blob.Default.Put(s.ctx, key, content); err != nil {
+		// 存储失败不影响本次返回，下次请求重新渲染
+		klog.CtxWarnf(s.ctx, "Store invoice file %s failed: %v", key, err)
+	}
+	return content, nil
+}
+
+// invoiceDocument 由发票快照还原单据
+func invoiceDocument(inv *model.Invoice) (*invoice.Document, error) {
+	var lines []invoice.Line
+	if inv.Items != "" {
+		if err := json.Unmarshal([]byte(inv.Items), &lines); err != nil {
+			return nil, err
+		}
+	}
+	return &invoice.Document{
+		No:       inv.InvoiceNo,
+		OrderID:  inv.OrderId,
+		IssuedAt: inv.IssuedAt,
+		Seller:   invoice.Party{Name: inv.SellerName, TaxID: inv.SellerTaxID, Address: inv.SellerAddress},
+		Buyer:    invoice.Party{Name: inv.BuyerName, Email: inv.BuyerEmail, Address: inv.BuyerAddress},
+		Lines:    lines,
+		TaxRate:  inv.TaxRate,
+		Net:      inv.NetAmount,
+		Tax:      inv.TaxAmount,
+		Total:    inv.TotalAmount,
+	}, nil
+}
+
+func formatAddress(addr *order.Address) string {
+	parts := make([]string, 0, 3)
+	for _, p := range []string{addr.City, addr.StreetAddress} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if addr.ZipCode != 0 {
+		parts = append(parts, strconv.Itoa(int(addr.ZipCode)))
+	}
+	return strings.Join(parts, " ")
+}
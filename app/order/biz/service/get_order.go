@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/order/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	order "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"gorm.io/gorm"
+)
+
+type GetOrderService struct {
+	ctx context.Context
+}
+
+func NewGetOrderService(ctx context.Context) *GetOrderService {
+	return &GetOrderService{ctx: ctx}
+}
+
+func (s *GetOrderService) Run(req *order.GetOrderReq) (*order.GetOrderResp, error) {
+	if req == nil || req.UserId == 0 || req.OrderId == "" {
+		return nil, errs.New(errs.ErrParam.Code, "user_id or order_id empty")
+	}
+
+	db := mysql.ShardByUserID(req.UserId)
+	var ord model.Order
+	err := db.Preload("Items").Where("order_id = ? AND user_id = ?", req.OrderId, req.UserId).First(&ord).Error
+	if err == nil {
+		return &order.GetOrderResp{Order: toProtoOrder(&ord)}, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, errs.New(errs.ErrInternal.Code, "get order failed: "+err.Error())
+	}
+
+	// 订单可能尚未落库，查询待落库视图
+	pending, err := redis.GetPendingOrder(s.ctx, req.OrderId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get pending order failed: "+err.Error())
+	}
+	if pending != nil && pending.UserID == req.UserId {
+		return &order.GetOrderResp{Order: pendingToProtoOrder(pending)}, nil
+	}
+
+	// 历史订单可能已归档
+	archived, err := model.GetArchivedOrder(s.ctx, db, req.OrderId, req.UserId)
+	if err == nil {
+		return &order.GetOrderResp{Order: toProtoOrder(archived.ToOrder())}, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, errs.New(errs.ErrInternal.Code, "get archived order failed: "+err.Error())
+	}
+	return nil, errs.New(errs.ErrRecordNotFound.Code, "order not found")
+}
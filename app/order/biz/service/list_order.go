@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/order/biz/model"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	order "github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type ListOrderService struct {
@@ -23,32 +26,104 @@ func (s *ListOrderService) Run(req *order.ListOrderReq) (*order.ListOrderResp, e
 		return nil, errs.New(errs.ErrParam.Code, "user_id empty")
 	}
 
-	var orders []model.Order
-	if err := mysql.DB.Preload("Items").Where("user_id = ?", req.UserId).Order("created_at desc").Find(&orders).Error; err != nil {
+	db := mysql.ShardByUserID(req.UserId)
+	pageSize := int(req.PageSize)
+	page := int(req.Page)
+	if page <= 0 {
+		page = 1
+	}
+	offset := (page - 1) * pageSize
+
+	// 在线与归档订单按创建时间合并分页
+	orders, err := model.ListUserOrders(s.ctx, db, req.UserId, offset, pageSize)
+	if err != nil {
 		return nil, errs.New(errs.ErrInternal.Code, "list orders failed: "+err.Error())
 	}
 
 	protoOrders := make([]*order.Order, 0, len(orders))
+	persisted := make(map[string]struct{}, len(orders))
 	for _, o := range orders {
-		po := &order.Order{
-			OrderId:   o.OrderId,
-			UserId:    o.UserId,
-			Email:     o.Email,
-			Status:    o.Status,
-			CreatedAt: int32(o.CreatedAt.Unix()),
-		}
-		items := make([]*order.CartItem, 0, len(o.Items))
-		for _, it := range o.Items {
-			items = append(items, &order.CartItem{
-				SkuId:    it.SkuId,
-				Quantity: it.Quantity,
-				SkuName:  it.SkuName,
-				Price:    fmt.Sprintf("%.2f", it.Price),
-			})
+		protoOrders = append(protoOrders, toProtoOrder(o))
+		persisted[o.OrderId] = struct{}{}
+	}
+
+	// 第一页合并尚未落库的订单
+	if page > 1 {
+		return &order.ListOrderResp{Orders: protoOrders}, nil
+	}
+	pendingOrders, err := redis.ListPendingOrders(s.ctx, req.UserId)
+	if err != nil {
+		klog.CtxWarnf(s.ctx, "List pending orders failed: %v", err)
+	}
+	merged := false
+	for _, p := range pendingOrders {
+		if _, ok := persisted[p.OrderID]; ok {
+			continue
 		}
-		po.Items = items
-		protoOrders = append(protoOrders, po)
+		protoOrders = append(protoOrders, pendingToProtoOrder(p))
+		merged = true
+	}
+	if merged {
+		sort.SliceStable(protoOrders, func(i, j int) bool {
+			return protoOrders[i].CreatedAt > protoOrders[j].CreatedAt
+		})
 	}
 
 	return &order.ListOrderResp{Orders: protoOrders}, nil
 }
+
+// toProtoOrder 将已落库订单转换为 RPC 订单
+func toProtoOrder(o *model.Order) *order.Order {
+	po := &order.Order{
+		OrderId: o.OrderId,
+		UserId:  o.UserId,
+		Email:   o.Email,
+		Status:  o.Status,
+		ShippingAddress: &order.Address{
+			Name:          o.ShippingAddress.Name,
+			StreetAddress: o.ShippingAddress.StreetAddress,
+			City:          o.ShippingAddress.City,
+			ZipCode:       o.ShippingAddress.ZipCode,
+		},
+		CreatedAt: int32(o.CreatedAt.Unix()),
+	}
+	items := make([]*order.CartItem, 0, len(o.Items))
+	for _, it := range o.Items {
+		items = append(items, &order.CartItem{
+			SkuId:    it.SkuId,
+			Quantity: it.Quantity,
+			SkuName:  it.SkuName,
+			Price:    fmt.Sprintf("%.2f", it.Price),
+		})
+	}
+	po.Items = items
+	return po
+}
+
+// pendingToProtoOrder 将待落库订单转换为 RPC 订单，状态为 processing 或 canceled
+func pendingToProtoOrder(p *redis.PendingOrder) *order.Order {
+	po := &order.Order{
+		OrderId: p.OrderID,
+		UserId:  p.UserID,
+		Email:   p.Email,
+		Status:  p.Status,
+		ShippingAddress: &order.Address{
+			Name:          p.Address.Name,
+			StreetAddress: p.Address.StreetAddress,
+			City:          p.Address.City,
+			ZipCode:       p.Address.ZipCode,
+		},
+		CreatedAt: int32(p.CreatedAt),
+	}
+	items := make([]*order.CartItem, 0, len(p.Items))
+	for _, it := range p.Items {
+		items = append(items, &order.CartItem{
+			SkuId:    it.SkuID,
+			Quantity: it.Quantity,
+			SkuName:  it.SkuName,
+			Price:    fmt.Sprintf("%.2f", it.Price),
+		})
+	}
+	po.Items = items
+	return po
+}
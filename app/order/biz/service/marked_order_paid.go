@@ -23,7 +23,8 @@ func (s *MarkOrderPaidService) Run(req *order.MarkOrderPaidReq) (*order.MarkOrde
 	}
 
 	var ord model.Order
-	if err := mysql.DB.Where("order_id = ?", req.OrderId).First(&ord).Error; err != nil {
+	db, err := mysql.FindOrder(s.ctx, req.OrderId, &ord)
+	if err != nil {
 		return nil, errs.New(errs.ErrRecordNotFound.Code, err.Error())
 	}
 
@@ -31,7 +32,7 @@ func (s *MarkOrderPaidService) Run(req *order.MarkOrderPaidReq) (*order.MarkOrde
 		return nil, errs.New(errs.ErrParam.Code, "order already canceled")
 	}
 
-	if err := mysql.DB.Model(&model.Order{}).Where("order_id = ?", req.OrderId).Update("status", model.OrderStatePaid).Error; err != nil {
+	if err := db.Model(&model.Order{}).Where("order_id = ?", req.OrderId).Update("status", model.OrderStatePaid).Error; err != nil {
 		return nil, errs.New(errs.ErrInternal.Code, "mark paid failed: "+err.Error())
 	}
 
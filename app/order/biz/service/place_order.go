@@ -2,14 +2,14 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"strconv"
 	"time"
 
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/rabbitmq"
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/order/biz/rpc"
 	"github.com/PiaoAdmin/pmall/common/errs"
-	"github.com/PiaoAdmin/pmall/common/uniqueid"
 	order "github.com/PiaoAdmin/pmall/rpc_gen/order"
 	"github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -31,7 +31,8 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (*order.PlaceOrderResp
 		return nil, errs.New(errs.ErrParam.Code, "items empty")
 	}
 
-	newOrderId := fmt.Sprintf("%d", uniqueid.GenId())
+	// 订单号编码了用户所属分片槽位
+	newOrderId := mysql.GenOrderID(req.UserId)
 
 	deductItems := make([]*product.SkuDeductItem, 0, len(req.Items))
 	for _, it := range req.Items {
@@ -87,9 +88,17 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (*order.PlaceOrderResp
 		})
 	}
 
-	// 4. 异步发送消息到 RabbitMQ（订单数据库写入将由消费者完成）
+	// 4. 写入待落库视图，保证订单落库前也能被查询和取消
+	if err := redis.SetPendingOrder(s.ctx, toPendingOrder(orderMsg)); err != nil {
+		klog.CtxWarnf(s.ctx, "Failed to set pending order %s: %v", newOrderId, err)
+	}
+
+	// 5. 异步发送消息到 RabbitMQ（订单数据库写入将由消费者完成）
 	if err := rabbitmq.PublishOrderMessage(s.ctx, orderMsg); err != nil {
 		klog.CtxErrorf(s.ctx, "Failed to publish order message, falling back to sync: %v", err)
+		if delErr := redis.DeletePendingOrder(s.ctx, newOrderId, req.UserId); delErr != nil {
+			klog.CtxWarnf(s.ctx, "Failed to remove pending order %s: %v", newOrderId, delErr)
+		}
 		// 发送失败时回退库存
 		if _, relErr := rpc.ProductClient.ReleaseStock(s.ctx, &product.ReleaseStockRequest{
 			OrderSn: newOrderId,
@@ -100,7 +109,7 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (*order.PlaceOrderResp
 		return nil, errs.New(errs.ErrInternal.Code, "place order failed: "+err.Error())
 	}
 
-	// 5. 发送延迟取消消息（30分钟后未支付则自动取消）
+	// 6. 发送延迟取消消息（30分钟后未支付则自动取消）
 	if err := rabbitmq.PublishOrderCancelDelay(s.ctx, newOrderId, req.UserId); err != nil {
 		// 延迟消息发送失败不影响下单，只记录日志
 		klog.CtxWarnf(s.ctx, "Failed to publish cancel delay message: %v", err)
@@ -112,3 +121,29 @@ func (s *PlaceOrderService) Run(req *order.PlaceOrderReq) (*order.PlaceOrderResp
 		Order: &order.OrderResult{OrderId: newOrderId},
 	}, nil
 }
+
+// toPendingOrder 将订单消息转换为待落库视图
+func toPendingOrder(msg *rabbitmq.OrderMessage) *redis.PendingOrder {
+	pending := &redis.PendingOrder{
+		OrderID: msg.OrderID,
+		UserID:  msg.UserID,
+		Email:   msg.Email,
+		Address: redis.PendingAddress{
+			Name:          msg.Address.Name,
+			StreetAddress: msg.Address.StreetAddress,
+			City:          msg.Address.City,
+			ZipCode:       msg.Address.ZipCode,
+		},
+		Items:     make([]*redis.PendingOrderItem, 0, len(msg.Items)),
+		CreatedAt: msg.CreatedAt,
+	}
+	for _, it := range msg.Items {
+		pending.Items = append(pending.Items, &redis.PendingOrderItem{
+			SkuID:    it.SkuID,
+			SkuName:  it.SkuName,
+			Price:    it.Price,
+			Quantity: it.Quantity,
+		})
+	}
+	return pending
+}
@@ -0,0 +1,63 @@
+// reshard 按当前 mysql.shards 配置重新分布订单数据。
+//
+// 在 app/order 目录下执行：
+//
+//	GO_ENV=dev go run ./cmd/reshard -dry-run
+//	GO_ENV=dev go run ./cmd/reshard -source "<旧库DSN>"
+//
+// 扩容时将新分片追加到 shards 末尾后执行；缩容或从单库迁移时，
+// 用 -source 指定不再出现在 shards 中的旧库，其数据会被迁出。
+package main
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"github.com/PiaoAdmin/pmall/app/order/biz/dal/mysql"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+func main() {
+	sources := flag.String("source", "", "额外的源库 DSN，多个用分号分隔")
+	batchSize := flag.Int("batch", mysql.DefaultReshardBatchSize, "每批扫描的订单数")
+	dryRun := flag.Bool("dry-run", false, "只统计需要迁移的订单，不写入")
+	flag.Parse()
+
+	mysql.Init()
+
+	shardDSNs := make(map[string]struct{})
+	for _, dsn := range mysql.ShardDSNs() {
+		shardDSNs[dsn] = struct{}{}
+	}
+
+	var extra []*gorm.DB
+	for _, dsn := range strings.Split(*sources, ";") {
+		dsn = strings.TrimSpace(dsn)
+		if dsn == "" {
+			continue
+		}
+		// 源库同时是分片时会被当作目标，跳过以免误删
+		if _, ok := shardDSNs[dsn]; ok {
+			klog.Warnf("Source %s is already a shard, skipped", dsn)
+			continue
+		}
+		db, err := mysql.Open(dsn)
+		if err != nil {
+			klog.Fatalf("Open source failed: %v", err)
+		}
+		extra = append(extra, db)
+	}
+
+	stats, err := mysql.Reshard(context.Background(), mysql.ReshardOptions{
+		Sources:   extra,
+		BatchSize: *batchSize,
+		DryRun:    *dryRun,
+	})
+	if err != nil {
+		klog.Fatalf("Reshard failed after scanning %d orders: %v", stats.Scanned, err)
+	}
+	klog.Infof("Reshard finished: scanned=%d moved=%d skipped=%d dry_run=%v",
+		stats.Scanned, stats.Moved, stats.Skipped, *dryRun)
+}
@@ -19,12 +19,27 @@ type Config struct {
 	Env      string
 	Kitex    Kitex    `yaml:"kitex"`
 	MySQL    MySQL    `yaml:"mysql"`
+	Redis    Redis    `yaml:"redis"`
 	RabbitMQ RabbitMQ `yaml:"rabbitmq"`
+	Archive  Archive  `yaml:"archive"`
+	Invoice  Invoice  `yaml:"invoice"`
+	Blob     Blob     `yaml:"blob"`
 	Registry Registry `yaml:"registry"`
 }
 
 type MySQL struct {
 	DSN string `yaml:"dsn"`
+	// Shards 按 user_id 路由的分库 DSN 列表，下标即分片号，为空时使用单库 DSN；调整后需执行 cmd/reshard
+	Shards []string `yaml:"shards"`
+	// OrderIDNode 订单号节点号（0-15），多实例部署时每个实例必须不同
+	OrderIDNode int `yaml:"order_id_node"`
+}
+
+type Redis struct {
+	Address  string `yaml:"address"`
+	Password string `yaml:"password"`
+	Username string `yaml:"username"`
+	DB       int    `yaml:"db"`
 }
 
 type RabbitMQ struct {
@@ -33,6 +48,32 @@ type RabbitMQ struct {
 	OrderExchange string `yaml:"order_exchange"`
 	PrefetchCount int    `yaml:"prefetch_count"`
 	WorkerCount   int    `yaml:"worker_count"`
+	// BatchSize 大于 1 时开启批量落库，BatchTimeoutMs 为攒批的最长等待时间
+	BatchSize      int `yaml:"batch_size"`
+	BatchTimeoutMs int `yaml:"batch_timeout_ms"`
+}
+
+// Archive 订单归档任务配置
+type Archive struct {
+	Enabled         bool `yaml:"enabled"`
+	AfterDays       int  `yaml:"after_days"`       // 创建超过多少天的已支付/已取消订单被归档
+	IntervalMinutes int  `yaml:"interval_minutes"` // 归档任务执行间隔
+	BatchSize       int  `yaml:"batch_size"`       // 每批迁移的订单数
+}
+
+// Invoice 发票配置，开票方信息在开具时写入发票快照
+type Invoice struct {
+	NumberPrefix  string  `yaml:"number_prefix"` // 发票号前缀，号码格式为 <前缀>-<年份>-<六位序号>
+	TaxRate       float64 `yaml:"tax_rate"`      // 商品价格为含税价，按该税率拆分税额
+	SellerName    string  `yaml:"seller_name"`
+	SellerTaxID   string  `yaml:"seller_tax_id"`
+	SellerAddress string  `yaml:"seller_address"`
+}
+
+// Blob 生成文件的存储配置
+type Blob struct {
+	Driver   string `yaml:"driver"`    // 存储驱动，目前支持 local
+	LocalDir string `yaml:"local_dir"` // local 驱动的存储目录
 }
 
 type Registry struct {
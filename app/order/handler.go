@@ -29,3 +29,18 @@ func (s *OrderServiceImpl) PlaceOrder(ctx context.Context, req *order.PlaceOrder
 func (s *OrderServiceImpl) MarkOrderPaid(ctx context.Context, req *order.MarkOrderPaidReq) (resp *order.MarkOrderPaidResp, err error) {
 	return service.NewMarkOrderPaidService(ctx).Run(req)
 }
+
+// GetOrder implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) GetOrder(ctx context.Context, req *order.GetOrderReq) (resp *order.GetOrderResp, err error) {
+	return service.NewGetOrderService(ctx).Run(req)
+}
+
+// ExportOrders implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) ExportOrders(ctx context.Context, req *order.ExportOrdersReq) (resp *order.ExportOrdersResp, err error) {
+	return service.NewExportOrdersService(ctx).Run(req)
+}
+
+// GetInvoice implements the OrderServiceImpl interface.
+func (s *OrderServiceImpl) GetInvoice(ctx context.Context, req *order.GetInvoiceReq) (resp *order.GetInvoiceResp, err error) {
+	return service.NewGetInvoiceService(ctx).Run(req)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal"
 	"github.com/PiaoAdmin/pmall/app/order/biz/dal/rabbitmq"
 	"github.com/PiaoAdmin/pmall/app/order/biz/rpc"
+	"github.com/PiaoAdmin/pmall/app/order/biz/service"
 	"github.com/PiaoAdmin/pmall/app/order/conf"
 	order "github.com/PiaoAdmin/pmall/rpc_gen/order/orderservice"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -42,6 +43,9 @@ func main() {
 	// 启动订单取消消费者（处理延迟取消消息）
 	rabbitmq.StartCancelConsumer(ctx)
 
+	// 启动订单归档任务
+	service.StartOrderArchiveTask(ctx)
+
 	// 优雅关闭
 	go func() {
 		sigChan := make(chan os.Signal, 1)
@@ -0,0 +1,75 @@
+// Package daltest 为测试提供进程内的 MySQL 与 Redis，不依赖外部环境。
+// MySQL 由 go-mysql-server 模拟，支持服务中使用的 MySQL 方言；Redis 由 miniredis 模拟。
+package daltest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/alicebob/miniredis/v2"
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+	gmysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const database = "p_product"
+
+// MySQL 启动一个空的内存数据库并建好全部表，替换 mysql.DB，测试结束后恢复
+func MySQL(t testing.TB) *gorm.DB {
+	t.Helper()
+	logrus.SetLevel(logrus.WarnLevel)
+
+	provider := memory.NewDBProvider(memory.NewDatabase(database))
+	engine := sqle.NewDefault(provider)
+	cfg := server.Config{Protocol: "tcp", Address: "127.0.0.1:0"}
+	srv, err := server.NewServer(cfg, engine, sql.NewContext, memory.NewSessionBuilder(provider), nil)
+	if err != nil {
+		t.Fatalf("start mysql server: %v", err)
+	}
+	go srv.Start()
+
+	dsn := fmt.Sprintf("root:@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local", srv.Listener.Addr(), database)
+	db, err := gorm.Open(gmysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		srv.Close()
+		t.Fatalf("open mysql: %v", err)
+	}
+	if err := mysql.AutoMigrate(db); err != nil {
+		srv.Close()
+		t.Fatalf("migrate: %v", err)
+	}
+
+	old := mysql.DB
+	mysql.DB = db
+	t.Cleanup(func() {
+		mysql.DB = old
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		srv.Close()
+	})
+	return db
+}
+
+// Redis 启动一个内存 Redis，替换 redis.RedisClient，测试结束后恢复
+func Redis(t testing.TB) *miniredis.Miniredis {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+
+	old := redis.RedisClient
+	redis.RedisClient = client
+	t.Cleanup(func() {
+		redis.RedisClient = old
+		client.Close()
+	})
+	return mr
+}
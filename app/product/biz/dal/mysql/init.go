@@ -18,12 +18,28 @@ func Init() {
 		panic(err)
 	}
 	if conf.GetEnv() == "test" {
-		DB.AutoMigrate(
-			&model.ProductSPU{},
-			&model.ProductSKU{},
-			&model.ProductCategory{},
-			&model.ProductBrand{},
-		)
+		AutoMigrate(DB)
 	}
 	klog.Info("Successfully connected to MySQL")
 }
+
+// AutoMigrate 创建或更新商品服务在 MySQL 中的全部表
+func AutoMigrate(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&model.ProductSPU{},
+		&model.ProductSKU{},
+		&model.ProductCategory{},
+		&model.ProductBrand{},
+		&model.ProductBrandCategory{},
+		&model.SpecAttribute{},
+		&model.ProductAudit{},
+		&model.ProductHistory{},
+		&model.ProductSchedule{},
+		&model.ProductImportJob{},
+		&model.MediaAsset{},
+		&model.SkuPriceHistory{},
+		&model.PriceAlert{},
+		&model.SearchLog{},
+		&model.SearchStat{},
+	)
+}
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// 分类树缓存，保存全部分类（含隐藏）的扁平列表，请求时按需组装成树；
+// 分类的任何变更都会主动清除
+const (
+	CategoryTreeKey    = "product:category:tree"
+	CategoryTreeExpire = 24 * time.Hour
+)
+
+// GetCategoryTreeFromCache 从缓存获取全部分类，未命中返回 nil
+func GetCategoryTreeFromCache(ctx context.Context) ([]*CachedCategory, error) {
+	data, err := RedisClient.Get(ctx, CategoryTreeKey).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var list []*CachedCategory
+	if err := json.Unmarshal(data, &list); err != nil {
+		klog.Warnf("Failed to unmarshal category tree cache: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetCategoryTreeCache 设置分类树缓存
+func SetCategoryTreeCache(ctx context.Context, list []*CachedCategory) error {
+	if list == nil {
+		list = []*CachedCategory{}
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return RedisClient.Set(ctx, CategoryTreeKey, data, CategoryTreeExpire).Err()
+}
+
+// DeleteCategoryTreeCache 删除分类树缓存
+func DeleteCategoryTreeCache(ctx context.Context) error {
+	return RedisClient.Del(ctx, CategoryTreeKey).Err()
+}
@@ -0,0 +1,143 @@
+package redis
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 商品 ID 布隆过滤器，基于 Redis 位图实现，拦截不存在商品 ID 的详情查询；
+// 商品删除后位不清除，误判由空值缓存兜底，定期全量重建
+const (
+	ProductBloomKey = "product:bloom:spu"
+	// 重建时的临时 key，带上时间戳避免多个实例同时重建互相覆盖，异常中断后自动过期
+	productBloomTmpKeyPrefix = "product:bloom:spu:tmp:"
+	productBloomTmpExpire    = 1 * time.Hour
+	// 8M 位（1MB），7 个哈希函数，百万级商品误判率约 2%
+	ProductBloomBits   = 1 << 23
+	ProductBloomHashes = 7
+
+	productBloomBatchSize = 1000
+)
+
+// productBloomOffsets 计算商品 ID 在位图中的偏移（双重哈希）
+func productBloomOffsets(productID uint64) []int64 {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], productID)
+
+	h1 := fnv.New64a()
+	h1.Write(buf[:])
+	h2 := fnv.New64()
+	h2.Write(buf[:])
+	a, b := h1.Sum64(), h2.Sum64()|1
+
+	offsets := make([]int64, ProductBloomHashes)
+	for i := range offsets {
+		offsets[i] = int64((a + uint64(i)*b) % ProductBloomBits)
+	}
+	return offsets
+}
+
+func addToBloom(ctx context.Context, key string, productIDs []uint64) error {
+	for start := 0; start < len(productIDs); start += productBloomBatchSize {
+		end := min(start+productBloomBatchSize, len(productIDs))
+		pipe := RedisClient.Pipeline()
+		for _, id := range productIDs[start:end] {
+			for _, offset := range productBloomOffsets(id) {
+				pipe.SetBit(ctx, key, offset, 1)
+			}
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddProductsToBloom 将商品 ID 加入布隆过滤器，商品创建或恢复时调用
+func AddProductsToBloom(ctx context.Context, productIDs []uint64) error {
+	return addToBloom(ctx, ProductBloomKey, productIDs)
+}
+
+// ProductMightExist 判断商品是否可能存在，返回 false 时商品一定不存在；
+// 过滤器尚未构建时视为可能存在
+func ProductMightExist(ctx context.Context, productID uint64) (bool, error) {
+	pipe := RedisClient.Pipeline()
+	existsCmd := pipe.Exists(ctx, ProductBloomKey)
+	offsets := productBloomOffsets(productID)
+	bitCmds := make([]*redis.IntCmd, len(offsets))
+	for i, offset := range offsets {
+		bitCmds[i] = pipe.GetBit(ctx, ProductBloomKey, offset)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return true, err
+	}
+	if existsCmd.Val() == 0 {
+		return true, nil
+	}
+	for _, cmd := range bitCmds {
+		if cmd.Val() == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// RebuildProductBloom 用全部商品 ID 重建布隆过滤器，先写入临时 key 再原子替换；
+// next 按游标分批返回商品 ID，返回空切片表示结束。替换后补入重建期间新建的商品
+func RebuildProductBloom(ctx context.Context, next func(afterID uint64) ([]uint64, error)) (int, error) {
+	tmpKey := fmt.Sprintf("%s%d", productBloomTmpKeyPrefix, time.Now().UnixNano())
+	// 先占位，保证空目录时也会生成过滤器
+	pipe := RedisClient.Pipeline()
+	pipe.SetBit(ctx, tmpKey, ProductBloomBits-1, 0)
+	pipe.Expire(ctx, tmpKey, productBloomTmpExpire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	var lastID uint64
+	count := 0
+	for {
+		ids, err := next(lastID)
+		if err != nil {
+			return count, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		if err := addToBloom(ctx, tmpKey, ids); err != nil {
+			return count, err
+		}
+		count += len(ids)
+		lastID = ids[len(ids)-1]
+	}
+
+	// RENAME 会保留临时 key 的过期时间，替换后需去掉
+	pipe = RedisClient.TxPipeline()
+	pipe.Rename(ctx, tmpKey, ProductBloomKey)
+	pipe.Persist(ctx, ProductBloomKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return count, err
+	}
+
+	// 重建期间新建的商品可能只写入了旧过滤器
+	for {
+		ids, err := next(lastID)
+		if err != nil {
+			return count, err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		if err := AddProductsToBloom(ctx, ids); err != nil {
+			return count, err
+		}
+		count += len(ids)
+		lastID = ids[len(ids)-1]
+	}
+	return count, nil
+}
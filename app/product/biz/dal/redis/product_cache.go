@@ -1,14 +1,18 @@
 package redis
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"strconv"
 	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
 )
 
 // 缓存key前缀和过期时间常量
@@ -16,6 +20,8 @@ const (
 	// 商品详情缓存
 	ProductDetailKeyPrefix = "product:detail:"
 	ProductDetailExpire    = 30 * time.Minute
+	// 不存在的商品写入空值占位，过期时间短，避免商品创建或恢复后长时间不可见
+	ProductDetailNullExpire = 1 * time.Minute
 
 	// 热门商品列表缓存
 	HotProductsKey    = "product:hot:list"
@@ -29,8 +35,33 @@ const (
 	// 分类商品缓存
 	CategoryProductsKeyPrefix = "product:category:"
 	CategoryProductsExpire    = 10 * time.Minute
+
+	// 过期时间随机浮动比例，避免同一批写入的缓存同时失效
+	cacheExpireJitter = 0.1
 )
 
+// productDetailNullValue 商品不存在时缓存的空值占位
+var productDetailNullValue = []byte("null")
+
+// ErrProductNotFound 商品不存在（空值缓存命中、布隆过滤器判定不存在或回源未找到）
+var ErrProductNotFound = errors.New("product not found")
+
+// productDetailGroup 合并同一商品详情的并发回源请求
+var productDetailGroup singleflight.Group
+
+// jitterExpire 在基础过期时间上随机浮动 ±10%
+func jitterExpire(base time.Duration) time.Duration {
+	delta := int64(float64(base) * cacheExpireJitter)
+	if delta <= 0 {
+		return base
+	}
+	return base - time.Duration(delta) + time.Duration(rand.Int64N(2*delta+1))
+}
+
+func productDetailKey(productID uint64) string {
+	return fmt.Sprintf("%s%d", ProductDetailKeyPrefix, productID)
+}
+
 // CachedProductDetail 缓存的商品详情结构
 type CachedProductDetail struct {
 	SPU      *CachedSPU      `json:"spu"`
@@ -47,6 +78,7 @@ type CachedSPU struct {
 	Name          string  `json:"name"`
 	SubTitle      string  `json:"sub_title"`
 	MainImage     string  `json:"main_image"`
+	MerchantID    uint64  `json:"merchant_id"`
 	PublishStatus int8    `json:"publish_status"`
 	VerifyStatus  int8    `json:"verify_status"`
 	LowPrice      float64 `json:"low_price"`
@@ -73,13 +105,14 @@ type CachedSKU struct {
 }
 
 type CachedCategory struct {
-	ID       uint64 `json:"id"`
-	ParentID uint64 `json:"parent_id"`
-	Name     string `json:"name"`
-	Level    int    `json:"level"`
-	Icon     string `json:"icon"`
-	Unit     string `json:"unit"`
-	Sort     int    `json:"sort"`
+	ID         uint64 `json:"id"`
+	ParentID   uint64 `json:"parent_id"`
+	Name       string `json:"name"`
+	Level      int    `json:"level"`
+	Icon       string `json:"icon"`
+	Unit       string `json:"unit"`
+	Sort       int    `json:"sort"`
+	ShowStatus bool   `json:"show_status,omitempty"`
 }
 
 type CachedBrand struct {
@@ -99,16 +132,18 @@ type CachedDetail struct {
 	FaqJSON       string   `json:"faq_json"`
 }
 
-// GetProductDetailFromCache 从缓存获取商品详情
+// GetProductDetailFromCache 从缓存获取商品详情，未命中返回 nil，命中空值缓存返回 ErrProductNotFound
 func GetProductDetailFromCache(ctx context.Context, productID uint64) (*CachedProductDetail, error) {
-	key := fmt.Sprintf("%s%d", ProductDetailKeyPrefix, productID)
-	data, err := RedisClient.Get(ctx, key).Bytes()
+	data, err := RedisClient.Get(ctx, productDetailKey(productID)).Bytes()
 	if err != nil {
 		if err == redis.Nil {
 			return nil, nil // 缓存未命中
 		}
 		return nil, err
 	}
+	if bytes.Equal(data, productDetailNullValue) {
+		return nil, ErrProductNotFound
+	}
 
 	var cached CachedProductDetail
 	if err := json.Unmarshal(data, &cached); err != nil {
@@ -120,18 +155,71 @@ func GetProductDetailFromCache(ctx context.Context, productID uint64) (*CachedPr
 
 // SetProductDetailCache 设置商品详情缓存
 func SetProductDetailCache(ctx context.Context, productID uint64, detail *CachedProductDetail) error {
-	key := fmt.Sprintf("%s%d", ProductDetailKeyPrefix, productID)
 	data, err := json.Marshal(detail)
 	if err != nil {
 		return err
 	}
-	return RedisClient.Set(ctx, key, data, ProductDetailExpire).Err()
+	return RedisClient.Set(ctx, productDetailKey(productID), data, jitterExpire(ProductDetailExpire)).Err()
+}
+
+// SetProductDetailNullCache 缓存商品不存在的空值占位，防止不存在的 ID 反复穿透到数据库
+func SetProductDetailNullCache(ctx context.Context, productID uint64) error {
+	return RedisClient.Set(ctx, productDetailKey(productID), productDetailNullValue, jitterExpire(ProductDetailNullExpire)).Err()
 }
 
-// DeleteProductDetailCache 删除商品详情缓存
+// DeleteProductDetailCache 删除商品详情缓存（包括空值占位）
 func DeleteProductDetailCache(ctx context.Context, productID uint64) error {
-	key := fmt.Sprintf("%s%d", ProductDetailKeyPrefix, productID)
-	return RedisClient.Del(ctx, key).Err()
+	return RedisClient.Del(ctx, productDetailKey(productID)).Err()
+}
+
+// LoadProductDetail 获取商品详情：布隆过滤器判定不存在直接返回，缓存未命中时同一商品的并发请求
+// 只有一个执行 loader 回源并写回缓存，其余等待共享结果；loader 返回 nil 表示商品不存在，写入空值缓存。
+// 不存在时返回 ErrProductNotFound，Redis 故障时降级为直接回源
+func LoadProductDetail(ctx context.Context, productID uint64, loader func(ctx context.Context) (*CachedProductDetail, error)) (*CachedProductDetail, error) {
+	exists, err := ProductMightExist(ctx, productID)
+	if err != nil {
+		klog.Warnf("Failed to check product bloom filter: %v", err)
+	} else if !exists {
+		return nil, ErrProductNotFound
+	}
+
+	cached, err := GetProductDetailFromCache(ctx, productID)
+	if errors.Is(err, ErrProductNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		klog.Warnf("Failed to get product detail from cache: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	v, err, _ := productDetailGroup.Do(strconv.FormatUint(productID, 10), func() (interface{}, error) {
+		// 回源不受首个请求取消的影响，结果会共享给其他等待者
+		loadCtx := context.WithoutCancel(ctx)
+		// 上一轮回源可能刚写回缓存，再查一次
+		if cached, err := GetProductDetailFromCache(loadCtx, productID); cached != nil || errors.Is(err, ErrProductNotFound) {
+			return cached, err
+		}
+		detail, err := loader(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		if detail == nil {
+			if err := SetProductDetailNullCache(loadCtx, productID); err != nil {
+				klog.Warnf("Failed to set product detail null cache: %v", err)
+			}
+			return nil, ErrProductNotFound
+		}
+		if err := SetProductDetailCache(loadCtx, productID, detail); err != nil {
+			klog.Warnf("Failed to set product detail cache: %v", err)
+		}
+		return detail, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*CachedProductDetail), nil
 }
 
 // BatchDeleteProductDetailCache 批量删除商品详情缓存
@@ -141,7 +229,7 @@ func BatchDeleteProductDetailCache(ctx context.Context, productIDs []uint64) err
 	}
 	keys := make([]string, len(productIDs))
 	for i, id := range productIDs {
-		keys[i] = fmt.Sprintf("%s%d", ProductDetailKeyPrefix, id)
+		keys[i] = productDetailKey(id)
 	}
 	return RedisClient.Del(ctx, keys...).Err()
 }
@@ -262,7 +350,7 @@ func SetProductListCache(ctx context.Context, page, pageSize int, keyword string
 	if err != nil {
 		return err
 	}
-	return RedisClient.Set(ctx, key, data, ProductListExpire).Err()
+	return RedisClient.Set(ctx, key, data, jitterExpire(ProductListExpire)).Err()
 }
 
 // InvalidateProductListCache 使商品列表缓存失效（当商品更新时调用）
@@ -296,13 +384,12 @@ func WarmUpProductDetailCache(ctx context.Context, details map[uint64]*CachedPro
 
 	pipe := RedisClient.Pipeline()
 	for productID, detail := range details {
-		key := fmt.Sprintf("%s%d", ProductDetailKeyPrefix, productID)
 		data, err := json.Marshal(detail)
 		if err != nil {
 			klog.Warnf("Failed to marshal product detail for warm up: %v", err)
 			continue
 		}
-		pipe.Set(ctx, key, data, ProductDetailExpire)
+		pipe.Set(ctx, productDetailKey(productID), data, jitterExpire(ProductDetailExpire))
 	}
 	_, err := pipe.Exec(ctx)
 	return err
@@ -2,7 +2,10 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -416,6 +419,201 @@ func TestCacheTTL(t *testing.T) {
 	DeleteProductDetailCache(ctx, productID)
 }
 
+// TestJitterExpire 测试过期时间随机浮动
+func TestJitterExpire(t *testing.T) {
+	seen := make(map[time.Duration]bool)
+	for i := 0; i < 1000; i++ {
+		ttl := jitterExpire(ProductDetailExpire)
+		if ttl < 27*time.Minute || ttl > 33*time.Minute {
+			t.Fatalf("过期时间超出浮动范围: %v", ttl)
+		}
+		seen[ttl] = true
+	}
+	if len(seen) < 2 {
+		t.Error("过期时间没有随机浮动")
+	}
+	t.Logf("✓ 过期时间在 ±10%% 内浮动，共 %d 种取值", len(seen))
+}
+
+// TestProductDetailNullCache 测试空值缓存
+func TestProductDetailNullCache(t *testing.T) {
+	ctx := context.Background()
+	productID := uint64(80001)
+
+	if err := SetProductDetailNullCache(ctx, productID); err != nil {
+		t.Fatalf("设置空值缓存失败: %v", err)
+	}
+	cached, err := GetProductDetailFromCache(ctx, productID)
+	if !errors.Is(err, ErrProductNotFound) || cached != nil {
+		t.Fatalf("空值缓存应返回 ErrProductNotFound，实际: %v, %v", cached, err)
+	}
+	t.Log("✓ 空值缓存命中返回商品不存在")
+
+	ttl := RedisClient.TTL(ctx, ProductDetailKeyPrefix+"80001").Val()
+	if ttl <= 0 || ttl > ProductDetailNullExpire+ProductDetailNullExpire/10 {
+		t.Errorf("空值缓存 TTL 不在预期范围内: %v", ttl)
+	}
+	t.Logf("✓ 空值缓存TTL正确: %v", ttl)
+
+	// 删除详情缓存同时清除空值占位
+	DeleteProductDetailCache(ctx, productID)
+	cached, err = GetProductDetailFromCache(ctx, productID)
+	if err != nil || cached != nil {
+		t.Errorf("删除后应为缓存未命中，实际: %v, %v", cached, err)
+	}
+	t.Log("✓ 删除后空值占位被清除")
+}
+
+// TestLoadProductDetailSingleflight 测试并发回源合并
+func TestLoadProductDetailSingleflight(t *testing.T) {
+	ctx := context.Background()
+	productID := uint64(80002)
+	defer DeleteProductDetailCache(ctx, productID)
+
+	var calls atomic.Int32
+	loader := func(ctx context.Context) (*CachedProductDetail, error) {
+		calls.Add(1)
+		time.Sleep(100 * time.Millisecond)
+		return &CachedProductDetail{SPU: &CachedSPU{ID: productID, Name: "回源测试商品"}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			detail, err := LoadProductDetail(ctx, productID, loader)
+			if err != nil || detail == nil || detail.SPU.Name != "回源测试商品" {
+				t.Errorf("并发加载失败: %v, %v", detail, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("50次并发请求应只回源1次，实际: %d", n)
+	}
+	t.Log("✓ 50次并发请求只回源1次")
+
+	if _, err := LoadProductDetail(ctx, productID, loader); err != nil {
+		t.Fatalf("加载失败: %v", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("回源结果应写回缓存，实际回源次数: %d", n)
+	}
+	t.Log("✓ 回源结果已写回缓存")
+}
+
+// TestLoadProductDetailNotFound 测试不存在商品的空值缓存
+func TestLoadProductDetailNotFound(t *testing.T) {
+	ctx := context.Background()
+	productID := uint64(80003)
+	defer DeleteProductDetailCache(ctx, productID)
+
+	calls := 0
+	loader := func(ctx context.Context) (*CachedProductDetail, error) {
+		calls++
+		return nil, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := LoadProductDetail(ctx, productID, loader); !errors.Is(err, ErrProductNotFound) {
+			t.Fatalf("不存在的商品应返回 ErrProductNotFound，实际: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("空值缓存后不应再回源，实际回源次数: %d", calls)
+	}
+	t.Log("✓ 不存在的商品只回源1次")
+
+	// 回源出错不写空值缓存
+	DeleteProductDetailCache(ctx, productID)
+	loadErr := errors.New("db down")
+	if _, err := LoadProductDetail(ctx, productID, func(ctx context.Context) (*CachedProductDetail, error) {
+		return nil, loadErr
+	}); !errors.Is(err, loadErr) {
+		t.Fatalf("应返回回源错误，实际: %v", err)
+	}
+	if cached, err := GetProductDetailFromCache(ctx, productID); err != nil || cached != nil {
+		t.Errorf("回源出错不应写入缓存，实际: %v, %v", cached, err)
+	}
+	t.Log("✓ 回源出错不写入空值缓存")
+}
+
+// TestProductBloom 测试商品布隆过滤器
+func TestProductBloom(t *testing.T) {
+	ctx := context.Background()
+	defer RedisClient.Del(ctx, ProductBloomKey)
+
+	// 未构建时不拦截
+	RedisClient.Del(ctx, ProductBloomKey)
+	if ok, err := ProductMightExist(ctx, 90001); err != nil || !ok {
+		t.Fatalf("过滤器未构建时应视为可能存在: %v, %v", ok, err)
+	}
+	t.Log("✓ 过滤器未构建时不拦截")
+
+	ids := make([]uint64, 0, 2500)
+	for i := uint64(1); i <= 2500; i++ {
+		ids = append(ids, i)
+	}
+	next := func(afterID uint64) ([]uint64, error) {
+		batch := make([]uint64, 0, 1000)
+		for _, id := range ids {
+			if id > afterID && len(batch) < 1000 {
+				batch = append(batch, id)
+			}
+		}
+		return batch, nil
+	}
+	count, err := RebuildProductBloom(ctx, next)
+	if err != nil || count != len(ids) {
+		t.Fatalf("重建布隆过滤器失败: %d, %v", count, err)
+	}
+	if ttl := RedisClient.TTL(ctx, ProductBloomKey).Val(); ttl != -1 {
+		t.Errorf("布隆过滤器不应过期，实际 TTL: %v", ttl)
+	}
+	t.Logf("✓ 重建布隆过滤器，共 %d 个商品", count)
+
+	for _, id := range ids {
+		if ok, _ := ProductMightExist(ctx, id); !ok {
+			t.Fatalf("已存在的商品 %d 被判定为不存在", id)
+		}
+	}
+	falsePositives := 0
+	for id := uint64(100001); id <= 101000; id++ {
+		if ok, _ := ProductMightExist(ctx, id); ok {
+			falsePositives++
+		}
+	}
+	if falsePositives > 10 {
+		t.Errorf("误判过多: %d/1000", falsePositives)
+	}
+	t.Logf("✓ 已存在商品全部命中，不存在商品误判 %d/1000", falsePositives)
+
+	// 新建商品加入过滤器
+	AddProductsToBloom(ctx, []uint64{200001})
+	if ok, _ := ProductMightExist(ctx, 200001); !ok {
+		t.Error("新加入的商品应判定为可能存在")
+	}
+
+	// 过滤器拦截的商品不回源
+	calls := 0
+	var target uint64
+	for id := uint64(300001); ; id++ {
+		if ok, _ := ProductMightExist(ctx, id); !ok {
+			target = id
+			break
+		}
+	}
+	_, err = LoadProductDetail(ctx, target, func(ctx context.Context) (*CachedProductDetail, error) {
+		calls++
+		return nil, nil
+	})
+	if !errors.Is(err, ErrProductNotFound) || calls != 0 {
+		t.Errorf("过滤器判定不存在时不应回源: %v, 回源次数 %d", err, calls)
+	}
+	t.Log("✓ 不存在的商品被布隆过滤器拦截")
+}
+
 // BenchmarkGetProductDetailCache 性能测试
 func BenchmarkGetProductDetailCache(b *testing.B) {
 	ctx := context.Background()
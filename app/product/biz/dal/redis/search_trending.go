@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// 热搜榜缓存，按统计窗口缓存完整榜单，请求时按 limit 截取
+const (
+	TrendingSearchesKeyPrefix = "product:search:trending:"
+	TrendingSearchesExpire    = 5 * time.Minute // 统计任务汇总后主动清除
+	TrendingSearchesLimit     = 50
+)
+
+type TrendingSearchInfo struct {
+	Keyword     string `json:"keyword"`
+	SearchCount int64  `json:"search_count"`
+}
+
+// GetTrendingSearchesFromCache 从缓存获取热搜榜
+func GetTrendingSearchesFromCache(ctx context.Context, period string) ([]*TrendingSearchInfo, error) {
+	data, err := RedisClient.Get(ctx, TrendingSearchesKeyPrefix+period).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var list []*TrendingSearchInfo
+	if err := json.Unmarshal(data, &list); err != nil {
+		klog.Warnf("Failed to unmarshal trending searches cache: %v", err)
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetTrendingSearchesCache 设置热搜榜缓存，空榜单也缓存，避免无搜索时反复查库
+func SetTrendingSearchesCache(ctx context.Context, period string, list []*TrendingSearchInfo) error {
+	if list == nil {
+		list = []*TrendingSearchInfo{}
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return RedisClient.Set(ctx, TrendingSearchesKeyPrefix+period, data, TrendingSearchesExpire).Err()
+}
+
+// DeleteTrendingSearchesCache 删除热搜榜缓存
+func DeleteTrendingSearchesCache(ctx context.Context, periods ...string) error {
+	if len(periods) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(periods))
+	for _, p := range periods {
+		keys = append(keys, TrendingSearchesKeyPrefix+p)
+	}
+	return RedisClient.Del(ctx, keys...).Err()
+}
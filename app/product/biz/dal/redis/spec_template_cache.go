@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/redis/go-redis/v9"
+)
+
+// 分类规格模板缓存，按分类缓存合并了上级分类属性后的完整模板；
+// 模板保存或分类移动时清除全部模板缓存
+const (
+	SpecTemplateKeyPrefix = "product:spec:template:"
+	SpecTemplateExpire    = 24 * time.Hour
+)
+
+type CachedSpecAttribute struct {
+	CategoryID uint64   `json:"category_id"`
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Values     []string `json:"values,omitempty"`
+	Filterable bool     `json:"filterable,omitempty"`
+	Sales      bool     `json:"sales,omitempty"`
+	Sort       int      `json:"sort"`
+}
+
+// GetSpecTemplateFromCache 从缓存获取分类的规格模板，未命中返回 nil
+func GetSpecTemplateFromCache(ctx context.Context, categoryID uint64) ([]*CachedSpecAttribute, error) {
+	key := fmt.Sprintf("%s%d", SpecTemplateKeyPrefix, categoryID)
+	data, err := RedisClient.Get(ctx, key).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var attrs []*CachedSpecAttribute
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		klog.Warnf("Failed to unmarshal spec template cache: %v", err)
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// SetSpecTemplateCache 设置分类的规格模板缓存，空模板也缓存，避免未配置模板的分类反复查库
+func SetSpecTemplateCache(ctx context.Context, categoryID uint64, attrs []*CachedSpecAttribute) error {
+	if attrs == nil {
+		attrs = []*CachedSpecAttribute{}
+	}
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%s%d", SpecTemplateKeyPrefix, categoryID)
+	return RedisClient.Set(ctx, key, data, SpecTemplateExpire).Err()
+}
+
+// InvalidateSpecTemplateCache 清除全部分类的规格模板缓存
+func InvalidateSpecTemplateCache(ctx context.Context) error {
+	var cursor uint64
+	pattern := SpecTemplateKeyPrefix + "*"
+	for {
+		keys, newCursor, err := RedisClient.Scan(ctx, cursor, pattern, 100).Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := RedisClient.Del(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+		cursor = newCursor
+		if cursor == 0 {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package redis
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 搜索联想相关 key
+// 前缀匹配依赖 ZRANGEBYLEX，要求同一 ZSet 内所有成员分值相同，
+// 因此字典序 ZSet 与权重 ZSet 分开存放
+const (
+	// 目录联想：商品名、品牌、分类，启动及定时任务全量重建
+	SuggestCatalogLexKey    = "product:suggest:catalog:lex"
+	SuggestCatalogWeightKey = "product:suggest:catalog:weight"
+
+	// 搜索词联想：用户历史搜索词，分值按时间衰减累加
+	SuggestQueryLexKey   = "product:suggest:query:lex"
+	SuggestQueryScoreKey = "product:suggest:query:score"
+
+	SuggestQueryMaxSize  = 10000              // 搜索词最多保留数量，超出淘汰分值最低的
+	SuggestQueryHalfLife = 7 * 24 * time.Hour // 搜索热度半衰期
+	SuggestTextMaxLen    = 50                 // 联想文本最大长度（字符）
+	suggestScanLimit     = 200                // 单次前缀匹配的候选上限
+)
+
+// 联想词来源
+const (
+	SuggestTypeQuery    = "query"
+	SuggestTypeProduct  = "product"
+	SuggestTypeBrand    = "brand"
+	SuggestTypeCategory = "category"
+)
+
+// suggestEpoch 热度衰减的基准时间，分值按 2^((now-epoch)/halfLife) 递增，
+// 越新的搜索权重越高，相当于旧搜索随时间衰减
+var suggestEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// SuggestEntry 目录联想词
+type SuggestEntry struct {
+	Text   string
+	Type   string
+	Weight float64
+}
+
+// Suggestion 联想结果
+type Suggestion struct {
+	Text  string
+	Type  string
+	Score float64
+}
+
+// NormalizeSuggestText 统一大小写与空白，并截断过长文本，返回空串表示不可用
+func NormalizeSuggestText(s string) string {
+	s = strings.ToLower(strings.Join(strings.Fields(s), " "))
+	if utf8.RuneCountInString(s) > SuggestTextMaxLen {
+		s = string([]rune(s)[:SuggestTextMaxLen])
+	}
+	return s
+}
+
+// catalogMember 目录成员格式：规范化文本\x00类型\x00原文，保证按规范化文本做前缀匹配
+func catalogMember(e SuggestEntry) string {
+	return NormalizeSuggestText(e.Text) + "\x00" + e.Type + "\x00" + e.Text
+}
+
+// lexRange 前缀对应的 ZRANGEBYLEX 区间
+func lexRange(prefix string) *redis.ZRangeBy {
+	return &redis.ZRangeBy{
+		Min:   "[" + prefix,
+		Max:   "[" + prefix + "\xff",
+		Count: suggestScanLimit,
+	}
+}
+
+// RebuildSuggestCatalog 用新的目录联想词整体替换旧数据，写入临时 key 后 RENAME 保证切换原子
+func RebuildSuggestCatalog(ctx context.Context, entries []SuggestEntry) error {
+	weights := make(map[string]float64, len(entries))
+	for _, e := range entries {
+		if NormalizeSuggestText(e.Text) == "" {
+			continue
+		}
+		member := catalogMember(e)
+		if w, ok := weights[member]; !ok || e.Weight > w {
+			weights[member] = e.Weight
+		}
+	}
+
+	if len(weights) == 0 {
+		return RedisClient.Del(ctx, SuggestCatalogLexKey, SuggestCatalogWeightKey).Err()
+	}
+
+	tmpLexKey := SuggestCatalogLexKey + ":tmp"
+	tmpWeightKey := SuggestCatalogWeightKey + ":tmp"
+
+	pipe := RedisClient.Pipeline()
+	pipe.Del(ctx, tmpLexKey, tmpWeightKey)
+	for member, weight := range weights {
+		pipe.ZAdd(ctx, tmpLexKey, redis.Z{Score: 0, Member: member})
+		pipe.ZAdd(ctx, tmpWeightKey, redis.Z{Score: weight, Member: member})
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	tx := RedisClient.TxPipeline()
+	tx.Rename(ctx, tmpLexKey, SuggestCatalogLexKey)
+	tx.Rename(ctx, tmpWeightKey, SuggestCatalogWeightKey)
+	_, err := tx.Exec(ctx)
+	return err
+}
+
+// GetCatalogSuggestions 按前缀匹配目录联想词，按权重降序返回
+func GetCatalogSuggestions(ctx context.Context, prefix string, limit int) ([]Suggestion, error) {
+	members, err := RedisClient.ZRangeByLex(ctx, SuggestCatalogLexKey, lexRange(prefix)).Result()
+	if err != nil || len(members) == 0 {
+		return nil, err
+	}
+	scores, err := RedisClient.ZMScore(ctx, SuggestCatalogWeightKey, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]Suggestion, 0, len(members))
+	for i, member := range members {
+		parts := strings.SplitN(member, "\x00", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		list = append(list, Suggestion{Text: parts[2], Type: parts[1], Score: scores[i]})
+	}
+	return topSuggestions(list, limit), nil
+}
+
+// RecordSearchQuery 记录一次搜索，热度按当前时间的衰减系数累加
+func RecordSearchQuery(ctx context.Context, query string) error {
+	query = NormalizeSuggestText(query)
+	if query == "" {
+		return nil
+	}
+	increment := math.Exp2(float64(time.Since(suggestEpoch)) / float64(SuggestQueryHalfLife))
+
+	pipe := RedisClient.Pipeline()
+	pipe.ZAdd(ctx, SuggestQueryLexKey, redis.Z{Score: 0, Member: query})
+	pipe.ZIncrBy(ctx, SuggestQueryScoreKey, increment, query)
+	card := pipe.ZCard(ctx, SuggestQueryScoreKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	// 超出容量时淘汰热度最低的搜索词
+	if overflow := card.Val() - SuggestQueryMaxSize; overflow > 0 {
+		popped, err := RedisClient.ZPopMin(ctx, SuggestQueryScoreKey, overflow).Result()
+		if err != nil {
+			return err
+		}
+		members := make([]interface{}, 0, len(popped))
+		for _, z := range popped {
+			members = append(members, z.Member)
+		}
+		if len(members) > 0 {
+			return RedisClient.ZRem(ctx, SuggestQueryLexKey, members...).Err()
+		}
+	}
+	return nil
+}
+
+// GetQuerySuggestions 按前缀匹配历史搜索词，按热度降序返回
+func GetQuerySuggestions(ctx context.Context, prefix string, limit int) ([]Suggestion, error) {
+	members, err := RedisClient.ZRangeByLex(ctx, SuggestQueryLexKey, lexRange(prefix)).Result()
+	if err != nil || len(members) == 0 {
+		return nil, err
+	}
+	scores, err := RedisClient.ZMScore(ctx, SuggestQueryScoreKey, members...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]Suggestion, 0, len(members))
+	for i, member := range members {
+		// 已被淘汰但字典序 ZSet 中残留的成员分值为 0
+		if scores[i] <= 0 {
+			continue
+		}
+		list = append(list, Suggestion{Text: member, Type: SuggestTypeQuery, Score: scores[i]})
+	}
+	return topSuggestions(list, limit), nil
+}
+
+func topSuggestions(list []Suggestion, limit int) []Suggestion {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Score > list[j].Score
+	})
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return list
+}
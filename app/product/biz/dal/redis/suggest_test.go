@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"testing"
+)
+
+// TestSuggestCatalog 测试目录联想词重建与前缀匹配
+func TestSuggestCatalog(t *testing.T) {
+	ctx := context.Background()
+
+	err := RebuildSuggestCatalog(ctx, []SuggestEntry{
+		{Text: "小米17", Type: SuggestTypeProduct, Weight: 51},
+		{Text: "小米", Type: SuggestTypeBrand, Weight: 251},
+		{Text: "iPhone 17 Pro", Type: SuggestTypeProduct, Weight: 10},
+		{Text: "手机", Type: SuggestTypeCategory, Weight: 300},
+	})
+	if err != nil {
+		t.Fatalf("重建联想词失败: %v", err)
+	}
+
+	list, err := GetCatalogSuggestions(ctx, "小米", 10)
+	if err != nil {
+		t.Fatalf("获取联想词失败: %v", err)
+	}
+	if len(list) != 2 || list[0].Text != "小米" || list[0].Type != SuggestTypeBrand {
+		t.Fatalf("联想结果不符合预期: %+v", list)
+	}
+
+	// 大小写不敏感，返回原文
+	list, err = GetCatalogSuggestions(ctx, NormalizeSuggestText("IPHONE"), 10)
+	if err != nil {
+		t.Fatalf("获取联想词失败: %v", err)
+	}
+	if len(list) != 1 || list[0].Text != "iPhone 17 Pro" {
+		t.Fatalf("联想结果不符合预期: %+v", list)
+	}
+
+	// 重建后旧词被替换
+	if err := RebuildSuggestCatalog(ctx, []SuggestEntry{{Text: "格力", Type: SuggestTypeBrand, Weight: 1}}); err != nil {
+		t.Fatalf("重建联想词失败: %v", err)
+	}
+	list, _ = GetCatalogSuggestions(ctx, "小米", 10)
+	if len(list) != 0 {
+		t.Errorf("重建后旧联想词应被清除: %+v", list)
+	}
+}
+
+// TestSuggestQuery 测试搜索词记录与热度排序
+func TestSuggestQuery(t *testing.T) {
+	ctx := context.Background()
+	testRedisClient.Del(ctx, SuggestQueryLexKey, SuggestQueryScoreKey)
+
+	for _, q := range []string{"小米手机", "小米  手环", "小米手机", "华为"} {
+		if err := RecordSearchQuery(ctx, q); err != nil {
+			t.Fatalf("记录搜索词失败: %v", err)
+		}
+	}
+
+	list, err := GetQuerySuggestions(ctx, "小米", 10)
+	if err != nil {
+		t.Fatalf("获取搜索词联想失败: %v", err)
+	}
+	if len(list) != 2 || list[0].Text != "小米手机" || list[1].Text != "小米 手环" {
+		t.Fatalf("搜索词联想不符合预期: %+v", list)
+	}
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"github.com/PiaoAdmin/pmall/common/uniqueid"
 	"gorm.io/gorm"
@@ -20,17 +21,38 @@ func (ProductBrand) TableName() string {
 	return "product_brand"
 }
 
+// ProductBrandCategory 品牌与分类的关联，用于前台分类页的品牌筛选
+type ProductBrandCategory struct {
+	ID         uint64 `gorm:"primarykey"`
+	BrandID    uint64 `gorm:"not null;uniqueIndex:uk_brand_category,priority:1;comment:品牌ID"`
+	CategoryID uint64 `gorm:"not null;uniqueIndex:uk_brand_category,priority:2;index;comment:分类ID"`
+	CreatedAt  time.Time
+}
+
+func (ProductBrandCategory) TableName() string {
+	return "product_brand_category"
+}
+
 func (b *ProductBrand) BeforeCreate(tx *gorm.DB) (err error) {
 	// 雪花算法生成id
 	b.ID = uint64(uniqueid.GenId())
 	return
 }
 
-func ListBrands(ctx context.Context, db *gorm.DB, page, pageSize int) ([]*ProductBrand, int64, error) {
+// ListBrands 分页查询品牌，categoryIDs 非空时只返回关联到其中任一分类的品牌
+func ListBrands(ctx context.Context, db *gorm.DB, page, pageSize int, categoryIDs []uint64, includeHidden bool) ([]*ProductBrand, int64, error) {
 	var brands []*ProductBrand
 	var total int64
 
-	query := db.WithContext(ctx).Model(&ProductBrand{}).Where("show_status = ?", true)
+	query := db.WithContext(ctx).Model(&ProductBrand{})
+	if !includeHidden {
+		query = query.Where("show_status = ?", true)
+	}
+	if len(categoryIDs) > 0 {
+		query = query.Where("id IN (?)", db.Model(&ProductBrandCategory{}).
+			Select("brand_id").
+			Where("category_id IN ?", categoryIDs))
+	}
 
 	// 获取总数
 	if err := query.Count(&total).Error; err != nil {
@@ -61,3 +83,74 @@ func GetBrandsByIds(ctx context.Context, db *gorm.DB, ids []uint64) ([]*ProductB
 	err := db.WithContext(ctx).Where("id IN ?", ids).Find(&brands).Error
 	return brands, err
 }
+
+// ExistsBrandName 品牌名称全局唯一，excludeID 用于重命名时排除自身
+func ExistsBrandName(ctx context.Context, db *gorm.DB, name string, excludeID uint64) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&ProductBrand{}).
+		Where("name = ? AND id <> ?", name, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateBrand 创建品牌并写入关联分类
+func CreateBrand(ctx context.Context, db *gorm.DB, brand *ProductBrand, categoryIDs []uint64) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(brand).Error; err != nil {
+			return err
+		}
+		return createBrandCategories(tx, brand.ID, categoryIDs)
+	})
+}
+
+func UpdateBrand(ctx context.Context, db *gorm.DB, id uint64, updates map[string]interface{}) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Model(&ProductBrand{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// DeleteBrand 物理删除品牌及其分类关联，名称有唯一索引，软删除会导致同名品牌无法重建
+func DeleteBrand(ctx context.Context, db *gorm.DB, id uint64) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("brand_id = ?", id).Delete(&ProductBrandCategory{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", id).Delete(&ProductBrand{}).Error
+	})
+}
+
+// SetBrandCategories 全量覆盖品牌关联的分类
+func SetBrandCategories(ctx context.Context, db *gorm.DB, brandID uint64, categoryIDs []uint64) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("brand_id = ?", brandID).Delete(&ProductBrandCategory{}).Error; err != nil {
+			return err
+		}
+		return createBrandCategories(tx, brandID, categoryIDs)
+	})
+}
+
+func createBrandCategories(tx *gorm.DB, brandID uint64, categoryIDs []uint64) error {
+	if len(categoryIDs) == 0 {
+		return nil
+	}
+	relations := make([]*ProductBrandCategory, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		relations = append(relations, &ProductBrandCategory{BrandID: brandID, CategoryID: categoryID})
+	}
+	return tx.Create(&relations).Error
+}
+
+// ListBrandCategoryIDs 批量获取品牌关联的分类 ID
+func ListBrandCategoryIDs(ctx context.Context, db *gorm.DB, brandIDs []uint64) (map[uint64][]uint64, error) {
+	result := make(map[uint64][]uint64, len(brandIDs))
+	if len(brandIDs) == 0 {
+		return result, nil
+	}
+	var relations []*ProductBrandCategory
+	err := db.WithContext(ctx).Where("brand_id IN ?", brandIDs).Order("id ASC").Find(&relations).Error
+	for _, r := range relations {
+		result[r.BrandID] = append(result[r.BrandID], r.CategoryID)
+	}
+	return result, err
+}
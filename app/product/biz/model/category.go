@@ -22,8 +22,11 @@ func (ProductCategory) TableName() string {
 	return "product_category"
 }
 
-// TODO: 目前所有分类都是直接写入库中，没有用到雪花id
+// MaxCategoryLevel 分类最大层级
+const MaxCategoryLevel = 3
+
 func (c *ProductCategory) BeforeCreate(tx *gorm.DB) (err error) {
+	// 雪花算法生成id
 	c.ID = uint64(uniqueid.GenId())
 	return
 }
@@ -51,6 +54,78 @@ func GetCategoryByID(ctx context.Context, db *gorm.DB, id uint64) (*ProductCateg
 	return &category, nil
 }
 
+// ListAllCategories 获取全部分类（包含隐藏的），用于构建分类树缓存与后台管理
+func ListAllCategories(ctx context.Context, db *gorm.DB) ([]*ProductCategory, error) {
+	var categories []*ProductCategory
+	err := db.WithContext(ctx).Order("level ASC, sort ASC, id ASC").Find(&categories).Error
+	return categories, err
+}
+
+// ExistsCategoryName 分类名称全局唯一，excludeID 用于重命名时排除自身
+func ExistsCategoryName(ctx context.Context, db *gorm.DB, name string, excludeID uint64) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&ProductCategory{}).
+		Where("name = ? AND id <> ?", name, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func CountChildCategories(ctx context.Context, db *gorm.DB, parentID uint64) (int64, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&ProductCategory{}).Where("parent_id = ?", parentID).Count(&count).Error
+	return count, err
+}
+
+func CreateCategory(ctx context.Context, db *gorm.DB, category *ProductCategory) error {
+	return db.WithContext(ctx).Create(category).Error
+}
+
+func UpdateCategory(ctx context.Context, db *gorm.DB, id uint64, updates map[string]interface{}) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Model(&ProductCategory{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// SortCategories 按 ids 顺序重写排序值，从 1 开始
+func SortCategories(ctx context.Context, db *gorm.DB, ids []uint64) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			if err := tx.Model(&ProductCategory{}).Where("id = ?", id).Update("sort", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MoveCategory 修改分类的父级，并将整棵子树（含自身）的层级调整 levelDelta
+func MoveCategory(ctx context.Context, db *gorm.DB, id, parentID uint64, subtreeIDs []uint64, levelDelta int) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&ProductCategory{}).Where("id = ?", id).Update("parent_id", parentID).Error; err != nil {
+			return err
+		}
+		if levelDelta == 0 {
+			return nil
+		}
+		return tx.Model(&ProductCategory{}).Where("id IN ?", subtreeIDs).
+			UpdateColumn("level", gorm.Expr("level + ?", levelDelta)).Error
+	})
+}
+
+// DeleteCategory 物理删除分类及其品牌关联、规格模板，名称有唯一索引，软删除会导致同名分类无法重建
+func DeleteCategory(ctx context.Context, db *gorm.DB, id uint64) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("category_id = ?", id).Delete(&ProductBrandCategory{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("category_id = ?", id).Delete(&SpecAttribute{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id = ?", id).Delete(&ProductCategory{}).Error
+	})
+}
+
 // GetCategoriesByIds 批量获取分类
 func GetCategoriesByIds(ctx context.Context, db *gorm.DB, ids []uint64) ([]*ProductCategory, error) {
 	if len(ids) == 0 {
@@ -35,6 +35,13 @@ func CreateProductWithTransaction(ctx context.Context, db *gorm.DB,
 		return
 	}
 
+	// 新商品进入待审核队列
+	err = tx.Create(&ProductAudit{SpuID: spuid}).Error
+	if err != nil {
+		tx.Rollback()
+		return
+	}
+
 	if err = tx.Commit().Error; err != nil {
 		return
 	}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 媒体资源类型
+const (
+	MediaKindImage = "image"
+	MediaKindVideo = "video"
+)
+
+// MediaAsset 商品图片与视频，文件由网关写入存储，这里只保存元数据与访问地址
+type MediaAsset struct {
+	ID           uint64 `gorm:"primarykey"`
+	Kind         string `gorm:"type:varchar(8);not null;index:idx_kind;comment:image/video"`
+	ContentType  string `gorm:"type:varchar(64);not null;comment:MIME类型"`
+	Size         int64  `gorm:"not null;default:0;comment:原文件字节数"`
+	Width        int32  `gorm:"not null;default:0;comment:图片宽度"`
+	Height       int32  `gorm:"not null;default:0;comment:图片高度"`
+	StorageKey   string `gorm:"type:varchar(255);not null;uniqueIndex:uk_storage_key;comment:原文件存储key"`
+	URL          string `gorm:"type:varchar(1000);not null;comment:原文件地址"`
+	ThumbnailURL string `gorm:"type:varchar(1000);not null;default:'';comment:缩略图地址"`
+	WebpURL      string `gorm:"type:varchar(1000);not null;default:'';comment:WebP地址"`
+	OriginalName string `gorm:"type:varchar(255);not null;default:'';comment:上传时的文件名"`
+	OperatorID   uint64 `gorm:"not null;default:0;comment:上传人ID"`
+	CreatedAt    time.Time
+}
+
+func (MediaAsset) TableName() string {
+	return "media_asset"
+}
+
+func CreateMediaAsset(ctx context.Context, db *gorm.DB, asset *MediaAsset) error {
+	return db.WithContext(ctx).Create(asset).Error
+}
+
+// GetMediaAssetsByIDs 按 ID 查询媒体资源，operatorID 非 0 时只返回该用户上传的资源
+func GetMediaAssetsByIDs(ctx context.Context, db *gorm.DB, ids []uint64, operatorID uint64) ([]*MediaAsset, error) {
+	var list []*MediaAsset
+	if len(ids) == 0 {
+		return list, nil
+	}
+	query := db.WithContext(ctx).Where("id IN ?", ids)
+	if operatorID > 0 {
+		query = query.Where("operator_id = ?", operatorID)
+	}
+	err := query.Find(&list).Error
+	return list, err
+}
+
+// ListMediaAssets 媒体库列表，kind 为空、operatorID 为 0 时不筛选
+func ListMediaAssets(ctx context.Context, db *gorm.DB, kind string, operatorID uint64, page, pageSize int) ([]*MediaAsset, int64, error) {
+	var list []*MediaAsset
+	var total int64
+
+	query := db.WithContext(ctx).Model(&MediaAsset{})
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	if operatorID > 0 {
+		query = query.Where("operator_id = ?", operatorID)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("id DESC").Offset(offset).Limit(pageSize).Find(&list).Error
+	return list, total, err
+}
@@ -0,0 +1,162 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 降价提醒状态
+const (
+	PriceAlertStatusWaiting  int8 = 0 // 等待降价
+	PriceAlertStatusNotified int8 = 1 // 已通知，重新订阅后恢复等待
+	PriceAlertStatusSending  int8 = 2 // 通知投递中，投递失败恢复等待；对外仍显示为等待降价
+)
+
+// PriceAlert 用户订阅的降价提醒，每个用户对每个 SKU 只保留一条
+type PriceAlert struct {
+	ID            uint64     `gorm:"primarykey"`
+	UserID        uint64     `gorm:"not null;uniqueIndex:uk_user_sku,priority:1;comment:用户ID"`
+	SkuID         uint64     `gorm:"not null;uniqueIndex:uk_user_sku,priority:2;index:idx_sku_status,priority:1;comment:SKU ID"`
+	SpuID         uint64     `gorm:"not null;comment:SPU ID"`
+	TargetPrice   float64    `gorm:"type:decimal(10,2);not null;comment:目标价"`
+	Status        int8       `gorm:"not null;default:0;index:idx_sku_status,priority:2;comment:0-等待降价,1-已通知,2-通知中"`
+	NotifiedPrice float64    `gorm:"type:decimal(10,2);not null;default:0;comment:触发通知时的售价"`
+	NotifiedAt    *time.Time `gorm:"comment:通知时间"`
+	RetryCount    int        `gorm:"not null;default:0;comment:连续投递失败次数"`
+	RetryAt       *time.Time `gorm:"index;comment:投递失败后的下次重试时间，为空表示无需重试"`
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (PriceAlert) TableName() string {
+	return "price_alert"
+}
+
+// UpsertPriceAlert 创建或覆盖用户对 SKU 的提醒，覆盖时重置为等待状态
+func UpsertPriceAlert(ctx context.Context, db *gorm.DB, alert *PriceAlert) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "sku_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"spu_id":         alert.SpuID,
+			"target_price":   alert.TargetPrice,
+			"status":         PriceAlertStatusWaiting,
+			"notified_price": 0,
+			"notified_at":    nil,
+			"retry_count":    0,
+			"retry_at":       nil,
+			"updated_at":     time.Now(),
+		}),
+	}).Create(alert).Error
+}
+
+func GetPriceAlert(ctx context.Context, db *gorm.DB, userID, skuID uint64) (*PriceAlert, error) {
+	var alert PriceAlert
+	if err := db.WithContext(ctx).Where("user_id = ? AND sku_id = ?", userID, skuID).First(&alert).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// DeletePriceAlert 删除提醒，不存在时返回 false
+func DeletePriceAlert(ctx context.Context, db *gorm.DB, userID, skuID uint64) (bool, error) {
+	result := db.WithContext(ctx).Where("user_id = ? AND sku_id = ?", userID, skuID).Delete(&PriceAlert{})
+	return result.RowsAffected > 0, result.Error
+}
+
+func ListPriceAlerts(ctx context.Context, db *gorm.DB, userID uint64, page, pageSize int) ([]*PriceAlert, int64, error) {
+	var list []*PriceAlert
+	var total int64
+
+	query := db.WithContext(ctx).Model(&PriceAlert{}).Where("user_id = ?", userID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("updated_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&list).Error
+	return list, total, err
+}
+
+func CountWaitingPriceAlerts(ctx context.Context, db *gorm.DB, userID uint64) (int64, error) {
+	var total int64
+	err := db.WithContext(ctx).Model(&PriceAlert{}).
+		Where("user_id = ? AND status IN ?", userID, []int8{PriceAlertStatusWaiting, PriceAlertStatusSending}).
+		Count(&total).Error
+	return total, err
+}
+
+// ListCrossedPriceAlerts 查询售价从 oldPrice 降到 newPrice 时越过目标价的等待中提醒，
+// 包含投递中但在 staleBefore 之前就已领取的提醒（处理实例中途退出）
+func ListCrossedPriceAlerts(ctx context.Context, db *gorm.DB, skuID uint64, oldPrice, newPrice float64, staleBefore time.Time) ([]*PriceAlert, error) {
+	var list []*PriceAlert
+	err := db.WithContext(ctx).
+		Where("sku_id = ? AND target_price >= ? AND target_price < ?", skuID, newPrice, oldPrice).
+		Where("status = ? OR (status = ? AND updated_at < ?)", PriceAlertStatusWaiting, PriceAlertStatusSending, staleBefore).
+		Find(&list).Error
+	return list, err
+}
+
+// ClaimPriceAlert 将等待中（或投递超时）的提醒置为投递中，多个实例同时处理时只有一个能成功
+func ClaimPriceAlert(ctx context.Context, db *gorm.DB, id uint64, staleBefore time.Time) (bool, error) {
+	result := db.WithContext(ctx).Model(&PriceAlert{}).
+		Where("id = ?", id).
+		Where("status = ? OR (status = ? AND updated_at < ?)", PriceAlertStatusWaiting, PriceAlertStatusSending, staleBefore).
+		Updates(map[string]interface{}{
+			"status":     PriceAlertStatusSending,
+			"updated_at": time.Now(),
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+// MarkPriceAlertNotified 投递成功后将提醒置为已通知；投递期间用户重新订阅时不修改
+func MarkPriceAlertNotified(ctx context.Context, db *gorm.DB, id uint64, price float64, now time.Time) error {
+	return db.WithContext(ctx).Model(&PriceAlert{}).
+		Where("id = ? AND status = ?", id, PriceAlertStatusSending).
+		Updates(map[string]interface{}{
+			"status":         PriceAlertStatusNotified,
+			"notified_price": price,
+			"notified_at":    now,
+			"retry_count":    0,
+			"retry_at":       nil,
+		}).Error
+}
+
+// ReleasePriceAlert 投递失败后将提醒恢复为等待并累加失败次数，retryAt 非空时由重试任务在该时间后补发，
+// 为空时不再补发，之后的降价可以再次触发
+func ReleasePriceAlert(ctx context.Context, db *gorm.DB, id uint64, retryAt *time.Time) error {
+	return db.WithContext(ctx).Model(&PriceAlert{}).
+		Where("id = ? AND status = ?", id, PriceAlertStatusSending).
+		Updates(map[string]interface{}{
+			"status":      PriceAlertStatusWaiting,
+			"retry_count": gorm.Expr("retry_count + 1"),
+			"retry_at":    retryAt,
+		}).Error
+}
+
+// ListRetryPriceAlerts 查询需要补发的提醒：到达重试时间的等待中提醒，
+// 以及在 staleBefore 之前领取、仍在投递中的提醒（处理实例中途退出）
+func ListRetryPriceAlerts(ctx context.Context, db *gorm.DB, now, staleBefore time.Time, limit int) ([]*PriceAlert, error) {
+	var list []*PriceAlert
+	err := db.WithContext(ctx).
+		Where("(status = ? AND retry_at <= ?) OR (status = ? AND updated_at < ?)",
+			PriceAlertStatusWaiting, now, PriceAlertStatusSending, staleBefore).
+		Order("id").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// CancelPriceAlertRetry 售价已回升到目标价以上时不再补发，提醒恢复等待，之后的降价可以再次触发
+func CancelPriceAlertRetry(ctx context.Context, db *gorm.DB, id uint64, staleBefore time.Time) error {
+	return db.WithContext(ctx).Model(&PriceAlert{}).
+		Where("id = ?", id).
+		Where("status = ? OR (status = ? AND updated_at < ?)", PriceAlertStatusWaiting, PriceAlertStatusSending, staleBefore).
+		Updates(map[string]interface{}{
+			"status":      PriceAlertStatusWaiting,
+			"retry_count": 0,
+			"retry_at":    nil,
+		}).Error
+}
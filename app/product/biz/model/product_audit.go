@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProductAudit 商品审核记录，提交审核、审核通过、驳回各记一条
+type ProductAudit struct {
+	ID           uint64 `gorm:"primarykey"`
+	SpuID        uint64 `gorm:"not null;index:idx_spu_id;comment:商品ID"`
+	VerifyStatus int8   `gorm:"not null;default:0;comment:0-提交审核,1-审核通过,2-审核不通过"`
+	Reason       string `gorm:"type:varchar(255);not null;default:'';comment:驳回原因"`
+	OperatorID   uint64 `gorm:"not null;default:0;comment:审核人ID，提交审核时为0"`
+	CreatedAt    time.Time
+}
+
+func (ProductAudit) TableName() string {
+	return "product_audit"
+}
+
+// SubmitForAudit 商品内容变更后重新进入待审核状态（SPU 及其 SKU），已在待审核的商品不重复记录
+func SubmitForAudit(ctx context.Context, db *gorm.DB, spuIDs []uint64) error {
+	if len(spuIDs) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uint64
+		if err := tx.Model(&ProductSPU{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND verify_status <> ?", spuIDs, 0).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		return setVerifyStatus(tx, ids, 0, "", 0)
+	})
+}
+
+// AuditSPUs 审核待审核的商品，返回实际审核的 SPU ID，不在待审核状态的商品被跳过
+func AuditSPUs(ctx context.Context, db *gorm.DB, spuIDs []uint64, verifyStatus int8, reason string, operatorID uint64) ([]uint64, error) {
+	var ids []uint64
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&ProductSPU{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND verify_status = ?", spuIDs, 0).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		return setVerifyStatus(tx, ids, verifyStatus, reason, operatorID)
+	})
+	return ids, err
+}
+
+// setVerifyStatus 同步更新 SPU 与 SKU 的审核状态并写入审核记录
+func setVerifyStatus(tx *gorm.DB, spuIDs []uint64, verifyStatus int8, reason string, operatorID uint64) error {
+	if len(spuIDs) == 0 {
+		return nil
+	}
+	if err := tx.Model(&ProductSPU{}).Where("id IN ?", spuIDs).
+		Update("verify_status", verifyStatus).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&ProductSKU{}).Where("spu_id IN ?", spuIDs).
+		Update("verify_status", verifyStatus).Error; err != nil {
+		return err
+	}
+	audits := make([]*ProductAudit, 0, len(spuIDs))
+	for _, id := range spuIDs {
+		audits = append(audits, &ProductAudit{
+			SpuID:        id,
+			VerifyStatus: verifyStatus,
+			Reason:       reason,
+			OperatorID:   operatorID,
+		})
+	}
+	return tx.Create(&audits).Error
+}
+
+// ListPendingSPUs 分页查询待审核商品，先进入待审核的排在前面
+func ListPendingSPUs(ctx context.Context, db *gorm.DB, page, pageSize int) ([]*ProductSPU, int64, error) {
+	var spus []*ProductSPU
+	var total int64
+
+	query := db.WithContext(ctx).Model(&ProductSPU{}).Where("verify_status = ?", 0)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("updated_at ASC, id ASC").Offset(offset).Limit(pageSize).Find(&spus).Error
+	return spus, total, err
+}
+
+// ListProductAudits 查询商品的审核记录，按时间倒序
+func ListProductAudits(ctx context.Context, db *gorm.DB, spuID uint64) ([]*ProductAudit, error) {
+	var audits []*ProductAudit
+	err := db.WithContext(ctx).Where("spu_id = ?", spuID).Order("id DESC").Find(&audits).Error
+	return audits, err
+}
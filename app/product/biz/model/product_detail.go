@@ -93,3 +93,24 @@ func GetProductDetailBySpuID(ctx context.Context, spuID uint64) (*ProductDetail,
 	}
 	return &detail, nil
 }
+
+// GetProductDetailsBySpuIDs 批量获取商品详情，返回以 spu_id 为键的 map，没有详情的商品不在其中
+func GetProductDetailsBySpuIDs(ctx context.Context, spuIDs []uint64) (map[uint64]*ProductDetail, error) {
+	result := make(map[uint64]*ProductDetail, len(spuIDs))
+	if len(spuIDs) == 0 {
+		return result, nil
+	}
+	collection := getProductDetailCollection()
+	cursor, err := collection.Find(ctx, bson.M{"spu_id": bson.M{"$in": spuIDs}})
+	if err != nil {
+		return nil, err
+	}
+	var details []*ProductDetail
+	if err := cursor.All(ctx, &details); err != nil {
+		return nil, err
+	}
+	for _, d := range details {
+		result[d.SpuID] = d
+	}
+	return result, nil
+}
@@ -0,0 +1,156 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 变更历史动作
+const (
+	HistoryActionBaseline  = "baseline"   // 开始记录历史前的商品状态
+	HistoryActionCreate    = "create"     // 创建商品
+	HistoryActionUpdate    = "update"     // 修改 SPU 或详情
+	HistoryActionUpdateSku = "update_sku" // 批量修改 SKU
+	HistoryActionRollback  = "rollback"   // 回滚到历史版本
+)
+
+// ProductHistory 商品版本，保存每次变更后的完整快照及相对上一版本的差异
+type ProductHistory struct {
+	ID            uint64 `gorm:"primarykey"`
+	SpuID         uint64 `gorm:"not null;uniqueIndex:uk_spu_version,priority:1;comment:商品ID"`
+	Version       int    `gorm:"not null;uniqueIndex:uk_spu_version,priority:2;comment:版本号，每个商品从1开始递增"`
+	OperatorID    uint64 `gorm:"not null;default:0;comment:操作人ID"`
+	Action        string `gorm:"type:varchar(16);not null;comment:baseline/create/update/update_sku/rollback"`
+	SourceVersion int    `gorm:"not null;default:0;comment:回滚时为回滚到的版本"`
+	Snapshot      string `gorm:"type:mediumtext;not null;comment:变更后的商品快照JSON"`
+	Changes       string `gorm:"type:text;comment:相对上一版本的字段差异JSON"`
+	CreatedAt     time.Time
+}
+
+func (ProductHistory) TableName() string {
+	return "product_history"
+}
+
+// ProductSnapshot 商品可编辑内容的快照，库存与销量由交易流程维护，不纳入版本
+type ProductSnapshot struct {
+	SPU    SnapshotSPU     `json:"spu"`
+	SKUs   []SnapshotSKU   `json:"skus"`
+	Detail *SnapshotDetail `json:"detail,omitempty"`
+}
+
+type SnapshotSPU struct {
+	Name        string `json:"name"`
+	SubTitle    string `json:"sub_title"`
+	MainImage   string `json:"main_image"`
+	BrandID     uint64 `json:"brand_id"`
+	CategoryID  uint64 `json:"category_id"`
+	ServiceBits int64  `json:"service_bits"`
+	Sort        int    `json:"sort"`
+}
+
+type SnapshotSKU struct {
+	ID          uint64  `json:"id"`
+	Name        string  `json:"name"`
+	SubTitle    string  `json:"sub_title"`
+	MainImage   string  `json:"main_image"`
+	Price       float64 `json:"price"`
+	MarketPrice float64 `json:"market_price"`
+	SkuSpecData string  `json:"sku_spec_data"`
+}
+
+type SnapshotDetail struct {
+	Description   string   `json:"description"`
+	Images        []string `json:"images"`
+	Videos        []string `json:"videos"`
+	MarketTagJSON string   `json:"market_tag_json"`
+	TechTagJSON   string   `json:"tech_tag_json"`
+	FaqJSON       string   `json:"faq_json"`
+}
+
+// FieldChange 单个字段的变更
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+func (h *ProductHistory) SetSnapshot(s *ProductSnapshot) {
+	data, _ := json.Marshal(s)
+	h.Snapshot = string(data)
+}
+
+func (h *ProductHistory) GetSnapshot() (*ProductSnapshot, error) {
+	var s ProductSnapshot
+	if err := json.Unmarshal([]byte(h.Snapshot), &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (h *ProductHistory) SetChanges(changes []FieldChange) {
+	data, _ := json.Marshal(changes)
+	h.Changes = string(data)
+}
+
+func (h *ProductHistory) GetChanges() []FieldChange {
+	var changes []FieldChange
+	if h.Changes != "" {
+		_ = json.Unmarshal([]byte(h.Changes), &changes)
+	}
+	return changes
+}
+
+// AppendProductHistory 为商品追加一个版本，版本号在锁住 SPU 行后按最大版本加一分配；
+// 商品还没有任何历史时先写入 baseline 作为第一个版本
+func AppendProductHistory(ctx context.Context, db *gorm.DB, baseline, entry *ProductHistory) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var spuID uint64
+		if err := tx.Model(&ProductSPU{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", entry.SpuID).Pluck("id", &spuID).Error; err != nil {
+			return err
+		}
+		var version int
+		if err := tx.Model(&ProductHistory{}).Where("spu_id = ?", entry.SpuID).
+			Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+			return err
+		}
+		if version == 0 && baseline != nil {
+			version++
+			baseline.Version = version
+			if err := tx.Create(baseline).Error; err != nil {
+				return err
+			}
+		}
+		entry.Version = version + 1
+		return tx.Create(entry).Error
+	})
+}
+
+// ListProductHistory 分页查询商品版本，按版本号倒序
+func ListProductHistory(ctx context.Context, db *gorm.DB, spuID uint64, page, pageSize int) ([]*ProductHistory, int64, error) {
+	var list []*ProductHistory
+	var total int64
+
+	query := db.WithContext(ctx).Model(&ProductHistory{}).Where("spu_id = ?", spuID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Omit("snapshot").Order("version DESC").Offset(offset).Limit(pageSize).Find(&list).Error
+	return list, total, err
+}
+
+// GetProductVersion 获取商品指定版本
+func GetProductVersion(ctx context.Context, db *gorm.DB, spuID uint64, version int) (*ProductHistory, error) {
+	var h ProductHistory
+	err := db.WithContext(ctx).Where("spu_id = ? AND version = ?", spuID, version).First(&h).Error
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 导入任务状态
+const (
+	ImportStatusPending int8 = 0 // 待执行
+	ImportStatusRunning int8 = 1 // 执行中
+	ImportStatusDone    int8 = 2 // 已完成（可能有行错误）
+	ImportStatusFailed  int8 = 3 // 任务失败，如文件无法解析
+)
+
+// ProductImportJob 商品批量导入任务，逐行错误报告以 JSON 保存在任务中
+type ProductImportJob struct {
+	ID              uint64 `gorm:"primarykey"`
+	OperatorID      uint64 `gorm:"not null;default:0;index:idx_operator_id;comment:操作人ID"`
+	MerchantID      uint64 `gorm:"not null;default:0;comment:导入商品所属商家ID"`
+	Format          string `gorm:"type:varchar(8);not null;comment:csv/xlsx"`
+	DryRun          bool   `gorm:"not null;default:false;comment:只校验不创建商品"`
+	Status          int8   `gorm:"not null;default:0;comment:0-待执行,1-执行中,2-已完成,3-失败"`
+	TotalRows       int    `gorm:"not null;default:0;comment:数据行数"`
+	ErrorRows       int    `gorm:"not null;default:0;comment:有错误的行数"`
+	TotalProducts   int    `gorm:"not null;default:0;comment:文件中的商品数"`
+	ValidProducts   int    `gorm:"not null;default:0;comment:校验通过的商品数"`
+	CreatedProducts int    `gorm:"not null;default:0;comment:实际创建的商品数"`
+	Errors          string `gorm:"type:mediumtext;comment:逐行错误报告JSON"`
+	Error           string `gorm:"type:varchar(255);not null;default:'';comment:任务失败原因"`
+	CreatedAt       time.Time
+	FinishedAt      *time.Time
+}
+
+func (ProductImportJob) TableName() string {
+	return "product_import_job"
+}
+
+// ImportRowError 导入错误，Row 为表格行号（表头为第 1 行），Column 为空表示整行或整个商品的错误
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (j *ProductImportJob) SetErrors(errors []ImportRowError) {
+	data, _ := json.Marshal(errors)
+	j.Errors = string(data)
+}
+
+func (j *ProductImportJob) GetErrors() []ImportRowError {
+	var errors []ImportRowError
+	if j.Errors != "" {
+		_ = json.Unmarshal([]byte(j.Errors), &errors)
+	}
+	return errors
+}
+
+func CreateImportJob(ctx context.Context, db *gorm.DB, job *ProductImportJob) error {
+	return db.WithContext(ctx).Create(job).Error
+}
+
+func GetImportJob(ctx context.Context, db *gorm.DB, id uint64) (*ProductImportJob, error) {
+	var job ProductImportJob
+	if err := db.WithContext(ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// SaveImportJob 保存任务的状态与结果
+func SaveImportJob(ctx context.Context, db *gorm.DB, job *ProductImportJob) error {
+	return db.WithContext(ctx).Model(job).Select(
+		"status", "total_rows", "error_rows", "total_products", "valid_products",
+		"created_products", "errors", "error", "finished_at",
+	).Updates(job).Error
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 定时任务目标与动作
+const (
+	ScheduleTargetSPU = "spu"
+	ScheduleTargetSKU = "sku"
+
+	ScheduleActionPublish   = "publish"
+	ScheduleActionUnpublish = "unpublish"
+	ScheduleActionPrice     = "price"
+)
+
+// 定时任务状态
+const (
+	ScheduleStatusPending  int8 = 0 // 待执行
+	ScheduleStatusRunning  int8 = 1 // 执行中
+	ScheduleStatusDone     int8 = 2 // 已执行
+	ScheduleStatusFailed   int8 = 3 // 执行失败
+	ScheduleStatusCanceled int8 = 4 // 已取消
+)
+
+// ProductSchedule 商品定时上架/下架/改价任务，执行结果与失败原因记录在任务本身
+type ProductSchedule struct {
+	ID          uint64     `gorm:"primarykey"`
+	TargetType  string     `gorm:"type:varchar(8);not null;index:idx_target,priority:1;comment:spu/sku"`
+	TargetID    uint64     `gorm:"not null;index:idx_target,priority:2;comment:SPU或SKU ID"`
+	Action      string     `gorm:"type:varchar(16);not null;comment:publish/unpublish/price"`
+	Price       float64    `gorm:"type:decimal(10,2);not null;default:0;comment:改价后的销售价"`
+	MarketPrice float64    `gorm:"type:decimal(10,2);not null;default:0;comment:改价后的市场价，0表示不修改"`
+	RunAt       time.Time  `gorm:"not null;index:idx_status_run_at,priority:2;comment:执行时间"`
+	Status      int8       `gorm:"not null;default:0;index:idx_status_run_at,priority:1;comment:0-待执行,1-执行中,2-已执行,3-执行失败,4-已取消"`
+	Error       string     `gorm:"type:varchar(255);not null;default:'';comment:执行失败原因"`
+	ExecutedAt  *time.Time `gorm:"comment:实际执行时间"`
+	OperatorID  uint64     `gorm:"not null;default:0;comment:创建人ID"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (ProductSchedule) TableName() string {
+	return "product_schedule"
+}
+
+func CreateProductSchedule(ctx context.Context, db *gorm.DB, schedule *ProductSchedule) error {
+	return db.WithContext(ctx).Create(schedule).Error
+}
+
+// ListProductSchedules 分页查询定时任务，targetType 为空、targetID 为 0、status 为 -1、merchantID 为 0 时不筛选
+func ListProductSchedules(ctx context.Context, db *gorm.DB, targetType string, targetID uint64, status int32, merchantID uint64, page, pageSize int) ([]*ProductSchedule, int64, error) {
+	var list []*ProductSchedule
+	var total int64
+
+	query := db.WithContext(ctx).Model(&ProductSchedule{})
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+	if targetID > 0 {
+		query = query.Where("target_id = ?", targetID)
+	}
+	if status >= 0 {
+		query = query.Where("status = ?", status)
+	}
+	if merchantID > 0 {
+		// 目标为 SKU 时按所属商品判断，已删除的商品仍归属原商家
+		spuIDs := db.Unscoped().Model(&ProductSPU{}).Select("id").Where("merchant_id = ?", merchantID)
+		skuIDs := db.Unscoped().Model(&ProductSKU{}).Select("id").Where("spu_id IN (?)", spuIDs)
+		query = query.Where("(target_type = ? AND target_id IN (?)) OR (target_type = ? AND target_id IN (?))",
+			ScheduleTargetSPU, spuIDs, ScheduleTargetSKU, skuIDs)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("run_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&list).Error
+	return list, total, err
+}
+
+// GetProductSchedule 根据ID获取定时任务
+func GetProductSchedule(ctx context.Context, db *gorm.DB, id uint64) (*ProductSchedule, error) {
+	var schedule ProductSchedule
+	err := db.WithContext(ctx).Where("id = ?", id).First(&schedule).Error
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// CancelProductSchedule 取消待执行的任务，任务不存在或已开始执行时返回 false
+func CancelProductSchedule(ctx context.Context, db *gorm.DB, id uint64) (bool, error) {
+	result := db.WithContext(ctx).Model(&ProductSchedule{}).
+		Where("id = ? AND status = ?", id, ScheduleStatusPending).
+		Update("status", ScheduleStatusCanceled)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ListDueSchedules 获取已到执行时间的待执行任务，按执行时间先后排列
+func ListDueSchedules(ctx context.Context, db *gorm.DB, now time.Time, limit int) ([]*ProductSchedule, error) {
+	var list []*ProductSchedule
+	err := db.WithContext(ctx).
+		Where("status = ? AND run_at <= ?", ScheduleStatusPending, now).
+		Order("run_at ASC, id ASC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
+// ClaimSchedule 将待执行任务置为执行中，多个实例同时扫描时只有一个能领取成功
+func ClaimSchedule(ctx context.Context, db *gorm.DB, id uint64) (bool, error) {
+	result := db.WithContext(ctx).Model(&ProductSchedule{}).
+		Where("id = ? AND status = ?", id, ScheduleStatusPending).
+		Update("status", ScheduleStatusRunning)
+	return result.RowsAffected > 0, result.Error
+}
+
+// FinishSchedule 记录任务执行结果，errMsg 为空表示执行成功
+func FinishSchedule(ctx context.Context, db *gorm.DB, id uint64, errMsg string) error {
+	status := ScheduleStatusDone
+	if errMsg != "" {
+		status = ScheduleStatusFailed
+	}
+	return db.WithContext(ctx).Model(&ProductSchedule{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":      status,
+			"error":       errMsg,
+			"executed_at": time.Now(),
+		}).Error
+}
+
+// FailStaleSchedules 将执行中超过 before 未结束的任务标记为失败（执行实例中途退出），返回处理的数量
+func FailStaleSchedules(ctx context.Context, db *gorm.DB, before time.Time) (int64, error) {
+	result := db.WithContext(ctx).Model(&ProductSchedule{}).
+		Where("status = ? AND updated_at < ?", ScheduleStatusRunning, before).
+		Updates(map[string]interface{}{
+			"status": ScheduleStatusFailed,
+			"error":  "interrupted before completion",
+		})
+	return result.RowsAffected, result.Error
+}
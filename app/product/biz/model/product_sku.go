@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"strings"
 
 	"github.com/PiaoAdmin/pmall/common/errs"
 	"github.com/PiaoAdmin/pmall/common/uniqueid"
@@ -49,6 +50,51 @@ func GetSKUsBySpuID(ctx context.Context, db *gorm.DB, spuID uint64) ([]*ProductS
 	return skus, err
 }
 
+// GetSKUsByIDs 批量获取SKU，不保证顺序
+func GetSKUsByIDs(ctx context.Context, db *gorm.DB, ids []uint64) ([]*ProductSKU, error) {
+	if len(ids) == 0 {
+		return []*ProductSKU{}, nil
+	}
+	var skus []*ProductSKU
+	err := db.WithContext(ctx).Where("id IN ?", ids).Find(&skus).Error
+	return skus, err
+}
+
+// GetSKUsBySpuIDs 批量获取多个商品的SKU，按 ID 升序
+func GetSKUsBySpuIDs(ctx context.Context, db *gorm.DB, spuIDs []uint64) ([]*ProductSKU, error) {
+	if len(spuIDs) == 0 {
+		return []*ProductSKU{}, nil
+	}
+	var skus []*ProductSKU
+	err := db.WithContext(ctx).Where("spu_id IN ?", spuIDs).Order("id ASC").Find(&skus).Error
+	return skus, err
+}
+
+// ListExistingSkuCodes 返回 codes 中已被使用的SKU编码，包含已删除的SKU（唯一索引不区分删除状态）
+func ListExistingSkuCodes(ctx context.Context, db *gorm.DB, codes []string) ([]string, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	var existing []string
+	err := db.WithContext(ctx).Unscoped().Model(&ProductSKU{}).
+		Where("sku_code IN ?", codes).
+		Pluck("sku_code", &existing).Error
+	return existing, err
+}
+
+// CountSKUsNotOwned 统计 ids 中所属商品不属于该商家的 SKU 数
+func CountSKUsNotOwned(ctx context.Context, db *gorm.DB, merchantID uint64, ids []uint64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	var count int64
+	err := db.WithContext(ctx).Unscoped().Table("product_sku").
+		Joins("INNER JOIN product_spu ON product_sku.spu_id = product_spu.id").
+		Where("product_sku.id IN ? AND product_spu.merchant_id <> ?", ids, merchantID).
+		Count(&count).Error
+	return count, err
+}
+
 // GetSKUByID 根据ID获取SKU
 func GetSKUByID(ctx context.Context, db *gorm.DB, id uint64) (*ProductSKU, error) {
 	var sku ProductSKU
@@ -117,8 +163,10 @@ func UpdateSKU(ctx context.Context, db *gorm.DB, skuID uint64, updates map[strin
 }
 
 // SearchSKUs C端搜索可购买的SKU（只返回已发布、审核通过、有库存的）
+// brandIDs/categoryIDs/specs 为分面多选，同一条件内为或关系
 func SearchSKUs(ctx context.Context, db *gorm.DB, page, pageSize int, keyword string,
-	categoryID, brandID uint64, minPrice, maxPrice float64, sortType int32) ([]*ProductSKU, int64, error) {
+	categoryID, brandID uint64, minPrice, maxPrice float64, sortType int32,
+	brandIDs, categoryIDs []uint64, specs map[string][]string) ([]*ProductSKU, int64, error) {
 
 	var skus []*ProductSKU
 	var total int64
@@ -126,9 +174,9 @@ func SearchSKUs(ctx context.Context, db *gorm.DB, page, pageSize int, keyword st
 	// 联表查询 SKU + SPU
 	query := db.WithContext(ctx).
 		Table("product_sku").
-		Joins("INNER JOIN product_spu ON product_sku.spu_id = product_spu.id").
-		// 暂时去掉状态校验，简化业务流程
-		// Where("product_spu.publish_status = ? AND product_spu.verify_status = ?", 1, 1).
+		Joins("INNER JOIN product_spu ON product_sku.spu_id = product_spu.id AND product_spu.deleted_at IS NULL").
+		// 已上架且审核通过
+		Where("product_spu.publish_status = ? AND product_spu.verify_status = ?", 1, 1).
 		Where("product_sku.stock > ?", 0) // 有库存
 
 	if keyword != "" {
@@ -144,6 +192,22 @@ func SearchSKUs(ctx context.Context, db *gorm.DB, page, pageSize int, keyword st
 		query = query.Where("product_spu.brand_id = ?", brandID)
 	}
 
+	if len(categoryIDs) > 0 {
+		query = query.Where("product_spu.category_id IN ?", categoryIDs)
+	}
+
+	if len(brandIDs) > 0 {
+		query = query.Where("product_spu.brand_id IN ?", brandIDs)
+	}
+
+	for name, values := range specs {
+		if len(values) == 0 {
+			continue
+		}
+		path := `$."` + strings.ReplaceAll(name, `"`, `\"`) + `"`
+		query = query.Where("JSON_UNQUOTE(JSON_EXTRACT(product_sku.sku_spec_data, ?)) IN ?", path, values)
+	}
+
 	if minPrice > 0 {
 		query = query.Where("product_sku.price >= ?", minPrice)
 	}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"github.com/PiaoAdmin/pmall/common/uniqueid"
 	"gorm.io/gorm"
@@ -14,6 +15,7 @@ type ProductSPU struct {
 	Name          string  `gorm:"type:varchar(255);not null;comment:商品名称"`
 	SubTitle      string  `gorm:"type:varchar(500);comment:商品副标题"`
 	MainImage     string  `gorm:"type:varchar(1000);comment:商品主图"`
+	MerchantID    uint64  `gorm:"not null;default:0;comment:所属商家用户ID，0为平台商品;index:idx_merchant_id"`
 	PublishStatus int8    `gorm:"default:0;comment:商品发布状态:0-未发布,1-已发布;index:idx_status"`
 	VerifyStatus  int8    `gorm:"default:0;comment:商品审核状态:0-未审核,1-审核通过,2-审核不通过;index:idx_status"`
 	LowPrice      float64 `gorm:"type:decimal(10,2);comment:最低售价;index:idx_price_range"`
@@ -66,11 +68,62 @@ func BatchUpdateStatus(ctx context.Context, db *gorm.DB, ids []uint64, updates m
 	return db.WithContext(ctx).Model(&ProductSPU{}).Where("id IN ?", ids).Updates(updates).Error
 }
 
-func ListProducts(ctx context.Context, db *gorm.DB, page, pageSize int, keyword string, categoryID, brandID uint64, publishStatus, verifyStatus int32) ([]*ProductSPU, int64, error) {
+// DeleteSPUs 软删除商品并同时下架，返回实际删除的数量
+func DeleteSPUs(ctx context.Context, db *gorm.DB, ids []uint64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := db.WithContext(ctx).Model(&ProductSPU{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+		"publish_status": 2,
+		"is_deleted":     true,
+		"deleted_at":     time.Now(),
+	})
+	return result.RowsAffected, result.Error
+}
+
+// RestoreSPUs 恢复已软删除的商品，恢复后保持下架状态，返回实际恢复的数量
+func RestoreSPUs(ctx context.Context, db *gorm.DB, ids []uint64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := db.WithContext(ctx).Unscoped().Model(&ProductSPU{}).
+		Where("id IN ? AND deleted_at IS NOT NULL", ids).
+		Updates(map[string]interface{}{
+			"is_deleted": false,
+			"deleted_at": nil,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// ListProducts 后台分页查询商品，publishStatus/verifyStatus 为 -1 时不筛选，deleted 为 true 时只查询已删除的商品，
+// merchantID 为 0 时不按商家筛选
+func ListProducts(ctx context.Context, db *gorm.DB, page, pageSize int, keyword string, categoryID, brandID uint64, publishStatus, verifyStatus int32, deleted bool, merchantID uint64) ([]*ProductSPU, int64, error) {
 	var spus []*ProductSPU
 	var total int64
 
-	query := db.WithContext(ctx).Model(&ProductSPU{})
+	query := filterProducts(db.WithContext(ctx).Model(&ProductSPU{}), keyword, categoryID, brandID, publishStatus, verifyStatus, deleted, merchantID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (page - 1) * pageSize
+	err := query.Order("sort DESC, created_at DESC").Offset(offset).Limit(pageSize).Find(&spus).Error
+	return spus, total, err
+}
+
+// ListProductsAfter 按 ID 升序游标遍历商品，筛选条件与 ListProducts 相同，用于导出
+func ListProductsAfter(ctx context.Context, db *gorm.DB, afterID uint64, limit int, keyword string, categoryID, brandID uint64, publishStatus, verifyStatus int32, deleted bool, merchantID uint64) ([]*ProductSPU, error) {
+	var spus []*ProductSPU
+	query := filterProducts(db.WithContext(ctx).Model(&ProductSPU{}), keyword, categoryID, brandID, publishStatus, verifyStatus, deleted, merchantID)
+	err := query.Where("id > ?", afterID).Order("id ASC").Limit(limit).Find(&spus).Error
+	return spus, err
+}
+
+// filterProducts 后台商品筛选条件，publishStatus/verifyStatus 为 -1 时不筛选，deleted 为 true 时只查询已删除的商品
+func filterProducts(query *gorm.DB, keyword string, categoryID, brandID uint64, publishStatus, verifyStatus int32, deleted bool, merchantID uint64) *gorm.DB {
+	if deleted {
+		query = query.Unscoped().Where("deleted_at IS NOT NULL")
+	}
 
 	if keyword != "" {
 		query = query.Where("name LIKE ? OR sub_title LIKE ?", "%"+keyword+"%", "%"+keyword+"%")
@@ -84,22 +137,18 @@ func ListProducts(ctx context.Context, db *gorm.DB, page, pageSize int, keyword
 		query = query.Where("brand_id = ?", brandID)
 	}
 
-	// 暂时去掉状态校验，简化业务流程
-	// if publishStatus >= 0 {
-	// 	query = query.Where("publish_status = ?", publishStatus)
-	// }
-
-	// if verifyStatus >= 0 {
-	// 	query = query.Where("verify_status = ?", verifyStatus)
-	// }
+	if publishStatus >= 0 {
+		query = query.Where("publish_status = ?", publishStatus)
+	}
 
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if verifyStatus >= 0 {
+		query = query.Where("verify_status = ?", verifyStatus)
 	}
 
-	offset := (page - 1) * pageSize
-	err := query.Order("sort DESC, created_at DESC").Offset(offset).Limit(pageSize).Find(&spus).Error
-	return spus, total, err
+	if merchantID > 0 {
+		query = query.Where("merchant_id = ?", merchantID)
+	}
+	return query
 }
 
 func GetProductsByIds(ctx context.Context, db *gorm.DB, ids []uint64) ([]*ProductSPU, error) {
@@ -111,12 +160,71 @@ func GetProductsByIds(ctx context.Context, db *gorm.DB, ids []uint64) ([]*Produc
 	return spus, err
 }
 
-// ListProductsBySaleCount 按销量排序获取商品列表（用于热门商品）
+// CountSPUsNotOwned 统计 ids 中不属于该商家的商品数，包含已删除的商品
+func CountSPUsNotOwned(ctx context.Context, db *gorm.DB, merchantID uint64, ids []uint64) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	var count int64
+	err := db.WithContext(ctx).Unscoped().Model(&ProductSPU{}).
+		Where("id IN ? AND merchant_id <> ?", ids, merchantID).
+		Count(&count).Error
+	return count, err
+}
+
+// ListSPUIDsAfter 按 ID 升序游标遍历未删除的商品 ID，用于全量重建索引
+func ListSPUIDsAfter(ctx context.Context, db *gorm.DB, afterID uint64, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := db.WithContext(ctx).Model(&ProductSPU{}).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountSPUsByCategoryID 统计分类下的商品数，包含回收站中的商品（恢复后仍会引用该分类）
+func CountSPUsByCategoryID(ctx context.Context, db *gorm.DB, categoryID uint64) (int64, error) {
+	var count int64
+	err := db.WithContext(ctx).Unscoped().Model(&ProductSPU{}).
+		Where("category_id = ?", categoryID).
+		Count(&count).Error
+	return count, err
+}
+
+// ListSPUIDsByCategoryID 获取分类下未删除的商品 ID
+func ListSPUIDsByCategoryID(ctx context.Context, db *gorm.DB, categoryID uint64) ([]uint64, error) {
+	var ids []uint64
+	err := db.WithContext(ctx).Model(&ProductSPU{}).
+		Where("category_id = ?", categoryID).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountSPUsByBrandID 统计品牌下的商品数，包含回收站中的商品
+func CountSPUsByBrandID(ctx context.Context, db *gorm.DB, brandID uint64) (int64, error) {
+	var count int64
+	err := db.WithContext(ctx).Unscoped().Model(&ProductSPU{}).
+		Where("brand_id = ?", brandID).
+		Count(&count).Error
+	return count, err
+}
+
+// ListSPUIDsByBrandID 获取品牌下未删除的商品 ID
+func ListSPUIDsByBrandID(ctx context.Context, db *gorm.DB, brandID uint64) ([]uint64, error) {
+	var ids []uint64
+	err := db.WithContext(ctx).Model(&ProductSPU{}).
+		Where("brand_id = ?", brandID).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// ListProductsBySaleCount 按销量排序获取已上架且审核通过的商品列表（用于热门商品）
 func ListProductsBySaleCount(ctx context.Context, db *gorm.DB, limit int) ([]*ProductSPU, int64, error) {
 	var spus []*ProductSPU
 	var total int64
 
-	query := db.WithContext(ctx).Model(&ProductSPU{})
+	query := db.WithContext(ctx).Model(&ProductSPU{}).Where("publish_status = ? AND verify_status = ?", 1, 1)
 
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
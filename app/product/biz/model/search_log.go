@@ -0,0 +1,134 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 搜索统计粒度
+const (
+	SearchStatHour = "hour"
+	SearchStatDay  = "day"
+)
+
+// SearchLog 搜索日志，每次 SearchProducts 调用一条
+type SearchLog struct {
+	ID          uint64    `gorm:"primarykey"`
+	UserID      uint64    `gorm:"not null;default:0;index;comment:用户ID，未登录为0"`
+	Keyword     string    `gorm:"type:varchar(100);not null;default:'';comment:规范化后的搜索关键词"`
+	Filters     string    `gorm:"type:varchar(1000);not null;default:'';comment:筛选条件JSON"`
+	Page        int       `gorm:"not null;default:1;comment:页码"`
+	ResultCount int64     `gorm:"not null;default:0;comment:结果总数"`
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+func (SearchLog) TableName() string {
+	return "product_search_log"
+}
+
+// SearchStat 搜索词按小时/天汇总的统计
+type SearchStat struct {
+	ID              uint64    `gorm:"primarykey"`
+	Granularity     string    `gorm:"type:varchar(8);not null;uniqueIndex:uk_search_stat,priority:1;comment:统计粒度 hour/day"`
+	BucketStart     time.Time `gorm:"not null;uniqueIndex:uk_search_stat,priority:2;comment:统计周期开始时间"`
+	Keyword         string    `gorm:"type:varchar(100);not null;uniqueIndex:uk_search_stat,priority:3"`
+	SearchCount     int64     `gorm:"not null;default:0"`
+	ZeroResultCount int64     `gorm:"not null;default:0"`
+	ResultTotal     int64     `gorm:"not null;default:0;comment:结果数之和，用于计算平均结果数"`
+	UserCount       int64     `gorm:"not null;default:0;comment:登录用户去重数"`
+	UpdatedAt       time.Time
+}
+
+func (SearchStat) TableName() string {
+	return "product_search_stat"
+}
+
+// SearchStatSummary 多个统计周期合并后的搜索词统计
+type SearchStatSummary struct {
+	Keyword         string
+	SearchCount     int64
+	ZeroResultCount int64
+	ResultTotal     int64
+	PeriodUserSum   int64 // 各周期 user_count 之和，跨周期的同一用户会重复计入
+}
+
+// HitCount 有结果的搜索次数
+func (s *SearchStatSummary) HitCount() int64 {
+	return s.SearchCount - s.ZeroResultCount
+}
+
+// CreateSearchLogs 批量写入搜索日志
+func CreateSearchLogs(ctx context.Context, db *gorm.DB, logs []*SearchLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(&logs).Error
+}
+
+// AggregateSearchStats 将 [start, end) 内的首页搜索日志汇总为一个统计周期，重复执行会覆盖旧值。
+// 更新时引用派生表列而不是 VALUES()，后者自 MySQL 8.0.20 起已废弃
+func AggregateSearchStats(ctx context.Context, db *gorm.DB, granularity string, start, end time.Time) error {
+	return db.WithContext(ctx).Exec(`
+		INSERT INTO product_search_stat
+			(granularity, bucket_start, keyword, search_count, zero_result_count, result_total, user_count, updated_at)
+		SELECT * FROM (
+			SELECT ? AS granularity, ? AS bucket_start, keyword,
+				COUNT(*) AS search_count, SUM(result_count = 0) AS zero_result_count,
+				SUM(result_count) AS result_total, COUNT(DISTINCT NULLIF(user_id, 0)) AS user_count, ? AS updated_at
+			FROM product_search_log
+			WHERE created_at >= ? AND created_at < ? AND keyword <> '' AND page = 1
+			GROUP BY keyword
+		) AS s
+		ON DUPLICATE KEY UPDATE
+			search_count = s.search_count,
+			zero_result_count = s.zero_result_count,
+			result_total = s.result_total,
+			user_count = s.user_count,
+			updated_at = s.updated_at`,
+		granularity, start, time.Now(), start, end,
+	).Error
+}
+
+// ListTrendingKeywords 合并 since 之后的统计周期，只返回有结果的搜索词，按有结果的搜索次数降序
+func ListTrendingKeywords(ctx context.Context, db *gorm.DB, granularity string, since time.Time, limit int) ([]*SearchStatSummary, error) {
+	var list []*SearchStatSummary
+	err := summarizeSearchStats(ctx, db, granularity, since).
+		Having("SUM(search_count) > SUM(zero_result_count)").
+		Order("SUM(search_count) - SUM(zero_result_count) DESC").
+		Limit(limit).
+		Scan(&list).Error
+	return list, err
+}
+
+// ListZeroResultKeywords 合并 since 之后的统计周期，只返回出现过零结果的搜索词，按零结果次数降序
+func ListZeroResultKeywords(ctx context.Context, db *gorm.DB, granularity string, since time.Time, limit int) ([]*SearchStatSummary, error) {
+	var list []*SearchStatSummary
+	err := summarizeSearchStats(ctx, db, granularity, since).
+		Having("zero_result_count > 0").
+		Order("zero_result_count DESC, search_count DESC").
+		Limit(limit).
+		Scan(&list).Error
+	return list, err
+}
+
+func summarizeSearchStats(ctx context.Context, db *gorm.DB, granularity string, since time.Time) *gorm.DB {
+	return db.WithContext(ctx).Model(&SearchStat{}).
+		Select("keyword, SUM(search_count) AS search_count, SUM(zero_result_count) AS zero_result_count, "+
+			"SUM(result_total) AS result_total, SUM(user_count) AS period_user_sum").
+		Where("granularity = ? AND bucket_start >= ?", granularity, since).
+		Group("keyword")
+}
+
+// PurgeSearchLogs 删除 before 之前的搜索日志
+func PurgeSearchLogs(ctx context.Context, db *gorm.DB, before time.Time) (int64, error) {
+	result := db.WithContext(ctx).Where("created_at < ?", before).Delete(&SearchLog{})
+	return result.RowsAffected, result.Error
+}
+
+// PurgeSearchStats 删除 before 之前的某粒度统计
+func PurgeSearchStats(ctx context.Context, db *gorm.DB, granularity string, before time.Time) (int64, error) {
+	result := db.WithContext(ctx).Where("granularity = ? AND bucket_start < ?", granularity, before).Delete(&SearchStat{})
+	return result.RowsAffected, result.Error
+}
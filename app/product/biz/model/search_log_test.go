@@ -0,0 +1,123 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/daltest"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"gorm.io/gorm"
+)
+
+func loadSearchStats(t *testing.T, db *gorm.DB, granularity string) map[string]model.SearchStat {
+	t.Helper()
+	var list []model.SearchStat
+	if err := db.Where("granularity = ?", granularity).Find(&list).Error; err != nil {
+		t.Fatalf("load stats: %v", err)
+	}
+	stats := make(map[string]model.SearchStat, len(list))
+	for _, st := range list {
+		stats[st.Keyword] = st
+	}
+	return stats
+}
+
+// 按小时、按天汇总首页搜索日志，重复执行结果不变，新日志会覆盖旧值
+func TestAggregateSearchStats(t *testing.T) {
+	db := daltest.MySQL(t)
+	ctx := context.Background()
+
+	hour := time.Now().Truncate(time.Hour).Add(-time.Hour)
+	day := time.Date(hour.Year(), hour.Month(), hour.Day(), 0, 0, 0, 0, hour.Location())
+	at := hour.Add(10 * time.Minute)
+	logs := []*model.SearchLog{
+		{UserID: 1, Keyword: "手机", Page: 1, ResultCount: 5, CreatedAt: at},
+		{UserID: 1, Keyword: "手机", Page: 1, ResultCount: 3, CreatedAt: at},
+		{UserID: 2, Keyword: "手机", Page: 1, ResultCount: 0, CreatedAt: at},
+		{UserID: 0, Keyword: "手机", Page: 1, ResultCount: 2, CreatedAt: at},
+		{UserID: 3, Keyword: "手机", Page: 2, ResultCount: 5, CreatedAt: at},                     // 翻页不计入
+		{UserID: 3, Keyword: "", Page: 1, ResultCount: 5, CreatedAt: at},                       // 空搜索词不计入
+		{UserID: 3, Keyword: "耳机", Page: 1, ResultCount: 0, CreatedAt: hour.Add(-time.Minute)}, // 不在本小时
+	}
+	if err := model.CreateSearchLogs(ctx, db, logs); err != nil {
+		t.Fatalf("create logs: %v", err)
+	}
+
+	aggregate := func() {
+		t.Helper()
+		if err := model.AggregateSearchStats(ctx, db, model.SearchStatHour, hour, hour.Add(time.Hour)); err != nil {
+			t.Fatalf("aggregate hour: %v", err)
+		}
+		if err := model.AggregateSearchStats(ctx, db, model.SearchStatDay, day, day.Add(24*time.Hour)); err != nil {
+			t.Fatalf("aggregate day: %v", err)
+		}
+	}
+	aggregate()
+	first := loadSearchStats(t, db, model.SearchStatHour)
+	if len(first) != 1 {
+		t.Fatalf("hour stats = %v, want only 手机", first)
+	}
+	st := first["手机"]
+	if st.SearchCount != 4 || st.ZeroResultCount != 1 || st.ResultTotal != 10 || st.UserCount != 2 {
+		t.Errorf("hour stat = %+v, want search 4, zero 1, total 10, users 2", st)
+	}
+	if !st.BucketStart.Equal(hour) {
+		t.Errorf("bucket start = %v, want %v", st.BucketStart, hour)
+	}
+	if got := loadSearchStats(t, db, model.SearchStatDay)["手机"]; got.SearchCount != 4 {
+		t.Errorf("day stat = %+v, want search 4", got)
+	}
+
+	// 重复汇总同一周期不产生重复行，数值不变
+	aggregate()
+	second := loadSearchStats(t, db, model.SearchStatHour)
+	if len(second) != 1 || second["手机"].ID != st.ID || second["手机"].SearchCount != st.SearchCount ||
+		second["手机"].UserCount != st.UserCount {
+		t.Errorf("re-aggregate changed stats: %+v -> %+v", first, second)
+	}
+
+	// 周期内有新日志时覆盖旧值
+	if err := model.CreateSearchLogs(ctx, db, []*model.SearchLog{{UserID: 4, Keyword: "手机", Page: 1, ResultCount: 1, CreatedAt: at}}); err != nil {
+		t.Fatalf("create log: %v", err)
+	}
+	aggregate()
+	if got := loadSearchStats(t, db, model.SearchStatHour)["手机"]; got.SearchCount != 5 || got.UserCount != 3 {
+		t.Errorf("after new log: %+v, want search 5, users 3", got)
+	}
+}
+
+// 热搜只统计有结果的搜索，按有结果的次数降序
+func TestListTrendingKeywords(t *testing.T) {
+	db := daltest.MySQL(t)
+	ctx := context.Background()
+
+	hour := time.Now().Truncate(time.Hour)
+	stats := []*model.SearchStat{
+		{Granularity: model.SearchStatHour, BucketStart: hour.Add(-time.Hour), Keyword: "手机", SearchCount: 5, ZeroResultCount: 1},
+		{Granularity: model.SearchStatHour, BucketStart: hour, Keyword: "手机", SearchCount: 2},
+		{Granularity: model.SearchStatHour, BucketStart: hour, Keyword: "耳机", SearchCount: 10, ZeroResultCount: 5},
+		{Granularity: model.SearchStatHour, BucketStart: hour, Keyword: "无结果", SearchCount: 20, ZeroResultCount: 20},
+		{Granularity: model.SearchStatHour, BucketStart: hour.Add(-3 * time.Hour), Keyword: "过期", SearchCount: 30},
+	}
+	if err := db.Create(&stats).Error; err != nil {
+		t.Fatalf("create stats: %v", err)
+	}
+
+	list, err := model.ListTrendingKeywords(ctx, db, model.SearchStatHour, hour.Add(-time.Hour), 10)
+	if err != nil {
+		t.Fatalf("list trending: %v", err)
+	}
+	want := []struct {
+		keyword string
+		hits    int64
+	}{{"手机", 6}, {"耳机", 5}}
+	if len(list) != len(want) {
+		t.Fatalf("trending = %d items, want %d", len(list), len(want))
+	}
+	for i, w := range want {
+		if list[i].Keyword != w.keyword || list[i].HitCount() != w.hits {
+			t.Errorf("trending[%d] = %s/%d, want %s/%d", i, list[i].Keyword, list[i].HitCount(), w.keyword, w.hits)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 价格变动来源
+const (
+	PriceSourceCreate   = "create"   // 创建商品时的初始价格
+	PriceSourceManual   = "manual"   // 后台编辑 SKU
+	PriceSourceSchedule = "schedule" // 定时改价
+	PriceSourceRollback = "rollback" // 回滚到历史版本
+)
+
+// SkuPriceHistory SKU 售价与划线价的变动记录
+type SkuPriceHistory struct {
+	ID             uint64    `gorm:"primarykey"`
+	SkuID          uint64    `gorm:"not null;index:idx_sku_created,priority:1;comment:SKU ID"`
+	SpuID          uint64    `gorm:"not null;index:idx_spu_created,priority:1;comment:SPU ID"`
+	OldPrice       float64   `gorm:"type:decimal(10,2);not null;default:0;comment:变动前售价"`
+	NewPrice       float64   `gorm:"type:decimal(10,2);not null;comment:变动后售价"`
+	OldMarketPrice float64   `gorm:"type:decimal(10,2);not null;default:0;comment:变动前市场价"`
+	NewMarketPrice float64   `gorm:"type:decimal(10,2);not null;default:0;comment:变动后市场价"`
+	Source         string    `gorm:"type:varchar(16);not null;comment:create/manual/schedule/rollback"`
+	OperatorID     uint64    `gorm:"not null;default:0;comment:操作人ID"`
+	CreatedAt      time.Time `gorm:"index:idx_sku_created,priority:2;index:idx_spu_created,priority:2"`
+}
+
+func (SkuPriceHistory) TableName() string {
+	return "sku_price_history"
+}
+
+func CreatePriceHistory(ctx context.Context, db *gorm.DB, list []*SkuPriceHistory) error {
+	if len(list) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(&list).Error
+}
+
+// ListPriceHistory 查询商品的价格变动，skuID 为 0 时返回全部 SKU，超出 limit 时保留最近的记录，结果按时间正序
+func ListPriceHistory(ctx context.Context, db *gorm.DB, spuID, skuID uint64, since time.Time, limit int) ([]*SkuPriceHistory, error) {
+	var list []*SkuPriceHistory
+	query := db.WithContext(ctx).Where("spu_id = ?", spuID)
+	if skuID > 0 {
+		query = query.Where("sku_id = ?", skuID)
+	}
+	if !since.IsZero() {
+		query = query.Where("created_at >= ?", since)
+	}
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(list)-1; i < j; i, j = i+1, j-1 {
+		list[i], list[j] = list[j], list[i]
+	}
+	return list, nil
+}
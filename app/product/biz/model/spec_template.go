@@ -0,0 +1,81 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 规格属性类型
+const (
+	SpecTypeEnum   = "enum"   // 枚举，取值必须在可选值内
+	SpecTypeText   = "text"   // 自由文本
+	SpecTypeNumber = "number" // 数值
+)
+
+// SpecAttribute 分类规格模板中的一个属性，子分类继承上级分类的属性
+type SpecAttribute struct {
+	ID         uint64 `gorm:"primarykey"`
+	CategoryID uint64 `gorm:"not null;uniqueIndex:uk_category_name,priority:1;comment:分类ID"`
+	Name       string `gorm:"type:varchar(32);not null;uniqueIndex:uk_category_name,priority:2;comment:属性名"`
+	Type       string `gorm:"type:varchar(16);not null;default:'enum';comment:enum/text/number"`
+	Values     string `gorm:"type:text;comment:可选值JSON数组"`
+	Filterable bool   `gorm:"not null;default:false;comment:是否作为搜索筛选项"`
+	Sales      bool   `gorm:"not null;default:false;comment:是否销售属性"`
+	Sort       int    `gorm:"not null;default:0"`
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (SpecAttribute) TableName() string {
+	return "product_spec_attr"
+}
+
+func (a *SpecAttribute) SetValues(values []string) {
+	a.Values = ""
+	if len(values) > 0 {
+		data, _ := json.Marshal(values)
+		a.Values = string(data)
+	}
+}
+
+func (a *SpecAttribute) GetValues() []string {
+	if a.Values == "" {
+		return nil
+	}
+	var values []string
+	if err := json.Unmarshal([]byte(a.Values), &values); err != nil {
+		return nil
+	}
+	return values
+}
+
+// ListSpecAttributes 获取多个分类的规格属性，按分类、排序升序排列
+func ListSpecAttributes(ctx context.Context, db *gorm.DB, categoryIDs []uint64) ([]*SpecAttribute, error) {
+	if len(categoryIDs) == 0 {
+		return []*SpecAttribute{}, nil
+	}
+	var attrs []*SpecAttribute
+	err := db.WithContext(ctx).Where("category_id IN ?", categoryIDs).
+		Order("category_id ASC, sort ASC, id ASC").
+		Find(&attrs).Error
+	return attrs, err
+}
+
+// ReplaceSpecAttributes 全量覆盖分类自身的规格属性
+func ReplaceSpecAttributes(ctx context.Context, db *gorm.DB, categoryID uint64, attrs []*SpecAttribute) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("category_id = ?", categoryID).Delete(&SpecAttribute{}).Error; err != nil {
+			return err
+		}
+		if len(attrs) == 0 {
+			return nil
+		}
+		for _, a := range attrs {
+			a.CategoryID = categoryID
+		}
+		return tx.Create(&attrs).Error
+	})
+}
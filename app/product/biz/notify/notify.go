@@ -0,0 +1,58 @@
+// Package notify 降价提醒通知。SKU 降价越过用户设置的目标价时投递事件，
+// 钩子的注册与投递见 common/notify。
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+	"github.com/PiaoAdmin/pmall/common/notify"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 事件类型
+const (
+	EventPriceDropped = "price.dropped" // SKU 售价降到用户的目标价及以下
+)
+
+// Event 降价提醒事件，价格为两位小数的字符串
+type Event struct {
+	Type        string    `json:"type"`
+	AlertID     uint64    `json:"alert_id"`
+	RecipientID uint64    `json:"recipient_id"` // 订阅提醒的用户
+	SpuID       uint64    `json:"spu_id"`
+	SkuID       uint64    `json:"sku_id"`
+	SkuName     string    `json:"sku_name"`
+	OldPrice    string    `json:"old_price"`
+	NewPrice    string    `json:"new_price"`
+	TargetPrice string    `json:"target_price"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+var notifier notify.Notifier[*Event]
+
+// Init 根据配置注册默认钩子
+func Init() {
+	cfg := conf.GetConf().Notify
+	Register(notify.DefaultHook(cfg.WebhookURL, cfg.TimeoutSeconds, logHook))
+}
+
+// Register 注册钩子
+func Register(h notify.Hook[*Event]) {
+	notifier.Register(h)
+}
+
+// Deliver 同步执行全部钩子，全部成功才返回 nil，调用方据此决定提醒是否已通知
+func Deliver(ctx context.Context, e *Event) error {
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	return notifier.Deliver(ctx, e)
+}
+
+func logHook(_ context.Context, e *Event) error {
+	klog.Infof("Price alert event %s: alert=%d user=%d sku=%d price %s -> %s (target %s)",
+		e.Type, e.AlertID, e.RecipientID, e.SkuID, e.OldPrice, e.NewPrice, e.TargetPrice)
+	return nil
+}
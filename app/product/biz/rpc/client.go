@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"sync"
+
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+	"github.com/PiaoAdmin/pmall/common/clientsuite"
+	"github.com/PiaoAdmin/pmall/rpc_gen/review/reviewservice"
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/cloudwego/kitex/pkg/transmeta"
+	"github.com/cloudwego/kitex/transport"
+)
+
+var (
+	ReviewClient reviewservice.Client
+	once         sync.Once
+	err          error
+	registryAddr string
+	serviceName  string
+)
+
+func Init() {
+	once.Do(func() {
+		if conf.GetConf().Env == "test" {
+			initReviewClientDirect("127.0.0.1:9905")
+			return
+		}
+		registryAddr = conf.GetConf().Registry.RegistryAddress[0]
+		serviceName = conf.GetConf().Kitex.Service
+		initReviewClient()
+	})
+}
+
+func initReviewClientDirect(addr string) {
+	ReviewClient, err = reviewservice.NewClient("review",
+		client.WithHostPorts(addr),
+		client.WithMetaHandler(transmeta.ClientHTTP2Handler),
+		client.WithTransportProtocol(transport.GRPC))
+	if err != nil {
+		klog.Fatal(err)
+	}
+}
+
+func initReviewClient() {
+	opts := []client.Option{
+		client.WithSuite(clientsuite.CommonGrpcClientSuite{
+			RegistryAddr:       registryAddr,
+			CurrentServiceName: serviceName,
+		}),
+	}
+
+	ReviewClient, err = reviewservice.NewClient("review", opts...)
+	if err != nil {
+		klog.Fatalf(err.Error())
+		panic(err)
+	}
+}
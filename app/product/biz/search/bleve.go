@@ -0,0 +1,499 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	blevesearch "github.com/blevesearch/bleve/v2/search"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	index "github.com/blevesearch/bleve_index_api"
+)
+
+const DriverBleve = "bleve"
+
+// mappingVersion 索引映射变更时递增
+const mappingVersion = "2"
+
+var mappingVersionKey = []byte("mapping_version")
+
+// 一个 SPU 下 SKU 数量的上限，用于 Replace 时查出旧文档
+const maxSkusPerSpu = 1000
+
+// 分面返回的取值个数上限，规格分面包含所有规格名下的取值，上限更大
+const (
+	maxFacetTerms     = 50
+	maxSpecFacetTerms = 500
+)
+
+// 关键词匹配的字段及权重，名称类字段权重最高
+var textFields = []struct {
+	name  string
+	boost float64
+}{
+	{"spu_name", 3},
+	{"sku_name", 3},
+	{"brand_name", 2},
+	{"category_name", 2},
+	{"sub_title", 1.5},
+	{"tech_tags", 1},
+	{"description", 0.5},
+}
+
+var highlightFields = []string{"spu_name", "sku_name", "sub_title", "description"}
+
+func init() {
+	Register(DriverBleve, newBleveEngine)
+}
+
+// bleveEngine 基于嵌入式 Bleve 的实现，文本字段使用 CJK 二元分词，BM25 打分
+type bleveEngine struct {
+	index bleve.Index
+	// Replace 需要先查后写，串行执行避免同一 SPU 的并发替换互相覆盖
+	mu sync.Mutex
+}
+
+func newBleveEngine(cfg conf.Search) (Engine, error) {
+	if cfg.Path == "" {
+		idx, err := bleve.NewMemOnly(buildMapping())
+		if err != nil {
+			return nil, err
+		}
+		return &bleveEngine{index: idx}, nil
+	}
+
+	idx, err := bleve.Open(cfg.Path)
+	if err == nil {
+		// 映射版本不一致时丢弃旧索引，启动后由 RebuildIfEmpty 重建
+		version, verr := idx.GetInternal(mappingVersionKey)
+		if verr == nil && string(version) == mappingVersion {
+			return &bleveEngine{index: idx}, nil
+		}
+		idx.Close()
+		if err := os.RemoveAll(cfg.Path); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		return nil, err
+	}
+
+	idx, err = bleve.New(cfg.Path, buildMapping())
+	if err != nil {
+		return nil, err
+	}
+	if err := idx.SetInternal(mappingVersionKey, []byte(mappingVersion)); err != nil {
+		idx.Close()
+		return nil, err
+	}
+	return &bleveEngine{index: idx}, nil
+}
+
+func buildMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = cjk.AnalyzerName
+	text.Store = true
+	text.IncludeTermVectors = true
+
+	id := bleve.NewTextFieldMapping()
+	id.Analyzer = keyword.Name
+	id.Store = false
+	id.IncludeInAll = false
+
+	num := bleve.NewNumericFieldMapping()
+	num.Store = false
+	num.IncludeInAll = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	for _, f := range textFields {
+		doc.AddFieldMappingsAt(f.name, text)
+	}
+	for _, f := range []string{"spu_id", "brand_id", "category_id", "specs"} {
+		doc.AddFieldMappingsAt(f, id)
+	}
+	for _, f := range []string{"price", "stock", "sale_count", "sort"} {
+		doc.AddFieldMappingsAt(f, num)
+	}
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	m.DefaultAnalyzer = cjk.AnalyzerName
+	m.ScoringModel = index.BM25Scoring
+	return m
+}
+
+// ID 类字段以字符串存储，雪花 ID 超出 float64 精度，不能走数值字段
+func formatID(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
+
+// 规格以 "规格名:规格值" 的形式存为多值关键词字段，分面统计后再拆开
+const specSep = ":"
+
+func specTerm(name, value string) string {
+	return name + specSep + value
+}
+
+func toFields(d *Document) map[string]interface{} {
+	specs := make([]string, 0, len(d.Specs))
+	for name, value := range d.Specs {
+		specs = append(specs, specTerm(name, value))
+	}
+	return map[string]interface{}{
+		"spu_name":      d.SpuName,
+		"sku_name":      d.SkuName,
+		"sub_title":     d.SubTitle,
+		"brand_name":    d.BrandName,
+		"category_name": d.CategoryName,
+		"tech_tags":     d.TechTags,
+		"description":   d.Description,
+		"spu_id":        formatID(d.SpuID),
+		"brand_id":      formatID(d.BrandID),
+		"category_id":   formatID(d.CategoryID),
+		"specs":         specs,
+		"price":         d.Price,
+		"stock":         float64(d.Stock),
+		"sale_count":    float64(d.SaleCount),
+		"sort":          float64(d.Sort),
+	}
+}
+
+func termQuery(field, term string) query.Query {
+	q := bleve.NewTermQuery(term)
+	q.SetField(field)
+	return q
+}
+
+func (e *bleveEngine) Replace(ctx context.Context, spuID uint64, docs []*Document) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	req := bleve.NewSearchRequestOptions(termQuery("spu_id", formatID(spuID)), maxSkusPerSpu, 0, false)
+	res, err := e.index.SearchInContext(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	batch := e.index.NewBatch()
+	keep := make(map[string]bool, len(docs))
+	for _, d := range docs {
+		id := formatID(d.SkuID)
+		keep[id] = true
+		if err := batch.Index(id, toFields(d)); err != nil {
+			return err
+		}
+	}
+	for _, hit := range res.Hits {
+		if !keep[hit.ID] {
+			batch.Delete(hit.ID)
+		}
+	}
+	if batch.Size() == 0 {
+		return nil
+	}
+	return e.index.Batch(batch)
+}
+
+// keywordQuery 任一字段包含全部关键词即命中，多字段命中时 BM25 得分累加
+func keywordQuery(keyword string) query.Query {
+	fields := make([]query.Query, 0, len(textFields))
+	for _, f := range textFields {
+		q := bleve.NewMatchQuery(keyword)
+		q.SetField(f.name)
+		q.SetBoost(f.boost)
+		q.SetOperator(query.MatchQueryOperatorAnd)
+		fields = append(fields, q)
+	}
+	return bleve.NewDisjunctionQuery(fields...)
+}
+
+func sortOrder(sortType int32) []string {
+	switch sortType {
+	case SortPriceAsc:
+		return []string{"price", "-_score"}
+	case SortPriceDesc:
+		return []string{"-price", "-_score"}
+	case SortSales:
+		return []string{"-sale_count", "-_score"}
+	default:
+		return []string{"-_score", "-sort", "-sale_count"}
+	}
+}
+
+// facetFilter 一组分面筛选，key 为 brand、category、price 或 spec:<规格名>
+type facetFilter struct {
+	key   string
+	query query.Query
+}
+
+func termsQuery(field string, terms []string) query.Query {
+	qs := make([]query.Query, 0, len(terms))
+	for _, t := range terms {
+		qs = append(qs, termQuery(field, t))
+	}
+	return bleve.NewDisjunctionQuery(qs...)
+}
+
+func idTerms(ids []uint64) []string {
+	terms := make([]string, 0, len(ids))
+	for _, id := range ids {
+		terms = append(terms, formatID(id))
+	}
+	return terms
+}
+
+// baseFilters 不参与分面的筛选条件：库存、关键词以及单选的分类和品牌
+func baseFilters(q *Query, keyword string) []query.Query {
+	inclusive := true
+	minStock := 1.0
+	stockQuery := bleve.NewNumericRangeInclusiveQuery(&minStock, nil, &inclusive, nil)
+	stockQuery.SetField("stock")
+	filters := []query.Query{stockQuery}
+
+	if keyword != "" {
+		filters = append(filters, keywordQuery(keyword))
+	}
+	if q.CategoryID > 0 {
+		filters = append(filters, termQuery("category_id", formatID(q.CategoryID)))
+	}
+	if q.BrandID > 0 {
+		filters = append(filters, termQuery("brand_id", formatID(q.BrandID)))
+	}
+	return filters
+}
+
+// facetFilters 分面上的筛选条件，价格区间视为价格分面的筛选
+func facetFilters(q *Query) []facetFilter {
+	var filters []facetFilter
+	if len(q.BrandIDs) > 0 {
+		filters = append(filters, facetFilter{FacetBrand, termsQuery("brand_id", idTerms(q.BrandIDs))})
+	}
+	if len(q.CategoryIDs) > 0 {
+		filters = append(filters, facetFilter{FacetCategory, termsQuery("category_id", idTerms(q.CategoryIDs))})
+	}
+	if q.MinPrice > 0 || q.MaxPrice > 0 {
+		inclusive := true
+		var min, max *float64
+		if q.MinPrice > 0 {
+			min = &q.MinPrice
+		}
+		if q.MaxPrice > 0 {
+			max = &q.MaxPrice
+		}
+		priceQuery := bleve.NewNumericRangeInclusiveQuery(min, max, &inclusive, &inclusive)
+		priceQuery.SetField("price")
+		filters = append(filters, facetFilter{FacetPrice, priceQuery})
+	}
+
+	names := make([]string, 0, len(q.Specs))
+	for name, values := range q.Specs {
+		if len(values) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		terms := make([]string, 0, len(q.Specs[name]))
+		for _, v := range q.Specs[name] {
+			terms = append(terms, specTerm(name, v))
+		}
+		filters = append(filters, facetFilter{FacetSpec + specSep + name, termsQuery("specs", terms)})
+	}
+	return filters
+}
+
+// filterQuery 组合筛选条件，exclude 对应的分面筛选不参与
+func filterQuery(base []query.Query, filters []facetFilter, exclude string) query.Query {
+	conjuncts := append([]query.Query{}, base...)
+	for _, f := range filters {
+		if f.key != exclude {
+			conjuncts = append(conjuncts, f.query)
+		}
+	}
+	return bleve.NewConjunctionQuery(conjuncts...)
+}
+
+func facetRequest(field string) *bleve.FacetRequest {
+	switch field {
+	case FacetBrand:
+		return bleve.NewFacetRequest("brand_id", maxFacetTerms)
+	case FacetCategory:
+		return bleve.NewFacetRequest("category_id", maxFacetTerms)
+	case FacetPrice:
+		fr := bleve.NewFacetRequest("price", len(PriceRanges))
+		for _, r := range PriceRanges {
+			min, max := r.Min, r.Max
+			if max == 0 {
+				fr.AddNumericRange(r.String(), &min, nil)
+			} else {
+				fr.AddNumericRange(r.String(), &min, &max)
+			}
+		}
+		return fr
+	default:
+		return bleve.NewFacetRequest("specs", maxSpecFacetTerms)
+	}
+}
+
+func (e *bleveEngine) Search(ctx context.Context, q *Query) (*Result, error) {
+	keyword := strings.TrimSpace(q.Keyword)
+	base := baseFilters(q, keyword)
+	filters := facetFilters(q)
+	selected := make(map[string]bool, len(filters))
+	for _, f := range filters {
+		selected[f.key] = true
+	}
+
+	req := bleve.NewSearchRequestOptions(filterQuery(base, filters, ""), q.PageSize, (q.Page-1)*q.PageSize, false)
+	req.SortBy(sortOrder(q.SortType))
+	if keyword != "" {
+		req.Highlight = bleve.NewHighlightWithStyle(html.Name)
+		for _, f := range highlightFields {
+			req.Highlight.AddField(f)
+		}
+	}
+	// 未选中的分面直接在主查询上统计
+	for _, field := range []string{FacetBrand, FacetCategory, FacetPrice, FacetSpec} {
+		if !selected[field] {
+			req.AddFacet(field, facetRequest(field))
+		}
+	}
+
+	res, err := e.index.SearchInContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]Hit, 0, len(res.Hits))
+	for _, h := range res.Hits {
+		skuID, err := strconv.ParseUint(h.ID, 10, 64)
+		if err != nil {
+			continue
+		}
+		hit := Hit{SkuID: skuID, Score: h.Score}
+		for field, fragments := range h.Fragments {
+			if len(fragments) == 0 {
+				continue
+			}
+			if hit.Highlights == nil {
+				hit.Highlights = make(map[string]string)
+			}
+			hit.Highlights[field] = fragments[0]
+		}
+		hits = append(hits, hit)
+	}
+
+	facets := res.Facets
+	if facets == nil {
+		facets = blevesearch.FacetResults{}
+	}
+	specFacets := map[string][]FacetValue{}
+	if fr := res.Facets[FacetSpec]; fr != nil {
+		for name, values := range splitSpecFacet(fr) {
+			if !selected[FacetSpec+specSep+name] {
+				specFacets[name] = values
+			}
+		}
+	}
+
+	// 已选中的分面去掉自身筛选单独统计，保证多选时其他选项的数量仍然可见
+	for _, f := range filters {
+		field, specName, _ := strings.Cut(f.key, specSep)
+		facetReq := bleve.NewSearchRequestOptions(filterQuery(base, filters, f.key), 0, 0, false)
+		fr := facetRequest(field)
+		if field == FacetSpec {
+			fr.SetPrefixFilter(specTerm(specName, ""))
+		}
+		facetReq.AddFacet(field, fr)
+		facetRes, err := e.index.SearchInContext(ctx, facetReq)
+		if err != nil {
+			return nil, err
+		}
+		if field == FacetSpec {
+			specFacets[specName] = splitSpecFacet(facetRes.Facets[field])[specName]
+		} else {
+			facets[field] = facetRes.Facets[field]
+		}
+	}
+
+	return &Result{Hits: hits, Total: res.Total, Facets: buildFacets(facets, specFacets)}, nil
+}
+
+// splitSpecFacet 把 "规格名:规格值" 的统计拆成按规格名分组
+func splitSpecFacet(fr *blevesearch.FacetResult) map[string][]FacetValue {
+	specs := map[string][]FacetValue{}
+	if fr == nil || fr.Terms == nil {
+		return specs
+	}
+	for _, t := range fr.Terms.Terms() {
+		name, value, ok := strings.Cut(t.Term, specSep)
+		if !ok {
+			continue
+		}
+		specs[name] = append(specs[name], FacetValue{Value: value, Count: t.Count})
+	}
+	return specs
+}
+
+// buildFacets 按品牌、分类、价格、规格（按名称排序）的顺序输出，价格区间保持固定顺序
+func buildFacets(facets map[string]*blevesearch.FacetResult, specFacets map[string][]FacetValue) []Facet {
+	result := make([]Facet, 0, 3+len(specFacets))
+	for _, field := range []string{FacetBrand, FacetCategory} {
+		fr := facets[field]
+		if fr == nil || fr.Terms == nil {
+			continue
+		}
+		facet := Facet{Field: field}
+		for _, t := range fr.Terms.Terms() {
+			facet.Values = append(facet.Values, FacetValue{Value: t.Term, Count: t.Count})
+		}
+		if len(facet.Values) > 0 {
+			result = append(result, facet)
+		}
+	}
+
+	if fr := facets[FacetPrice]; fr != nil {
+		counts := make(map[string]int, len(fr.NumericRanges))
+		for _, r := range fr.NumericRanges {
+			counts[r.Name] = r.Count
+		}
+		facet := Facet{Field: FacetPrice}
+		for _, r := range PriceRanges {
+			if n := counts[r.String()]; n > 0 {
+				facet.Values = append(facet.Values, FacetValue{Value: r.String(), Count: n})
+			}
+		}
+		if len(facet.Values) > 0 {
+			result = append(result, facet)
+		}
+	}
+
+	names := make([]string, 0, len(specFacets))
+	for name, values := range specFacets {
+		if len(values) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		result = append(result, Facet{Field: FacetSpec, Name: name, Values: specFacets[name]})
+	}
+	return result
+}
+
+func (e *bleveEngine) Count() (uint64, error) {
+	return e.index.DocCount()
+}
+
+func (e *bleveEngine) Close() error {
+	return e.index.Close()
+}
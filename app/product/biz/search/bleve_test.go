@@ -0,0 +1,227 @@
+package search
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+)
+
+func testDocs() []*Document {
+	return []*Document{
+		{SkuID: 101, SpuID: 1, SpuName: "小米17", SkuName: "小米17 12GB+256GB 曜石黑", BrandID: 10, BrandName: "小米", CategoryID: 20, CategoryName: "手机", TechTags: "骁龙8 Gen4 5000mAh", Price: 3999, Stock: 100, SaleCount: 50, Specs: map[string]string{"颜色": "曜石黑", "存储": "256GB"}},
+		{SkuID: 102, SpuID: 1, SpuName: "小米17", SkuName: "小米17 16GB+512GB 雪山白", BrandID: 10, BrandName: "小米", CategoryID: 20, CategoryName: "手机", TechTags: "骁龙8 Gen4 5000mAh", Price: 4599, Stock: 0, SaleCount: 50, Specs: map[string]string{"颜色": "雪山白", "存储": "512GB"}},
+		{SkuID: 201, SpuID: 2, SpuName: "红米K90", SkuName: "红米K90 12GB+256GB", BrandID: 11, BrandName: "红米", CategoryID: 20, CategoryName: "手机", TechTags: "天玑9300+ 6000mAh", Price: 2499, Stock: 10, SaleCount: 200, Specs: map[string]string{"颜色": "曜石黑", "存储": "256GB"}},
+		{SkuID: 301, SpuID: 3, SpuName: "格力云佳空调", SkuName: "格力云佳 1.5匹 新一级能效", BrandID: 12, BrandName: "格力", CategoryID: 30, CategoryName: "空调", Description: "静音节能，适合卧室使用", Price: 2999, Stock: 5, SaleCount: 80},
+	}
+}
+
+func newTestEngine(t *testing.T) Engine {
+	t.Helper()
+	engine, err := New(conf.Search{Driver: DriverBleve})
+	if err != nil {
+		t.Fatalf("new engine: %v", err)
+	}
+	t.Cleanup(func() { engine.Close() })
+
+	ctx := context.Background()
+	bySpu := map[uint64][]*Document{}
+	for _, d := range testDocs() {
+		bySpu[d.SpuID] = append(bySpu[d.SpuID], d)
+	}
+	for spuID, docs := range bySpu {
+		if err := engine.Replace(ctx, spuID, docs); err != nil {
+			t.Fatalf("replace spu %d: %v", spuID, err)
+		}
+	}
+	return engine
+}
+
+func skuIDs(res *Result) []uint64 {
+	ids := make([]uint64, 0, len(res.Hits))
+	for _, h := range res.Hits {
+		ids = append(ids, h.SkuID)
+	}
+	return ids
+}
+
+func TestBleveSearchKeyword(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	// 中文关键词能切词命中，无库存的 SKU 被过滤
+	res, err := engine.Search(ctx, &Query{Keyword: "小米", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 1 || res.Hits[0].SkuID != 101 {
+		t.Fatalf("expected only sku 101, got %v", skuIDs(res))
+	}
+	if h := res.Hits[0].Highlights["spu_name"]; !strings.Contains(h, "<mark>") {
+		t.Errorf("expected highlighted spu_name, got %q", h)
+	}
+
+	// 详情描述参与检索
+	res, err = engine.Search(ctx, &Query{Keyword: "静音", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 1 || res.Hits[0].SkuID != 301 {
+		t.Fatalf("expected sku 301 by description, got %v", skuIDs(res))
+	}
+
+	// 品类名命中
+	res, err = engine.Search(ctx, &Query{Keyword: "手机", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 2 {
+		t.Fatalf("expected 2 phones in stock, got %v", skuIDs(res))
+	}
+}
+
+func TestBleveSearchFilterAndSort(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	res, err := engine.Search(ctx, &Query{CategoryID: 20, SortType: SortPriceAsc, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if ids := skuIDs(res); len(ids) != 2 || ids[0] != 201 || ids[1] != 101 {
+		t.Fatalf("expected [201 101], got %v", ids)
+	}
+
+	res, err = engine.Search(ctx, &Query{MinPrice: 2500, MaxPrice: 3000, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if ids := skuIDs(res); len(ids) != 1 || ids[0] != 301 {
+		t.Fatalf("expected [301], got %v", ids)
+	}
+
+	res, err = engine.Search(ctx, &Query{SortType: SortSales, Page: 2, PageSize: 1})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 3 || len(res.Hits) != 1 || res.Hits[0].SkuID != 301 {
+		t.Fatalf("expected second page [301] of 3, got %v total %d", skuIDs(res), res.Total)
+	}
+}
+
+func facetValues(res *Result, field, name string) map[string]int {
+	for _, f := range res.Facets {
+		if f.Field == field && f.Name == name {
+			values := make(map[string]int, len(f.Values))
+			for _, v := range f.Values {
+				values[v.Value] = v.Count
+			}
+			return values
+		}
+	}
+	return nil
+}
+
+func TestBleveSearchFacets(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	res, err := engine.Search(ctx, &Query{Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if got := facetValues(res, FacetBrand, ""); got["10"] != 1 || got["11"] != 1 || got["12"] != 1 {
+		t.Errorf("unexpected brand facet: %v", got)
+	}
+	if got := facetValues(res, FacetCategory, ""); got["20"] != 2 || got["30"] != 1 {
+		t.Errorf("unexpected category facet: %v", got)
+	}
+	if got := facetValues(res, FacetPrice, ""); got["2000-5000"] != 3 {
+		t.Errorf("unexpected price facet: %v", got)
+	}
+	// 无库存的雪山白不计入
+	if got := facetValues(res, FacetSpec, "颜色"); len(got) != 1 || got["曜石黑"] != 2 {
+		t.Errorf("unexpected color facet: %v", got)
+	}
+
+	// 选中品牌后，品牌分面仍按未选品牌统计，其他分面按选中结果统计
+	res, err = engine.Search(ctx, &Query{BrandIDs: []uint64{10}, Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 1 {
+		t.Fatalf("expected 1 hit for brand 10, got %v", skuIDs(res))
+	}
+	if got := facetValues(res, FacetBrand, ""); len(got) != 3 {
+		t.Errorf("brand facet should ignore its own filter: %v", got)
+	}
+	if got := facetValues(res, FacetCategory, ""); len(got) != 1 || got["20"] != 1 {
+		t.Errorf("category facet should apply brand filter: %v", got)
+	}
+
+	// 同一分面内多选为或关系，规格分面与品牌筛选组合
+	res, err = engine.Search(ctx, &Query{
+		BrandIDs: []uint64{10, 11},
+		Specs:    map[string][]string{"存储": {"256GB"}},
+		Page:     1,
+		PageSize: 10,
+	})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 2 {
+		t.Fatalf("expected 2 hits, got %v", skuIDs(res))
+	}
+	if got := facetValues(res, FacetSpec, "存储"); got["256GB"] != 2 {
+		t.Errorf("unexpected storage facet: %v", got)
+	}
+}
+
+func TestBleveReplace(t *testing.T) {
+	engine := newTestEngine(t)
+	ctx := context.Background()
+
+	// 替换后旧 SKU 被删除
+	docs := testDocs()[:1]
+	docs[0].SpuName = "小米17 Pro"
+	if err := engine.Replace(ctx, 1, docs); err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	if n, _ := engine.Count(); n != 3 {
+		t.Fatalf("expected 3 docs after replace, got %d", n)
+	}
+
+	if err := engine.Replace(ctx, 1, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	res, err := engine.Search(ctx, &Query{Keyword: "小米", Page: 1, PageSize: 10})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if res.Total != 0 {
+		t.Fatalf("expected no hits after delete, got %v", skuIDs(res))
+	}
+}
+
+func TestBlevePersistentIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "search.bleve")
+	engine, err := New(conf.Search{Driver: DriverBleve, Path: path})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if err := engine.Replace(context.Background(), 1, testDocs()[:2]); err != nil {
+		t.Fatalf("replace: %v", err)
+	}
+	engine.Close()
+
+	engine, err = New(conf.Search{Driver: DriverBleve, Path: path})
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer engine.Close()
+	if n, _ := engine.Count(); n != 2 {
+		t.Fatalf("expected 2 docs after reopen, got %d", n)
+	}
+}
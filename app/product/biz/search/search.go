@@ -0,0 +1,160 @@
+// Package search 商品全文检索。索引以 SKU 为文档，按 SPU 整体替换，通过 driver 配置切换实现。
+package search
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 排序方式，与 SearchProductsRequest.sort_type 一致
+const (
+	SortRelevance = 0 // 综合（相关度）
+	SortPriceAsc  = 1
+	SortPriceDesc = 2
+	SortSales     = 3
+)
+
+// 分面类型，与 SearchFacet.field 一致
+const (
+	FacetBrand    = "brand"
+	FacetCategory = "category"
+	FacetPrice    = "price"
+	FacetSpec     = "spec"
+)
+
+// PriceRange 价格分面区间，左闭右开，Max 为 0 表示不封顶
+type PriceRange struct {
+	Min float64
+	Max float64
+}
+
+// String 区间的筛选值，如 1000-2000、10000-
+func (r PriceRange) String() string {
+	if r.Max == 0 {
+		return strconv.FormatFloat(r.Min, 'f', -1, 64) + "-"
+	}
+	return strconv.FormatFloat(r.Min, 'f', -1, 64) + "-" + strconv.FormatFloat(r.Max, 'f', -1, 64)
+}
+
+// PriceRanges 价格分面的固定区间
+var PriceRanges = []PriceRange{
+	{0, 500},
+	{500, 1000},
+	{1000, 2000},
+	{2000, 5000},
+	{5000, 10000},
+	{10000, 0},
+}
+
+// Document 索引文档，一个 SKU 一条
+type Document struct {
+	SkuID        uint64
+	SpuID        uint64
+	SpuName      string
+	SkuName      string
+	SubTitle     string
+	BrandID      uint64
+	BrandName    string
+	CategoryID   uint64
+	CategoryName string
+	TechTags     string // 技术参数中的文本值，空格分隔
+	Description  string // 去掉标签后的详情描述
+	Price        float64
+	Stock        int
+	SaleCount    int               // SPU 销量
+	Sort         int               // SPU 排序权重
+	Specs        map[string]string // SKU 规格键值对，来自 sku_spec_data
+}
+
+// Query 检索条件
+type Query struct {
+	Keyword    string
+	CategoryID uint64
+	BrandID    uint64
+	MinPrice   float64 // 0 表示不限
+	MaxPrice   float64 // 0 表示不限
+	SortType   int32
+	Page       int
+	PageSize   int
+	// 分面多选，同一分面内为或关系；计算某个分面的统计时忽略它自身的筛选
+	BrandIDs    []uint64
+	CategoryIDs []uint64
+	Specs       map[string][]string // 规格名 -> 可选值
+}
+
+// Hit 命中的 SKU
+type Hit struct {
+	SkuID      uint64
+	Score      float64
+	Highlights map[string]string // 字段 -> 高亮片段，关键词以 <mark> 包裹
+}
+
+// FacetValue 分面取值及命中数
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+// Facet 一个分面的统计结果，Name 仅规格分面有值
+type Facet struct {
+	Field  string
+	Name   string
+	Values []FacetValue
+}
+
+// Result 检索结果，Hits 已按排序方式排好，Facets 基于全部命中结果统计
+type Result struct {
+	Hits   []Hit
+	Total  uint64
+	Facets []Facet
+}
+
+// Engine 检索引擎接口
+type Engine interface {
+	// Replace 用 docs 替换 SPU 下已索引的全部 SKU，docs 为空时删除该 SPU
+	Replace(ctx context.Context, spuID uint64, docs []*Document) error
+	Search(ctx context.Context, q *Query) (*Result, error)
+	// Count 返回已索引的文档数
+	Count() (uint64, error)
+	Close() error
+}
+
+// Factory 根据配置创建引擎
+type Factory func(cfg conf.Search) (Engine, error)
+
+var (
+	factories = map[string]Factory{}
+	// Default 全局检索引擎，未配置 driver 时为 nil，搜索走 SQL
+	Default Engine
+)
+
+// Register 注册引擎实现，在 init 中调用
+func Register(driver string, f Factory) {
+	factories[driver] = f
+}
+
+// New 按配置的 driver 创建引擎，driver 为空时返回 nil
+func New(cfg conf.Search) (Engine, error) {
+	if cfg.Driver == "" {
+		return nil, nil
+	}
+	f, ok := factories[cfg.Driver]
+	if !ok {
+		return nil, fmt.Errorf("unknown search driver %q", cfg.Driver)
+	}
+	return f(cfg)
+}
+
+// Init 按全局配置初始化 Default，失败时记录日志并保持 nil，搜索退回 SQL
+func Init() {
+	engine, err := New(conf.GetConf().Search)
+	if err != nil {
+		klog.Errorf("Failed to init search engine, falling back to SQL search: %v", err)
+		return
+	}
+	Default = engine
+}
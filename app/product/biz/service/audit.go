@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// 商品审核限制
+const (
+	maxAuditBatch     = 100
+	maxAuditReasonLen = 255
+)
+
+// submitForAudit 商品内容被修改后重新进入待审核，审核通过前不会出现在前台搜索中
+func submitForAudit(ctx context.Context, spuIDs []uint64) error {
+	if err := model.SubmitForAudit(ctx, mysql.DB, spuIDs); err != nil {
+		return errs.New(errs.ErrInternal.Code, "submit product for audit failed: "+err.Error())
+	}
+	return nil
+}
+
+func toProtoSPU(spu *model.ProductSPU) *product.ProductSPU {
+	return &product.ProductSPU{
+		Id:            spu.ID,
+		BrandId:       spu.BrandID,
+		CategoryId:    spu.CategoryID,
+		Name:          spu.Name,
+		SubTitle:      spu.SubTitle,
+		MainImage:     spu.MainImage,
+		MerchantId:    spu.MerchantID,
+		PublishStatus: int32(spu.PublishStatus),
+		VerifyStatus:  int32(spu.VerifyStatus),
+		LowPrice:      utils.PriceToString(spu.LowPrice),
+		HighPrice:     utils.PriceToString(spu.HighPrice),
+		SaleCount:     int32(spu.SaleCount),
+		Sort:          int32(spu.Sort),
+		ServiceBits:   spu.ServiceBits,
+		Version:       int32(spu.Version),
+	}
+}
+
+func toProtoProductAudit(a *model.ProductAudit) *product.ProductAudit {
+	return &product.ProductAudit{
+		Id:           a.ID,
+		SpuId:        a.SpuID,
+		VerifyStatus: int32(a.VerifyStatus),
+		Reason:       a.Reason,
+		OperatorId:   a.OperatorID,
+		CreatedAt:    a.CreatedAt.Unix(),
+	}
+}
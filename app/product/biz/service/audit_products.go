@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type AuditProductsService struct {
+	ctx context.Context
+}
+
+func NewAuditProductsService(ctx context.Context) *AuditProductsService {
+	return &AuditProductsService{ctx: ctx}
+}
+
+// Run 审核待审核的商品，通过后已上架的商品才会出现在前台；驳回必须填写原因
+func (s *AuditProductsService) Run(req *product.AuditProductsRequest) (*product.AuditProductsResponse, error) {
+	if len(req.Ids) == 0 || len(req.Ids) > maxAuditBatch {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("ids must contain 1-%d items", maxAuditBatch))
+	}
+	status := int8(req.VerifyStatus)
+	if status != utils.VerifyStatusApproved && status != utils.VerifyStatusNotApproved {
+		return nil, errs.New(errs.ErrParam.Code, "verify_status must be 1 (approved) or 2 (rejected)")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if status == utils.VerifyStatusNotApproved && reason == "" {
+		return nil, errs.New(errs.ErrParam.Code, "reason is required when rejecting")
+	}
+	if utf8.RuneCountInString(reason) > maxAuditReasonLen {
+		return nil, errs.New(errs.ErrParam.Code, "reason too long")
+	}
+
+	ids, err := model.AuditSPUs(s.ctx, mysql.DB, req.Ids, status, reason, req.OperatorId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "audit products failed: "+err.Error())
+	}
+	if len(ids) > 0 {
+		invalidateProductCaches(s.ctx, ids)
+		refreshSearchIndex(s.ctx, ids)
+	}
+
+	return &product.AuditProductsResponse{Affected: int64(len(ids))}, nil
+}
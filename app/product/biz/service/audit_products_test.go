@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// 参数校验失败时不访问数据库
+func TestAuditProductsValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *product.AuditProductsRequest
+	}{
+		{name: "ids 为空", req: &product.AuditProductsRequest{VerifyStatus: 1}},
+		{name: "ids 过多", req: &product.AuditProductsRequest{Ids: make([]uint64, maxAuditBatch+1), VerifyStatus: 1}},
+		{name: "非法状态", req: &product.AuditProductsRequest{Ids: []uint64{1}, VerifyStatus: 0}},
+		{name: "驳回无原因", req: &product.AuditProductsRequest{Ids: []uint64{1}, VerifyStatus: 2, Reason: "  "}},
+		{name: "原因过长", req: &product.AuditProductsRequest{Ids: []uint64{1}, VerifyStatus: 2, Reason: strings.Repeat("长", maxAuditReasonLen+1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewAuditProductsService(context.Background()).Run(tt.req); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
 	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
 	"gorm.io/gorm"
 )
 
@@ -24,6 +26,24 @@ func (s *BatchUpdateSkuService) Run(req *product.BatchUpdateSkuRequest) (*produc
 		return &product.BatchUpdateSkuResponse{Success: true}, nil
 	}
 
+	if err := s.checkSpecs(req.Skus); err != nil {
+		return nil, err
+	}
+	skuIDs := make([]uint64, 0, len(req.Skus))
+	for _, sku := range req.Skus {
+		skuIDs = append(skuIDs, sku.Id)
+	}
+	if err := checkSKUOwner(s.ctx, req.MerchantId, skuIDs); err != nil {
+		return nil, err
+	}
+	if err := resolveMediaRefs(s.ctx, req.MerchantId, nil, req.Skus, nil); err != nil {
+		return nil, err
+	}
+	before := s.loadSnapshots(req.Skus)
+
+	// 名称、副标题、主图、规格属于商品内容，修改后所属商品需要重新审核；价格和库存不需要
+	var auditSkuIDs, auditSpuIDs []uint64
+	var priceChanges []*model.SkuPriceHistory
 	err := mysql.DB.Transaction(func(tx *gorm.DB) error {
 		for _, sku := range req.Skus {
 			if sku.Id == 0 {
@@ -69,15 +89,23 @@ func (s *BatchUpdateSkuService) Run(req *product.BatchUpdateSkuRequest) (*produc
 				}
 				updates["sku_spec_data"] = sku.SkuSpecData
 			}
+			if sku.Name != "" || sku.SubTitle != "" || sku.MainImage != "" || sku.SkuSpecData != "" {
+				auditSkuIDs = append(auditSkuIDs, sku.Id)
+			}
 			// TODO: 最好批量更新
 			if len(updates) > 0 {
-				err := model.UpdateSKU(s.ctx, tx, sku.Id, updates)
+				change, err := updateSKUWithPriceHistory(s.ctx, tx, sku.Id, updates, model.PriceSourceManual, req.OperatorId)
 				if err != nil {
 					return err
 				}
+				if change != nil {
+					priceChanges = append(priceChanges, change)
+				}
 			}
 		}
-		return nil
+		var err error
+		auditSpuIDs, err = s.submitForAudit(tx, auditSkuIDs)
+		return err
 	})
 
 	if err != nil {
@@ -87,7 +115,123 @@ func (s *BatchUpdateSkuService) Run(req *product.BatchUpdateSkuRequest) (*produc
 		return nil, errs.New(errs.ErrInternal.Code, "batch update sku failed: "+err.Error())
 	}
 
+	if len(auditSpuIDs) > 0 {
+		invalidateProductCaches(s.ctx, auditSpuIDs)
+	}
+	notifyPriceDrops(s.ctx, priceChanges)
+
+	refreshSearchIndexBySkus(s.ctx, skuIDs)
+	for spuID, snapshot := range before {
+		if _, err := recordProductChange(s.ctx, spuID, req.OperatorId, model.HistoryActionUpdateSku, 0, snapshot); err != nil {
+			klog.Warnf("Failed to record product history: %v", err)
+		}
+	}
+
 	return &product.BatchUpdateSkuResponse{
 		Success: true,
 	}, nil
 }
+
+// checkSpecs 按所属分类的规格模板校验修改了规格的 SKU，
+// 同一商品下未修改的 SKU 也参与销售属性组合的去重
+func (s *BatchUpdateSkuService) checkSpecs(reqSkus []*product.ProductSKU) error {
+	newSpecs := make(map[uint64]string)
+	ids := make([]uint64, 0, len(reqSkus))
+	for _, sku := range reqSkus {
+		if sku.Id != 0 && sku.SkuSpecData != "" {
+			newSpecs[sku.Id] = sku.SkuSpecData
+			ids = append(ids, sku.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	changed, err := model.GetSKUsByIDs(s.ctx, mysql.DB, ids)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "get skus failed: "+err.Error())
+	}
+	checked := make(map[uint64]bool, len(changed))
+	for _, c := range changed {
+		if checked[c.SpuID] {
+			continue
+		}
+		checked[c.SpuID] = true
+
+		spu, err := model.GetSPUByID(s.ctx, mysql.DB, c.SpuID)
+		if err != nil {
+			return errs.New(errs.ErrInternal.Code, "get spu failed: "+err.Error())
+		}
+		template, err := loadSpecTemplate(s.ctx, spu.CategoryID)
+		if err != nil {
+			return errs.New(errs.ErrInternal.Code, "load spec template failed: "+err.Error())
+		}
+		if len(template) == 0 {
+			continue
+		}
+		skus, err := model.GetSKUsBySpuID(s.ctx, mysql.DB, c.SpuID)
+		if err != nil {
+			return errs.New(errs.ErrInternal.Code, "get skus failed: "+err.Error())
+		}
+		specs := make([]string, 0, len(skus))
+		for _, sku := range skus {
+			if spec, ok := newSpecs[sku.ID]; ok {
+				specs = append(specs, spec)
+			} else {
+				specs = append(specs, sku.SkuSpecData)
+			}
+		}
+		if err := validateSkuSpecs(template, specs, func(i int) string {
+			return fmt.Sprintf("sku %d", skus[i].ID)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadSnapshots 获取待修改 SKU 所属商品修改前的快照，用于记录变更历史
+func (s *BatchUpdateSkuService) loadSnapshots(reqSkus []*product.ProductSKU) map[uint64]*model.ProductSnapshot {
+	ids := make([]uint64, 0, len(reqSkus))
+	for _, sku := range reqSkus {
+		ids = append(ids, sku.Id)
+	}
+	skus, err := model.GetSKUsByIDs(s.ctx, mysql.DB, ids)
+	if err != nil {
+		klog.Warnf("Failed to get skus for product snapshot: %v", err)
+		return nil
+	}
+	snapshots := make(map[uint64]*model.ProductSnapshot, len(skus))
+	for _, sku := range skus {
+		if _, ok := snapshots[sku.SpuID]; ok {
+			continue
+		}
+		snapshot, err := loadProductSnapshot(s.ctx, sku.SpuID)
+		if err != nil {
+			klog.Warnf("Failed to load product snapshot: %v", err)
+			continue
+		}
+		snapshots[sku.SpuID] = snapshot
+	}
+	return snapshots
+}
+
+// submitForAudit 将修改了内容的 SKU 所属商品重新置为待审核，与 SKU 更新在同一事务中
+func (s *BatchUpdateSkuService) submitForAudit(tx *gorm.DB, skuIDs []uint64) ([]uint64, error) {
+	if len(skuIDs) == 0 {
+		return nil, nil
+	}
+	skus, err := model.GetSKUsByIDs(s.ctx, tx, skuIDs)
+	if err != nil {
+		return nil, err
+	}
+	spuIDs := make([]uint64, 0, len(skus))
+	seen := make(map[uint64]bool, len(skus))
+	for _, sku := range skus {
+		if !seen[sku.SpuID] {
+			seen[sku.SpuID] = true
+			spuIDs = append(spuIDs, sku.SpuID)
+		}
+	}
+	return spuIDs, model.SubmitForAudit(s.ctx, tx, spuIDs)
+}
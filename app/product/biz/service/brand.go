@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"gorm.io/gorm"
+)
+
+// maxBrandNameLen 品牌名称最大长度（字符数），与表结构 varchar(64) 一致
+const maxBrandNameLen = 64
+
+// getBrand 按 ID 获取品牌，不存在时返回 ErrRecordNotFound
+func getBrand(ctx context.Context, id uint64) (*model.ProductBrand, error) {
+	brand, err := model.GetBrandByID(ctx, mysql.DB, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "brand not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get brand failed: "+err.Error())
+	}
+	return brand, nil
+}
+
+// checkBrandName 校验品牌名称并返回去除首尾空白后的名称，名称全局唯一
+func checkBrandName(ctx context.Context, name string, excludeID uint64) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errs.New(errs.ErrParam.Code, "brand name is required")
+	}
+	if utf8.RuneCountInString(name) > maxBrandNameLen {
+		return "", errs.New(errs.ErrParam.Code, fmt.Sprintf("brand name exceeds %d characters", maxBrandNameLen))
+	}
+	exists, err := model.ExistsBrandName(ctx, mysql.DB, name, excludeID)
+	if err != nil {
+		return "", errs.New(errs.ErrInternal.Code, "check brand name failed: "+err.Error())
+	}
+	if exists {
+		return "", errs.New(errs.ErrRecordAlreadyEx.Code, "brand name already exists")
+	}
+	return name, nil
+}
+
+// brandFirstLetter 返回品牌首字母，未指定时按名称推算；指定时只接受单个字母或 "#"
+func brandFirstLetter(firstLetter, name string) (string, error) {
+	firstLetter = strings.ToUpper(strings.TrimSpace(firstLetter))
+	if firstLetter == "" {
+		return utils.FirstLetter(name), nil
+	}
+	if len(firstLetter) != 1 || (firstLetter != "#" && (firstLetter[0] < 'A' || firstLetter[0] > 'Z')) {
+		return "", errs.New(errs.ErrParam.Code, "first letter must be a single letter A-Z or #")
+	}
+	return firstLetter, nil
+}
+
+// checkCategoryIDs 去重并校验分类均存在
+func checkCategoryIDs(ctx context.Context, ids []uint64) ([]uint64, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	seen := make(map[uint64]bool, len(ids))
+	unique := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	categories, err := model.GetCategoriesByIds(ctx, mysql.DB, unique)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get categories failed: "+err.Error())
+	}
+	if len(categories) != len(unique) {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "category not found")
+	}
+	return unique, nil
+}
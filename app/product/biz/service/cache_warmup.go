@@ -7,6 +7,7 @@ import (
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/cloudwego/kitex/pkg/klog"
 )
 
@@ -137,6 +138,120 @@ func (s *CacheWarmUpService) WarmUpTopProductDetails(limit int) error {
 	return nil
 }
 
+// WarmUpProductBloom 用全部未删除商品 ID 重建商品布隆过滤器
+func (s *CacheWarmUpService) WarmUpProductBloom() error {
+	klog.Info("Starting product bloom filter rebuild...")
+	count, err := redis.RebuildProductBloom(s.ctx, func(afterID uint64) ([]uint64, error) {
+		return model.ListSPUIDsAfter(s.ctx, mysql.DB, afterID, searchRebuildBatchSize)
+	})
+	if err != nil {
+		klog.Errorf("Failed to rebuild product bloom filter: %v", err)
+		return err
+	}
+	klog.Infof("Product bloom filter rebuild completed, added %d products", count)
+	return nil
+}
+
+// registerProducts 新建或恢复商品后加入布隆过滤器并清除空值缓存，保证商品立即可查
+func registerProducts(ctx context.Context, ids []uint64) {
+	if err := redis.AddProductsToBloom(ctx, ids); err != nil {
+		klog.Warnf("Failed to add products to bloom filter: %v", err)
+	}
+	if err := redis.BatchDeleteProductDetailCache(ctx, ids); err != nil {
+		klog.Warnf("Failed to delete product detail cache: %v", err)
+	}
+}
+
+// WarmUpSuggestions 从商品目录重建搜索联想词（商品名、品牌、分类），权重取销量；
+// 只收录前台可见的商品，品牌和分类只取自这些商品，避免暴露未上架商品的信息
+func (s *CacheWarmUpService) WarmUpSuggestions() error {
+	klog.Info("Starting search suggestions warm-up...")
+
+	categories, err := loadCategories(s.ctx)
+	if err != nil {
+		klog.Errorf("Failed to load categories for suggestions: %v", err)
+		return err
+	}
+	visibleCategory := make(map[uint64]*redis.CachedCategory)
+	for _, c := range visibleCategories(categories) {
+		visibleCategory[c.ID] = c
+	}
+
+	entries := make([]redis.SuggestEntry, 0)
+	brandSales := make(map[uint64]int)
+	categorySales := make(map[uint64]int)
+
+	var lastID uint64
+	for {
+		ids, err := model.ListSPUIDsAfter(s.ctx, mysql.DB, lastID, searchRebuildBatchSize)
+		if err != nil {
+			klog.Errorf("Failed to list products for suggestions: %v", err)
+			return err
+		}
+		if len(ids) == 0 {
+			break
+		}
+		spus, err := model.GetProductsByIds(s.ctx, mysql.DB, ids)
+		if err != nil {
+			klog.Errorf("Failed to get products for suggestions: %v", err)
+			return err
+		}
+		for _, spu := range spus {
+			if !utils.IsOnSale(spu.PublishStatus, spu.VerifyStatus) || visibleCategory[spu.CategoryID] == nil {
+				continue
+			}
+			entries = append(entries, redis.SuggestEntry{
+				Text:   spu.Name,
+				Type:   redis.SuggestTypeProduct,
+				Weight: float64(spu.SaleCount + 1),
+			})
+			if spu.BrandID > 0 {
+				brandSales[spu.BrandID] += spu.SaleCount
+			}
+			categorySales[spu.CategoryID] += spu.SaleCount
+		}
+		lastID = ids[len(ids)-1]
+	}
+
+	if len(brandSales) > 0 {
+		brandIDs := make([]uint64, 0, len(brandSales))
+		for id := range brandSales {
+			brandIDs = append(brandIDs, id)
+		}
+		brands, err := model.GetBrandsByIds(s.ctx, mysql.DB, brandIDs)
+		if err != nil {
+			klog.Errorf("Failed to get brands for suggestions: %v", err)
+			return err
+		}
+		for _, brand := range brands {
+			if !brand.ShowStatus {
+				continue
+			}
+			entries = append(entries, redis.SuggestEntry{
+				Text:   brand.Name,
+				Type:   redis.SuggestTypeBrand,
+				Weight: float64(brandSales[brand.ID] + 1),
+			})
+		}
+	}
+
+	for id, sales := range categorySales {
+		entries = append(entries, redis.SuggestEntry{
+			Text:   visibleCategory[id].Name,
+			Type:   redis.SuggestTypeCategory,
+			Weight: float64(sales + 1),
+		})
+	}
+
+	if err := redis.RebuildSuggestCatalog(s.ctx, entries); err != nil {
+		klog.Errorf("Failed to rebuild suggestion catalog: %v", err)
+		return err
+	}
+
+	klog.Infof("Search suggestions warm-up completed, cached %d entries", len(entries))
+	return nil
+}
+
 // buildCacheData 构建缓存数据
 func buildCacheData(spu *model.ProductSPU, skus []*model.ProductSKU, category *model.ProductCategory, brand *model.ProductBrand, detail *model.ProductDetail) *redis.CachedProductDetail {
 	cached := &redis.CachedProductDetail{}
@@ -149,6 +264,7 @@ func buildCacheData(spu *model.ProductSPU, skus []*model.ProductSKU, category *m
 			Name:          spu.Name,
 			SubTitle:      spu.SubTitle,
 			MainImage:     spu.MainImage,
+			MerchantID:    spu.MerchantID,
 			PublishStatus: spu.PublishStatus,
 			VerifyStatus:  spu.VerifyStatus,
 			LowPrice:      spu.LowPrice,
@@ -216,12 +332,16 @@ func buildCacheData(spu *model.ProductSPU, skus []*model.ProductSKU, category *m
 
 // StartCacheRefreshTask 启动定时刷新缓存任务
 func StartCacheRefreshTask(ctx context.Context) {
-	ticker := time.NewTicker(5 * time.Minute) // 每5分钟刷新一次热门商品缓存
+	ticker := time.NewTicker(5 * time.Minute)      // 每5分钟刷新一次热门商品缓存
+	suggestTicker := time.NewTicker(1 * time.Hour) // 每小时重建一次搜索联想词
+	bloomTicker := time.NewTicker(24 * time.Hour)  // 每天重建一次商品布隆过滤器，清理已删除商品
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
 				ticker.Stop()
+				suggestTicker.Stop()
+				bloomTicker.Stop()
 				klog.Info("Cache refresh task stopped")
 				return
 			case <-ticker.C:
@@ -229,6 +349,14 @@ func StartCacheRefreshTask(ctx context.Context) {
 				if err := service.WarmUpHotProducts(); err != nil {
 					klog.Errorf("Periodic hot products cache refresh failed: %v", err)
 				}
+			case <-suggestTicker.C:
+				if err := NewCacheWarmUpService(ctx).WarmUpSuggestions(); err != nil {
+					klog.Errorf("Periodic search suggestions refresh failed: %v", err)
+				}
+			case <-bloomTicker.C:
+				if err := NewCacheWarmUpService(ctx).WarmUpProductBloom(); err != nil {
+					klog.Errorf("Periodic product bloom filter rebuild failed: %v", err)
+				}
 			}
 		}
 	}()
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type CancelPriceAlertService struct {
+	ctx context.Context
+}
+
+func NewCancelPriceAlertService(ctx context.Context) *CancelPriceAlertService {
+	return &CancelPriceAlertService{ctx: ctx}
+}
+
+func (s *CancelPriceAlertService) Run(req *product.CancelPriceAlertRequest) (*product.CancelPriceAlertResponse, error) {
+	if req.UserId == 0 || req.SkuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user id and sku id are required")
+	}
+	deleted, err := model.DeletePriceAlert(s.ctx, mysql.DB, req.UserId, req.SkuId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "delete price alert failed: "+err.Error())
+	}
+	if !deleted {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "price alert not found")
+	}
+	return &product.CancelPriceAlertResponse{Success: true}, nil
+}
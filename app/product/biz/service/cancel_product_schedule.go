@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
+)
+
+type CancelProductScheduleService struct {
+	ctx context.Context
+}
+
+func NewCancelProductScheduleService(ctx context.Context) *CancelProductScheduleService {
+	return &CancelProductScheduleService{ctx: ctx}
+}
+
+// Run 取消待执行的定时任务，已执行、执行中或已取消的任务不能取消
+func (s *CancelProductScheduleService) Run(req *product.CancelProductScheduleRequest) (*product.CancelProductScheduleResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "schedule id is required")
+	}
+	if req.MerchantId != 0 {
+		schedule, err := model.GetProductSchedule(s.ctx, mysql.DB, req.Id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.New(errs.ErrRecordNotFound.Code, "pending schedule not found")
+			}
+			return nil, errs.New(errs.ErrInternal.Code, "get product schedule failed: "+err.Error())
+		}
+		if err := checkScheduleTargetOwner(s.ctx, req.MerchantId, schedule.TargetType, schedule.TargetID); err != nil {
+			return nil, err
+		}
+	}
+
+	ok, err := model.CancelProductSchedule(s.ctx, mysql.DB, req.Id)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "cancel product schedule failed: "+err.Error())
+	}
+	if !ok {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "pending schedule not found")
+	}
+	return &product.CancelProductScheduleResponse{Success: true}, nil
+}
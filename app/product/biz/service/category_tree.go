@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+// maxCategoryNameLen 分类名称最大长度（字符数），与表结构 varchar(64) 一致
+const maxCategoryNameLen = 64
+
+// loadCategories 读取全部分类（含隐藏），优先走分类树缓存，未命中时查库并回填
+func loadCategories(ctx context.Context) ([]*redis.CachedCategory, error) {
+	cached, err := redis.GetCategoryTreeFromCache(ctx)
+	if err != nil {
+		klog.Warnf("Failed to get category tree from cache: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	categories, err := model.ListAllCategories(ctx, mysql.DB)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*redis.CachedCategory, 0, len(categories))
+	for _, c := range categories {
+		list = append(list, &redis.CachedCategory{
+			ID:         c.ID,
+			ParentID:   c.ParentID,
+			Name:       c.Name,
+			Level:      c.Level,
+			Icon:       c.Icon,
+			Unit:       c.Unit,
+			Sort:       c.Sort,
+			ShowStatus: c.ShowStatus,
+		})
+	}
+	if err := redis.SetCategoryTreeCache(ctx, list); err != nil {
+		klog.Warnf("Failed to set category tree cache: %v", err)
+	}
+	return list, nil
+}
+
+// getCategory 按 ID 获取分类，不存在时返回 ErrRecordNotFound
+func getCategory(ctx context.Context, id uint64) (*model.ProductCategory, error) {
+	category, err := model.GetCategoryByID(ctx, mysql.DB, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "category not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get category failed: "+err.Error())
+	}
+	return category, nil
+}
+
+// checkCategoryName 校验分类名称并返回去除首尾空白后的名称，名称全局唯一
+func checkCategoryName(ctx context.Context, name string, excludeID uint64) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errs.New(errs.ErrParam.Code, "category name is required")
+	}
+	if utf8.RuneCountInString(name) > maxCategoryNameLen {
+		return "", errs.New(errs.ErrParam.Code, fmt.Sprintf("category name exceeds %d characters", maxCategoryNameLen))
+	}
+	exists, err := model.ExistsCategoryName(ctx, mysql.DB, name, excludeID)
+	if err != nil {
+		return "", errs.New(errs.ErrInternal.Code, "check category name failed: "+err.Error())
+	}
+	if exists {
+		return "", errs.New(errs.ErrRecordAlreadyEx.Code, "category name already exists")
+	}
+	return name, nil
+}
+
+// invalidateCategoryTree 分类变更后清除分类树缓存
+func invalidateCategoryTree(ctx context.Context) {
+	if err := redis.DeleteCategoryTreeCache(ctx); err != nil {
+		klog.Warnf("Failed to delete category tree cache: %v", err)
+	}
+}
+
+// visibleCategories 过滤掉隐藏的分类，父分类隐藏时整棵子树都不可见；
+// 输入需按层级升序排列
+func visibleCategories(list []*redis.CachedCategory) []*redis.CachedCategory {
+	visible := make(map[uint64]bool, len(list))
+	result := make([]*redis.CachedCategory, 0, len(list))
+	for _, c := range list {
+		if !c.ShowStatus {
+			continue
+		}
+		if c.ParentID != 0 && !visible[c.ParentID] {
+			continue
+		}
+		visible[c.ID] = true
+		result = append(result, c)
+	}
+	return result
+}
+
+func toProtoCategory(c *redis.CachedCategory) *product.Category {
+	return &product.Category{
+		Id:         c.ID,
+		ParentId:   c.ParentID,
+		Name:       c.Name,
+		Level:      int32(c.Level),
+		Icon:       c.Icon,
+		Unit:       c.Unit,
+		Sort:       int32(c.Sort),
+		ShowStatus: c.ShowStatus,
+	}
+}
+
+// buildCategoryTree 组装分类树，输入需按层级、排序升序排列
+func buildCategoryTree(list []*redis.CachedCategory) []*product.Category {
+	categoryMap := make(map[uint64]*product.Category, len(list))
+	roots := make([]*product.Category, 0)
+	for _, c := range list {
+		node := toProtoCategory(c)
+		node.Children = []*product.Category{}
+		categoryMap[c.ID] = node
+		if c.ParentID == 0 {
+			roots = append(roots, node)
+		} else if parent, ok := categoryMap[c.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	return roots
+}
+
+// planCategoryMove 校验分类移动并返回需要调整层级的子树（含自身）及层级变化量，
+// 不允许移动到自身或子孙分类下，移动后子树最深层级不能超过 model.MaxCategoryLevel
+func planCategoryMove(all []*model.ProductCategory, id, parentID uint64) ([]uint64, int, error) {
+	byID := make(map[uint64]*model.ProductCategory, len(all))
+	children := make(map[uint64][]uint64, len(all))
+	for _, c := range all {
+		byID[c.ID] = c
+		children[c.ParentID] = append(children[c.ParentID], c.ID)
+	}
+
+	node, ok := byID[id]
+	if !ok {
+		return nil, 0, errs.New(errs.ErrRecordNotFound.Code, "category not found")
+	}
+	newLevel := 1
+	if parentID != 0 {
+		parent, ok := byID[parentID]
+		if !ok {
+			return nil, 0, errs.New(errs.ErrRecordNotFound.Code, "parent category not found")
+		}
+		newLevel = parent.Level + 1
+	}
+
+	subtree := []uint64{id}
+	maxLevel := node.Level
+	for i := 0; i < len(subtree); i++ {
+		c := byID[subtree[i]]
+		if c.ID == parentID {
+			return nil, 0, errs.New(errs.ErrParam.Code, "cannot move category under itself or its descendants")
+		}
+		maxLevel = max(maxLevel, c.Level)
+		subtree = append(subtree, children[c.ID]...)
+	}
+
+	delta := newLevel - node.Level
+	if maxLevel+delta > model.MaxCategoryLevel {
+		return nil, 0, errs.New(errs.ErrParam.Code, fmt.Sprintf("category level cannot exceed %d", model.MaxCategoryLevel))
+	}
+	return subtree, delta, nil
+}
+
+// relatedCategoryIDs 返回分类自身、祖先与全部子孙分类的 ID，
+// 品牌关联到其中任一分类即视为该分类下可筛选的品牌
+func relatedCategoryIDs(list []*redis.CachedCategory, id uint64) []uint64 {
+	byID := make(map[uint64]*redis.CachedCategory, len(list))
+	children := make(map[uint64][]uint64, len(list))
+	for _, c := range list {
+		byID[c.ID] = c
+		children[c.ParentID] = append(children[c.ParentID], c.ID)
+	}
+
+	ids := []uint64{id}
+	for c, ok := byID[id]; ok && c.ParentID != 0; c, ok = byID[c.ParentID] {
+		ids = append(ids, c.ParentID)
+	}
+	descendants := children[id]
+	for i := 0; i < len(descendants); i++ {
+		descendants = append(descendants, children[descendants[i]]...)
+	}
+	return append(ids, descendants...)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+)
+
+func testCategory(id, parentID uint64, level int) *model.ProductCategory {
+	c := &model.ProductCategory{ParentID: parentID, Level: level}
+	c.ID = id
+	return c
+}
+
+// 手机(1) > 小米手机(101) > 小米数字系列(1001)；电视(2)；家电(3) > 冰箱(301)
+func testCategories() []*model.ProductCategory {
+	return []*model.ProductCategory{
+		testCategory(1, 0, 1),
+		testCategory(2, 0, 1),
+		testCategory(3, 0, 1),
+		testCategory(101, 1, 2),
+		testCategory(301, 3, 2),
+		testCategory(1001, 101, 3),
+	}
+}
+
+func TestPlanCategoryMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        uint64
+		parentID  uint64
+		wantDelta int
+		wantNodes int
+		wantErr   bool
+	}{
+		{name: "二级移到其他一级下", id: 101, parentID: 2, wantDelta: 0, wantNodes: 2},
+		{name: "二级提升为一级", id: 101, parentID: 0, wantDelta: -1, wantNodes: 2},
+		{name: "一级降为二级", id: 2, parentID: 3, wantDelta: 1, wantNodes: 1},
+		{name: "子树超过三级", id: 1, parentID: 2, wantErr: true},
+		{name: "移到二级下导致超过三级", id: 101, parentID: 301, wantErr: true},
+		{name: "移到自身下", id: 1, parentID: 1, wantErr: true},
+		{name: "移到子孙下", id: 1, parentID: 1001, wantErr: true},
+		{name: "分类不存在", id: 999, parentID: 0, wantErr: true},
+		{name: "父分类不存在", id: 101, parentID: 999, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subtree, delta, err := planCategoryMove(testCategories(), tt.id, tt.parentID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got subtree=%v delta=%d", subtree, delta)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if delta != tt.wantDelta || len(subtree) != tt.wantNodes {
+				t.Errorf("got delta=%d subtree=%v, want delta=%d nodes=%d", delta, subtree, tt.wantDelta, tt.wantNodes)
+			}
+		})
+	}
+}
+
+func TestVisibleCategoriesHidesSubtree(t *testing.T) {
+	list := []*redis.CachedCategory{
+		{ID: 1, Level: 1, ShowStatus: true},
+		{ID: 2, Level: 1, ShowStatus: false},
+		{ID: 101, ParentID: 1, Level: 2, ShowStatus: true},
+		{ID: 201, ParentID: 2, Level: 2, ShowStatus: true},
+		{ID: 2001, ParentID: 201, Level: 3, ShowStatus: true},
+	}
+	tree := buildCategoryTree(visibleCategories(list))
+	if len(tree) != 1 || tree[0].Id != 1 {
+		t.Fatalf("expected only category 1 at root, got %+v", tree)
+	}
+	if len(tree[0].Children) != 1 || tree[0].Children[0].Id != 101 {
+		t.Errorf("expected category 101 under 1, got %+v", tree[0].Children)
+	}
+}
+
+func TestRelatedCategoryIDs(t *testing.T) {
+	list := []*redis.CachedCategory{
+		{ID: 1, Level: 1},
+		{ID: 2, Level: 1},
+		{ID: 101, ParentID: 1, Level: 2},
+		{ID: 102, ParentID: 1, Level: 2},
+		{ID: 1001, ParentID: 101, Level: 3},
+	}
+	tests := []struct {
+		id   uint64
+		want []uint64
+	}{
+		{id: 1, want: []uint64{1, 101, 102, 1001}},
+		{id: 101, want: []uint64{101, 1, 1001}},
+		{id: 1001, want: []uint64{1001, 101, 1}},
+		{id: 2, want: []uint64{2}},
+	}
+	for _, tt := range tests {
+		got := relatedCategoryIDs(list, tt.id)
+		if len(got) != len(tt.want) {
+			t.Errorf("relatedCategoryIDs(%d) = %v, want %v", tt.id, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("relatedCategoryIDs(%d) = %v, want %v", tt.id, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type CreateBrandService struct {
+	ctx context.Context
+}
+
+func NewCreateBrandService(ctx context.Context) *CreateBrandService {
+	return &CreateBrandService{ctx: ctx}
+}
+
+// Run 创建品牌，未指定首字母时按名称推算
+func (s *CreateBrandService) Run(req *product.CreateBrandRequest) (*product.CreateBrandResponse, error) {
+	name, err := checkBrandName(s.ctx, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+	firstLetter, err := brandFirstLetter(req.FirstLetter, name)
+	if err != nil {
+		return nil, err
+	}
+	categoryIDs, err := checkCategoryIDs(s.ctx, req.CategoryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := &model.ProductBrand{
+		Name:        name,
+		FirstLetter: firstLetter,
+		Logo:        req.Logo,
+		Sort:        int(req.Sort),
+		ShowStatus:  true,
+	}
+	if err := model.CreateBrand(s.ctx, mysql.DB, brand, categoryIDs); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create brand failed: "+err.Error())
+	}
+
+	return &product.CreateBrandResponse{
+		Id:          brand.ID,
+		FirstLetter: firstLetter,
+	}, nil
+}
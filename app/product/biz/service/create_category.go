@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type CreateCategoryService struct {
+	ctx context.Context
+}
+
+func NewCreateCategoryService(ctx context.Context) *CreateCategoryService {
+	return &CreateCategoryService{ctx: ctx}
+}
+
+// Run 创建分类，层级由父分类推算，最多三级
+func (s *CreateCategoryService) Run(req *product.CreateCategoryRequest) (*product.CreateCategoryResponse, error) {
+	name, err := checkCategoryName(s.ctx, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	level := 1
+	if req.ParentId > 0 {
+		parent, err := getCategory(s.ctx, req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		level = parent.Level + 1
+	}
+	if level > model.MaxCategoryLevel {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("category level cannot exceed %d", model.MaxCategoryLevel))
+	}
+
+	category := &model.ProductCategory{
+		Name:       name,
+		ParentID:   req.ParentId,
+		Level:      level,
+		Sort:       int(req.Sort),
+		ShowStatus: true,
+		Icon:       req.Icon,
+		Unit:       req.Unit,
+	}
+	if err := model.CreateCategory(s.ctx, mysql.DB, category); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create category failed: "+err.Error())
+	}
+	invalidateCategoryTree(s.ctx)
+
+	return &product.CreateCategoryResponse{
+		Id:    category.ID,
+		Level: int32(level),
+	}, nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type CreateMediaAssetService struct {
+	ctx context.Context
+}
+
+func NewCreateMediaAssetService(ctx context.Context) *CreateMediaAssetService {
+	return &CreateMediaAssetService{ctx: ctx}
+}
+
+// Run 登记网关已写入存储的媒体文件
+func (s *CreateMediaAssetService) Run(req *product.CreateMediaAssetRequest) (*product.CreateMediaAssetResponse, error) {
+	a := req.Asset
+	if a == nil {
+		return nil, errs.New(errs.ErrParam.Code, "asset is required")
+	}
+	if a.Kind != model.MediaKindImage && a.Kind != model.MediaKindVideo {
+		return nil, errs.New(errs.ErrParam.Code, "kind must be image or video")
+	}
+	if a.StorageKey == "" || a.Url == "" {
+		return nil, errs.New(errs.ErrParam.Code, "storage key and url are required")
+	}
+	if len(a.StorageKey) > 255 || len(a.Url) > 1000 || len(a.ThumbnailUrl) > 1000 || len(a.WebpUrl) > 1000 {
+		return nil, errs.New(errs.ErrParam.Code, "storage key or url too long")
+	}
+	name := a.OriginalName
+	if len(name) > 255 {
+		name = name[:255]
+	}
+
+	asset := &model.MediaAsset{
+		Kind:         a.Kind,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		Width:        a.Width,
+		Height:       a.Height,
+		StorageKey:   a.StorageKey,
+		URL:          a.Url,
+		ThumbnailURL: a.ThumbnailUrl,
+		WebpURL:      a.WebpUrl,
+		OriginalName: strings.ToValidUTF8(name, ""),
+		OperatorID:   a.OperatorId,
+	}
+	if err := model.CreateMediaAsset(s.ctx, mysql.DB, asset); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create media asset failed: "+err.Error())
+	}
+	return &product.CreateMediaAssetResponse{Asset: toProtoMediaAsset(asset)}, nil
+}
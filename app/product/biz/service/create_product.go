@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
 	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type CreateProductService struct {
@@ -22,14 +24,18 @@ func (s *CreateProductService) Run(req *product.CreateProductRequest) (resp *pro
 	if req.Spu == nil || req.Skus == nil || req.Detail == nil {
 		return nil, errs.New(errs.ErrParam.Code, "invalid request")
 	}
+	if err := resolveMediaRefs(s.ctx, req.MerchantId, req.Spu, req.Skus, req.Detail); err != nil {
+		return nil, err
+	}
 	newSPU := &model.ProductSPU{
 		Name:          req.Spu.Name,
 		CategoryID:    req.Spu.CategoryId,
 		BrandID:       req.Spu.BrandId,
 		SubTitle:      req.Spu.SubTitle,
 		MainImage:     req.Spu.MainImage,
+		MerchantID:    req.MerchantId,
 		PublishStatus: 1,
-		VerifyStatus:  1,
+		VerifyStatus:  utils.VerifyStatusUnverified, // 审核通过后才会出现在前台
 		ServiceBits:   req.Spu.ServiceBits,
 	}
 	newDetail := &model.ProductDetail{
@@ -68,10 +74,36 @@ func (s *CreateProductService) Run(req *product.CreateProductRequest) (resp *pro
 			SkuSpecData:   sku.SkuSpecData,
 			Version:       1,
 			PublishStatus: 1,
-			VerifyStatus:  1,
+			VerifyStatus:  utils.VerifyStatusUnverified,
 		}
 		newSKUs = append(newSKUs, newSKU)
 	}
+	if err := s.checkSpecs(req); err != nil {
+		return nil, err
+	}
 	spuid, err := model.CreateProductWithTransaction(s.ctx, mysql.DB, newSPU, newSKUs, newDetail)
+	if err == nil {
+		registerProducts(s.ctx, []uint64{spuid})
+		refreshSearchIndex(s.ctx, []uint64{spuid})
+		recordInitialPrices(s.ctx, newSKUs, req.OperatorId)
+		if _, err := recordProductChange(s.ctx, spuid, req.OperatorId, model.HistoryActionCreate, 0, nil); err != nil {
+			klog.Warnf("Failed to record product history: %v", err)
+		}
+	}
 	return &product.CreateProductResponse{SpuId: spuid}, err
 }
+
+// checkSpecs 按所属分类的规格模板校验全部 SKU 的规格数据
+func (s *CreateProductService) checkSpecs(req *product.CreateProductRequest) error {
+	template, err := loadSpecTemplate(s.ctx, req.Spu.CategoryId)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "load spec template failed: "+err.Error())
+	}
+	specs := make([]string, 0, len(req.Skus))
+	for _, sku := range req.Skus {
+		specs = append(specs, sku.SkuSpecData)
+	}
+	return validateSkuSpecs(template, specs, func(i int) string {
+		return fmt.Sprintf("sku %d", i+1)
+	})
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
+)
+
+type CreateProductScheduleService struct {
+	ctx context.Context
+}
+
+func NewCreateProductScheduleService(ctx context.Context) *CreateProductScheduleService {
+	return &CreateProductScheduleService{ctx: ctx}
+}
+
+// Run 创建定时上架/下架/改价任务，到期后由 StartProductScheduleTask 执行
+func (s *CreateProductScheduleService) Run(req *product.CreateProductScheduleRequest) (*product.CreateProductScheduleResponse, error) {
+	if req.TargetType != model.ScheduleTargetSPU && req.TargetType != model.ScheduleTargetSKU {
+		return nil, errs.New(errs.ErrParam.Code, "target_type must be spu or sku")
+	}
+	if req.TargetId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "target_id is required")
+	}
+	if err := checkScheduleTargetOwner(s.ctx, req.MerchantId, req.TargetType, req.TargetId); err != nil {
+		return nil, err
+	}
+	runAt := time.Unix(req.RunAt, 0)
+	if !runAt.After(time.Now()) {
+		return nil, errs.New(errs.ErrParam.Code, "run_at must be in the future")
+	}
+
+	schedule := &model.ProductSchedule{
+		TargetType: req.TargetType,
+		TargetID:   req.TargetId,
+		Action:     req.Action,
+		RunAt:      runAt,
+		Status:     model.ScheduleStatusPending,
+		OperatorID: req.OperatorId,
+	}
+	switch req.Action {
+	case model.ScheduleActionPublish, model.ScheduleActionUnpublish:
+	case model.ScheduleActionPrice:
+		if req.TargetType != model.ScheduleTargetSKU {
+			return nil, errs.New(errs.ErrParam.Code, "price schedules only apply to skus")
+		}
+		if strings.TrimSpace(req.Price) == "" {
+			return nil, errs.New(errs.ErrParam.Code, "price is required")
+		}
+		price, err := utils.PriceConvert(req.Price)
+		if err != nil || price < 0 {
+			return nil, errs.New(errs.ErrParam.Code, "invalid price")
+		}
+		schedule.Price = price
+		if req.MarketPrice != "" {
+			marketPrice, err := utils.PriceConvert(req.MarketPrice)
+			if err != nil || marketPrice < 0 {
+				return nil, errs.New(errs.ErrParam.Code, "invalid market_price")
+			}
+			schedule.MarketPrice = marketPrice
+		}
+	default:
+		return nil, errs.New(errs.ErrParam.Code, "action must be publish, unpublish or price")
+	}
+
+	if err := s.checkTarget(req.TargetType, req.TargetId); err != nil {
+		return nil, err
+	}
+	if err := model.CreateProductSchedule(s.ctx, mysql.DB, schedule); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create product schedule failed: "+err.Error())
+	}
+	return &product.CreateProductScheduleResponse{Id: schedule.ID}, nil
+}
+
+func (s *CreateProductScheduleService) checkTarget(targetType string, id uint64) error {
+	var err error
+	if targetType == model.ScheduleTargetSPU {
+		_, err = model.GetSPUByID(s.ctx, mysql.DB, id)
+	} else {
+		_, err = model.GetSKUByID(s.ctx, mysql.DB, id)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.New(errs.ErrRecordNotFound.Code, targetType+" not found")
+		}
+		return errs.New(errs.ErrInternal.Code, "get "+targetType+" failed: "+err.Error())
+	}
+	return nil
+}
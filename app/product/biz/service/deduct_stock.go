@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -53,6 +55,14 @@ func (s *DeductStockService) Run(req *product.DeductStockRequest) (*product.Dedu
 			if sku == nil {
 				return errs.New(errs.ErrRecordNotFound.Code, "sku not found")
 			}
+			// 未上架或未审核通过的商品不可购买，整单回滚
+			spu, err := model.GetSPUByID(s.ctx, tx, sku.SpuID)
+			if err != nil && err != gorm.ErrRecordNotFound {
+				return err
+			}
+			if spu == nil || !utils.IsOnSale(spu.PublishStatus, spu.VerifyStatus) || sku.VerifyStatus != utils.VerifyStatusApproved {
+				return errs.New(errs.ErrParam.Code, fmt.Sprintf("sku %d is not on sale", item.SkuId))
+			}
 			if err := model.AddSaleCount(s.ctx, tx, sku.SpuID, int(item.Count)); err != nil {
 				return err
 			}
@@ -69,6 +79,12 @@ func (s *DeductStockService) Run(req *product.DeductStockRequest) (*product.Dedu
 		return nil, errs.New(errs.ErrInternal.Code, "deduct stock failed: "+err.Error())
 	}
 
+	spuIDs := make([]uint64, 0, len(spuSaleUpdates))
+	for spuID := range spuSaleUpdates {
+		spuIDs = append(spuIDs, spuID)
+	}
+	refreshSearchIndex(s.ctx, spuIDs)
+
 	// 异步更新热门商品排行榜和缓存
 	go func() {
 		for spuID, increment := range spuSaleUpdates {
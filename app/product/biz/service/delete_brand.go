@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type DeleteBrandService struct {
+	ctx context.Context
+}
+
+func NewDeleteBrandService(ctx context.Context) *DeleteBrandService {
+	return &DeleteBrandService{ctx: ctx}
+}
+
+// Run 删除品牌及其分类关联，仍有商品（含回收站中的商品）引用时拒绝删除
+func (s *DeleteBrandService) Run(req *product.DeleteBrandRequest) (*product.DeleteBrandResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "brand id is required")
+	}
+	if _, err := getBrand(s.ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	spus, err := model.CountSPUsByBrandID(s.ctx, mysql.DB, req.Id)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "count brand products failed: "+err.Error())
+	}
+	if spus > 0 {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("brand still has %d products", spus))
+	}
+
+	if err := model.DeleteBrand(s.ctx, mysql.DB, req.Id); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "delete brand failed: "+err.Error())
+	}
+
+	return &product.DeleteBrandResponse{Success: true}, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type DeleteCategoryService struct {
+	ctx context.Context
+}
+
+func NewDeleteCategoryService(ctx context.Context) *DeleteCategoryService {
+	return &DeleteCategoryService{ctx: ctx}
+}
+
+// Run 删除分类，存在子分类或商品（含回收站中的商品）时拒绝删除
+func (s *DeleteCategoryService) Run(req *product.DeleteCategoryRequest) (*product.DeleteCategoryResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "category id is required")
+	}
+	if _, err := getCategory(s.ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	children, err := model.CountChildCategories(s.ctx, mysql.DB, req.Id)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "count child categories failed: "+err.Error())
+	}
+	if children > 0 {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("category still has %d child categories", children))
+	}
+	spus, err := model.CountSPUsByCategoryID(s.ctx, mysql.DB, req.Id)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "count category products failed: "+err.Error())
+	}
+	if spus > 0 {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("category still contains %d products", spus))
+	}
+
+	if err := model.DeleteCategory(s.ctx, mysql.DB, req.Id); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "delete category failed: "+err.Error())
+	}
+	invalidateCategoryTree(s.ctx)
+	invalidateSpecTemplates(s.ctx)
+
+	return &product.DeleteCategoryResponse{Success: true}, nil
+}
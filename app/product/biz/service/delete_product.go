@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type DeleteProductService struct {
+	ctx context.Context
+}
+
+func NewDeleteProductService(ctx context.Context) *DeleteProductService {
+	return &DeleteProductService{ctx: ctx}
+}
+
+// Run 软删除商品，删除的同时下架，SKU 保留以便恢复
+func (s *DeleteProductService) Run(req *product.DeleteProductRequest) (*product.DeleteProductResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+	if err := checkSPUOwner(s.ctx, req.MerchantId, req.Ids); err != nil {
+		return nil, err
+	}
+
+	affected, err := model.DeleteSPUs(s.ctx, mysql.DB, req.Ids)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "delete product failed: "+err.Error())
+	}
+
+	invalidateProductCaches(s.ctx, req.Ids)
+	refreshSearchIndex(s.ctx, req.Ids)
+
+	return &product.DeleteProductResponse{
+		Affected: affected,
+	}, nil
+}
+
+// invalidateProductCaches 异步清除商品详情、列表与热门商品缓存
+func invalidateProductCaches(ctx context.Context, ids []uint64) {
+	go func() {
+		if err := redis.BatchDeleteProductDetailCache(ctx, ids); err != nil {
+			klog.Warnf("Failed to batch delete product detail cache: %v", err)
+		}
+		if err := redis.InvalidateProductListCache(ctx); err != nil {
+			klog.Warnf("Failed to invalidate product list cache: %v", err)
+		}
+		if err := redis.DeleteHotProductsCache(ctx); err != nil {
+			klog.Warnf("Failed to delete hot products cache: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ExportProductsService struct {
+	ctx context.Context
+}
+
+func NewExportProductsService(ctx context.Context) *ExportProductsService {
+	return &ExportProductsService{ctx: ctx}
+}
+
+// Run 按 ID 游标分批导出商品及其 SKU 与详情，筛选条件与 ListProducts 相同
+func (s *ExportProductsService) Run(req *product.ExportProductsRequest) (*product.ExportProductsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	spus, err := model.ListProductsAfter(s.ctx, mysql.DB, req.Cursor, limit, req.Keyword, req.CategoryId, req.BrandId, req.PublishStatus, req.VerifyStatus, req.Deleted, req.MerchantId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list products failed: "+err.Error())
+	}
+	if len(spus) == 0 {
+		return &product.ExportProductsResponse{List: []*product.ExportedProduct{}}, nil
+	}
+
+	ids := make([]uint64, 0, len(spus))
+	for _, spu := range spus {
+		ids = append(ids, spu.ID)
+	}
+	skus, err := model.GetSKUsBySpuIDs(s.ctx, mysql.DB, ids)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get skus failed: "+err.Error())
+	}
+	details, err := model.GetProductDetailsBySpuIDs(s.ctx, ids)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get product details failed: "+err.Error())
+	}
+
+	list := make([]*product.ExportedProduct, 0, len(spus))
+	bySpu := make(map[uint64]*product.ExportedProduct, len(spus))
+	for _, spu := range spus {
+		item := &product.ExportedProduct{Spu: toProtoSPU(spu), Detail: &product.ProductDetail{}}
+		if d, ok := details[spu.ID]; ok {
+			item.Detail = &product.ProductDetail{
+				Description:   d.Description,
+				Images:        d.Images,
+				Videos:        d.Videos,
+				MarketTagJson: d.MarketTagJSON,
+				TechTagJson:   d.TechTagJSON,
+				FaqJson:       d.FaqJSON,
+			}
+		}
+		bySpu[spu.ID] = item
+		list = append(list, item)
+	}
+	for _, sku := range skus {
+		item := bySpu[sku.SpuID]
+		item.Skus = append(item.Skus, &product.ProductSKU{
+			Id:          sku.ID,
+			SpuId:       sku.SpuID,
+			SkuCode:     sku.SkuCode,
+			Name:        sku.Name,
+			SubTitle:    sku.SubTitle,
+			MainImage:   sku.MainImage,
+			Price:       utils.PriceToString(sku.Price),
+			MarketPrice: utils.PriceToString(sku.MarketPrice),
+			Stock:       int32(sku.Stock),
+			LockStock:   int32(sku.LockStock),
+			SkuSpecData: sku.SkuSpecData,
+			Version:     int32(sku.Version),
+		})
+	}
+
+	resp := &product.ExportProductsResponse{List: list}
+	if len(spus) == limit {
+		resp.NextCursor = spus[len(spus)-1].ID
+	}
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
+)
+
+type GetImportJobService struct {
+	ctx context.Context
+}
+
+func NewGetImportJobService(ctx context.Context) *GetImportJobService {
+	return &GetImportJobService{ctx: ctx}
+}
+
+func (s *GetImportJobService) Run(req *product.GetImportJobRequest) (*product.GetImportJobResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "import job id is required")
+	}
+	job, err := model.GetImportJob(s.ctx, mysql.DB, req.Id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "import job not found")
+	}
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get import job failed: "+err.Error())
+	}
+	if req.MerchantId != 0 && job.MerchantID != req.MerchantId {
+		return nil, errs.New(errs.ErrPermission.Code, "import job does not belong to merchant")
+	}
+	return &product.GetImportJobResponse{Job: toProtoImportJob(job)}, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type GetMediaAssetsService struct {
+	ctx context.Context
+}
+
+func NewGetMediaAssetsService(ctx context.Context) *GetMediaAssetsService {
+	return &GetMediaAssetsService{ctx: ctx}
+}
+
+func (s *GetMediaAssetsService) Run(req *product.GetMediaAssetsRequest) (*product.GetMediaAssetsResponse, error) {
+	if len(req.Ids) > 100 {
+		return nil, errs.New(errs.ErrParam.Code, "too many ids")
+	}
+	list, err := model.GetMediaAssetsByIDs(s.ctx, mysql.DB, req.Ids, req.MerchantId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get media assets failed: "+err.Error())
+	}
+	assets := make(map[uint64]*product.MediaAsset, len(list))
+	for _, a := range list {
+		assets[a.ID] = toProtoMediaAsset(a)
+	}
+	return &product.GetMediaAssetsResponse{Assets: assets}, nil
+}
@@ -2,14 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/rpc"
 	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
 	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 )
 
 type GetProductDetailService struct {
@@ -25,122 +29,104 @@ func (s *GetProductDetailService) Run(req *product.GetProductDetailRequest) (*pr
 		return nil, errs.New(errs.ErrParam.Code, "product id is required")
 	}
 
-	// 优先从缓存获取商品详情
-	cached, err := redis.GetProductDetailFromCache(s.ctx, req.Id)
+	// 布隆过滤器拦截不存在的 ID，缓存未命中时合并并发回源
+	cached, err := redis.LoadProductDetail(s.ctx, req.Id, func(ctx context.Context) (*redis.CachedProductDetail, error) {
+		return s.loadFromDB(ctx, req.Id)
+	})
 	if err != nil {
-		klog.Warnf("Failed to get product detail from cache: %v", err)
+		if errors.Is(err, redis.ErrProductNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "product not found")
+		}
+		return nil, err
 	}
-	if cached != nil {
-		klog.Debugf("Product %d cache hit", req.Id)
-		return s.convertCachedToResponse(cached), nil
+	// 前台只展示已上架且审核通过的商品，缓存中保留原始状态，后台预览时同样可以命中
+	if !req.IncludeUnavailable && (cached.SPU == nil || !utils.IsOnSale(cached.SPU.PublishStatus, cached.SPU.VerifyStatus)) {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "product not found")
 	}
 
-	// 缓存未命中，从数据库获取
-	klog.Debugf("Product %d cache miss, fetching from database", req.Id)
-	spu, err := model.GetSPUByID(s.ctx, mysql.DB, req.Id)
+	resp := s.convertCachedToResponse(cached)
+	resp.Rating = s.getRating(req.Id)
+	resp.SpecSelectors = s.getSpecSelectors(resp.Spu, resp.Skus)
+	return resp, nil
+}
+
+// loadFromDB 从数据库加载商品详情，商品不存在时返回 nil
+func (s *GetProductDetailService) loadFromDB(ctx context.Context, id uint64) (*redis.CachedProductDetail, error) {
+	klog.Debugf("Product %d cache miss, fetching from database", id)
+	spu, err := model.GetSPUByID(ctx, mysql.DB, id)
 	if err != nil {
-		return nil, errs.New(errs.ErrRecordNotFound.Code, "product not found")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get product failed: "+err.Error())
 	}
 
-	skus, err := model.GetSKUsBySpuID(s.ctx, mysql.DB, req.Id)
+	skus, err := model.GetSKUsBySpuID(ctx, mysql.DB, id)
 	if err != nil {
 		return nil, errs.New(errs.ErrInternal.Code, "get skus failed: "+err.Error())
 	}
 
 	var category *model.ProductCategory
-	var protoCategory *product.Category
 	if spu.CategoryID > 0 {
-		category, err = model.GetCategoryByID(s.ctx, mysql.DB, spu.CategoryID)
-		if err == nil && category != nil {
-			protoCategory = &product.Category{
-				Id:       category.ID,
-				ParentId: category.ParentID,
-				Name:     category.Name,
-				Level:    int32(category.Level),
-				Icon:     category.Icon,
-				Unit:     category.Unit,
-				Sort:     int32(category.Sort),
-			}
+		if c, err := model.GetCategoryByID(ctx, mysql.DB, spu.CategoryID); err == nil {
+			category = c
 		}
 	}
 
 	var brand *model.ProductBrand
-	var protoBrand *product.Brand
 	if spu.BrandID > 0 {
-		brand, err = model.GetBrandByID(s.ctx, mysql.DB, spu.BrandID)
-		if err == nil && brand != nil {
-			protoBrand = &product.Brand{
-				Id:          brand.ID,
-				Name:        brand.Name,
-				FirstLetter: brand.FirstLetter,
-				Logo:        brand.Logo,
-				Sort:        int32(brand.Sort),
-			}
+		if b, err := model.GetBrandByID(ctx, mysql.DB, spu.BrandID); err == nil {
+			brand = b
 		}
 	}
 
 	var detail *model.ProductDetail
-	var protoDetail *product.ProductDetail
-	detail, err = model.GetProductDetailBySpuID(s.ctx, req.Id)
-	if err == nil && detail != nil {
-		protoDetail = &product.ProductDetail{
-			Description:   detail.Description,
-			Images:        detail.Images,
-			Videos:        detail.Videos,
-			MarketTagJson: detail.MarketTagJSON,
-			TechTagJson:   detail.TechTagJSON,
-			FaqJson:       detail.FaqJSON,
-		}
+	if d, err := model.GetProductDetailBySpuID(ctx, id); err == nil {
+		detail = d
 	}
 
-	protoSPU := &product.ProductSPU{
-		Id:            spu.ID,
-		BrandId:       spu.BrandID,
-		CategoryId:    spu.CategoryID,
-		Name:          spu.Name,
-		SubTitle:      spu.SubTitle,
-		MainImage:     spu.MainImage,
-		PublishStatus: int32(spu.PublishStatus),
-		VerifyStatus:  int32(spu.VerifyStatus),
-		SaleCount:     int32(spu.SaleCount),
-		Sort:          int32(spu.Sort),
-		ServiceBits:   spu.ServiceBits,
-		Version:       int32(spu.Version),
-	}
+	return s.buildCacheData(spu, skus, category, brand, detail), nil
+}
 
-	protoSKUs := make([]*product.ProductSKU, 0, len(skus))
+// getSpecSelectors 按分类规格模板构建规格选择器，模板可能随时调整，不放入详情缓存
+func (s *GetProductDetailService) getSpecSelectors(spu *product.ProductSPU, skus []*product.ProductSKU) []*product.SpecSelector {
+	if spu == nil {
+		return nil
+	}
+	template, err := loadSpecTemplate(s.ctx, spu.CategoryId)
+	if err != nil {
+		klog.Warnf("Failed to load spec template for category %d: %v", spu.CategoryId, err)
+		return nil
+	}
+	if len(template) == 0 {
+		return nil
+	}
+	specs := make([]string, 0, len(skus))
 	for _, sku := range skus {
-		protoSKUs = append(protoSKUs, &product.ProductSKU{
-			Id:          sku.ID,
-			SpuId:       sku.SpuID,
-			SkuCode:     sku.SkuCode,
-			Name:        sku.Name,
-			SubTitle:    sku.SubTitle,
-			MainImage:   sku.MainImage,
-			Price:       utils.PriceToString(sku.Price),
-			MarketPrice: utils.PriceToString(sku.MarketPrice),
-			Stock:       int32(sku.Stock),
-			LockStock:   int32(sku.LockStock),
-			SkuSpecData: sku.SkuSpecData,
-			Version:     int32(sku.Version),
-		})
+		specs = append(specs, sku.SkuSpecData)
 	}
+	return buildSpecSelectors(template, specs)
+}
 
-	// 异步设置缓存
-	go func() {
-		cachedDetail := s.buildCacheData(spu, skus, category, brand, detail)
-		if err := redis.SetProductDetailCache(s.ctx, req.Id, cachedDetail); err != nil {
-			klog.Warnf("Failed to set product detail cache: %v", err)
-		}
-	}()
-
-	return &product.GetProductDetailResponse{
-		Spu:      protoSPU,
-		Skus:     protoSKUs,
-		Category: protoCategory,
-		Brand:    protoBrand,
-		Detail:   protoDetail,
-	}, nil
+// getRating 从评价服务获取评分汇总，评分变化频繁不放入详情缓存，失败时返回空
+func (s *GetProductDetailService) getRating(spuID uint64) *product.ProductRating {
+	if rpc.ReviewClient == nil {
+		return nil
+	}
+	resp, err := rpc.ReviewClient.GetRatingSummaries(s.ctx, &review.GetRatingSummariesRequest{SpuIds: []uint64{spuID}})
+	if err != nil {
+		klog.Warnf("Failed to get rating summary for product %d: %v", spuID, err)
+		return nil
+	}
+	if len(resp.Summaries) == 0 {
+		return nil
+	}
+	sum := resp.Summaries[0]
+	return &product.ProductRating{
+		ReviewCount:   sum.ReviewCount,
+		AverageRating: sum.AverageRating,
+		RatingCounts:  sum.RatingCounts,
+	}
 }
 
 // convertCachedToResponse 将缓存数据转换为响应
@@ -154,6 +140,7 @@ func (s *GetProductDetailService) convertCachedToResponse(cached *redis.CachedPr
 			Name:          cached.SPU.Name,
 			SubTitle:      cached.SPU.SubTitle,
 			MainImage:     cached.SPU.MainImage,
+			MerchantId:    cached.SPU.MerchantID,
 			PublishStatus: int32(cached.SPU.PublishStatus),
 			VerifyStatus:  int32(cached.SPU.VerifyStatus),
 			SaleCount:     int32(cached.SPU.SaleCount),
@@ -238,6 +225,7 @@ func (s *GetProductDetailService) buildCacheData(spu *model.ProductSPU, skus []*
 			Name:          spu.Name,
 			SubTitle:      spu.SubTitle,
 			MainImage:     spu.MainImage,
+			MerchantID:    spu.MerchantID,
 			PublishStatus: spu.PublishStatus,
 			VerifyStatus:  spu.VerifyStatus,
 			LowPrice:      spu.LowPrice,
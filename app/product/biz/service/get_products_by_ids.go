@@ -38,6 +38,7 @@ func (s *GetProductsByIdsService) Run(req *product.GetProductsByIdsRequest) (*pr
 			Name:          spu.Name,
 			SubTitle:      spu.SubTitle,
 			MainImage:     spu.MainImage,
+			MerchantId:    spu.MerchantID,
 			PublishStatus: int32(spu.PublishStatus),
 			VerifyStatus:  int32(spu.VerifyStatus),
 			SaleCount:     int32(spu.SaleCount),
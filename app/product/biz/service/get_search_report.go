@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type GetSearchReportService struct {
+	ctx context.Context
+}
+
+func NewGetSearchReportService(ctx context.Context) *GetSearchReportService {
+	return &GetSearchReportService{ctx: ctx}
+}
+
+// Run 按天统计汇总最近 days 天的搜索词，zero_result_only 时返回零结果报表
+func (s *GetSearchReportService) Run(req *product.GetSearchReportRequest) (*product.GetSearchReportResponse, error) {
+	days := int(req.Days)
+	if days <= 0 {
+		days = 7
+	}
+	if days > 90 {
+		days = 90
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1-days)
+
+	var stats []*model.SearchStatSummary
+	var err error
+	if req.ZeroResultOnly {
+		stats, err = model.ListZeroResultKeywords(s.ctx, mysql.DB, model.SearchStatDay, since, limit)
+	} else {
+		stats, err = model.ListTrendingKeywords(s.ctx, mysql.DB, model.SearchStatDay, since, limit)
+	}
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get search report failed: "+err.Error())
+	}
+
+	items := make([]*product.SearchQueryStat, 0, len(stats))
+	for _, st := range stats {
+		var avg float64
+		if st.SearchCount > 0 {
+			avg = float64(st.ResultTotal) / float64(st.SearchCount)
+		}
+		items = append(items, &product.SearchQueryStat{
+			Keyword:         st.Keyword,
+			SearchCount:     st.SearchCount,
+			ZeroResultCount: st.ZeroResultCount,
+			AvgResultCount:  avg,
+			PeriodUserSum:   st.PeriodUserSum,
+		})
+	}
+	return &product.GetSearchReportResponse{Items: items}, nil
+}
@@ -35,6 +35,12 @@ func (s *GetSkusByIdsService) Run(req *product.GetSkusByIdsRequest) (*product.Ge
 		return nil, err
 	}
 
+	onSaleSPUs, err := s.onSaleSPUs(skus)
+	if err != nil {
+		klog.CtxErrorf(s.ctx, "GetSkusByIds query spus failed: %v", err)
+		return nil, err
+	}
+
 	// 转换为 map 结构
 	skuMap := make(map[uint64]*product.ProductSKU)
 	for _, sku := range skus {
@@ -51,6 +57,7 @@ func (s *GetSkusByIdsService) Run(req *product.GetSkusByIdsRequest) (*product.Ge
 			LockStock:   int32(sku.LockStock),
 			SkuSpecData: sku.SkuSpecData,
 			Version:     int32(sku.Version),
+			OnSale:      onSaleSPUs[sku.SpuID] && sku.VerifyStatus == utils.VerifyStatusApproved,
 		}
 	}
 
@@ -59,3 +66,24 @@ func (s *GetSkusByIdsService) Run(req *product.GetSkusByIdsRequest) (*product.Ge
 		Skus: skuMap,
 	}, nil
 }
+
+// onSaleSPUs 查询 SKU 所属商品中已上架且审核通过的商品，已删除的商品不会返回
+func (s *GetSkusByIdsService) onSaleSPUs(skus []*model.ProductSKU) (map[uint64]bool, error) {
+	spuIDs := make([]uint64, 0, len(skus))
+	seen := make(map[uint64]struct{}, len(skus))
+	for _, sku := range skus {
+		if _, ok := seen[sku.SpuID]; !ok {
+			seen[sku.SpuID] = struct{}{}
+			spuIDs = append(spuIDs, sku.SpuID)
+		}
+	}
+	spus, err := model.GetProductsByIds(s.ctx, mysql.DB, spuIDs)
+	if err != nil {
+		return nil, err
+	}
+	onSale := make(map[uint64]bool, len(spus))
+	for _, spu := range spus {
+		onSale[spu.ID] = utils.IsOnSale(spu.PublishStatus, spu.VerifyStatus)
+	}
+	return onSale, nil
+}
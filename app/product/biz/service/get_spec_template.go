@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type GetSpecTemplateService struct {
+	ctx context.Context
+}
+
+func NewGetSpecTemplateService(ctx context.Context) *GetSpecTemplateService {
+	return &GetSpecTemplateService{ctx: ctx}
+}
+
+// Run 返回分类生效的规格模板，包含从上级分类继承的属性
+func (s *GetSpecTemplateService) Run(req *product.GetSpecTemplateRequest) (*product.GetSpecTemplateResponse, error) {
+	if req.CategoryId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "category id is required")
+	}
+	if _, err := getCategory(s.ctx, req.CategoryId); err != nil {
+		return nil, err
+	}
+
+	template, err := loadSpecTemplate(s.ctx, req.CategoryId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "load spec template failed: "+err.Error())
+	}
+	attributes := make([]*product.SpecAttribute, 0, len(template))
+	for _, a := range template {
+		attributes = append(attributes, toProtoSpecAttribute(a))
+	}
+
+	return &product.GetSpecTemplateResponse{Attributes: attributes}, nil
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 热搜榜统计窗口
+const (
+	trendingPeriodHour = "hour"
+	trendingPeriodDay  = "day"
+
+	trendingCandidateFactor = 2 // 从统计中读取的候选数为榜单上限的倍数
+)
+
+type GetTrendingSearchesService struct {
+	ctx context.Context
+}
+
+func NewGetTrendingSearchesService(ctx context.Context) *GetTrendingSearchesService {
+	return &GetTrendingSearchesService{ctx: ctx}
+}
+
+// Run 获取热搜榜，hour 为最近一小时，day 为最近24小时，均由小时统计合并；
+// 只统计有结果的搜索，屏蔽词与过长的搜索词不上榜
+func (s *GetTrendingSearchesService) Run(req *product.GetTrendingSearchesRequest) (*product.GetTrendingSearchesResponse, error) {
+	period := req.Period
+	if period == "" {
+		period = trendingPeriodDay
+	}
+	var window time.Duration
+	switch period {
+	case trendingPeriodHour:
+		window = time.Hour
+	case trendingPeriodDay:
+		window = 24 * time.Hour
+	default:
+		return nil, errs.New(errs.ErrParam.Code, "invalid period, must be hour or day")
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > redis.TrendingSearchesLimit {
+		limit = redis.TrendingSearchesLimit
+	}
+
+	list, err := redis.GetTrendingSearchesFromCache(s.ctx, period)
+	if err != nil {
+		klog.Warnf("Failed to get trending searches from cache: %v", err)
+	}
+	if list == nil {
+		// 当前小时的统计尚未结束，窗口向前取整到小时，保证窗口内至少有一个完整小时
+		since := time.Now().Add(-window).Truncate(time.Hour)
+		// 多取一些候选，过滤屏蔽词与过长的搜索词后仍能填满榜单
+		stats, err := model.ListTrendingKeywords(s.ctx, mysql.DB, model.SearchStatHour, since, redis.TrendingSearchesLimit*trendingCandidateFactor)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "get trending searches failed: "+err.Error())
+		}
+		list = make([]*redis.TrendingSearchInfo, 0, redis.TrendingSearchesLimit)
+		for _, st := range stats {
+			if len(list) >= redis.TrendingSearchesLimit {
+				break
+			}
+			if trendingKeywordAllowed(st.Keyword) {
+				list = append(list, &redis.TrendingSearchInfo{Keyword: st.Keyword, SearchCount: st.HitCount()})
+			}
+		}
+		if err := redis.SetTrendingSearchesCache(s.ctx, period, list); err != nil {
+			klog.Warnf("Failed to set trending searches cache: %v", err)
+		}
+	}
+
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	items := make([]*product.TrendingSearch, 0, len(list))
+	for _, t := range list {
+		items = append(items, &product.TrendingSearch{Keyword: t.Keyword, SearchCount: t.SearchCount})
+	}
+	return &product.GetTrendingSearchesResponse{Items: items}, nil
+}
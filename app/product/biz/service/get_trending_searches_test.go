@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/daltest"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// setupTrendingSearches 准备热搜测试环境，写入 n 个有结果的搜索词，搜索次数依次递减
+func setupTrendingSearches(t *testing.T, n int) {
+	t.Helper()
+	// 屏蔽词检查读取配置，切换到项目根目录
+	t.Chdir("../..")
+	daltest.MySQL(t)
+	daltest.Redis(t)
+
+	hour := time.Now().Truncate(time.Hour)
+	stats := make([]*model.SearchStat, 0, n)
+	for i := 0; i < n; i++ {
+		stats = append(stats, &model.SearchStat{
+			Granularity: model.SearchStatHour,
+			BucketStart: hour,
+			Keyword:     fmt.Sprintf("keyword%02d", i),
+			SearchCount: int64(1000 - i),
+		})
+	}
+	if len(stats) > 0 {
+		if err := mysql.DB.Create(&stats).Error; err != nil {
+			t.Fatalf("create stats: %v", err)
+		}
+	}
+}
+
+func TestGetTrendingSearchesPeriod(t *testing.T) {
+	setupTrendingSearches(t, 0)
+	ctx := context.Background()
+
+	_, err := NewGetTrendingSearchesService(ctx).Run(&product.GetTrendingSearchesRequest{Period: "week"})
+	if err == nil || errs.ConvertErr(err).Code != errs.ErrParam.Code {
+		t.Fatalf("invalid period: expected param error, got %v", err)
+	}
+	for _, period := range []string{"", trendingPeriodHour, trendingPeriodDay} {
+		if _, err := NewGetTrendingSearchesService(ctx).Run(&product.GetTrendingSearchesRequest{Period: period}); err != nil {
+			t.Errorf("period %q: %v", period, err)
+		}
+	}
+}
+
+func TestGetTrendingSearchesLimit(t *testing.T) {
+	setupTrendingSearches(t, redis.TrendingSearchesLimit+10)
+	ctx := context.Background()
+
+	cases := []struct {
+		limit int32
+		want  int
+	}{
+		{0, 10},
+		{5, 5},
+		{redis.TrendingSearchesLimit + 100, redis.TrendingSearchesLimit},
+	}
+	for _, c := range cases {
+		resp, err := NewGetTrendingSearchesService(ctx).Run(&product.GetTrendingSearchesRequest{Limit: c.limit})
+		if err != nil {
+			t.Fatalf("limit %d: %v", c.limit, err)
+		}
+		if len(resp.Items) != c.want {
+			t.Errorf("limit %d: got %d items, want %d", c.limit, len(resp.Items), c.want)
+		}
+	}
+}
+
+// 缓存未命中时查库并写入缓存，命中时直接返回缓存
+func TestGetTrendingSearchesCache(t *testing.T) {
+	setupTrendingSearches(t, 3)
+	ctx := context.Background()
+
+	resp, err := NewGetTrendingSearchesService(ctx).Run(&product.GetTrendingSearchesRequest{Period: trendingPeriodHour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Items) != 3 || resp.Items[0].Keyword != "keyword00" || resp.Items[0].SearchCount != 1000 {
+		t.Fatalf("from db: unexpected items %v", resp.Items)
+	}
+	cached, err := redis.GetTrendingSearchesFromCache(ctx, trendingPeriodHour)
+	if err != nil || len(cached) != 3 {
+		t.Fatalf("cache not populated: %v, %v", cached, err)
+	}
+
+	if err := redis.SetTrendingSearchesCache(ctx, trendingPeriodHour, []*redis.TrendingSearchInfo{{Keyword: "cached", SearchCount: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = NewGetTrendingSearchesService(ctx).Run(&product.GetTrendingSearchesRequest{Period: trendingPeriodHour})
+	if err != nil || len(resp.Items) != 1 || resp.Items[0].Keyword != "cached" {
+		t.Fatalf("from cache: unexpected result %v, %v", resp, err)
+	}
+}
+
+// 零结果、屏蔽和过长的搜索词不上榜
+func TestGetTrendingSearchesFilter(t *testing.T) {
+	setupTrendingSearches(t, 1)
+	ctx := context.Background()
+	RegisterKeywordBlocker(func(keyword string) bool { return strings.Contains(keyword, "blocked") })
+
+	hour := time.Now().Truncate(time.Hour)
+	stats := []*model.SearchStat{
+		{Granularity: model.SearchStatHour, BucketStart: hour, Keyword: "noresult", SearchCount: 5000, ZeroResultCount: 5000},
+		{Granularity: model.SearchStatHour, BucketStart: hour, Keyword: "blocked word", SearchCount: 5000},
+		{Granularity: model.SearchStatHour, BucketStart: hour, Keyword: strings.Repeat("x", trendingKeywordMaxLen+1), SearchCount: 5000},
+	}
+	if err := mysql.DB.Create(&stats).Error; err != nil {
+		t.Fatalf("create stats: %v", err)
+	}
+
+	resp, err := NewGetTrendingSearchesService(ctx).Run(&product.GetTrendingSearchesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].Keyword != "keyword00" {
+		t.Fatalf("unexpected items %v", resp.Items)
+	}
+}
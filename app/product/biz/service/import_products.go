@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/sheet"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ImportProductsService struct {
+	ctx context.Context
+}
+
+func NewImportProductsService(ctx context.Context) *ImportProductsService {
+	return &ImportProductsService{ctx: ctx}
+}
+
+// Run 创建导入任务并在后台执行，通过 GetImportJob 查询进度与逐行错误报告
+func (s *ImportProductsService) Run(req *product.ImportProductsRequest) (*product.ImportProductsResponse, error) {
+	if len(req.File) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "file is required")
+	}
+	if len(req.File) > maxImportFileSize {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("file must be at most %d MB", maxImportFileSize>>20))
+	}
+	format, err := sheet.ParseFormat(req.Format)
+	if err != nil {
+		return nil, errs.New(errs.ErrParam.Code, err.Error())
+	}
+
+	job := &model.ProductImportJob{
+		OperatorID: req.OperatorId,
+		MerchantID: req.MerchantId,
+		Format:     string(format),
+		DryRun:     req.DryRun,
+		Status:     model.ImportStatusPending,
+	}
+	if err := model.CreateImportJob(s.ctx, mysql.DB, job); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create import job failed: "+err.Error())
+	}
+	startImportJob(s.ctx, job, format, req.File)
+	return &product.ImportProductsResponse{Job: toProtoImportJob(job)}, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ListAuditQueueService struct {
+	ctx context.Context
+}
+
+func NewListAuditQueueService(ctx context.Context) *ListAuditQueueService {
+	return &ListAuditQueueService{ctx: ctx}
+}
+
+// Run 分页查询待审核商品，先提交的排在前面
+func (s *ListAuditQueueService) Run(req *product.ListAuditQueueRequest) (*product.ListAuditQueueResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	spus, total, err := model.ListPendingSPUs(s.ctx, mysql.DB, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list audit queue failed: "+err.Error())
+	}
+	list := make([]*product.ProductSPU, 0, len(spus))
+	for _, spu := range spus {
+		list = append(list, toProtoSPU(spu))
+	}
+
+	return &product.ListAuditQueueResponse{
+		List:  list,
+		Total: total,
+	}, nil
+}
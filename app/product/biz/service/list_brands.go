@@ -17,6 +17,8 @@ func NewListBrandsService(ctx context.Context) *ListBrandsService {
 	return &ListBrandsService{ctx: ctx}
 }
 
+// Run 分页查询品牌，指定分类时只返回关联到该分类、其祖先或子孙分类的品牌；
+// include_hidden 为后台管理用，包含隐藏品牌并返回关联分类
 func (s *ListBrandsService) Run(req *product.ListBrandsRequest) (*product.ListBrandsResponse, error) {
 	page := int(req.Page)
 	pageSize := int(req.PageSize)
@@ -31,11 +33,32 @@ func (s *ListBrandsService) Run(req *product.ListBrandsRequest) (*product.ListBr
 		pageSize = 100 // 限制最大页面大小
 	}
 
-	brands, total, err := model.ListBrands(s.ctx, mysql.DB, page, pageSize)
+	var categoryIDs []uint64
+	if req.CategoryId > 0 {
+		categories, err := loadCategories(s.ctx)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "load categories failed: "+err.Error())
+		}
+		categoryIDs = relatedCategoryIDs(categories, req.CategoryId)
+	}
+
+	brands, total, err := model.ListBrands(s.ctx, mysql.DB, page, pageSize, categoryIDs, req.IncludeHidden)
 	if err != nil {
 		return nil, errs.New(errs.ErrInternal.Code, "list brands failed: "+err.Error())
 	}
 
+	var brandCategories map[uint64][]uint64
+	if req.IncludeHidden {
+		brandIDs := make([]uint64, 0, len(brands))
+		for _, b := range brands {
+			brandIDs = append(brandIDs, b.ID)
+		}
+		brandCategories, err = model.ListBrandCategoryIDs(s.ctx, mysql.DB, brandIDs)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "list brand categories failed: "+err.Error())
+		}
+	}
+
 	respBrands := make([]*product.Brand, 0, len(brands))
 	for _, b := range brands {
 		respBrands = append(respBrands, &product.Brand{
@@ -44,6 +67,8 @@ func (s *ListBrandsService) Run(req *product.ListBrandsRequest) (*product.ListBr
 			FirstLetter: b.FirstLetter,
 			Logo:        b.Logo,
 			Sort:        int32(b.Sort),
+			ShowStatus:  b.ShowStatus,
+			CategoryIds: brandCategories[b.ID],
 		})
 	}
 
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ListMediaAssetsService struct {
+	ctx context.Context
+}
+
+func NewListMediaAssetsService(ctx context.Context) *ListMediaAssetsService {
+	return &ListMediaAssetsService{ctx: ctx}
+}
+
+// Run 分页查询媒体库，最近上传的在前
+func (s *ListMediaAssetsService) Run(req *product.ListMediaAssetsRequest) (*product.ListMediaAssetsResponse, error) {
+	if req.Kind != "" && req.Kind != model.MediaKindImage && req.Kind != model.MediaKindVideo {
+		return nil, errs.New(errs.ErrParam.Code, "kind must be image or video")
+	}
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	assets, total, err := model.ListMediaAssets(s.ctx, mysql.DB, req.Kind, req.MerchantId, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list media assets failed: "+err.Error())
+	}
+	list := make([]*product.MediaAsset, 0, len(assets))
+	for _, a := range assets {
+		list = append(list, toProtoMediaAsset(a))
+	}
+
+	return &product.ListMediaAssetsResponse{
+		List:  list,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ListPriceAlertsService struct {
+	ctx context.Context
+}
+
+func NewListPriceAlertsService(ctx context.Context) *ListPriceAlertsService {
+	return &ListPriceAlertsService{ctx: ctx}
+}
+
+// Run 分页查询用户的降价提醒，附带 SKU 名称、主图与当前售价
+func (s *ListPriceAlertsService) Run(req *product.ListPriceAlertsRequest) (*product.ListPriceAlertsResponse, error) {
+	if req.UserId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user id is required")
+	}
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	alerts, total, err := model.ListPriceAlerts(s.ctx, mysql.DB, req.UserId, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list price alerts failed: "+err.Error())
+	}
+	skuIDs := make([]uint64, 0, len(alerts))
+	for _, a := range alerts {
+		skuIDs = append(skuIDs, a.SkuID)
+	}
+	skus, err := model.GetSKUsByIDs(s.ctx, mysql.DB, skuIDs)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get skus failed: "+err.Error())
+	}
+	skuMap := make(map[uint64]*model.ProductSKU, len(skus))
+	for _, sku := range skus {
+		skuMap[sku.ID] = sku
+	}
+
+	list := make([]*product.PriceAlert, 0, len(alerts))
+	for _, a := range alerts {
+		p := toProtoPriceAlert(a)
+		// SKU 已删除时只返回提醒本身
+		if sku, ok := skuMap[a.SkuID]; ok {
+			p.SkuName = sku.Name
+			p.MainImage = sku.MainImage
+			p.CurrentPrice = utils.PriceToString(sku.Price)
+		}
+		list = append(list, p)
+	}
+
+	return &product.ListPriceAlertsResponse{
+		List:  list,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
+)
+
+type ListPriceHistoryService struct {
+	ctx context.Context
+}
+
+func NewListPriceHistoryService(ctx context.Context) *ListPriceHistoryService {
+	return &ListPriceHistoryService{ctx: ctx}
+}
+
+// Run 查询商品的价格变动，按时间正序，便于绘制价格曲线
+func (s *ListPriceHistoryService) Run(req *product.ListPriceHistoryRequest) (*product.ListPriceHistoryResponse, error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu id is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 200
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	if _, err := model.GetSPUByID(s.ctx, mysql.DB, req.SpuId); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "product not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get spu failed: "+err.Error())
+	}
+
+	var since time.Time
+	if req.Since > 0 {
+		since = time.Unix(req.Since, 0)
+	}
+	history, err := model.ListPriceHistory(s.ctx, mysql.DB, req.SpuId, req.SkuId, since, limit)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list price history failed: "+err.Error())
+	}
+	list := make([]*product.SkuPriceChange, 0, len(history))
+	for _, h := range history {
+		c := &product.SkuPriceChange{
+			SkuId:          h.SkuID,
+			NewPrice:       utils.PriceToString(h.NewPrice),
+			NewMarketPrice: utils.PriceToString(h.NewMarketPrice),
+			Source:         h.Source,
+			OperatorId:     h.OperatorID,
+			CreatedAt:      h.CreatedAt.Unix(),
+		}
+		if h.Source != model.PriceSourceCreate {
+			c.OldPrice = utils.PriceToString(h.OldPrice)
+			c.OldMarketPrice = utils.PriceToString(h.OldMarketPrice)
+		}
+		list = append(list, c)
+	}
+	return &product.ListPriceHistoryResponse{List: list}, nil
+}
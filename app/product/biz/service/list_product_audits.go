@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ListProductAuditsService struct {
+	ctx context.Context
+}
+
+func NewListProductAuditsService(ctx context.Context) *ListProductAuditsService {
+	return &ListProductAuditsService{ctx: ctx}
+}
+
+// Run 返回商品的全部审核记录（含历次驳回原因），按时间倒序
+func (s *ListProductAuditsService) Run(req *product.ListProductAuditsRequest) (*product.ListProductAuditsResponse, error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu id is required")
+	}
+
+	audits, err := model.ListProductAudits(s.ctx, mysql.DB, req.SpuId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list product audits failed: "+err.Error())
+	}
+	list := make([]*product.ProductAudit, 0, len(audits))
+	for _, a := range audits {
+		list = append(list, toProtoProductAudit(a))
+	}
+
+	return &product.ListProductAuditsResponse{List: list}, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ListProductHistoryService struct {
+	ctx context.Context
+}
+
+func NewListProductHistoryService(ctx context.Context) *ListProductHistoryService {
+	return &ListProductHistoryService{ctx: ctx}
+}
+
+// Run 分页查询商品的版本历史，最新版本在前，每个版本带操作人和相对上一版本的差异
+func (s *ListProductHistoryService) Run(req *product.ListProductHistoryRequest) (*product.ListProductHistoryResponse, error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu id is required")
+	}
+	if err := checkSPUOwner(s.ctx, req.MerchantId, []uint64{req.SpuId}); err != nil {
+		return nil, err
+	}
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	versions, total, err := model.ListProductHistory(s.ctx, mysql.DB, req.SpuId, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list product history failed: "+err.Error())
+	}
+	list := make([]*product.ProductVersion, 0, len(versions))
+	for _, v := range versions {
+		list = append(list, toProtoProductVersion(v))
+	}
+
+	return &product.ListProductHistoryResponse{
+		List:  list,
+		Total: total,
+	}, nil
+}
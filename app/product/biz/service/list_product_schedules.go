@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type ListProductSchedulesService struct {
+	ctx context.Context
+}
+
+func NewListProductSchedulesService(ctx context.Context) *ListProductSchedulesService {
+	return &ListProductSchedulesService{ctx: ctx}
+}
+
+// Run 分页查询定时任务及其执行结果，执行时间晚的在前
+func (s *ListProductSchedulesService) Run(req *product.ListProductSchedulesRequest) (*product.ListProductSchedulesResponse, error) {
+	page := int(req.Page)
+	pageSize := int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	schedules, total, err := model.ListProductSchedules(s.ctx, mysql.DB, req.TargetType, req.TargetId, req.Status, req.MerchantId, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list product schedules failed: "+err.Error())
+	}
+	list := make([]*product.ProductSchedule, 0, len(schedules))
+	for _, sch := range schedules {
+		list = append(list, toProtoProductSchedule(sch))
+	}
+
+	return &product.ListProductSchedulesResponse{
+		List:  list,
+		Total: total,
+	}, nil
+}
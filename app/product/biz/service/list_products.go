@@ -6,6 +6,7 @@ import (
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -33,14 +34,19 @@ func (s *ListProductsService) Run(req *product.ListProductsRequest) (*product.Li
 		pageSize = 100 // 限制最大页面大小
 	}
 
+	// 按状态、商家筛选或查询已删除商品属于后台场景，不走列表缓存
+	useCache := req.PublishStatus < 0 && req.VerifyStatus < 0 && !req.Deleted && req.MerchantId == 0
+
 	// 尝试从缓存获取商品列表
-	cachedList, err := redis.GetProductListFromCache(s.ctx, page, pageSize, req.Keyword, req.CategoryId, req.BrandId)
-	if err != nil {
-		klog.Warnf("Failed to get product list from cache: %v", err)
-	}
-	if cachedList != nil {
-		klog.Debugf("Product list cache hit: page=%d, pageSize=%d", page, pageSize)
-		return s.convertCachedListToResponse(cachedList), nil
+	if useCache {
+		cachedList, err := redis.GetProductListFromCache(s.ctx, page, pageSize, req.Keyword, req.CategoryId, req.BrandId)
+		if err != nil {
+			klog.Warnf("Failed to get product list from cache: %v", err)
+		}
+		if cachedList != nil {
+			klog.Debugf("Product list cache hit: page=%d, pageSize=%d", page, pageSize)
+			return s.convertCachedListToResponse(cachedList), nil
+		}
 	}
 
 	// 缓存未命中，从数据库获取
@@ -55,6 +61,8 @@ func (s *ListProductsService) Run(req *product.ListProductsRequest) (*product.Li
 		req.BrandId,
 		req.PublishStatus,
 		req.VerifyStatus,
+		req.Deleted,
+		req.MerchantId,
 	)
 	if err != nil {
 		return nil, errs.New(errs.ErrInternal.Code, "list products failed: "+err.Error())
@@ -69,15 +77,25 @@ func (s *ListProductsService) Run(req *product.ListProductsRequest) (*product.Li
 			Name:          spu.Name,
 			SubTitle:      spu.SubTitle,
 			MainImage:     spu.MainImage,
+			MerchantId:    spu.MerchantID,
 			PublishStatus: int32(spu.PublishStatus),
 			VerifyStatus:  int32(spu.VerifyStatus),
 			SaleCount:     int32(spu.SaleCount),
 			Sort:          int32(spu.Sort),
 			ServiceBits:   spu.ServiceBits,
 			Version:       int32(spu.Version),
+			LowPrice:      utils.PriceToString(spu.LowPrice),
+			HighPrice:     utils.PriceToString(spu.HighPrice),
 		})
 	}
 
+	if !useCache {
+		return &product.ListProductsResponse{
+			List:  protoSPUs,
+			Total: total,
+		}, nil
+	}
+
 	// 异步设置缓存
 	go func() {
 		cachedSPUs := make([]*redis.CachedSPU, 0, len(spus))
@@ -89,6 +107,7 @@ func (s *ListProductsService) Run(req *product.ListProductsRequest) (*product.Li
 				Name:          spu.Name,
 				SubTitle:      spu.SubTitle,
 				MainImage:     spu.MainImage,
+				MerchantID:    spu.MerchantID,
 				PublishStatus: spu.PublishStatus,
 				VerifyStatus:  spu.VerifyStatus,
 				LowPrice:      spu.LowPrice,
@@ -125,12 +144,15 @@ func (s *ListProductsService) convertCachedListToResponse(cached *redis.CachedPr
 			Name:          spu.Name,
 			SubTitle:      spu.SubTitle,
 			MainImage:     spu.MainImage,
+			MerchantId:    spu.MerchantID,
 			PublishStatus: int32(spu.PublishStatus),
 			VerifyStatus:  int32(spu.VerifyStatus),
 			SaleCount:     int32(spu.SaleCount),
 			Sort:          int32(spu.Sort),
 			ServiceBits:   spu.ServiceBits,
 			Version:       int32(spu.Version),
+			LowPrice:      utils.PriceToString(spu.LowPrice),
+			HighPrice:     utils.PriceToString(spu.HighPrice),
 		})
 	}
 	return &product.ListProductsResponse{
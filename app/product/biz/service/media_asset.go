@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// resolveMediaRefs 将请求中引用的媒体资源 ID 解析为地址，写回 main_image/images/videos，
+// 之后的逻辑只需处理地址。merchantID 非 0 时只能引用该商家上传的资源
+func resolveMediaRefs(ctx context.Context, merchantID uint64, spu *product.ProductSPU, skus []*product.ProductSKU, detail *product.ProductDetail) error {
+	var ids []uint64
+	if spu != nil && spu.MainImageAssetId != 0 {
+		ids = append(ids, spu.MainImageAssetId)
+	}
+	for _, sku := range skus {
+		if sku.MainImageAssetId != 0 {
+			ids = append(ids, sku.MainImageAssetId)
+		}
+	}
+	if detail != nil {
+		ids = append(ids, detail.ImageAssetIds...)
+		ids = append(ids, detail.VideoAssetIds...)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	list, err := model.GetMediaAssetsByIDs(ctx, mysql.DB, ids, merchantID)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "get media assets failed: "+err.Error())
+	}
+	assets := make(map[uint64]*model.MediaAsset, len(list))
+	for _, a := range list {
+		assets[a.ID] = a
+	}
+	return applyMediaRefs(assets, spu, skus, detail)
+}
+
+// applyMediaRefs 按已加载的媒体资源替换地址，引用不存在或类型不符时返回参数错误
+func applyMediaRefs(assets map[uint64]*model.MediaAsset, spu *product.ProductSPU, skus []*product.ProductSKU, detail *product.ProductDetail) error {
+	lookup := func(id uint64, kind string) (string, error) {
+		a, ok := assets[id]
+		if !ok {
+			return "", errs.New(errs.ErrParam.Code, fmt.Sprintf("media asset %d not found", id))
+		}
+		if a.Kind != kind {
+			return "", errs.New(errs.ErrParam.Code, fmt.Sprintf("media asset %d is not of kind %s", id, kind))
+		}
+		return a.URL, nil
+	}
+
+	if spu != nil && spu.MainImageAssetId != 0 {
+		url, err := lookup(spu.MainImageAssetId, model.MediaKindImage)
+		if err != nil {
+			return err
+		}
+		spu.MainImage = url
+	}
+	for _, sku := range skus {
+		if sku.MainImageAssetId == 0 {
+			continue
+		}
+		url, err := lookup(sku.MainImageAssetId, model.MediaKindImage)
+		if err != nil {
+			return err
+		}
+		sku.MainImage = url
+	}
+	if detail == nil {
+		return nil
+	}
+	if len(detail.ImageAssetIds) > 0 {
+		images := make([]string, 0, len(detail.ImageAssetIds))
+		for _, id := range detail.ImageAssetIds {
+			url, err := lookup(id, model.MediaKindImage)
+			if err != nil {
+				return err
+			}
+			images = append(images, url)
+		}
+		detail.Images = images
+	}
+	if len(detail.VideoAssetIds) > 0 {
+		videos := make([]string, 0, len(detail.VideoAssetIds))
+		for _, id := range detail.VideoAssetIds {
+			url, err := lookup(id, model.MediaKindVideo)
+			if err != nil {
+				return err
+			}
+			videos = append(videos, url)
+		}
+		detail.Videos = videos
+	}
+	return nil
+}
+
+func toProtoMediaAsset(a *model.MediaAsset) *product.MediaAsset {
+	return &product.MediaAsset{
+		Id:           a.ID,
+		Kind:         a.Kind,
+		ContentType:  a.ContentType,
+		Size:         a.Size,
+		Width:        a.Width,
+		Height:       a.Height,
+		StorageKey:   a.StorageKey,
+		Url:          a.URL,
+		ThumbnailUrl: a.ThumbnailURL,
+		WebpUrl:      a.WebpURL,
+		OriginalName: a.OriginalName,
+		OperatorId:   a.OperatorID,
+		CreatedAt:    a.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+func TestApplyMediaRefs(t *testing.T) {
+	assets := map[uint64]*model.MediaAsset{
+		1: {ID: 1, Kind: model.MediaKindImage, URL: "/media/a.jpg"},
+		2: {ID: 2, Kind: model.MediaKindImage, URL: "/media/b.png"},
+		3: {ID: 3, Kind: model.MediaKindVideo, URL: "/media/c.mp4"},
+	}
+	spu := &product.ProductSPU{MainImage: "old.jpg", MainImageAssetId: 2}
+	skus := []*product.ProductSKU{
+		{MainImage: "keep.jpg"},
+		{MainImageAssetId: 1},
+	}
+	detail := &product.ProductDetail{
+		Images:        []string{"old.jpg"},
+		ImageAssetIds: []uint64{2, 1},
+		VideoAssetIds: []uint64{3},
+	}
+	if err := applyMediaRefs(assets, spu, skus, detail); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if spu.MainImage != "/media/b.png" {
+		t.Errorf("spu main image = %q", spu.MainImage)
+	}
+	if skus[0].MainImage != "keep.jpg" || skus[1].MainImage != "/media/a.jpg" {
+		t.Errorf("sku main images = %q, %q", skus[0].MainImage, skus[1].MainImage)
+	}
+	if len(detail.Images) != 2 || detail.Images[0] != "/media/b.png" || detail.Images[1] != "/media/a.jpg" {
+		t.Errorf("images = %v", detail.Images)
+	}
+	if len(detail.Videos) != 1 || detail.Videos[0] != "/media/c.mp4" {
+		t.Errorf("videos = %v", detail.Videos)
+	}
+
+	cases := []struct {
+		name   string
+		spu    *product.ProductSPU
+		detail *product.ProductDetail
+	}{
+		{"missing", &product.ProductSPU{MainImageAssetId: 9}, nil},
+		{"video as image", &product.ProductSPU{MainImageAssetId: 3}, nil},
+		{"image as video", nil, &product.ProductDetail{VideoAssetIds: []uint64{1}}},
+	}
+	for _, c := range cases {
+		if err := applyMediaRefs(assets, c.spu, nil, c.detail); err == nil {
+			t.Errorf("%s: expected error", c.name)
+		}
+	}
+}
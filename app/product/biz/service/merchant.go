@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+)
+
+// checkSPUOwner 商家只能操作自己的商品，merchantID 为 0 表示平台运营，不做限制
+func checkSPUOwner(ctx context.Context, merchantID uint64, spuIDs []uint64) error {
+	if merchantID == 0 {
+		return nil
+	}
+	count, err := model.CountSPUsNotOwned(ctx, mysql.DB, merchantID, spuIDs)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "check product owner failed: "+err.Error())
+	}
+	if count > 0 {
+		return errs.New(errs.ErrPermission.Code, "product does not belong to merchant")
+	}
+	return nil
+}
+
+// checkSKUOwner 同 checkSPUOwner，按 SKU 所属商品判断
+func checkSKUOwner(ctx context.Context, merchantID uint64, skuIDs []uint64) error {
+	if merchantID == 0 {
+		return nil
+	}
+	count, err := model.CountSKUsNotOwned(ctx, mysql.DB, merchantID, skuIDs)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "check product owner failed: "+err.Error())
+	}
+	if count > 0 {
+		return errs.New(errs.ErrPermission.Code, "product does not belong to merchant")
+	}
+	return nil
+}
+
+// checkScheduleTargetOwner 校验定时任务的目标商品或 SKU 属于该商家
+func checkScheduleTargetOwner(ctx context.Context, merchantID uint64, targetType string, targetID uint64) error {
+	if targetType == model.ScheduleTargetSKU {
+		return checkSKUOwner(ctx, merchantID, []uint64{targetID})
+	}
+	return checkSPUOwner(ctx, merchantID, []uint64{targetID})
+}
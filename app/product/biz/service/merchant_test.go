@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/daltest"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+const (
+	merchantA uint64 = 101
+	merchantB uint64 = 102
+)
+
+func assertPermissionDenied(t *testing.T, err error) {
+	t.Helper()
+	if err == nil {
+		t.Fatal("expected permission error, got nil")
+	}
+	if code := errs.ConvertErr(err).Code; code != errs.ErrPermission.Code {
+		t.Fatalf("expected permission error, got %v", err)
+	}
+}
+
+// 商家不能查看其他商家的导入任务与版本历史，平台运营 (merchant_id 为 0) 不受限制
+func TestMerchantReadScope(t *testing.T) {
+	daltest.MySQL(t)
+	ctx := context.Background()
+
+	spuID, err := model.CreateSPU(ctx, mysql.DB, &model.ProductSPU{Name: "B 的商品", MerchantID: merchantB})
+	if err != nil {
+		t.Fatalf("create spu: %v", err)
+	}
+	history := &model.ProductHistory{SpuID: spuID, Action: "create"}
+	history.SetSnapshot(&model.ProductSnapshot{SPU: model.SnapshotSPU{Name: "B 的商品"}})
+	if err := model.AppendProductHistory(ctx, mysql.DB, nil, history); err != nil {
+		t.Fatalf("append history: %v", err)
+	}
+	job := &model.ProductImportJob{OperatorID: merchantB, MerchantID: merchantB, Format: "csv"}
+	if err := model.CreateImportJob(ctx, mysql.DB, job); err != nil {
+		t.Fatalf("create import job: %v", err)
+	}
+
+	t.Run("import job", func(t *testing.T) {
+		_, err := NewGetImportJobService(ctx).Run(&product.GetImportJobRequest{Id: job.ID, MerchantId: merchantA})
+		assertPermissionDenied(t, err)
+
+		for _, merchantID := range []uint64{merchantB, 0} {
+			resp, err := NewGetImportJobService(ctx).Run(&product.GetImportJobRequest{Id: job.ID, MerchantId: merchantID})
+			if err != nil || resp.Job.Id != job.ID {
+				t.Fatalf("merchant %d: unexpected result %v, %v", merchantID, resp, err)
+			}
+		}
+	})
+
+	t.Run("history", func(t *testing.T) {
+		_, err := NewListProductHistoryService(ctx).Run(&product.ListProductHistoryRequest{SpuId: spuID, MerchantId: merchantA})
+		assertPermissionDenied(t, err)
+
+		for _, merchantID := range []uint64{merchantB, 0} {
+			resp, err := NewListProductHistoryService(ctx).Run(&product.ListProductHistoryRequest{SpuId: spuID, MerchantId: merchantID})
+			if err != nil || resp.Total != 1 {
+				t.Fatalf("merchant %d: unexpected result %v, %v", merchantID, resp, err)
+			}
+		}
+	})
+}
+
+// 商家只能列出、导出自己的商品，只能看到自己商品的定时任务
+func TestMerchantListScope(t *testing.T) {
+	daltest.MySQL(t)
+	ctx := context.Background()
+
+	spuA, err := model.CreateSPU(ctx, mysql.DB, &model.ProductSPU{Name: "A 的商品", MerchantID: merchantA})
+	if err != nil {
+		t.Fatalf("create spu: %v", err)
+	}
+	spuB, err := model.CreateSPU(ctx, mysql.DB, &model.ProductSPU{Name: "B 的商品", MerchantID: merchantB})
+	if err != nil {
+		t.Fatalf("create spu: %v", err)
+	}
+	for _, spuID := range []uint64{spuA, spuB} {
+		sch := &model.ProductSchedule{TargetType: model.ScheduleTargetSPU, TargetID: spuID, Action: model.ScheduleActionPublish, RunAt: time.Now()}
+		if err := model.CreateProductSchedule(ctx, mysql.DB, sch); err != nil {
+			t.Fatalf("create schedule: %v", err)
+		}
+	}
+
+	list, err := NewListProductsService(ctx).Run(&product.ListProductsRequest{PublishStatus: -1, VerifyStatus: -1, MerchantId: merchantA})
+	if err != nil || list.Total != 1 || list.List[0].Id != spuA {
+		t.Errorf("list products: unexpected result %v, %v", list, err)
+	}
+
+	// 导出与列表使用相同的筛选条件
+	exported, err := model.ListProductsAfter(ctx, mysql.DB, 0, 10, "", 0, 0, -1, -1, false, merchantA)
+	if err != nil || len(exported) != 1 || exported[0].ID != spuA {
+		t.Errorf("export products: unexpected result %v, %v", exported, err)
+	}
+
+	schedules, err := NewListProductSchedulesService(ctx).Run(&product.ListProductSchedulesRequest{Status: -1, MerchantId: merchantA})
+	if err != nil || schedules.Total != 1 || schedules.List[0].TargetId != spuA {
+		t.Errorf("list schedules: unexpected result %v, %v", schedules, err)
+	}
+	schedules, err = NewListProductSchedulesService(ctx).Run(&product.ListProductSchedulesRequest{Status: -1})
+	if err != nil || schedules.Total != 2 {
+		t.Errorf("list all schedules: unexpected result %v, %v", schedules, err)
+	}
+}
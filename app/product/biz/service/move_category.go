@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type MoveCategoryService struct {
+	ctx context.Context
+}
+
+func NewMoveCategoryService(ctx context.Context) *MoveCategoryService {
+	return &MoveCategoryService{ctx: ctx}
+}
+
+// Run 将分类连同子树移动到新的父分类下，并重新计算子树各节点的层级
+func (s *MoveCategoryService) Run(req *product.MoveCategoryRequest) (*product.MoveCategoryResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "category id is required")
+	}
+
+	all, err := model.ListAllCategories(s.ctx, mysql.DB)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list categories failed: "+err.Error())
+	}
+	subtree, levelDelta, err := planCategoryMove(all, req.Id, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.MoveCategory(s.ctx, mysql.DB, req.Id, req.ParentId, subtree, levelDelta); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "move category failed: "+err.Error())
+	}
+	invalidateCategoryTree(s.ctx)
+	invalidateSpecTemplates(s.ctx)
+
+	return &product.MoveCategoryResponse{Success: true}, nil
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/notify"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// updateSKUWithPriceHistory 更新 SKU，售价或市场价有变化时在同一事务内写入价格历史。
+// 返回的变动记录需在事务提交后交给 notifyPriceDrops
+func updateSKUWithPriceHistory(ctx context.Context, tx *gorm.DB, skuID uint64, updates map[string]interface{}, source string, operatorID uint64) (*model.SkuPriceHistory, error) {
+	_, hasPrice := updates["price"]
+	_, hasMarketPrice := updates["market_price"]
+	if !hasPrice && !hasMarketPrice {
+		return nil, model.UpdateSKU(ctx, tx, skuID, updates)
+	}
+
+	var before model.ProductSKU
+	if err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", skuID).First(&before).Error; err != nil {
+		return nil, err
+	}
+	if err := model.UpdateSKU(ctx, tx, skuID, updates); err != nil {
+		return nil, err
+	}
+	after, err := model.GetSKUByID(ctx, tx, skuID)
+	if err != nil {
+		return nil, err
+	}
+
+	change := priceChange(&before, after, source, operatorID)
+	if change == nil {
+		return nil, nil
+	}
+	if err := model.CreatePriceHistory(ctx, tx, []*model.SkuPriceHistory{change}); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// priceChange 对比更新前后的 SKU，价格没有变化时返回 nil
+func priceChange(before, after *model.ProductSKU, source string, operatorID uint64) *model.SkuPriceHistory {
+	if before.Price == after.Price && before.MarketPrice == after.MarketPrice {
+		return nil
+	}
+	return &model.SkuPriceHistory{
+		SkuID:          after.ID,
+		SpuID:          after.SpuID,
+		OldPrice:       before.Price,
+		NewPrice:       after.Price,
+		OldMarketPrice: before.MarketPrice,
+		NewMarketPrice: after.MarketPrice,
+		Source:         source,
+		OperatorID:     operatorID,
+	}
+}
+
+// recordInitialPrices 记录新建 SKU 的初始价格，作为价格曲线的起点
+func recordInitialPrices(ctx context.Context, skus []*model.ProductSKU, operatorID uint64) {
+	list := make([]*model.SkuPriceHistory, 0, len(skus))
+	for _, sku := range skus {
+		list = append(list, &model.SkuPriceHistory{
+			SkuID:          sku.ID,
+			SpuID:          sku.SpuID,
+			NewPrice:       sku.Price,
+			NewMarketPrice: sku.MarketPrice,
+			Source:         model.PriceSourceCreate,
+			OperatorID:     operatorID,
+		})
+	}
+	if err := model.CreatePriceHistory(ctx, mysql.DB, list); err != nil {
+		klog.Warnf("Failed to record initial sku prices: %v", err)
+	}
+}
+
+// 降价提醒投递与重试
+const (
+	priceAlertSendTimeout    = 5 * time.Minute // 提醒领取后超过该时间仍在投递中，视为处理实例已退出，可重新领取
+	priceAlertRetryInterval  = time.Minute     // 重试任务扫描间隔
+	priceAlertRetryBaseDelay = time.Minute     // 首次重试延迟，之后每次翻倍
+	priceAlertRetryMaxDelay  = time.Hour
+	priceAlertMaxRetries     = 10 // 连续失败达到该次数后不再补发，等待下次降价
+	priceAlertRetryBatch     = 100
+)
+
+// notifyPriceDrops 异步检查降价越过目标价的提醒，投递成功后才标记为已通知，每条提醒只通知一次
+func notifyPriceDrops(ctx context.Context, changes []*model.SkuPriceHistory) {
+	var drops []*model.SkuPriceHistory
+	for _, c := range changes {
+		if c != nil && c.NewPrice < c.OldPrice {
+			drops = append(drops, c)
+		}
+	}
+	if len(drops) == 0 {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		for _, c := range drops {
+			staleBefore := time.Now().Add(-priceAlertSendTimeout)
+			alerts, err := model.ListCrossedPriceAlerts(ctx, mysql.DB, c.SkuID, c.OldPrice, c.NewPrice, staleBefore)
+			if err != nil {
+				klog.Warnf("Failed to list price alerts for sku %d: %v", c.SkuID, err)
+				continue
+			}
+			if len(alerts) == 0 {
+				continue
+			}
+			var skuName string
+			if sku, err := model.GetSKUByID(ctx, mysql.DB, c.SkuID); err == nil {
+				skuName = sku.Name
+			}
+			for _, a := range alerts {
+				ok, err := model.ClaimPriceAlert(ctx, mysql.DB, a.ID, staleBefore)
+				if err != nil {
+					klog.Warnf("Failed to claim price alert %d: %v", a.ID, err)
+					continue
+				}
+				if ok {
+					deliverPriceAlert(ctx, a, skuName, c.OldPrice, c.NewPrice)
+				}
+			}
+		}
+	}()
+}
+
+// deliverPriceAlert 投递已领取的提醒，成功后标记为已通知，失败时恢复等待并安排重试
+func deliverPriceAlert(ctx context.Context, a *model.PriceAlert, skuName string, oldPrice, newPrice float64) {
+	now := time.Now()
+	err := notify.Deliver(ctx, &notify.Event{
+		Type:        notify.EventPriceDropped,
+		AlertID:     a.ID,
+		RecipientID: a.UserID,
+		SpuID:       a.SpuID,
+		SkuID:       a.SkuID,
+		SkuName:     skuName,
+		OldPrice:    utils.PriceToString(oldPrice),
+		NewPrice:    utils.PriceToString(newPrice),
+		TargetPrice: utils.PriceToString(a.TargetPrice),
+		OccurredAt:  now,
+	})
+	if err != nil {
+		klog.Warnf("Failed to deliver price alert %d: %v", a.ID, err)
+		if err := model.ReleasePriceAlert(ctx, mysql.DB, a.ID, priceAlertRetryAt(a.RetryCount, now)); err != nil {
+			klog.Warnf("Failed to release price alert %d: %v", a.ID, err)
+		}
+		return
+	}
+	if err := model.MarkPriceAlertNotified(ctx, mysql.DB, a.ID, newPrice, now); err != nil {
+		klog.Warnf("Failed to mark price alert %d notified: %v", a.ID, err)
+	}
+}
+
+// priceAlertRetryAt 已连续失败 failures 次的提醒再次失败后的重试时间，按次数指数退避，达到上限时返回 nil
+func priceAlertRetryAt(failures int, now time.Time) *time.Time {
+	if failures+1 >= priceAlertMaxRetries {
+		return nil
+	}
+	delay := priceAlertRetryMaxDelay
+	if failures < 6 {
+		delay = min(priceAlertRetryBaseDelay<<failures, priceAlertRetryMaxDelay)
+	}
+	at := now.Add(delay)
+	return &at
+}
+
+// RetryPriceAlerts 补发投递失败或投递中断的提醒，按当前售价判断是否仍低于目标价，已回升的不再补发
+func RetryPriceAlerts(ctx context.Context, now time.Time) error {
+	staleBefore := now.Add(-priceAlertSendTimeout)
+	alerts, err := model.ListRetryPriceAlerts(ctx, mysql.DB, now, staleBefore, priceAlertRetryBatch)
+	if err != nil {
+		return err
+	}
+	for _, a := range alerts {
+		sku, err := model.GetSKUByID(ctx, mysql.DB, a.SkuID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			klog.Warnf("Failed to get sku %d for price alert %d: %v", a.SkuID, a.ID, err)
+			continue
+		}
+		if sku == nil || sku.Price > a.TargetPrice {
+			if err := model.CancelPriceAlertRetry(ctx, mysql.DB, a.ID, staleBefore); err != nil {
+				klog.Warnf("Failed to cancel price alert %d retry: %v", a.ID, err)
+			}
+			continue
+		}
+		ok, err := model.ClaimPriceAlert(ctx, mysql.DB, a.ID, staleBefore)
+		if err != nil {
+			klog.Warnf("Failed to claim price alert %d: %v", a.ID, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		// 原价取最近一次价格变动前的售价
+		oldPrice := sku.Price
+		if list, err := model.ListPriceHistory(ctx, mysql.DB, sku.SpuID, sku.ID, time.Time{}, 1); err == nil && len(list) > 0 && list[0].OldPrice > 0 {
+			oldPrice = list[0].OldPrice
+		}
+		deliverPriceAlert(ctx, a, sku.Name, oldPrice, sku.Price)
+	}
+	return nil
+}
+
+// StartPriceAlertRetryTask 启动降价提醒补发任务
+func StartPriceAlertRetryTask(ctx context.Context) {
+	ticker := time.NewTicker(priceAlertRetryInterval)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				klog.Info("Price alert retry task stopped")
+				return
+			case <-ticker.C:
+				if err := RetryPriceAlerts(ctx, time.Now()); err != nil {
+					klog.Errorf("Price alert retry task failed: %v", err)
+				}
+			}
+		}
+	}()
+	klog.Infof("Price alert retry task started, interval=%v", priceAlertRetryInterval)
+}
+
+// publicPriceAlertStatus 投递中是内部状态，对外显示为等待降价
+func publicPriceAlertStatus(status int8) int8 {
+	if status == model.PriceAlertStatusSending {
+		return model.PriceAlertStatusWaiting
+	}
+	return status
+}
+
+func toProtoPriceAlert(a *model.PriceAlert) *product.PriceAlert {
+	p := &product.PriceAlert{
+		Id:          a.ID,
+		UserId:      a.UserID,
+		SkuId:       a.SkuID,
+		SpuId:       a.SpuID,
+		TargetPrice: utils.PriceToString(a.TargetPrice),
+		Status:      int32(publicPriceAlertStatus(a.Status)),
+		CreatedAt:   a.CreatedAt.Unix(),
+	}
+	if a.NotifiedAt != nil {
+		p.NotifiedPrice = utils.PriceToString(a.NotifiedPrice)
+		p.NotifiedAt = a.NotifiedAt.Unix()
+	}
+	return p
+}
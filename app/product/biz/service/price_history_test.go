@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/daltest"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/notify"
+)
+
+func TestPriceChange(t *testing.T) {
+	before := &model.ProductSKU{Model: model.Model{ID: 1}, SpuID: 2, Price: 99.9, MarketPrice: 129}
+	after := &model.ProductSKU{Model: model.Model{ID: 1}, SpuID: 2, Price: 99.9, MarketPrice: 129, Name: "renamed"}
+	if c := priceChange(before, after, model.PriceSourceManual, 7); c != nil {
+		t.Errorf("expected no change, got %+v", c)
+	}
+
+	after.Price = 89.9
+	c := priceChange(before, after, model.PriceSourceSchedule, 7)
+	if c == nil {
+		t.Fatal("expected a price change")
+	}
+	if c.SkuID != 1 || c.SpuID != 2 || c.OldPrice != 99.9 || c.NewPrice != 89.9 ||
+		c.OldMarketPrice != 129 || c.NewMarketPrice != 129 || c.Source != model.PriceSourceSchedule || c.OperatorID != 7 {
+		t.Errorf("unexpected change: %+v", c)
+	}
+
+	after.Price, after.MarketPrice = 99.9, 139
+	if c := priceChange(before, after, model.PriceSourceManual, 0); c == nil || c.NewMarketPrice != 139 {
+		t.Errorf("expected market price change, got %+v", c)
+	}
+}
+
+func TestPriceAlertRetryAt(t *testing.T) {
+	now := time.Now()
+	for failures, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute} {
+		if at := priceAlertRetryAt(failures, now); at == nil || at.Sub(now) != want {
+			t.Errorf("failures %d: retry at %v, want +%v", failures, at, want)
+		}
+	}
+	if at := priceAlertRetryAt(7, now); at == nil || at.Sub(now) != priceAlertRetryMaxDelay {
+		t.Errorf("retry delay should be capped at %v, got %v", priceAlertRetryMaxDelay, at)
+	}
+	if at := priceAlertRetryAt(priceAlertMaxRetries-1, now); at != nil {
+		t.Errorf("should give up after %d failures, got %v", priceAlertMaxRetries, at)
+	}
+}
+
+// testNotifier 测试用的通知钩子，fail 为 true 时投递失败
+type testNotifier struct {
+	mu        sync.Mutex
+	fail      bool
+	delivered []*notify.Event
+}
+
+var priceAlertNotifier = func() *testNotifier {
+	n := &testNotifier{}
+	notify.Register(func(_ context.Context, e *notify.Event) error {
+		n.mu.Lock()
+		defer n.mu.Unlock()
+		if n.fail {
+			return errors.New("webhook unavailable")
+		}
+		n.delivered = append(n.delivered, e)
+		return nil
+	})
+	return n
+}()
+
+func (n *testNotifier) reset(fail bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.fail = fail
+	n.delivered = nil
+}
+
+func (n *testNotifier) deliveredAlerts() []uint64 {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	ids := make([]uint64, 0, len(n.delivered))
+	for _, e := range n.delivered {
+		ids = append(ids, e.AlertID)
+	}
+	return ids
+}
+
+// 投递失败的提醒到达重试时间后按当前售价补发，售价已回升的不再补发
+func TestRetryPriceAlerts(t *testing.T) {
+	daltest.MySQL(t)
+	ctx := context.Background()
+
+	sku := &model.ProductSKU{SpuID: 1, Name: "测试 SKU", Price: 80, SkuSpecData: "{}"}
+	if err := mysql.DB.Create(sku).Error; err != nil {
+		t.Fatalf("create sku: %v", err)
+	}
+	if err := model.CreatePriceHistory(ctx, mysql.DB, []*model.SkuPriceHistory{{SkuID: sku.ID, SpuID: 1, OldPrice: 100, NewPrice: 80}}); err != nil {
+		t.Fatalf("create price history: %v", err)
+	}
+	alert := &model.PriceAlert{UserID: 1, SkuID: sku.ID, SpuID: 1, TargetPrice: 90}
+	if err := model.UpsertPriceAlert(ctx, mysql.DB, alert); err != nil {
+		t.Fatalf("create alert: %v", err)
+	}
+	reload := func(id uint64) *model.PriceAlert {
+		t.Helper()
+		var a model.PriceAlert
+		if err := mysql.DB.First(&a, id).Error; err != nil {
+			t.Fatalf("load alert: %v", err)
+		}
+		return &a
+	}
+
+	// 降价时投递失败，提醒恢复等待并安排重试
+	priceAlertNotifier.reset(true)
+	if ok, err := model.ClaimPriceAlert(ctx, mysql.DB, alert.ID, time.Now().Add(-priceAlertSendTimeout)); err != nil || !ok {
+		t.Fatalf("claim alert: %v, %v", ok, err)
+	}
+	deliverPriceAlert(ctx, alert, sku.Name, 100, 80)
+	a := reload(alert.ID)
+	if a.Status != model.PriceAlertStatusWaiting || a.RetryCount != 1 || a.RetryAt == nil {
+		t.Fatalf("after failed delivery: status %d, retries %d, retry at %v", a.Status, a.RetryCount, a.RetryAt)
+	}
+
+	// 未到重试时间不补发
+	priceAlertNotifier.reset(false)
+	if err := RetryPriceAlerts(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if ids := priceAlertNotifier.deliveredAlerts(); len(ids) != 0 {
+		t.Fatalf("retried before retry_at: %v", ids)
+	}
+
+	// 到达重试时间后补发，成功后标记为已通知
+	if err := RetryPriceAlerts(ctx, a.RetryAt.Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if ids := priceAlertNotifier.deliveredAlerts(); len(ids) != 1 || ids[0] != alert.ID {
+		t.Fatalf("expected alert %d to be retried, got %v", alert.ID, ids)
+	}
+	if e := priceAlertNotifier.delivered[0]; e.OldPrice != "100.00" || e.NewPrice != "80.00" {
+		t.Errorf("retried event prices %s -> %s, want 100.00 -> 80.00", e.OldPrice, e.NewPrice)
+	}
+	a = reload(alert.ID)
+	if a.Status != model.PriceAlertStatusNotified || a.RetryCount != 0 || a.RetryAt != nil {
+		t.Errorf("after retry: status %d, retries %d, retry at %v", a.Status, a.RetryCount, a.RetryAt)
+	}
+
+	// 售价回升到目标价以上时取消重试，等待下次降价
+	risen := &model.PriceAlert{UserID: 2, SkuID: sku.ID, SpuID: 1, TargetPrice: 70}
+	if err := model.UpsertPriceAlert(ctx, mysql.DB, risen); err != nil {
+		t.Fatalf("create alert: %v", err)
+	}
+	due := time.Now().Add(-time.Minute)
+	if err := mysql.DB.Model(risen).Updates(map[string]interface{}{"retry_count": 1, "retry_at": due}).Error; err != nil {
+		t.Fatal(err)
+	}
+	priceAlertNotifier.reset(false)
+	if err := RetryPriceAlerts(ctx, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if ids := priceAlertNotifier.deliveredAlerts(); len(ids) != 0 {
+		t.Fatalf("alert above target price should not be delivered: %v", ids)
+	}
+	a = reload(risen.ID)
+	if a.Status != model.PriceAlertStatusWaiting || a.RetryCount != 0 || a.RetryAt != nil {
+		t.Errorf("after price rose: status %d, retries %d, retry at %v", a.Status, a.RetryCount, a.RetryAt)
+	}
+}
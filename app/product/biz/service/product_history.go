@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// loadProductSnapshot 读取商品当前的 SPU、SKU 和详情，SKU 按 ID 排序保证快照稳定
+func loadProductSnapshot(ctx context.Context, spuID uint64) (*model.ProductSnapshot, error) {
+	spu, err := model.GetSPUByID(ctx, mysql.DB, spuID)
+	if err != nil {
+		return nil, err
+	}
+	skus, err := model.GetSKUsBySpuID(ctx, mysql.DB, spuID)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := model.GetProductDetailBySpuID(ctx, spuID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &model.ProductSnapshot{
+		SPU: model.SnapshotSPU{
+			Name:        spu.Name,
+			SubTitle:    spu.SubTitle,
+			MainImage:   spu.MainImage,
+			BrandID:     spu.BrandID,
+			CategoryID:  spu.CategoryID,
+			ServiceBits: spu.ServiceBits,
+			Sort:        spu.Sort,
+		},
+		SKUs: make([]model.SnapshotSKU, 0, len(skus)),
+	}
+	for _, sku := range skus {
+		snapshot.SKUs = append(snapshot.SKUs, model.SnapshotSKU{
+			ID:          sku.ID,
+			Name:        sku.Name,
+			SubTitle:    sku.SubTitle,
+			MainImage:   sku.MainImage,
+			Price:       sku.Price,
+			MarketPrice: sku.MarketPrice,
+			SkuSpecData: sku.SkuSpecData,
+		})
+	}
+	slices.SortFunc(snapshot.SKUs, func(a, b model.SnapshotSKU) int {
+		switch {
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		}
+		return 0
+	})
+	if detail != nil {
+		snapshot.Detail = &model.SnapshotDetail{
+			Description:   detail.Description,
+			Images:        detail.Images,
+			Videos:        detail.Videos,
+			MarketTagJSON: detail.MarketTagJSON,
+			TechTagJSON:   detail.TechTagJSON,
+			FaqJSON:       detail.FaqJSON,
+		}
+	}
+	return snapshot, nil
+}
+
+// diffSnapshots 逐字段比较两个快照，字段名形如 spu.name、sku.<id>.price、detail.images，
+// 列表字段按 JSON 比较；新增或移除的 SKU 记为 sku.<id>，值为 SKU 名称
+func diffSnapshots(before, after *model.ProductSnapshot) []model.FieldChange {
+	var changes []model.FieldChange
+	add := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, model.FieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	add("spu.name", before.SPU.Name, after.SPU.Name)
+	add("spu.sub_title", before.SPU.SubTitle, after.SPU.SubTitle)
+	add("spu.main_image", before.SPU.MainImage, after.SPU.MainImage)
+	add("spu.brand_id", strconv.FormatUint(before.SPU.BrandID, 10), strconv.FormatUint(after.SPU.BrandID, 10))
+	add("spu.category_id", strconv.FormatUint(before.SPU.CategoryID, 10), strconv.FormatUint(after.SPU.CategoryID, 10))
+	add("spu.service_bits", strconv.FormatInt(before.SPU.ServiceBits, 10), strconv.FormatInt(after.SPU.ServiceBits, 10))
+	add("spu.sort", strconv.Itoa(before.SPU.Sort), strconv.Itoa(after.SPU.Sort))
+
+	oldSkus := make(map[uint64]model.SnapshotSKU, len(before.SKUs))
+	for _, sku := range before.SKUs {
+		oldSkus[sku.ID] = sku
+	}
+	newIDs := make(map[uint64]bool, len(after.SKUs))
+	for _, sku := range after.SKUs {
+		newIDs[sku.ID] = true
+		prefix := fmt.Sprintf("sku.%d", sku.ID)
+		old, ok := oldSkus[sku.ID]
+		if !ok {
+			add(prefix, "", sku.Name)
+			continue
+		}
+		add(prefix+".name", old.Name, sku.Name)
+		add(prefix+".sub_title", old.SubTitle, sku.SubTitle)
+		add(prefix+".main_image", old.MainImage, sku.MainImage)
+		add(prefix+".price", utils.PriceToString(old.Price), utils.PriceToString(sku.Price))
+		add(prefix+".market_price", utils.PriceToString(old.MarketPrice), utils.PriceToString(sku.MarketPrice))
+		add(prefix+".sku_spec_data", old.SkuSpecData, sku.SkuSpecData)
+	}
+	for _, sku := range before.SKUs {
+		if !newIDs[sku.ID] {
+			add(fmt.Sprintf("sku.%d", sku.ID), sku.Name, "")
+		}
+	}
+
+	oldDetail, newDetail := before.Detail, after.Detail
+	if oldDetail == nil {
+		oldDetail = &model.SnapshotDetail{}
+	}
+	if newDetail == nil {
+		newDetail = &model.SnapshotDetail{}
+	}
+	add("detail.description", oldDetail.Description, newDetail.Description)
+	add("detail.images", jsonString(oldDetail.Images), jsonString(newDetail.Images))
+	add("detail.videos", jsonString(oldDetail.Videos), jsonString(newDetail.Videos))
+	add("detail.market_tag_json", oldDetail.MarketTagJSON, newDetail.MarketTagJSON)
+	add("detail.tech_tag_json", oldDetail.TechTagJSON, newDetail.TechTagJSON)
+	add("detail.faq_json", oldDetail.FaqJSON, newDetail.FaqJSON)
+	return changes
+}
+
+// jsonString 列表字段按 JSON 比较，nil 与空列表视为相同
+func jsonString(list []string) string {
+	if len(list) == 0 {
+		return "[]"
+	}
+	data, _ := json.Marshal(list)
+	return string(data)
+}
+
+// recordProductChange 读取变更后的商品快照并与 before 比较，有差异时追加一个版本。
+// before 为空表示新建商品，不计算差异；商品此前没有历史时先以 before 作为 baseline 版本。
+// 返回新版本号，没有差异时返回 0
+func recordProductChange(ctx context.Context, spuID, operatorID uint64, action string, sourceVersion int, before *model.ProductSnapshot) (int, error) {
+	after, err := loadProductSnapshot(ctx, spuID)
+	if err != nil {
+		return 0, err
+	}
+
+	entry := &model.ProductHistory{
+		SpuID:         spuID,
+		OperatorID:    operatorID,
+		Action:        action,
+		SourceVersion: sourceVersion,
+	}
+	entry.SetSnapshot(after)
+
+	var baseline *model.ProductHistory
+	if before != nil {
+		changes := diffSnapshots(before, after)
+		if len(changes) == 0 {
+			return 0, nil
+		}
+		entry.SetChanges(changes)
+
+		baseline = &model.ProductHistory{SpuID: spuID, Action: model.HistoryActionBaseline}
+		baseline.SetSnapshot(before)
+	}
+
+	if err := model.AppendProductHistory(ctx, mysql.DB, baseline, entry); err != nil {
+		return 0, err
+	}
+	return entry.Version, nil
+}
+
+func toProtoProductVersion(h *model.ProductHistory) *product.ProductVersion {
+	changes := h.GetChanges()
+	list := make([]*product.FieldChange, 0, len(changes))
+	for _, c := range changes {
+		list = append(list, &product.FieldChange{
+			Field:    c.Field,
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+	return &product.ProductVersion{
+		Version:       int32(h.Version),
+		OperatorId:    h.OperatorID,
+		Action:        h.Action,
+		Changes:       list,
+		SourceVersion: int32(h.SourceVersion),
+		CreatedAt:     h.CreatedAt.Unix(),
+	}
+}
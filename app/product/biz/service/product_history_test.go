@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+func testSnapshot() *model.ProductSnapshot {
+	return &model.ProductSnapshot{
+		SPU: model.SnapshotSPU{Name: "小米14", CategoryID: 1001, BrandID: 1},
+		SKUs: []model.SnapshotSKU{
+			{ID: 1, Name: "小米14 黑色", Price: 3999, SkuSpecData: `{"颜色":"黑色"}`},
+			{ID: 2, Name: "小米14 白色", Price: 3999, SkuSpecData: `{"颜色":"白色"}`},
+		},
+		Detail: &model.SnapshotDetail{Description: "desc", Images: []string{"a.jpg"}},
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	before := testSnapshot()
+	after := testSnapshot()
+	if changes := diffSnapshots(before, after); len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v", changes)
+	}
+
+	after.SPU.Name = "小米14 Pro"
+	after.SKUs[0].Price = 3799
+	after.SKUs = append(after.SKUs[:1], model.SnapshotSKU{ID: 3, Name: "小米14 蓝色"})
+	after.Detail.Images = []string{"a.jpg", "b.jpg"}
+
+	want := map[string][2]string{
+		"spu.name":      {"小米14", "小米14 Pro"},
+		"sku.1.price":   {"3999.00", "3799.00"},
+		"sku.3":         {"", "小米14 蓝色"},
+		"sku.2":         {"小米14 白色", ""},
+		"detail.images": {`["a.jpg"]`, `["a.jpg","b.jpg"]`},
+	}
+	changes := diffSnapshots(before, after)
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes %v, want %d", len(changes), changes, len(want))
+	}
+	for _, c := range changes {
+		w, ok := want[c.Field]
+		if !ok || c.OldValue != w[0] || c.NewValue != w[1] {
+			t.Errorf("unexpected change %+v", c)
+		}
+	}
+}
+
+// 详情缺失与空列表不算差异
+func TestDiffSnapshotsEmptyDetail(t *testing.T) {
+	before := testSnapshot()
+	before.Detail = nil
+	after := testSnapshot()
+	after.Detail = &model.SnapshotDetail{Images: []string{}}
+	if changes := diffSnapshots(before, after); len(changes) != 0 {
+		t.Errorf("expected no changes, got %v", changes)
+	}
+}
+
+func TestRollbackUpdates(t *testing.T) {
+	current := testSnapshot()
+	target := testSnapshot()
+	target.SPU.Sort = 10
+	target.SKUs[1].Price = 4299
+	target.SKUs = append(target.SKUs, model.SnapshotSKU{ID: 9, Name: "已删除的SKU"})
+
+	spu := rollbackSPUUpdates(&current.SPU, &target.SPU)
+	if len(spu) != 1 || spu["sort"] != 10 {
+		t.Errorf("unexpected spu updates %v", spu)
+	}
+	skus := rollbackSKUUpdates(current.SKUs, target.SKUs)
+	if len(skus) != 1 || skus[2]["price"] != float64(4299) {
+		t.Errorf("unexpected sku updates %v", skus)
+	}
+	if detail := rollbackDetailUpdates(current.Detail, target.Detail); detail != nil {
+		t.Errorf("expected no detail updates, got %v", detail)
+	}
+	target.Detail.Description = "old desc"
+	if detail := rollbackDetailUpdates(current.Detail, target.Detail); detail["description"] != "old desc" {
+		t.Errorf("unexpected detail updates %v", detail)
+	}
+}
+
+func TestRollbackProductValidation(t *testing.T) {
+	reqs := []*product.RollbackProductRequest{
+		{Version: 1},
+		{SpuId: 1},
+	}
+	for _, req := range reqs {
+		if _, err := NewRollbackProductService(context.Background()).Run(req); err == nil {
+			t.Errorf("expected error for %+v", req)
+		}
+	}
+}
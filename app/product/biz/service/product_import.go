@@ -0,0 +1,448 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/sheet"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 导入限制
+const (
+	maxImportFileSize = 5 << 20
+	maxImportRows     = 5000
+	maxImportJobs     = 2 // 同时执行的导入任务数
+)
+
+// 导入/导出表格的列，spu_ref 相同的行属于同一个商品，商品与详情字段取该商品第一行的值
+var importColumns = []string{
+	"spu_ref", "spu_name", "spu_sub_title", "spu_main_image", "category_id", "brand_id", "service_bits",
+	"description", "images", "videos", "market_tag_json", "tech_tag_json", "faq_json",
+	"sku_code", "sku_name", "sku_sub_title", "sku_main_image", "price", "market_price", "stock", "sku_spec_data",
+}
+
+var requiredImportColumns = []string{"spu_ref", "spu_name", "category_id", "sku_code", "sku_name", "price"}
+
+// 商品级的列，同一商品的后续行填写时必须与第一行一致
+var spuImportColumns = []string{
+	"spu_name", "spu_sub_title", "spu_main_image", "category_id", "brand_id", "service_bits",
+	"description", "images", "videos", "market_tag_json", "tech_tag_json", "faq_json",
+}
+
+// 多值列的分隔符
+const importListSep = "|"
+
+var importSlots = make(chan struct{}, maxImportJobs)
+
+// importGroup 文件中的一个商品
+type importGroup struct {
+	ref    string
+	rows   []int // 每个 SKU 所在的表格行号
+	values map[string]string
+	req    *product.CreateProductRequest
+}
+
+func (g *importGroup) firstRow() int {
+	return g.rows[0]
+}
+
+// importReport 收集逐行错误
+type importReport struct {
+	errors  []model.ImportRowError
+	badRows map[int]bool
+}
+
+func newImportReport() *importReport {
+	return &importReport{badRows: make(map[int]bool)}
+}
+
+func (r *importReport) add(row int, column, message string) {
+	r.errors = append(r.errors, model.ImportRowError{Row: row, Column: column, Message: message})
+	r.badRows[row] = true
+}
+
+// groupValid 商品的所有行都没有错误
+func (r *importReport) groupValid(g *importGroup) bool {
+	for _, row := range g.rows {
+		if r.badRows[row] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseImportSheet 解析表格并按 spu_ref 分组，完成不依赖数据库的校验。
+// 返回商品分组与数据行数，表头缺少必填列时返回错误
+func parseImportSheet(rows [][]string, report *importReport) ([]*importGroup, int, error) {
+	if len(rows) == 0 {
+		return nil, 0, fmt.Errorf("sheet is empty")
+	}
+	header := make(map[string]int, len(rows[0]))
+	for i, name := range rows[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := header[name]; name != "" && !ok {
+			header[name] = i
+		}
+	}
+	var missing []string
+	for _, name := range requiredImportColumns {
+		if _, ok := header[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, 0, fmt.Errorf("missing required columns: %s", strings.Join(missing, ", "))
+	}
+
+	var groups []*importGroup
+	byRef := make(map[string]*importGroup)
+	skuRows := make(map[string]int)
+	total := 0
+	for i, cells := range rows[1:] {
+		rowNum := i + 2
+		values := make(map[string]string, len(importColumns))
+		blank := true
+		for _, name := range importColumns {
+			if idx, ok := header[name]; ok && idx < len(cells) {
+				values[name] = strings.TrimSpace(cells[idx])
+				if values[name] != "" {
+					blank = false
+				}
+			}
+		}
+		if blank {
+			continue
+		}
+		total++
+
+		ref := values["spu_ref"]
+		if ref == "" {
+			report.add(rowNum, "spu_ref", "required")
+			continue
+		}
+		g, ok := byRef[ref]
+		if !ok {
+			g = &importGroup{ref: ref, values: values}
+			byRef[ref] = g
+			groups = append(groups, g)
+			g.req = parseImportSPU(rowNum, values, report)
+		} else {
+			for _, name := range spuImportColumns {
+				if values[name] != "" && values[name] != g.values[name] {
+					report.add(rowNum, name, fmt.Sprintf("conflicts with row %d of the same spu_ref", g.firstRow()))
+				}
+			}
+		}
+		g.rows = append(g.rows, rowNum)
+
+		sku := parseImportSKU(rowNum, values, report)
+		if first, ok := skuRows[sku.SkuCode]; ok && sku.SkuCode != "" {
+			report.add(rowNum, "sku_code", fmt.Sprintf("duplicate of row %d", first))
+		} else {
+			skuRows[sku.SkuCode] = rowNum
+		}
+		g.req.Skus = append(g.req.Skus, sku)
+	}
+	return groups, total, nil
+}
+
+// parseImportSPU 由商品第一行解析商品与详情
+func parseImportSPU(row int, values map[string]string, report *importReport) *product.CreateProductRequest {
+	spu := &product.ProductSPU{
+		Name:      values["spu_name"],
+		SubTitle:  values["spu_sub_title"],
+		MainImage: values["spu_main_image"],
+	}
+	checkImportText(row, "spu_name", spu.Name, 255, true, report)
+	checkImportText(row, "spu_sub_title", spu.SubTitle, 500, false, report)
+	checkImportText(row, "spu_main_image", spu.MainImage, 1000, false, report)
+	if v := values["category_id"]; v == "" {
+		report.add(row, "category_id", "required")
+	} else if id, err := strconv.ParseUint(v, 10, 64); err != nil || id == 0 {
+		report.add(row, "category_id", "must be a positive integer")
+	} else {
+		spu.CategoryId = id
+	}
+	if v := values["brand_id"]; v != "" {
+		if id, err := strconv.ParseUint(v, 10, 64); err != nil {
+			report.add(row, "brand_id", "must be a non-negative integer")
+		} else {
+			spu.BrandId = id
+		}
+	}
+	if v := values["service_bits"]; v != "" {
+		if bits, err := strconv.ParseInt(v, 10, 64); err != nil || bits < 0 {
+			report.add(row, "service_bits", "must be a non-negative integer")
+		} else {
+			spu.ServiceBits = bits
+		}
+	}
+
+	detail := &product.ProductDetail{
+		Description:   values["description"],
+		Images:        splitImportList(values["images"]),
+		Videos:        splitImportList(values["videos"]),
+		MarketTagJson: values["market_tag_json"],
+		TechTagJson:   values["tech_tag_json"],
+		FaqJson:       values["faq_json"],
+	}
+	for _, name := range []string{"market_tag_json", "tech_tag_json", "faq_json"} {
+		if values[name] != "" && utils.ValidateJsonFormat(values[name]) != nil {
+			report.add(row, name, "invalid JSON")
+		}
+	}
+	return &product.CreateProductRequest{Spu: spu, Detail: detail}
+}
+
+// parseImportSKU 解析一行中的 SKU
+func parseImportSKU(row int, values map[string]string, report *importReport) *product.ProductSKU {
+	sku := &product.ProductSKU{
+		SkuCode:     values["sku_code"],
+		Name:        values["sku_name"],
+		SubTitle:    values["sku_sub_title"],
+		MainImage:   values["sku_main_image"],
+		SkuSpecData: values["sku_spec_data"],
+	}
+	checkImportText(row, "sku_code", sku.SkuCode, 64, true, report)
+	checkImportText(row, "sku_name", sku.Name, 255, true, report)
+	checkImportText(row, "sku_sub_title", sku.SubTitle, 500, false, report)
+	checkImportText(row, "sku_main_image", sku.MainImage, 1000, false, report)
+
+	if v := values["price"]; v == "" {
+		report.add(row, "price", "required")
+	} else if price, err := utils.PriceConvert(v); err != nil || price < 0 {
+		report.add(row, "price", "must be a non-negative number")
+	} else {
+		sku.Price = utils.PriceToString(price)
+	}
+	if v := values["market_price"]; v != "" {
+		if price, err := utils.PriceConvert(v); err != nil || price < 0 {
+			report.add(row, "market_price", "must be a non-negative number")
+		} else {
+			sku.MarketPrice = utils.PriceToString(price)
+		}
+	}
+	if v := values["stock"]; v != "" {
+		if stock, err := strconv.ParseInt(v, 10, 32); err != nil || stock < 0 {
+			report.add(row, "stock", "must be a non-negative integer")
+		} else {
+			sku.Stock = int32(stock)
+		}
+	}
+	if sku.SkuSpecData == "" {
+		sku.SkuSpecData = "{}"
+	} else if _, err := parseSpecData(sku.SkuSpecData); err != nil {
+		report.add(row, "sku_spec_data", err.Error())
+	}
+	return sku
+}
+
+func checkImportText(row int, column, value string, maxLen int, required bool, report *importReport) {
+	if value == "" {
+		if required {
+			report.add(row, column, "required")
+		}
+		return
+	}
+	if utf8.RuneCountInString(value) > maxLen {
+		report.add(row, column, fmt.Sprintf("must be at most %d characters", maxLen))
+	}
+}
+
+func splitImportList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, importListSep) {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// validateImportGroups 完成依赖数据库的校验：分类、品牌存在，SKU 编码未被占用，规格符合分类模板
+func validateImportGroups(ctx context.Context, groups []*importGroup, report *importReport) error {
+	categories, err := loadCategories(ctx)
+	if err != nil {
+		return fmt.Errorf("load categories failed: %w", err)
+	}
+	categoryIDs := make(map[uint64]bool, len(categories))
+	for _, c := range categories {
+		categoryIDs[c.ID] = true
+	}
+
+	var brandIDs []uint64
+	var codes []string
+	skuRows := make(map[string]int)
+	for _, g := range groups {
+		if g.req.Spu.BrandId > 0 {
+			brandIDs = append(brandIDs, g.req.Spu.BrandId)
+		}
+		for i, sku := range g.req.Skus {
+			if sku.SkuCode != "" {
+				codes = append(codes, sku.SkuCode)
+				skuRows[sku.SkuCode] = g.rows[i]
+			}
+		}
+	}
+	brands, err := model.GetBrandsByIds(ctx, mysql.DB, brandIDs)
+	if err != nil {
+		return fmt.Errorf("load brands failed: %w", err)
+	}
+	brandExists := make(map[uint64]bool, len(brands))
+	for _, b := range brands {
+		brandExists[b.ID] = true
+	}
+	existing, err := model.ListExistingSkuCodes(ctx, mysql.DB, codes)
+	if err != nil {
+		return fmt.Errorf("check sku codes failed: %w", err)
+	}
+	for _, code := range existing {
+		report.add(skuRows[code], "sku_code", "already exists")
+	}
+
+	for _, g := range groups {
+		spu := g.req.Spu
+		if spu.CategoryId == 0 {
+			continue
+		}
+		if !categoryIDs[spu.CategoryId] {
+			report.add(g.firstRow(), "category_id", "category not found")
+			continue
+		}
+		if spu.BrandId > 0 && !brandExists[spu.BrandId] {
+			report.add(g.firstRow(), "brand_id", "brand not found")
+		}
+		template, err := loadSpecTemplate(ctx, spu.CategoryId)
+		if err != nil {
+			return fmt.Errorf("load spec template failed: %w", err)
+		}
+		combos := make(map[string]int, len(g.req.Skus))
+		for i, sku := range g.req.Skus {
+			combo, err := validateSpecData(template, sku.SkuSpecData)
+			if err != nil {
+				report.add(g.rows[i], "sku_spec_data", err.Error())
+				continue
+			}
+			if combo == "" {
+				continue
+			}
+			if first, ok := combos[combo]; ok {
+				report.add(g.rows[i], "sku_spec_data", fmt.Sprintf("duplicate sales spec combination with row %d", first))
+				continue
+			}
+			combos[combo] = g.rows[i]
+		}
+	}
+	return nil
+}
+
+// startImportJob 在后台执行导入任务，同时最多执行 maxImportJobs 个，其余任务保持待执行
+func startImportJob(ctx context.Context, job *model.ProductImportJob, format sheet.Format, data []byte) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		importSlots <- struct{}{}
+		defer func() { <-importSlots }()
+		defer func() {
+			if r := recover(); r != nil {
+				klog.Errorf("Import job %d panicked: %v", job.ID, r)
+				finishImportJob(ctx, job, nil, fmt.Sprintf("internal error: %v", r))
+			}
+		}()
+
+		job.Status = model.ImportStatusRunning
+		if err := model.SaveImportJob(ctx, mysql.DB, job); err != nil {
+			klog.Warnf("Failed to update import job %d: %v", job.ID, err)
+		}
+		report, err := runImportJob(ctx, job, format, data)
+		if err != nil {
+			finishImportJob(ctx, job, report, err.Error())
+			return
+		}
+		finishImportJob(ctx, job, report, "")
+	}()
+}
+
+func runImportJob(ctx context.Context, job *model.ProductImportJob, format sheet.Format, data []byte) (*importReport, error) {
+	rows, err := sheet.Read(format, data, maxImportRows+1)
+	if err != nil {
+		return nil, err
+	}
+	report := newImportReport()
+	groups, total, err := parseImportSheet(rows, report)
+	if err != nil {
+		return nil, err
+	}
+	job.TotalRows = total
+	job.TotalProducts = len(groups)
+	if err := validateImportGroups(ctx, groups, report); err != nil {
+		return report, err
+	}
+
+	for _, g := range groups {
+		if !report.groupValid(g) {
+			continue
+		}
+		job.ValidProducts++
+		if job.DryRun {
+			continue
+		}
+		g.req.OperatorId = job.OperatorID
+		g.req.MerchantId = job.MerchantID
+		if _, err := NewCreateProductService(ctx).Run(g.req); err != nil {
+			report.add(g.firstRow(), "", "create product failed: "+err.Error())
+			continue
+		}
+		job.CreatedProducts++
+	}
+	return report, nil
+}
+
+func finishImportJob(ctx context.Context, job *model.ProductImportJob, report *importReport, failure string) {
+	now := time.Now()
+	job.FinishedAt = &now
+	job.Status = model.ImportStatusDone
+	if failure != "" {
+		job.Status = model.ImportStatusFailed
+		job.Error = truncateRunes(failure, 255)
+	}
+	if report != nil {
+		job.ErrorRows = len(report.badRows)
+		job.SetErrors(report.errors)
+	}
+	if err := model.SaveImportJob(ctx, mysql.DB, job); err != nil {
+		klog.Errorf("Failed to save import job %d: %v", job.ID, err)
+	}
+}
+
+func toProtoImportJob(job *model.ProductImportJob) *product.ImportJob {
+	p := &product.ImportJob{
+		Id:              job.ID,
+		Format:          job.Format,
+		DryRun:          job.DryRun,
+		Status:          int32(job.Status),
+		TotalRows:       int32(job.TotalRows),
+		ErrorRows:       int32(job.ErrorRows),
+		TotalProducts:   int32(job.TotalProducts),
+		ValidProducts:   int32(job.ValidProducts),
+		CreatedProducts: int32(job.CreatedProducts),
+		Error:           job.Error,
+		OperatorId:      job.OperatorID,
+		CreatedAt:       job.CreatedAt.Unix(),
+	}
+	if job.FinishedAt != nil {
+		p.FinishedAt = job.FinishedAt.Unix()
+	}
+	for _, e := range job.GetErrors() {
+		p.Errors = append(p.Errors, &product.ImportRowError{Row: int32(e.Row), Column: e.Column, Message: e.Message})
+	}
+	return p
+}
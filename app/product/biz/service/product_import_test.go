@@ -0,0 +1,71 @@
+package service
+
+import (
+	"testing"
+)
+
+func TestParseImportSheet(t *testing.T) {
+	rows := [][]string{
+		{"SPU_REF", "spu_name", "category_id", "brand_id", "images", "sku_code", "sku_name", "price", "stock", "unknown"},
+		{"a", "手机", "1001", "1", "x.jpg| y.jpg", "A-1", "黑色", "99.9", "10", "ignored"},
+		{"a", "", "", "", "", "A-2", "白色", "100", "", ""},
+		{},
+		{"b", "电视", "abc", "", "", "A-1", "默认", "-1", "", ""},
+		{"a", "平板", "", "", "", "A-3", "", "1", "", ""},
+		{"", "耳机", "1", "", "", "C-1", "默认", "1", "", ""},
+	}
+	report := newImportReport()
+	groups, total, err := parseImportSheet(rows, report)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 5 || len(groups) != 2 {
+		t.Fatalf("got total=%d groups=%d, want 5 and 2", total, len(groups))
+	}
+
+	a := groups[0]
+	if len(a.req.Skus) != 3 || a.req.Spu.CategoryId != 1001 || a.req.Spu.BrandId != 1 {
+		t.Errorf("unexpected group a: %+v skus=%d", a.req.Spu, len(a.req.Skus))
+	}
+	if len(a.req.Detail.Images) != 2 || a.req.Detail.Images[1] != "y.jpg" {
+		t.Errorf("images = %v", a.req.Detail.Images)
+	}
+	if a.req.Skus[0].Price != "99.90" || a.req.Skus[0].Stock != 10 || a.req.Skus[0].SkuSpecData != "{}" {
+		t.Errorf("unexpected sku: %+v", a.req.Skus[0])
+	}
+
+	want := map[int][]string{
+		5: {"category_id", "price", "sku_code"},
+		6: {"spu_name", "sku_name"},
+		7: {"spu_ref"},
+	}
+	got := make(map[int][]string)
+	for _, e := range report.errors {
+		got[e.Row] = append(got[e.Row], e.Column)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("errors = %+v", report.errors)
+	}
+	for row, cols := range want {
+		if len(got[row]) != len(cols) {
+			t.Errorf("row %d errors = %v, want %v", row, got[row], cols)
+			continue
+		}
+		for i := range cols {
+			if got[row][i] != cols[i] {
+				t.Errorf("row %d errors = %v, want %v", row, got[row], cols)
+				break
+			}
+		}
+	}
+	if report.groupValid(a) || report.groupValid(groups[1]) {
+		t.Error("groups with bad rows should be invalid")
+	}
+}
+
+func TestParseImportSheetMissingColumns(t *testing.T) {
+	_, _, err := parseImportSheet([][]string{{"spu_ref", "spu_name", "price"}}, newImportReport())
+	if err == nil {
+		t.Fatal("expected error for missing required columns")
+	}
+}
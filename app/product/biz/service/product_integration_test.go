@@ -70,10 +70,24 @@ func TestProductIntegration(t *testing.T) {
 		t.Logf("第1阶段完成: 共创建 %d 个商品", len(createdProducts))
 	})
 
-	// ==================== 阶段2: 更新商品状态并上架 ====================
-	// 暂时弃用 UpdateProductStatus，简化业务流程
-	t.Run("Phase2_UpdateProductStatus", func(t *testing.T) {
-		t.Skip("暂时跳过商品状态更新，已去掉 publish_status 和 verify_status 校验")
+	// ==================== 阶段2: 审核通过新商品 ====================
+	// 新商品进入待审核队列，审核通过后才能被搜索到
+	t.Run("Phase2_AuditProducts", func(t *testing.T) {
+		ids := make([]uint64, 0, len(createdProducts))
+		for _, p := range createdProducts {
+			ids = append(ids, p.SpuID)
+		}
+		resp, err := NewAuditProductsService(ctx).Run(&product.AuditProductsRequest{
+			Ids:          ids,
+			VerifyStatus: 1,
+		})
+		if err != nil {
+			t.Fatalf("审核商品失败: %v", err)
+		}
+		if resp.Affected != int64(len(ids)) {
+			t.Fatalf("审核数量 = %d, want %d", resp.Affected, len(ids))
+		}
+		t.Logf("第2阶段完成: 共审核通过 %d 个商品", resp.Affected)
 	})
 
 	// ==================== 阶段3: 查询商品列表（后台） ====================
@@ -83,8 +97,10 @@ func TestProductIntegration(t *testing.T) {
 		// 测试1: 查询所有商品
 		t.Run("ListAll", func(t *testing.T) {
 			resp, err := service.Run(&product.ListProductsRequest{
-				Page:     1,
-				PageSize: 10,
+				Page:          1,
+				PageSize:      10,
+				PublishStatus: -1,
+				VerifyStatus:  -1,
 			})
 			if err != nil {
 				t.Fatalf("查询商品列表失败: %v", err)
@@ -98,9 +114,11 @@ func TestProductIntegration(t *testing.T) {
 		// 测试2: 按分类查询（手机）
 		t.Run("ListByCategory_Phone", func(t *testing.T) {
 			resp, err := service.Run(&product.ListProductsRequest{
-				Page:       1,
-				PageSize:   10,
-				CategoryId: utils.CategoryPhoneXiaomi,
+				Page:          1,
+				PageSize:      10,
+				CategoryId:    utils.CategoryPhoneXiaomi,
+				PublishStatus: -1,
+				VerifyStatus:  -1,
 			})
 			if err != nil {
 				t.Fatalf("按分类查询失败: %v", err)
@@ -111,9 +129,11 @@ func TestProductIntegration(t *testing.T) {
 		// 测试3: 按品牌查询（小米）
 		t.Run("ListByBrand_Xiaomi", func(t *testing.T) {
 			resp, err := service.Run(&product.ListProductsRequest{
-				Page:     1,
-				PageSize: 10,
-				BrandId:  utils.BrandXiaomi,
+				Page:          1,
+				PageSize:      10,
+				BrandId:       utils.BrandXiaomi,
+				PublishStatus: -1,
+				VerifyStatus:  -1,
 			})
 			if err != nil {
 				t.Fatalf("按品牌查询失败: %v", err)
@@ -124,9 +144,11 @@ func TestProductIntegration(t *testing.T) {
 		// 测试4: 关键词搜索
 		t.Run("SearchByKeyword", func(t *testing.T) {
 			resp, err := service.Run(&product.ListProductsRequest{
-				Page:     1,
-				PageSize: 10,
-				Keyword:  "小米",
+				Page:          1,
+				PageSize:      10,
+				Keyword:       "小米",
+				PublishStatus: -1,
+				VerifyStatus:  -1,
 			})
 			if err != nil {
 				t.Fatalf("关键词搜索失败: %v", err)
@@ -312,6 +334,65 @@ func TestProductIntegration(t *testing.T) {
 		// })
 	})
 
+	// ==================== 阶段8: 删除与恢复商品 ====================
+	t.Run("Phase8_DeleteAndRestore", func(t *testing.T) {
+		if len(createdProducts) == 0 {
+			t.Skip("没有可删除的商品")
+		}
+		spuID := createdProducts[0].SpuID
+
+		delResp, err := NewDeleteProductService(ctx).Run(&product.DeleteProductRequest{Ids: []uint64{spuID}})
+		if err != nil {
+			t.Fatalf("删除商品失败: %v", err)
+		}
+		if delResp.Affected != 1 {
+			t.Fatalf("期望删除 1 个商品，实际 %d", delResp.Affected)
+		}
+
+		listResp, err := NewListProductsService(ctx).Run(&product.ListProductsRequest{
+			Page:          1,
+			PageSize:      100,
+			Keyword:       createdProducts[0].Name,
+			PublishStatus: -1,
+			VerifyStatus:  -1,
+			Deleted:       true,
+		})
+		if err != nil {
+			t.Fatalf("查询已删除商品失败: %v", err)
+		}
+		found := false
+		for _, spu := range listResp.List {
+			if spu.Id == spuID {
+				found = true
+				if spu.PublishStatus != int32(utils.PublishStatusUnpublished) {
+					t.Errorf("删除后商品应为下架状态，实际 %d", spu.PublishStatus)
+				}
+			}
+		}
+		if !found {
+			t.Fatal("已删除列表中没有找到商品")
+		}
+
+		// 重复删除不再生效
+		delResp, err = NewDeleteProductService(ctx).Run(&product.DeleteProductRequest{Ids: []uint64{spuID}})
+		if err != nil || delResp.Affected != 0 {
+			t.Fatalf("重复删除应不生效: affected=%v err=%v", delResp, err)
+		}
+
+		restoreResp, err := NewRestoreProductService(ctx).Run(&product.RestoreProductRequest{Ids: []uint64{spuID}})
+		if err != nil {
+			t.Fatalf("恢复商品失败: %v", err)
+		}
+		if restoreResp.Affected != 1 {
+			t.Fatalf("期望恢复 1 个商品，实际 %d", restoreResp.Affected)
+		}
+		detail, err := NewGetProductDetailService(ctx).Run(&product.GetProductDetailRequest{Id: spuID, IncludeUnavailable: true})
+		if err != nil || detail.Spu == nil {
+			t.Fatalf("恢复后查询商品失败: %v", err)
+		}
+		t.Log("✅ 第8阶段完成: 删除与恢复商品测试通过")
+	})
+
 	t.Log("========================================")
 	t.Log("✅✅✅ 集成测试全部完成 ✅✅✅")
 	t.Log("========================================")
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultScheduleInterval = 30 * time.Second
+	scheduleBatchSize       = 100
+	// 执行中超过该时长仍未结束的任务视为执行实例已退出
+	scheduleStaleAfter  = 10 * time.Minute
+	maxScheduleErrorLen = 255
+)
+
+// runProductSchedule 执行单个定时任务，成功后清除商品缓存并刷新搜索索引
+func runProductSchedule(ctx context.Context, sch *model.ProductSchedule) error {
+	if sch.TargetType == model.ScheduleTargetSPU {
+		if _, err := model.GetSPUByID(ctx, mysql.DB, sch.TargetID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("product not found")
+			}
+			return err
+		}
+		status := utils.PublishStatusPublished
+		if sch.Action == model.ScheduleActionUnpublish {
+			status = utils.PublishStatusUnpublished
+		}
+		if err := model.BatchUpdateStatus(ctx, mysql.DB, []uint64{sch.TargetID}, map[string]interface{}{
+			"publish_status": status,
+		}); err != nil {
+			return err
+		}
+		invalidateProductCaches(ctx, []uint64{sch.TargetID})
+		refreshSearchIndex(ctx, []uint64{sch.TargetID})
+		return nil
+	}
+
+	sku, err := model.GetSKUByID(ctx, mysql.DB, sch.TargetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("sku not found")
+		}
+		return err
+	}
+	updates := make(map[string]interface{})
+	var before *model.ProductSnapshot
+	switch sch.Action {
+	case model.ScheduleActionPublish:
+		updates["publish_status"] = utils.PublishStatusPublished
+	case model.ScheduleActionUnpublish:
+		updates["publish_status"] = utils.PublishStatusUnpublished
+	case model.ScheduleActionPrice:
+		updates["price"] = sch.Price
+		if sch.MarketPrice > 0 {
+			updates["market_price"] = sch.MarketPrice
+		}
+		// 改价属于商品内容变更，记入版本历史
+		if before, err = loadProductSnapshot(ctx, sku.SpuID); err != nil {
+			klog.Warnf("Failed to load product snapshot: %v", err)
+		}
+	}
+	var change *model.SkuPriceHistory
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		change, err = updateSKUWithPriceHistory(ctx, tx, sku.ID, updates, model.PriceSourceSchedule, sch.OperatorID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	notifyPriceDrops(ctx, []*model.SkuPriceHistory{change})
+	invalidateProductCaches(ctx, []uint64{sku.SpuID})
+	refreshSearchIndexBySkus(ctx, []uint64{sku.ID})
+	if before != nil {
+		if _, err := recordProductChange(ctx, sku.SpuID, sch.OperatorID, model.HistoryActionUpdateSku, 0, before); err != nil {
+			klog.Warnf("Failed to record product history: %v", err)
+		}
+	}
+	return nil
+}
+
+// RunDueSchedules 执行已到期的定时任务，每个任务先领取再执行，执行结果写回任务记录
+func RunDueSchedules(ctx context.Context, now time.Time) error {
+	if n, err := model.FailStaleSchedules(ctx, mysql.DB, now.Add(-scheduleStaleAfter)); err != nil {
+		klog.Warnf("Failed to mark stale product schedules: %v", err)
+	} else if n > 0 {
+		klog.Warnf("Marked %d interrupted product schedules as failed", n)
+	}
+
+	schedules, err := model.ListDueSchedules(ctx, mysql.DB, now, scheduleBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, sch := range schedules {
+		claimed, err := model.ClaimSchedule(ctx, mysql.DB, sch.ID)
+		if err != nil {
+			klog.Errorf("Failed to claim product schedule %d: %v", sch.ID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		errMsg := ""
+		if err := runProductSchedule(ctx, sch); err != nil {
+			errMsg = truncateRunes(err.Error(), maxScheduleErrorLen)
+			klog.Errorf("Product schedule %d (%s %s %d) failed: %v", sch.ID, sch.Action, sch.TargetType, sch.TargetID, err)
+		} else {
+			klog.Infof("Product schedule %d (%s %s %d) executed", sch.ID, sch.Action, sch.TargetType, sch.TargetID)
+		}
+		if err := model.FinishSchedule(ctx, mysql.DB, sch.ID, errMsg); err != nil {
+			klog.Errorf("Failed to save product schedule %d result: %v", sch.ID, err)
+		}
+	}
+	return nil
+}
+
+// StartProductScheduleTask 启动商品定时任务调度，启动时先执行一次
+func StartProductScheduleTask(ctx context.Context) {
+	cfg := conf.GetConf().Schedule
+	if !cfg.Enabled {
+		klog.Info("Product schedule task disabled")
+		return
+	}
+	interval := defaultScheduleInterval
+	if cfg.IntervalSeconds > 0 {
+		interval = time.Duration(cfg.IntervalSeconds) * time.Second
+	}
+
+	run := func() {
+		if err := RunDueSchedules(ctx, time.Now()); err != nil {
+			klog.Errorf("Product schedule task failed: %v", err)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		run()
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				klog.Info("Product schedule task stopped")
+				return
+			case <-ticker.C:
+				run()
+			}
+		}
+	}()
+	klog.Infof("Product schedule task started, interval=%v", interval)
+}
+
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+func toProtoProductSchedule(sch *model.ProductSchedule) *product.ProductSchedule {
+	p := &product.ProductSchedule{
+		Id:         sch.ID,
+		TargetType: sch.TargetType,
+		TargetId:   sch.TargetID,
+		Action:     sch.Action,
+		RunAt:      sch.RunAt.Unix(),
+		Status:     int32(sch.Status),
+		Error:      sch.Error,
+		OperatorId: sch.OperatorID,
+		CreatedAt:  sch.CreatedAt.Unix(),
+	}
+	if sch.Action == model.ScheduleActionPrice {
+		p.Price = utils.PriceToString(sch.Price)
+		if sch.MarketPrice > 0 {
+			p.MarketPrice = utils.PriceToString(sch.MarketPrice)
+		}
+	}
+	if sch.ExecutedAt != nil {
+		p.ExecutedAt = sch.ExecutedAt.Unix()
+	}
+	return p
+}
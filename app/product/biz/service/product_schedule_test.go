@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// 参数校验失败时不访问数据库
+func TestCreateProductScheduleValidation(t *testing.T) {
+	future := time.Now().Add(time.Hour).Unix()
+	tests := []struct {
+		name string
+		req  *product.CreateProductScheduleRequest
+	}{
+		{name: "非法目标类型", req: &product.CreateProductScheduleRequest{TargetType: "brand", TargetId: 1, Action: "publish", RunAt: future}},
+		{name: "缺少目标", req: &product.CreateProductScheduleRequest{TargetType: "spu", Action: "publish", RunAt: future}},
+		{name: "执行时间已过", req: &product.CreateProductScheduleRequest{TargetType: "spu", TargetId: 1, Action: "publish", RunAt: time.Now().Add(-time.Minute).Unix()}},
+		{name: "非法动作", req: &product.CreateProductScheduleRequest{TargetType: "spu", TargetId: 1, Action: "delete", RunAt: future}},
+		{name: "SPU 改价", req: &product.CreateProductScheduleRequest{TargetType: "spu", TargetId: 1, Action: "price", RunAt: future, Price: "99"}},
+		{name: "缺少价格", req: &product.CreateProductScheduleRequest{TargetType: "sku", TargetId: 1, Action: "price", RunAt: future}},
+		{name: "负价格", req: &product.CreateProductScheduleRequest{TargetType: "sku", TargetId: 1, Action: "price", RunAt: future, Price: "-1"}},
+		{name: "非法市场价", req: &product.CreateProductScheduleRequest{TargetType: "sku", TargetId: 1, Action: "price", RunAt: future, Price: "99", MarketPrice: "abc"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCreateProductScheduleService(context.Background()).Run(tt.req); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	if got := truncateRunes("商品不存在", 3); got != "商品不" {
+		t.Errorf("truncateRunes = %q", got)
+	}
+	if got := truncateRunes("ok", 3); got != "ok" {
+		t.Errorf("truncateRunes = %q", got)
+	}
+}
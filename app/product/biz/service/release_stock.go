@@ -71,6 +71,12 @@ func (s *ReleaseStockService) Run(req *product.ReleaseStockRequest) (*product.Re
 		return nil, errs.New(errs.ErrInternal.Code, "release stock failed: "+err.Error())
 	}
 
+	spuIDs := make([]uint64, 0, len(spuSaleUpdates))
+	for spuID := range spuSaleUpdates {
+		spuIDs = append(spuIDs, spuID)
+	}
+	refreshSearchIndex(s.ctx, spuIDs)
+
 	// 异步更新热门商品排行榜和缓存
 	go func() {
 		for spuID, decrement := range spuSaleUpdates {
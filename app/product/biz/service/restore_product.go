@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type RestoreProductService struct {
+	ctx context.Context
+}
+
+func NewRestoreProductService(ctx context.Context) *RestoreProductService {
+	return &RestoreProductService{ctx: ctx}
+}
+
+// Run 恢复已删除的商品，恢复后仍为下架状态，需要重新上架
+func (s *RestoreProductService) Run(req *product.RestoreProductRequest) (*product.RestoreProductResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+	if err := checkSPUOwner(s.ctx, req.MerchantId, req.Ids); err != nil {
+		return nil, err
+	}
+
+	affected, err := model.RestoreSPUs(s.ctx, mysql.DB, req.Ids)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "restore product failed: "+err.Error())
+	}
+
+	registerProducts(s.ctx, req.Ids)
+	invalidateProductCaches(s.ctx, req.Ids)
+	refreshSearchIndex(s.ctx, req.Ids)
+
+	return &product.RestoreProductResponse{
+		Affected: affected,
+	}, nil
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
+)
+
+type RollbackProductService struct {
+	ctx context.Context
+}
+
+func NewRollbackProductService(ctx context.Context) *RollbackProductService {
+	return &RollbackProductService{ctx: ctx}
+}
+
+// Run 将商品内容恢复为指定版本的快照，并作为一个新版本记录。
+// 只恢复快照中仍存在的 SKU，之后新增的 SKU 保持不变；库存不属于版本内容，不会回滚。
+// 回滚后商品重新进入待审核
+func (s *RollbackProductService) Run(req *product.RollbackProductRequest) (*product.RollbackProductResponse, error) {
+	if req.SpuId == 0 || req.Version <= 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu id and version are required")
+	}
+	if err := checkSPUOwner(s.ctx, req.MerchantId, []uint64{req.SpuId}); err != nil {
+		return nil, err
+	}
+
+	history, err := model.GetProductVersion(s.ctx, mysql.DB, req.SpuId, int(req.Version))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "product version not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get product version failed: "+err.Error())
+	}
+	target, err := history.GetSnapshot()
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "invalid product snapshot: "+err.Error())
+	}
+	current, err := loadProductSnapshot(s.ctx, req.SpuId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "product not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "load product failed: "+err.Error())
+	}
+
+	spuUpdates := rollbackSPUUpdates(&current.SPU, &target.SPU)
+	skuUpdates := rollbackSKUUpdates(current.SKUs, target.SKUs)
+	detailUpdates := rollbackDetailUpdates(current.Detail, target.Detail)
+	if len(spuUpdates) == 0 && len(skuUpdates) == 0 && len(detailUpdates) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "product already matches this version")
+	}
+	if err := s.checkRefs(spuUpdates); err != nil {
+		return nil, err
+	}
+	if err := s.checkSpecs(current, target); err != nil {
+		return nil, err
+	}
+
+	var priceChanges []*model.SkuPriceHistory
+	err = mysql.DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := model.UpdateSPU(s.ctx, tx, req.SpuId, spuUpdates); err != nil {
+			return err
+		}
+		for skuID, updates := range skuUpdates {
+			change, err := updateSKUWithPriceHistory(s.ctx, tx, skuID, updates, model.PriceSourceRollback, req.OperatorId)
+			if err != nil {
+				return err
+			}
+			if change != nil {
+				priceChanges = append(priceChanges, change)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "rollback product failed: "+err.Error())
+	}
+	if _, err := model.UpdateProductDetail(s.ctx, req.SpuId, detailUpdates); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "rollback product detail failed: "+err.Error())
+	}
+
+	if err := submitForAudit(s.ctx, []uint64{req.SpuId}); err != nil {
+		return nil, err
+	}
+	invalidateProductCaches(s.ctx, []uint64{req.SpuId})
+	refreshSearchIndex(s.ctx, []uint64{req.SpuId})
+	notifyPriceDrops(s.ctx, priceChanges)
+
+	version, err := recordProductChange(s.ctx, req.SpuId, req.OperatorId, model.HistoryActionRollback, int(req.Version), current)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "record product history failed: "+err.Error())
+	}
+	return &product.RollbackProductResponse{Version: int32(version)}, nil
+}
+
+// checkRefs 回滚会恢复的品牌和分类可能在该版本之后被删除，不存在时拒绝回滚
+func (s *RollbackProductService) checkRefs(spuUpdates map[string]interface{}) error {
+	if brandID, ok := spuUpdates["brand_id"].(uint64); ok {
+		if _, err := model.GetBrandByID(s.ctx, mysql.DB, brandID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errs.New(errs.ErrParam.Code, fmt.Sprintf("brand %d of this version no longer exists", brandID))
+			}
+			return errs.New(errs.ErrInternal.Code, "get brand failed: "+err.Error())
+		}
+	}
+	if categoryID, ok := spuUpdates["category_id"].(uint64); ok {
+		if _, err := model.GetCategoryByID(s.ctx, mysql.DB, categoryID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errs.New(errs.ErrParam.Code, fmt.Sprintf("category %d of this version no longer exists", categoryID))
+			}
+			return errs.New(errs.ErrInternal.Code, "get category failed: "+err.Error())
+		}
+	}
+	return nil
+}
+
+// checkSpecs 按回滚后所属分类的模板校验回滚后的全部 SKU 规格，模板可能在该版本之后被修改过
+func (s *RollbackProductService) checkSpecs(current, target *model.ProductSnapshot) error {
+	template, err := loadSpecTemplate(s.ctx, target.SPU.CategoryID)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "load spec template failed: "+err.Error())
+	}
+	if len(template) == 0 {
+		return nil
+	}
+	targetSpecs := make(map[uint64]string, len(target.SKUs))
+	for _, sku := range target.SKUs {
+		targetSpecs[sku.ID] = sku.SkuSpecData
+	}
+	specs := make([]string, 0, len(current.SKUs))
+	for _, sku := range current.SKUs {
+		if spec, ok := targetSpecs[sku.ID]; ok {
+			specs = append(specs, spec)
+		} else {
+			specs = append(specs, sku.SkuSpecData)
+		}
+	}
+	return validateSkuSpecs(template, specs, func(i int) string {
+		return fmt.Sprintf("sku %d", current.SKUs[i].ID)
+	})
+}
+
+func rollbackSPUUpdates(current, target *model.SnapshotSPU) map[string]interface{} {
+	updates := make(map[string]interface{})
+	if current.Name != target.Name {
+		updates["name"] = target.Name
+	}
+	if current.SubTitle != target.SubTitle {
+		updates["sub_title"] = target.SubTitle
+	}
+	if current.MainImage != target.MainImage {
+		updates["main_image"] = target.MainImage
+	}
+	if current.BrandID != target.BrandID {
+		updates["brand_id"] = target.BrandID
+	}
+	if current.CategoryID != target.CategoryID {
+		updates["category_id"] = target.CategoryID
+	}
+	if current.ServiceBits != target.ServiceBits {
+		updates["service_bits"] = target.ServiceBits
+	}
+	if current.Sort != target.Sort {
+		updates["sort"] = target.Sort
+	}
+	return updates
+}
+
+// rollbackSKUUpdates 按 SKU ID 对比，只处理两个快照中都存在的 SKU
+func rollbackSKUUpdates(current, target []model.SnapshotSKU) map[uint64]map[string]interface{} {
+	result := make(map[uint64]map[string]interface{})
+	for _, t := range target {
+		i := slices.IndexFunc(current, func(c model.SnapshotSKU) bool { return c.ID == t.ID })
+		if i < 0 {
+			continue
+		}
+		c := current[i]
+		updates := make(map[string]interface{})
+		if c.Name != t.Name {
+			updates["name"] = t.Name
+		}
+		if c.SubTitle != t.SubTitle {
+			updates["sub_title"] = t.SubTitle
+		}
+		if c.MainImage != t.MainImage {
+			updates["main_image"] = t.MainImage
+		}
+		if c.Price != t.Price {
+			updates["price"] = t.Price
+		}
+		if c.MarketPrice != t.MarketPrice {
+			updates["market_price"] = t.MarketPrice
+		}
+		if c.SkuSpecData != t.SkuSpecData {
+			updates["sku_spec_data"] = t.SkuSpecData
+		}
+		if len(updates) > 0 {
+			result[t.ID] = updates
+		}
+	}
+	return result
+}
+
+// rollbackDetailUpdates 详情有差异时整体覆盖为快照中的内容
+func rollbackDetailUpdates(current, target *model.SnapshotDetail) map[string]interface{} {
+	if target == nil {
+		return nil
+	}
+	if current != nil && current.Description == target.Description &&
+		jsonString(current.Images) == jsonString(target.Images) &&
+		jsonString(current.Videos) == jsonString(target.Videos) &&
+		current.MarketTagJSON == target.MarketTagJSON &&
+		current.TechTagJSON == target.TechTagJSON &&
+		current.FaqJSON == target.FaqJSON {
+		return nil
+	}
+	return map[string]interface{}{
+		"description":     target.Description,
+		"images":          target.Images,
+		"videos":          target.Videos,
+		"market_tag_json": target.MarketTagJSON,
+		"tech_tag_json":   target.TechTagJSON,
+		"faq_json":        target.FaqJSON,
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type SaveSpecTemplateService struct {
+	ctx context.Context
+}
+
+func NewSaveSpecTemplateService(ctx context.Context) *SaveSpecTemplateService {
+	return &SaveSpecTemplateService{ctx: ctx}
+}
+
+// Run 全量覆盖分类自身的规格属性，子分类继承的模板随之变化；
+// 已有商品不会被重新校验，下次修改时才按新模板校验
+func (s *SaveSpecTemplateService) Run(req *product.SaveSpecTemplateRequest) (*product.SaveSpecTemplateResponse, error) {
+	if req.CategoryId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "category id is required")
+	}
+	if _, err := getCategory(s.ctx, req.CategoryId); err != nil {
+		return nil, err
+	}
+	attrs, err := checkSpecAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.ReplaceSpecAttributes(s.ctx, mysql.DB, req.CategoryId, attrs); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "save spec template failed: "+err.Error())
+	}
+	invalidateSpecTemplates(s.ctx)
+
+	return &product.SaveSpecTemplateResponse{Success: true}, nil
+}
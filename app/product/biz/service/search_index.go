@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/search"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
+)
+
+const searchRebuildBatchSize = 200
+
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// SearchIndexService 维护商品全文索引，未配置检索引擎时所有方法均为空操作
+type SearchIndexService struct {
+	ctx context.Context
+}
+
+func NewSearchIndexService(ctx context.Context) *SearchIndexService {
+	return &SearchIndexService{ctx: ctx}
+}
+
+// Refresh 按数据库当前状态重建指定 SPU 的索引文档，已删除、未上架或未审核通过的 SPU 会从索引中移除
+func (s *SearchIndexService) Refresh(spuIDs ...uint64) {
+	if search.Default == nil {
+		return
+	}
+	for _, spuID := range spuIDs {
+		docs, err := s.buildDocuments(spuID)
+		if err != nil {
+			klog.Warnf("Failed to build search documents for SPU %d: %v", spuID, err)
+			continue
+		}
+		if err := search.Default.Replace(s.ctx, spuID, docs); err != nil {
+			klog.Warnf("Failed to update search index for SPU %d: %v", spuID, err)
+		}
+	}
+}
+
+// Rebuild 遍历全部商品重建索引
+func (s *SearchIndexService) Rebuild() error {
+	if search.Default == nil {
+		return nil
+	}
+	klog.Info("Starting search index rebuild...")
+
+	var lastID uint64
+	indexed := 0
+	for {
+		ids, err := model.ListSPUIDsAfter(s.ctx, mysql.DB, lastID, searchRebuildBatchSize)
+		if err != nil {
+			return fmt.Errorf("list spu ids failed: %w", err)
+		}
+		if len(ids) == 0 {
+			break
+		}
+		s.Refresh(ids...)
+		indexed += len(ids)
+		lastID = ids[len(ids)-1]
+	}
+
+	klog.Infof("Search index rebuild completed, indexed %d products", indexed)
+	return nil
+}
+
+// RebuildIfEmpty 索引为空时（首次启动或内存索引）执行全量重建
+func (s *SearchIndexService) RebuildIfEmpty() error {
+	if search.Default == nil {
+		return nil
+	}
+	count, err := search.Default.Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return s.Rebuild()
+}
+
+func (s *SearchIndexService) buildDocuments(spuID uint64) ([]*search.Document, error) {
+	spu, err := model.GetSPUByID(s.ctx, mysql.DB, spuID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if spu.PublishStatus != utils.PublishStatusPublished || spu.VerifyStatus != utils.VerifyStatusApproved {
+		return nil, nil
+	}
+
+	skus, err := model.GetSKUsBySpuID(s.ctx, mysql.DB, spuID)
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) == 0 {
+		return nil, nil
+	}
+
+	var brandName, categoryName string
+	if spu.BrandID > 0 {
+		if brand, err := model.GetBrandByID(s.ctx, mysql.DB, spu.BrandID); err == nil && brand != nil {
+			brandName = brand.Name
+		}
+	}
+	if spu.CategoryID > 0 {
+		if category, err := model.GetCategoryByID(s.ctx, mysql.DB, spu.CategoryID); err == nil && category != nil {
+			categoryName = category.Name
+		}
+	}
+
+	var description, techTags string
+	detail, err := model.GetProductDetailBySpuID(s.ctx, spuID)
+	if err != nil {
+		klog.Warnf("Failed to get product detail for SPU %d, indexing without it: %v", spuID, err)
+	} else if detail != nil {
+		description = stripHTML(detail.Description)
+		techTags = techTagText(detail.TechTagJSON)
+	}
+
+	// 分类配置了规格模板时只索引可筛选的规格
+	template, err := loadSpecTemplate(s.ctx, spu.CategoryID)
+	if err != nil {
+		klog.Warnf("Failed to load spec template for SPU %d, indexing all specs: %v", spuID, err)
+	}
+
+	docs := make([]*search.Document, 0, len(skus))
+	for _, sku := range skus {
+		docs = append(docs, &search.Document{
+			SkuID:        sku.ID,
+			SpuID:        spu.ID,
+			SpuName:      spu.Name,
+			SkuName:      sku.Name,
+			SubTitle:     strings.TrimSpace(spu.SubTitle + " " + sku.SubTitle),
+			BrandID:      spu.BrandID,
+			BrandName:    brandName,
+			CategoryID:   spu.CategoryID,
+			CategoryName: categoryName,
+			TechTags:     techTags,
+			Description:  description,
+			Price:        sku.Price,
+			Stock:        sku.Stock,
+			SaleCount:    spu.SaleCount,
+			Sort:         spu.Sort,
+			Specs:        filterableSpecs(template, specValues(sku.SkuSpecData)),
+		})
+	}
+	return docs, nil
+}
+
+// refreshSearchIndex 异步刷新商品索引，写操作成功后调用
+func refreshSearchIndex(ctx context.Context, spuIDs []uint64) {
+	if search.Default == nil || len(spuIDs) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go NewSearchIndexService(ctx).Refresh(spuIDs...)
+}
+
+// refreshSearchIndexBySkus 异步刷新 SKU 所属商品的索引
+func refreshSearchIndexBySkus(ctx context.Context, skuIDs []uint64) {
+	if search.Default == nil || len(skuIDs) == 0 {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		skus, err := model.GetSKUsByIDs(ctx, mysql.DB, skuIDs)
+		if err != nil {
+			klog.Warnf("Failed to get SKUs for search index refresh: %v", err)
+			return
+		}
+		spuIDs := make([]uint64, 0, len(skus))
+		seen := make(map[uint64]bool, len(skus))
+		for _, sku := range skus {
+			if !seen[sku.SpuID] {
+				seen[sku.SpuID] = true
+				spuIDs = append(spuIDs, sku.SpuID)
+			}
+		}
+		NewSearchIndexService(ctx).Refresh(spuIDs...)
+	}()
+}
+
+// stripHTML 去掉富文本标签，只保留可检索的文本
+func stripHTML(s string) string {
+	s = htmlTagPattern.ReplaceAllString(s, " ")
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
+
+// techTagText 取技术参数 JSON 中的参数值，如 {"处理器":"骁龙8 Gen4"} 取 "骁龙8 Gen4"
+func techTagText(techTagJSON string) string {
+	if techTagJSON == "" {
+		return ""
+	}
+	var tags map[string]interface{}
+	if err := json.Unmarshal([]byte(techTagJSON), &tags); err != nil {
+		return ""
+	}
+	values := make([]string, 0, len(tags))
+	for _, v := range tags {
+		if str, ok := v.(string); ok && str != "" {
+			values = append(values, str)
+		}
+	}
+	return strings.Join(values, " ")
+}
+
+// specValues 解析 sku_spec_data，如 {"颜色":"曜石黑"}，只保留字符串值
+func specValues(specJSON string) map[string]string {
+	if specJSON == "" {
+		return nil
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(specJSON), &raw); err != nil {
+		return nil
+	}
+	specs := make(map[string]string, len(raw))
+	for name, v := range raw {
+		if str, ok := v.(string); ok && name != "" && str != "" && !strings.Contains(name, ":") {
+			specs[name] = str
+		}
+	}
+	return specs
+}
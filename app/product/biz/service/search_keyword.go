@@ -0,0 +1,64 @@
+package service
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+)
+
+// trendingKeywordMaxLen 热搜榜展示的搜索词最大长度（字符），过长的搜索词通常不是正常检索
+const trendingKeywordMaxLen = 20
+
+// KeywordBlocker 判断搜索词是否需要屏蔽，返回 true 的搜索词不记录也不展示。
+// 入参为规范化后的搜索词，可接入外部敏感词服务
+type KeywordBlocker func(keyword string) bool
+
+var keywordBlockers struct {
+	mu   sync.RWMutex
+	list []KeywordBlocker
+}
+
+// RegisterKeywordBlocker 注册搜索词屏蔽钩子，任一钩子返回 true 即屏蔽
+func RegisterKeywordBlocker(b KeywordBlocker) {
+	keywordBlockers.mu.Lock()
+	defer keywordBlockers.mu.Unlock()
+	keywordBlockers.list = append(keywordBlockers.list, b)
+}
+
+// normalizeSearchKeyword 去掉不可见字符后统一大小写与空白并截断，返回空串表示不记录
+func normalizeSearchKeyword(keyword string) string {
+	keyword = strings.Map(func(r rune) rune {
+		if unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return r
+		}
+		return -1
+	}, keyword)
+	return redis.NormalizeSuggestText(keyword)
+}
+
+// keywordBlocked 搜索词包含配置的屏蔽词或被任一钩子屏蔽
+func keywordBlocked(keyword string) bool {
+	for _, word := range conf.GetConf().SearchLog.BlockedWords {
+		if word = redis.NormalizeSuggestText(word); word != "" && strings.Contains(keyword, word) {
+			return true
+		}
+	}
+	keywordBlockers.mu.RLock()
+	list := keywordBlockers.list
+	keywordBlockers.mu.RUnlock()
+	for _, b := range list {
+		if b(keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// trendingKeywordAllowed 可在热搜榜展示的搜索词：长度合适且未被屏蔽
+func trendingKeywordAllowed(keyword string) bool {
+	return keyword != "" && utf8.RuneCountInString(keyword) <= trendingKeywordMaxLen && !keywordBlocked(keyword)
+}
@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/search"
 	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
 type SearchProductsService struct {
@@ -48,20 +52,66 @@ func (s *SearchProductsService) Run(req *product.SearchProductsRequest) (*produc
 		}
 	}
 
-	skus, total, err := model.SearchSKUs(
-		s.ctx,
-		mysql.DB,
-		page,
-		pageSize,
-		req.Keyword,
-		req.CategoryId,
-		req.BrandId,
-		minPrice,
-		maxPrice,
-		req.SortType,
-	)
-	if err != nil {
-		return nil, errs.New(errs.ErrInternal.Code, "search products failed: "+err.Error())
+	var specs map[string][]string
+	if len(req.Specs) > 0 {
+		specs = make(map[string][]string, len(req.Specs))
+		for _, spec := range req.Specs {
+			if spec.Name != "" && len(spec.Values) > 0 {
+				specs[spec.Name] = append(specs[spec.Name], spec.Values...)
+			}
+		}
+	}
+
+	var skus []*model.ProductSKU
+	var total int64
+	var highlights map[uint64]map[string]string
+	var facets []search.Facet
+	searched := false
+	if search.Default != nil {
+		skus, total, highlights, facets, err = s.searchIndex(&search.Query{
+			Keyword:     req.Keyword,
+			CategoryID:  req.CategoryId,
+			BrandID:     req.BrandId,
+			MinPrice:    minPrice,
+			MaxPrice:    maxPrice,
+			SortType:    req.SortType,
+			Page:        page,
+			PageSize:    pageSize,
+			BrandIDs:    req.BrandIds,
+			CategoryIDs: req.CategoryIds,
+			Specs:       specs,
+		})
+		if err != nil {
+			klog.CtxWarnf(s.ctx, "Search index query failed, falling back to SQL: %v", err)
+		} else {
+			searched = true
+		}
+	}
+	if !searched {
+		skus, total, err = model.SearchSKUs(
+			s.ctx,
+			mysql.DB,
+			page,
+			pageSize,
+			req.Keyword,
+			req.CategoryId,
+			req.BrandId,
+			minPrice,
+			maxPrice,
+			req.SortType,
+			req.BrandIds,
+			req.CategoryIds,
+			specs,
+		)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "search products failed: "+err.Error())
+		}
+	}
+
+	recordSearchLog(req, specs, page, total)
+	// 有结果的首页搜索计入搜索联想词，翻页不重复计数
+	if page == 1 && total > 0 {
+		recordSearchQuery(s.ctx, req.Keyword)
 	}
 
 	spuIDs := make([]uint64, 0, len(skus))
@@ -163,11 +213,169 @@ func (s *SearchProductsService) Run(req *product.SearchProductsRequest) (*produc
 			CategoryId:   spu.CategoryID,
 			CategoryName: categoryName,
 			SpuSaleCount: int32(spu.SaleCount),
+			Highlights:   highlights[sku.ID],
 		})
 	}
 
+	respFacets, err := s.buildFacets(req, minPrice, maxPrice, facets)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "build search facets failed: "+err.Error())
+	}
+
 	return &product.SearchProductsResponse{
-		List:  items,
-		Total: total,
+		List:   items,
+		Total:  total,
+		Facets: respFacets,
 	}, nil
 }
+
+// searchIndex 走全文索引检索，按命中顺序从数据库加载 SKU，索引中已不存在于数据库的 SKU 直接跳过
+func (s *SearchProductsService) searchIndex(q *search.Query) ([]*model.ProductSKU, int64, map[uint64]map[string]string, []search.Facet, error) {
+	res, err := search.Default.Search(s.ctx, q)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+
+	skuIDs := make([]uint64, 0, len(res.Hits))
+	highlights := make(map[uint64]map[string]string, len(res.Hits))
+	for _, hit := range res.Hits {
+		skuIDs = append(skuIDs, hit.SkuID)
+		highlights[hit.SkuID] = hit.Highlights
+	}
+
+	found, err := model.GetSKUsByIDs(s.ctx, mysql.DB, skuIDs)
+	if err != nil {
+		return nil, 0, nil, nil, err
+	}
+	skuMap := make(map[uint64]*model.ProductSKU, len(found))
+	for _, sku := range found {
+		skuMap[sku.ID] = sku
+	}
+
+	skus := make([]*model.ProductSKU, 0, len(skuIDs))
+	for _, id := range skuIDs {
+		if sku, ok := skuMap[id]; ok {
+			skus = append(skus, sku)
+		}
+	}
+	return skus, int64(res.Total), highlights, res.Facets, nil
+}
+
+// buildFacets 转换分面统计，补充品牌、分类名称并标记请求中已选中的取值
+func (s *SearchProductsService) buildFacets(req *product.SearchProductsRequest, minPrice, maxPrice float64, facets []search.Facet) ([]*product.SearchFacet, error) {
+	if len(facets) == 0 {
+		return nil, nil
+	}
+
+	selected := map[string]map[string]bool{
+		search.FacetBrand:    {},
+		search.FacetCategory: {},
+		search.FacetPrice:    {},
+	}
+	for _, id := range req.BrandIds {
+		selected[search.FacetBrand][strconv.FormatUint(id, 10)] = true
+	}
+	for _, id := range req.CategoryIds {
+		selected[search.FacetCategory][strconv.FormatUint(id, 10)] = true
+	}
+	for _, r := range search.PriceRanges {
+		if (minPrice > 0 || maxPrice > 0) && minPrice == r.Min && maxPrice == r.Max {
+			selected[search.FacetPrice][r.String()] = true
+		}
+	}
+	for _, spec := range req.Specs {
+		key := search.FacetSpec + ":" + spec.Name
+		if selected[key] == nil {
+			selected[key] = map[string]bool{}
+		}
+		for _, v := range spec.Values {
+			selected[key][v] = true
+		}
+	}
+
+	labels, err := s.facetLabels(facets)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*product.SearchFacet, 0, len(facets))
+	for _, f := range facets {
+		key := f.Field
+		if f.Field == search.FacetSpec {
+			key = search.FacetSpec + ":" + f.Name
+		}
+		values := make([]*product.SearchFacetValue, 0, len(f.Values))
+		for _, v := range f.Values {
+			label := v.Value
+			if l, ok := labels[f.Field][v.Value]; ok {
+				label = l
+			}
+			values = append(values, &product.SearchFacetValue{
+				Value:    v.Value,
+				Label:    label,
+				Count:    int64(v.Count),
+				Selected: selected[key][v.Value],
+			})
+		}
+		result = append(result, &product.SearchFacet{
+			Field:  f.Field,
+			Name:   f.Name,
+			Values: values,
+		})
+	}
+	return result, nil
+}
+
+// facetLabels 查询品牌、分类分面的展示名称
+func (s *SearchProductsService) facetLabels(facets []search.Facet) (map[string]map[string]string, error) {
+	labels := map[string]map[string]string{
+		search.FacetBrand:    {},
+		search.FacetCategory: {},
+	}
+	var brandIDs, categoryIDs []uint64
+	for _, f := range facets {
+		if f.Field != search.FacetBrand && f.Field != search.FacetCategory {
+			continue
+		}
+		for _, v := range f.Values {
+			id, err := strconv.ParseUint(v.Value, 10, 64)
+			if err != nil {
+				continue
+			}
+			if f.Field == search.FacetBrand {
+				brandIDs = append(brandIDs, id)
+			} else {
+				categoryIDs = append(categoryIDs, id)
+			}
+		}
+	}
+
+	if len(brandIDs) > 0 {
+		brands, err := model.GetBrandsByIds(s.ctx, mysql.DB, brandIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, brand := range brands {
+			labels[search.FacetBrand][strconv.FormatUint(brand.ID, 10)] = brand.Name
+		}
+	}
+	if len(categoryIDs) > 0 {
+		categories, err := model.GetCategoriesByIds(s.ctx, mysql.DB, categoryIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range categories {
+			labels[search.FacetCategory][strconv.FormatUint(category.ID, 10)] = category.Name
+		}
+	}
+
+	labels[search.FacetPrice] = make(map[string]string, len(search.PriceRanges))
+	for _, r := range search.PriceRanges {
+		label := r.String()
+		if r.Max == 0 {
+			label = strings.TrimSuffix(label, "-") + "以上"
+		}
+		labels[search.FacetPrice][r.String()] = label
+	}
+	return labels, nil
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/conf"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	defaultSearchStatsInterval = 10 * time.Minute
+	defaultSearchLogRetention  = 30
+	searchHourStatRetention    = 7 * 24 * time.Hour // 小时统计只用于热搜榜，保留一周
+	searchLogFiltersMaxLen     = 1000
+
+	searchLogQueueSize     = 4096
+	searchLogBatchSize     = 200
+	searchLogFlushInterval = time.Second
+)
+
+// searchLogWriter 搜索日志写入队列，由单个协程批量写入数据库。
+// 队列满时丢弃新日志，数据库变慢不会拖慢搜索，也不会堆积协程
+type searchLogWriter struct {
+	queue   chan *model.SearchLog
+	dropped atomic.Int64
+}
+
+var searchLogs = newSearchLogWriter(searchLogQueueSize)
+
+func newSearchLogWriter(size int) *searchLogWriter {
+	return &searchLogWriter{queue: make(chan *model.SearchLog, size)}
+}
+
+// add 加入写入队列，队列已满时丢弃并返回 false
+func (w *searchLogWriter) add(log *model.SearchLog) bool {
+	select {
+	case w.queue <- log:
+		return true
+	default:
+		w.dropped.Add(1)
+		return false
+	}
+}
+
+// run 攒满 searchLogBatchSize 条或每隔 searchLogFlushInterval 写入一次，ctx 结束时写完队列中剩余的日志后退出
+func (w *searchLogWriter) run(ctx context.Context) {
+	writeCtx := context.WithoutCancel(ctx)
+	batch := make([]*model.SearchLog, 0, searchLogBatchSize)
+	flush := func() {
+		if n := w.dropped.Swap(0); n > 0 {
+			klog.Warnf("Search log queue is full, dropped %d logs", n)
+		}
+		if len(batch) == 0 {
+			return
+		}
+		if err := model.CreateSearchLogs(writeCtx, mysql.DB, batch); err != nil {
+			klog.Warnf("Failed to write %d search logs: %v", len(batch), err)
+		}
+		batch = make([]*model.SearchLog, 0, searchLogBatchSize)
+	}
+
+	ticker := time.NewTicker(searchLogFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			for {
+				select {
+				case log := <-w.queue:
+					batch = append(batch, log)
+					if len(batch) >= searchLogBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		case log := <-w.queue:
+			batch = append(batch, log)
+			if len(batch) >= searchLogBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// searchLogFilters 搜索日志中记录的筛选条件，未使用的条件不输出
+type searchLogFilters struct {
+	CategoryID  uint64              `json:"category_id,omitempty"`
+	BrandID     uint64              `json:"brand_id,omitempty"`
+	CategoryIDs []uint64            `json:"category_ids,omitempty"`
+	BrandIDs    []uint64            `json:"brand_ids,omitempty"`
+	MinPrice    string              `json:"min_price,omitempty"`
+	MaxPrice    string              `json:"max_price,omitempty"`
+	Specs       map[string][]string `json:"specs,omitempty"`
+	SortType    int32               `json:"sort_type,omitempty"`
+}
+
+// recordSearchLog 将搜索日志加入写入队列，不影响搜索
+func recordSearchLog(req *product.SearchProductsRequest, specs map[string][]string, page int, total int64) {
+	if !conf.GetConf().SearchLog.Enabled {
+		return
+	}
+	keyword := normalizeSearchKeyword(req.Keyword)
+	if keyword != "" && keywordBlocked(keyword) {
+		return
+	}
+	filters := ""
+	data, err := json.Marshal(searchLogFilters{
+		CategoryID:  req.CategoryId,
+		BrandID:     req.BrandId,
+		CategoryIDs: req.CategoryIds,
+		BrandIDs:    req.BrandIds,
+		MinPrice:    req.MinPrice,
+		MaxPrice:    req.MaxPrice,
+		Specs:       specs,
+		SortType:    req.SortType,
+	})
+	if err == nil && string(data) != "{}" && len(data) <= searchLogFiltersMaxLen {
+		filters = string(data)
+	}
+	searchLogs.add(&model.SearchLog{
+		UserID:      req.UserId,
+		Keyword:     keyword,
+		Filters:     filters,
+		Page:        page,
+		ResultCount: total,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// SearchStatsService 将搜索日志汇总为按小时、按天的搜索词统计
+type SearchStatsService struct {
+	ctx context.Context
+}
+
+func NewSearchStatsService(ctx context.Context) *SearchStatsService {
+	return &SearchStatsService{ctx: ctx}
+}
+
+// Aggregate 重新汇总 now 所在及上一个小时、天的统计，未结束的周期下次执行时会被覆盖
+func (s *SearchStatsService) Aggregate(now time.Time) error {
+	hour := now.Truncate(time.Hour)
+	for _, start := range []time.Time{hour.Add(-time.Hour), hour} {
+		if err := model.AggregateSearchStats(s.ctx, mysql.DB, model.SearchStatHour, start, start.Add(time.Hour)); err != nil {
+			return err
+		}
+	}
+
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for _, start := range []time.Time{day.AddDate(0, 0, -1), day} {
+		if err := model.AggregateSearchStats(s.ctx, mysql.DB, model.SearchStatDay, start, start.AddDate(0, 0, 1)); err != nil {
+			return err
+		}
+	}
+
+	if err := redis.DeleteTrendingSearchesCache(s.ctx, trendingPeriodHour, trendingPeriodDay); err != nil {
+		klog.Warnf("Failed to delete trending searches cache: %v", err)
+	}
+	return nil
+}
+
+// Purge 清理过期的搜索日志与小时统计
+func (s *SearchStatsService) Purge(now time.Time) error {
+	retentionDays := conf.GetConf().SearchLog.RetentionDays
+	if retentionDays <= 0 {
+		retentionDays = defaultSearchLogRetention
+	}
+	if _, err := model.PurgeSearchLogs(s.ctx, mysql.DB, now.AddDate(0, 0, -retentionDays)); err != nil {
+		return err
+	}
+	_, err := model.PurgeSearchStats(s.ctx, mysql.DB, model.SearchStatHour, now.Add(-searchHourStatRetention))
+	return err
+}
+
+// StartSearchStatsTask 启动搜索日志写入与搜索词统计定时任务，统计启动时先执行一次
+func StartSearchStatsTask(ctx context.Context) {
+	cfg := conf.GetConf().SearchLog
+	if !cfg.Enabled {
+		klog.Info("Search stats task disabled")
+		return
+	}
+	go searchLogs.run(ctx)
+
+	interval := defaultSearchStatsInterval
+	if cfg.IntervalMinutes > 0 {
+		interval = time.Duration(cfg.IntervalMinutes) * time.Minute
+	}
+
+	run := func() {
+		now := time.Now()
+		service := NewSearchStatsService(ctx)
+		if err := service.Aggregate(now); err != nil {
+			klog.Errorf("Search stats aggregation failed: %v", err)
+		}
+		if err := service.Purge(now); err != nil {
+			klog.Errorf("Search log purge failed: %v", err)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	go func() {
+		run()
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				klog.Info("Search stats task stopped")
+				return
+			case <-ticker.C:
+				run()
+			}
+		}
+	}()
+	klog.Infof("Search stats task started, interval=%v", interval)
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/daltest"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+)
+
+// 队列满时丢弃新日志，不阻塞调用方
+func TestSearchLogWriterDropsWhenFull(t *testing.T) {
+	w := newSearchLogWriter(2)
+	for i := 0; i < 2; i++ {
+		if !w.add(&model.SearchLog{Keyword: "a"}) {
+			t.Fatalf("log %d should be queued", i)
+		}
+	}
+	if w.add(&model.SearchLog{Keyword: "a"}) {
+		t.Fatal("log should be dropped when the queue is full")
+	}
+	if n := w.dropped.Load(); n != 1 {
+		t.Errorf("dropped = %d, want 1", n)
+	}
+}
+
+// 超过一批的日志分批写入，退出前写完队列中剩余的日志
+func TestSearchLogWriterRun(t *testing.T) {
+	daltest.MySQL(t)
+	w := newSearchLogWriter(searchLogBatchSize * 2)
+	total := searchLogBatchSize + 10
+	for i := 0; i < total; i++ {
+		w.add(&model.SearchLog{Keyword: "手机", Page: 1, ResultCount: 3, CreatedAt: time.Now()})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		w.run(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("writer did not stop")
+	}
+
+	var count int64
+	if err := mysql.DB.Model(&model.SearchLog{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != int64(total) {
+		t.Errorf("wrote %d logs, want %d", count, total)
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type SetBrandCategoriesService struct {
+	ctx context.Context
+}
+
+func NewSetBrandCategoriesService(ctx context.Context) *SetBrandCategoriesService {
+	return &SetBrandCategoriesService{ctx: ctx}
+}
+
+// Run 全量覆盖品牌关联的分类，category_ids 为空时清除全部关联
+func (s *SetBrandCategoriesService) Run(req *product.SetBrandCategoriesRequest) (*product.SetBrandCategoriesResponse, error) {
+	if req.BrandId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "brand id is required")
+	}
+	if _, err := getBrand(s.ctx, req.BrandId); err != nil {
+		return nil, err
+	}
+	categoryIDs, err := checkCategoryIDs(s.ctx, req.CategoryIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.SetBrandCategories(s.ctx, mysql.DB, req.BrandId, categoryIDs); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "set brand categories failed: "+err.Error())
+	}
+
+	return &product.SetBrandCategoriesResponse{Success: true}, nil
+}
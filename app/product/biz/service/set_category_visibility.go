@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type SetCategoryVisibilityService struct {
+	ctx context.Context
+}
+
+func NewSetCategoryVisibilityService(ctx context.Context) *SetCategoryVisibilityService {
+	return &SetCategoryVisibilityService{ctx: ctx}
+}
+
+// Run 显示或隐藏分类，隐藏后整棵子树在前台分类树中不可见
+func (s *SetCategoryVisibilityService) Run(req *product.SetCategoryVisibilityRequest) (*product.SetCategoryVisibilityResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "category id is required")
+	}
+	category, err := getCategory(s.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if category.ShowStatus == req.ShowStatus {
+		return &product.SetCategoryVisibilityResponse{Success: true}, nil
+	}
+
+	if err := model.UpdateCategory(s.ctx, mysql.DB, req.Id, map[string]interface{}{
+		"show_status": req.ShowStatus,
+	}); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "update category failed: "+err.Error())
+	}
+	invalidateCategoryTree(s.ctx)
+
+	return &product.SetCategoryVisibilityResponse{Success: true}, nil
+}
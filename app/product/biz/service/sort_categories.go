@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+type SortCategoriesService struct {
+	ctx context.Context
+}
+
+func NewSortCategoriesService(ctx context.Context) *SortCategoriesService {
+	return &SortCategoriesService{ctx: ctx}
+}
+
+// Run 按 ids 顺序重排同一父分类下的子分类，ids 必须都属于该父分类
+func (s *SortCategoriesService) Run(req *product.SortCategoriesRequest) (*product.SortCategoriesResponse, error) {
+	if len(req.Ids) == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
+	}
+	seen := make(map[uint64]bool, len(req.Ids))
+	for _, id := range req.Ids {
+		if seen[id] {
+			return nil, errs.New(errs.ErrParam.Code, "duplicate category id")
+		}
+		seen[id] = true
+	}
+
+	categories, err := model.GetCategoriesByIds(s.ctx, mysql.DB, req.Ids)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get categories failed: "+err.Error())
+	}
+	if len(categories) != len(req.Ids) {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "category not found")
+	}
+	for _, c := range categories {
+		if c.ParentID != req.ParentId {
+			return nil, errs.New(errs.ErrParam.Code, "categories must share the given parent")
+		}
+	}
+
+	if err := model.SortCategories(s.ctx, mysql.DB, req.Ids); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "sort categories failed: "+err.Error())
+	}
+	invalidateCategoryTree(s.ctx)
+
+	return &product.SortCategoriesResponse{Success: true}, nil
+}
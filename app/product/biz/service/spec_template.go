@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 规格模板限制
+const (
+	maxSpecAttributes  = 50
+	maxSpecNameLen     = 32
+	maxSpecValues      = 100
+	maxSpecValueLength = 64
+)
+
+// loadSpecTemplate 获取分类的完整规格模板：从一级分类到自身逐级合并，
+// 子分类的同名属性覆盖上级分类；未配置模板时返回空
+func loadSpecTemplate(ctx context.Context, categoryID uint64) ([]*redis.CachedSpecAttribute, error) {
+	if categoryID == 0 {
+		return nil, nil
+	}
+	cached, err := redis.GetSpecTemplateFromCache(ctx, categoryID)
+	if err != nil {
+		klog.Warnf("Failed to get spec template from cache: %v", err)
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	categories, err := loadCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	chain := categoryAncestors(categories, categoryID)
+	attrs, err := model.ListSpecAttributes(ctx, mysql.DB, chain)
+	if err != nil {
+		return nil, err
+	}
+	template := mergeSpecAttributes(chain, attrs)
+
+	if err := redis.SetSpecTemplateCache(ctx, categoryID, template); err != nil {
+		klog.Warnf("Failed to set spec template cache: %v", err)
+	}
+	return template, nil
+}
+
+// invalidateSpecTemplates 模板保存或分类层级变化后清除全部模板缓存
+func invalidateSpecTemplates(ctx context.Context) {
+	if err := redis.InvalidateSpecTemplateCache(ctx); err != nil {
+		klog.Warnf("Failed to invalidate spec template cache: %v", err)
+	}
+}
+
+// categoryAncestors 返回从一级分类到 id 自身的分类链
+func categoryAncestors(list []*redis.CachedCategory, id uint64) []uint64 {
+	byID := make(map[uint64]*redis.CachedCategory, len(list))
+	for _, c := range list {
+		byID[c.ID] = c
+	}
+	chain := []uint64{id}
+	for c, ok := byID[id]; ok && c.ParentID != 0 && len(chain) <= model.MaxCategoryLevel; c, ok = byID[c.ParentID] {
+		chain = append(chain, c.ParentID)
+	}
+	slices.Reverse(chain)
+	return chain
+}
+
+// mergeSpecAttributes 按分类链顺序合并属性，子分类的同名属性替换上级分类的属性
+func mergeSpecAttributes(chain []uint64, attrs []*model.SpecAttribute) []*redis.CachedSpecAttribute {
+	byCategory := make(map[uint64][]*model.SpecAttribute, len(chain))
+	for _, a := range attrs {
+		byCategory[a.CategoryID] = append(byCategory[a.CategoryID], a)
+	}
+
+	template := make([]*redis.CachedSpecAttribute, 0, len(attrs))
+	index := make(map[string]int, len(attrs))
+	for _, categoryID := range chain {
+		for _, a := range byCategory[categoryID] {
+			attr := &redis.CachedSpecAttribute{
+				CategoryID: a.CategoryID,
+				Name:       a.Name,
+				Type:       a.Type,
+				Values:     a.GetValues(),
+				Filterable: a.Filterable,
+				Sales:      a.Sales,
+				Sort:       a.Sort,
+			}
+			if i, ok := index[a.Name]; ok {
+				template[i] = attr
+				continue
+			}
+			index[a.Name] = len(template)
+			template = append(template, attr)
+		}
+	}
+	return template
+}
+
+func toProtoSpecAttribute(a *redis.CachedSpecAttribute) *product.SpecAttribute {
+	return &product.SpecAttribute{
+		CategoryId: a.CategoryID,
+		Name:       a.Name,
+		Type:       a.Type,
+		Values:     a.Values,
+		Filterable: a.Filterable,
+		Sales:      a.Sales,
+		Sort:       int32(a.Sort),
+	}
+}
+
+// checkSpecAttributes 校验待保存的模板属性：名称唯一，enum 必须给出可选值，销售属性必须是 enum
+func checkSpecAttributes(attrs []*product.SpecAttribute) ([]*model.SpecAttribute, error) {
+	if len(attrs) > maxSpecAttributes {
+		return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("at most %d spec attributes per category", maxSpecAttributes))
+	}
+	result := make([]*model.SpecAttribute, 0, len(attrs))
+	seen := make(map[string]bool, len(attrs))
+	for _, a := range attrs {
+		name := strings.TrimSpace(a.Name)
+		if name == "" || utf8.RuneCountInString(name) > maxSpecNameLen {
+			return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("spec attribute name must be 1-%d characters", maxSpecNameLen))
+		}
+		if seen[name] {
+			return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("duplicate spec attribute %q", name))
+		}
+		seen[name] = true
+
+		switch a.Type {
+		case model.SpecTypeEnum, model.SpecTypeText, model.SpecTypeNumber:
+		default:
+			return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("spec attribute %q: type must be enum, text or number", name))
+		}
+		if a.Sales && a.Type != model.SpecTypeEnum {
+			return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("spec attribute %q: sales attributes must be enum", name))
+		}
+
+		values := make([]string, 0, len(a.Values))
+		if a.Type == model.SpecTypeEnum {
+			if len(a.Values) == 0 || len(a.Values) > maxSpecValues {
+				return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("spec attribute %q: enum needs 1-%d values", name, maxSpecValues))
+			}
+			valueSeen := make(map[string]bool, len(a.Values))
+			for _, v := range a.Values {
+				v = strings.TrimSpace(v)
+				if v == "" || utf8.RuneCountInString(v) > maxSpecValueLength {
+					return nil, errs.New(errs.ErrParam.Code, fmt.Sprintf("spec attribute %q: values must be 1-%d characters", name, maxSpecValueLength))
+				}
+				if !valueSeen[v] {
+					valueSeen[v] = true
+					values = append(values, v)
+				}
+			}
+		}
+
+		attr := &model.SpecAttribute{
+			Name:       name,
+			Type:       a.Type,
+			Filterable: a.Filterable,
+			Sales:      a.Sales,
+			Sort:       int(a.Sort),
+		}
+		attr.SetValues(values)
+		result = append(result, attr)
+	}
+	return result, nil
+}
+
+// parseSpecData 解析 sku_spec_data，只接受字符串或数值
+func parseSpecData(specJSON string) (map[string]string, error) {
+	specs := make(map[string]string)
+	if strings.TrimSpace(specJSON) == "" {
+		return specs, nil
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(specJSON), &raw); err != nil {
+		return nil, fmt.Errorf("invalid sku_spec_data format")
+	}
+	for name, v := range raw {
+		switch val := v.(type) {
+		case string:
+			specs[name] = val
+		case float64:
+			specs[name] = strconv.FormatFloat(val, 'f', -1, 64)
+		default:
+			return nil, fmt.Errorf("spec %q must be a string or number", name)
+		}
+	}
+	return specs, nil
+}
+
+// validateSpecData 按模板校验单个 SKU 的规格：不允许模板外的属性，销售属性必填，
+// enum 取值必须在可选值内，number 必须是数值。模板为空时只校验 JSON 格式。
+// 返回销售属性组合的规范化表示，用于检查同一商品下 SKU 是否重复
+func validateSpecData(template []*redis.CachedSpecAttribute, specJSON string) (string, error) {
+	specs, err := parseSpecData(specJSON)
+	if err != nil {
+		return "", err
+	}
+	if len(template) == 0 {
+		return "", nil
+	}
+
+	attrs := make(map[string]*redis.CachedSpecAttribute, len(template))
+	for _, a := range template {
+		attrs[a.Name] = a
+	}
+	for name, value := range specs {
+		attr, ok := attrs[name]
+		if !ok {
+			return "", fmt.Errorf("unknown spec %q", name)
+		}
+		switch attr.Type {
+		case model.SpecTypeEnum:
+			if !slices.Contains(attr.Values, value) {
+				return "", fmt.Errorf("spec %q: %q is not an allowed value", name, value)
+			}
+		case model.SpecTypeNumber:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return "", fmt.Errorf("spec %q must be a number", name)
+			}
+		}
+	}
+
+	var sales []string
+	for _, a := range template {
+		if !a.Sales {
+			continue
+		}
+		value, ok := specs[a.Name]
+		if !ok || value == "" {
+			return "", fmt.Errorf("sales spec %q is required", a.Name)
+		}
+		sales = append(sales, a.Name+"="+value)
+	}
+	return strings.Join(sales, ";"), nil
+}
+
+// validateSkuSpecs 按分类模板校验一个商品的全部 SKU 规格，label 用于错误信息中定位 SKU
+func validateSkuSpecs(template []*redis.CachedSpecAttribute, specs []string, label func(i int) string) error {
+	combos := make(map[string]bool, len(specs))
+	for i, specJSON := range specs {
+		combo, err := validateSpecData(template, specJSON)
+		if err != nil {
+			return errs.New(errs.ErrParam.Code, label(i)+": "+err.Error())
+		}
+		if combo == "" {
+			continue
+		}
+		if combos[combo] {
+			return errs.New(errs.ErrParam.Code, label(i)+": duplicate sales spec combination")
+		}
+		combos[combo] = true
+	}
+	return nil
+}
+
+// filterableSpecs 只保留模板中可筛选的属性，模板为空时原样返回以兼容未配置模板的分类
+func filterableSpecs(template []*redis.CachedSpecAttribute, specs map[string]string) map[string]string {
+	if len(template) == 0 || len(specs) == 0 {
+		return specs
+	}
+	result := make(map[string]string, len(specs))
+	for _, a := range template {
+		if v, ok := specs[a.Name]; ok && a.Filterable {
+			result[a.Name] = v
+		}
+	}
+	return result
+}
+
+// buildSpecSelectors 由模板中的销售属性构建前台规格选择器，只保留 SKU 实际用到的取值，
+// 取值按模板中的顺序排列
+func buildSpecSelectors(template []*redis.CachedSpecAttribute, skuSpecs []string) []*product.SpecSelector {
+	used := make(map[string]map[string]bool)
+	for _, specJSON := range skuSpecs {
+		specs, err := parseSpecData(specJSON)
+		if err != nil {
+			continue
+		}
+		for name, value := range specs {
+			if used[name] == nil {
+				used[name] = make(map[string]bool)
+			}
+			used[name][value] = true
+		}
+	}
+
+	sales := make([]*redis.CachedSpecAttribute, 0, len(template))
+	for _, a := range template {
+		if a.Sales {
+			sales = append(sales, a)
+		}
+	}
+	sort.SliceStable(sales, func(i, j int) bool { return sales[i].Sort < sales[j].Sort })
+
+	selectors := make([]*product.SpecSelector, 0, len(sales))
+	for _, a := range sales {
+		values := make([]string, 0, len(a.Values))
+		for _, v := range a.Values {
+			if used[a.Name][v] {
+				values = append(values, v)
+			}
+		}
+		if len(values) > 0 {
+			selectors = append(selectors, &product.SpecSelector{Name: a.Name, Values: values})
+		}
+	}
+	return selectors
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+func testSpecAttribute(categoryID uint64, name, typ string, values []string, sales bool, sortNum int) *model.SpecAttribute {
+	a := &model.SpecAttribute{CategoryID: categoryID, Name: name, Type: typ, Sales: sales, Sort: sortNum}
+	a.SetValues(values)
+	return a
+}
+
+// 手机: 颜色、存储(销售属性) + 屏幕尺寸(数值) + 产地(文本)
+func testSpecTemplate() []*redis.CachedSpecAttribute {
+	return []*redis.CachedSpecAttribute{
+		{Name: "存储", Type: model.SpecTypeEnum, Values: []string{"256G", "512G", "1T"}, Sales: true, Sort: 2},
+		{Name: "颜色", Type: model.SpecTypeEnum, Values: []string{"黑色", "白色", "蓝色"}, Sales: true, Filterable: true, Sort: 1},
+		{Name: "屏幕尺寸", Type: model.SpecTypeNumber, Filterable: true},
+		{Name: "产地", Type: model.SpecTypeText},
+	}
+}
+
+func TestCategoryAncestors(t *testing.T) {
+	list := []*redis.CachedCategory{
+		{ID: 1}, {ID: 101, ParentID: 1}, {ID: 1001, ParentID: 101},
+	}
+	if got := categoryAncestors(list, 1001); !reflect.DeepEqual(got, []uint64{1, 101, 1001}) {
+		t.Errorf("categoryAncestors(1001) = %v", got)
+	}
+	if got := categoryAncestors(list, 1); !reflect.DeepEqual(got, []uint64{1}) {
+		t.Errorf("categoryAncestors(1) = %v", got)
+	}
+}
+
+func TestMergeSpecAttributes(t *testing.T) {
+	attrs := []*model.SpecAttribute{
+		testSpecAttribute(1, "颜色", model.SpecTypeEnum, []string{"黑色"}, true, 1),
+		testSpecAttribute(1, "产地", model.SpecTypeText, nil, false, 2),
+		testSpecAttribute(101, "颜色", model.SpecTypeEnum, []string{"黑色", "白色"}, true, 1),
+		testSpecAttribute(101, "存储", model.SpecTypeEnum, []string{"256G"}, true, 3),
+	}
+	template := mergeSpecAttributes([]uint64{1, 101}, attrs)
+	if len(template) != 3 {
+		t.Fatalf("len = %d, want 3", len(template))
+	}
+	if template[0].Name != "颜色" || template[0].CategoryID != 101 || len(template[0].Values) != 2 {
+		t.Errorf("子分类属性应覆盖上级同名属性，got %+v", template[0])
+	}
+	if template[1].Name != "产地" || template[2].Name != "存储" {
+		t.Errorf("order = %s, %s", template[1].Name, template[2].Name)
+	}
+}
+
+func TestCheckSpecAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   []*product.SpecAttribute
+		wantErr bool
+	}{
+		{name: "合法模板", attrs: []*product.SpecAttribute{
+			{Name: "颜色", Type: model.SpecTypeEnum, Values: []string{"黑色", " 黑色 "}, Sales: true},
+			{Name: "屏幕尺寸", Type: model.SpecTypeNumber},
+		}},
+		{name: "空模板", attrs: nil},
+		{name: "名称为空", attrs: []*product.SpecAttribute{{Name: " ", Type: model.SpecTypeText}}, wantErr: true},
+		{name: "名称重复", attrs: []*product.SpecAttribute{
+			{Name: "产地", Type: model.SpecTypeText},
+			{Name: "产地", Type: model.SpecTypeText},
+		}, wantErr: true},
+		{name: "未知类型", attrs: []*product.SpecAttribute{{Name: "颜色", Type: "color"}}, wantErr: true},
+		{name: "枚举无可选值", attrs: []*product.SpecAttribute{{Name: "颜色", Type: model.SpecTypeEnum}}, wantErr: true},
+		{name: "销售属性非枚举", attrs: []*product.SpecAttribute{{Name: "产地", Type: model.SpecTypeText, Sales: true}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkSpecAttributes(tt.attrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.name == "合法模板" && len(got[0].GetValues()) != 1 {
+				t.Errorf("可选值应去重，got %v", got[0].GetValues())
+			}
+		})
+	}
+}
+
+func TestValidateSkuSpecs(t *testing.T) {
+	label := func(i int) string { return "sku" }
+	tests := []struct {
+		name     string
+		template []*redis.CachedSpecAttribute
+		specs    []string
+		wantErr  bool
+	}{
+		{name: "合法规格", template: testSpecTemplate(), specs: []string{
+			`{"颜色":"黑色","存储":"256G","屏幕尺寸":6.7,"产地":"深圳"}`,
+			`{"颜色":"白色","存储":"256G"}`,
+		}},
+		{name: "无模板只校验格式", specs: []string{`{"任意":"值"}`, ``}},
+		{name: "无模板格式错误", specs: []string{`[1,2]`}, wantErr: true},
+		{name: "模板外属性", template: testSpecTemplate(), specs: []string{`{"颜色":"黑色","存储":"256G","重量":"200g"}`}, wantErr: true},
+		{name: "枚举值不合法", template: testSpecTemplate(), specs: []string{`{"颜色":"红色","存储":"256G"}`}, wantErr: true},
+		{name: "数值不合法", template: testSpecTemplate(), specs: []string{`{"颜色":"黑色","存储":"256G","屏幕尺寸":"大"}`}, wantErr: true},
+		{name: "缺少销售属性", template: testSpecTemplate(), specs: []string{`{"颜色":"黑色"}`}, wantErr: true},
+		{name: "销售属性组合重复", template: testSpecTemplate(), specs: []string{
+			`{"颜色":"黑色","存储":"256G"}`,
+			`{"存储":"256G","颜色":"黑色","产地":"深圳"}`,
+		}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateSkuSpecs(tt.template, tt.specs, label); (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFilterableSpecs(t *testing.T) {
+	specs := map[string]string{"颜色": "黑色", "存储": "256G", "产地": "深圳"}
+	got := filterableSpecs(testSpecTemplate(), specs)
+	if !reflect.DeepEqual(got, map[string]string{"颜色": "黑色"}) {
+		t.Errorf("filterableSpecs = %v", got)
+	}
+	if got := filterableSpecs(nil, specs); len(got) != 3 {
+		t.Errorf("无模板时应保留全部规格，got %v", got)
+	}
+}
+
+func TestBuildSpecSelectors(t *testing.T) {
+	selectors := buildSpecSelectors(testSpecTemplate(), []string{
+		`{"颜色":"蓝色","存储":"512G"}`,
+		`{"颜色":"黑色","存储":"512G"}`,
+		`{"颜色":"黑色","存储":"256G"}`,
+	})
+	want := []*product.SpecSelector{
+		{Name: "颜色", Values: []string{"黑色", "蓝色"}},
+		{Name: "存储", Values: []string{"256G", "512G"}},
+	}
+	if len(selectors) != len(want) {
+		t.Fatalf("len = %d, want %d", len(selectors), len(want))
+	}
+	for i := range want {
+		if selectors[i].Name != want[i].Name || !reflect.DeepEqual(selectors[i].Values, want[i].Values) {
+			t.Errorf("selectors[%d] = %v %v, want %v %v", i, selectors[i].Name, selectors[i].Values, want[i].Name, want[i].Values)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/app/product/biz/utils"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"gorm.io/gorm"
+)
+
+// maxWaitingPriceAlerts 每个用户同时等待中的提醒数上限
+const maxWaitingPriceAlerts = 100
+
+type SubscribePriceAlertService struct {
+	ctx context.Context
+}
+
+func NewSubscribePriceAlertService(ctx context.Context) *SubscribePriceAlertService {
+	return &SubscribePriceAlertService{ctx: ctx}
+}
+
+// Run 订阅降价提醒，目标价需低于当前售价，重复订阅时覆盖目标价并重新等待
+func (s *SubscribePriceAlertService) Run(req *product.SubscribePriceAlertRequest) (*product.SubscribePriceAlertResponse, error) {
+	if req.UserId == 0 || req.SkuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user id and sku id are required")
+	}
+	target, err := utils.PriceConvert(req.TargetPrice)
+	if err != nil || target <= 0 {
+		return nil, errs.New(errs.ErrParam.Code, "target price must be a positive number")
+	}
+
+	sku, err := model.GetSKUByID(s.ctx, mysql.DB, req.SkuId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "sku not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get sku failed: "+err.Error())
+	}
+	if target >= sku.Price {
+		return nil, errs.New(errs.ErrParam.Code, "target price must be lower than current price "+utils.PriceToString(sku.Price))
+	}
+
+	existing, err := model.GetPriceAlert(s.ctx, mysql.DB, req.UserId, req.SkuId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errs.New(errs.ErrInternal.Code, "get price alert failed: "+err.Error())
+	}
+	if existing == nil || existing.Status == model.PriceAlertStatusNotified {
+		waiting, err := model.CountWaitingPriceAlerts(s.ctx, mysql.DB, req.UserId)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "count price alerts failed: "+err.Error())
+		}
+		if waiting >= maxWaitingPriceAlerts {
+			return nil, errs.New(errs.ErrParam.Code, "too many price alerts")
+		}
+	}
+
+	alert := &model.PriceAlert{
+		UserID:      req.UserId,
+		SkuID:       sku.ID,
+		SpuID:       sku.SpuID,
+		TargetPrice: target,
+	}
+	if err := model.UpsertPriceAlert(s.ctx, mysql.DB, alert); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "save price alert failed: "+err.Error())
+	}
+	saved, err := model.GetPriceAlert(s.ctx, mysql.DB, req.UserId, req.SkuId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get price alert failed: "+err.Error())
+	}
+	return &product.SubscribePriceAlertResponse{Alert: toProtoPriceAlert(saved)}, nil
+}
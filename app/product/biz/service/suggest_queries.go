@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type SuggestQueriesService struct {
+	ctx context.Context
+}
+
+func NewSuggestQueriesService(ctx context.Context) *SuggestQueriesService {
+	return &SuggestQueriesService{ctx: ctx}
+}
+
+// Run 按前缀返回搜索联想词，热门搜索词在前，商品名、品牌、分类补全在后
+func (s *SuggestQueriesService) Run(req *product.SuggestQueriesRequest) (*product.SuggestQueriesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 20 {
+		limit = 20
+	}
+
+	prefix := redis.NormalizeSuggestText(req.Prefix)
+	if prefix == "" {
+		return &product.SuggestQueriesResponse{Suggestions: []*product.QuerySuggestion{}}, nil
+	}
+
+	queries, err := redis.GetQuerySuggestions(s.ctx, prefix, limit)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get query suggestions failed: "+err.Error())
+	}
+	catalog, err := redis.GetCatalogSuggestions(s.ctx, prefix, limit)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get catalog suggestions failed: "+err.Error())
+	}
+
+	// 搜索词最多占一半名额，其余留给目录补全，任一来源不足时由另一来源补齐
+	queryQuota := (limit + 1) / 2
+	if len(catalog) < limit-queryQuota {
+		queryQuota = limit - len(catalog)
+	}
+
+	suggestions := make([]*product.QuerySuggestion, 0, limit)
+	seen := make(map[string]bool, limit)
+	add := func(list []redis.Suggestion, max int) {
+		for _, sg := range list {
+			if len(suggestions) >= max {
+				return
+			}
+			key := redis.NormalizeSuggestText(sg.Text)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			suggestions = append(suggestions, &product.QuerySuggestion{Text: sg.Text, Type: sg.Type})
+		}
+	}
+	add(queries, queryQuota)
+	add(catalog, limit)
+	add(queries, limit)
+
+	return &product.SuggestQueriesResponse{Suggestions: suggestions}, nil
+}
+
+// recordSearchQuery 异步记录搜索词，屏蔽的搜索词不进入联想，失败只记录日志，不影响搜索
+func recordSearchQuery(ctx context.Context, keyword string) {
+	keyword = normalizeSearchKeyword(keyword)
+	if keyword == "" || keywordBlocked(keyword) {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := redis.RecordSearchQuery(ctx, keyword); err != nil {
+			klog.Warnf("Failed to record search query %q: %v", keyword, err)
+		}
+	}()
+}
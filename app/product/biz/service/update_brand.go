@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type UpdateBrandService struct {
+	ctx context.Context
+}
+
+func NewUpdateBrandService(ctx context.Context) *UpdateBrandService {
+	return &UpdateBrandService{ctx: ctx}
+}
+
+// Run 修改品牌，字符串字段只更新非空值；重命名且未指定首字母时重新推算首字母。
+// 名称或 LOGO 变化时清除该品牌商品的详情缓存，重命名时同步刷新搜索索引
+func (s *UpdateBrandService) Run(req *product.UpdateBrandRequest) (*product.UpdateBrandResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "brand id is required")
+	}
+	if req.ShowStatus < 0 || req.ShowStatus > 2 {
+		return nil, errs.New(errs.ErrParam.Code, "invalid show status")
+	}
+	brand, err := getBrand(s.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	renamed := false
+	name := brand.Name
+	if req.Name != "" {
+		if name, err = checkBrandName(s.ctx, req.Name, req.Id); err != nil {
+			return nil, err
+		}
+		if name != brand.Name {
+			updates["name"] = name
+			renamed = true
+		}
+	}
+	if req.FirstLetter != "" || renamed {
+		firstLetter, err := brandFirstLetter(req.FirstLetter, name)
+		if err != nil {
+			return nil, err
+		}
+		if firstLetter != brand.FirstLetter {
+			updates["first_letter"] = firstLetter
+		}
+	}
+	logoChanged := req.Logo != "" && req.Logo != brand.Logo
+	if logoChanged {
+		updates["logo"] = req.Logo
+	}
+	if req.Sort >= 0 {
+		updates["sort"] = req.Sort
+	}
+	if req.ShowStatus != 0 {
+		updates["show_status"] = req.ShowStatus == 1
+	}
+
+	if err := model.UpdateBrand(s.ctx, mysql.DB, req.Id, updates); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "update brand failed: "+err.Error())
+	}
+
+	if renamed || logoChanged {
+		spuIDs, err := model.ListSPUIDsByBrandID(s.ctx, mysql.DB, req.Id)
+		if err != nil {
+			klog.Warnf("Failed to list products of brand %d: %v", req.Id, err)
+		} else if len(spuIDs) > 0 {
+			invalidateProductCaches(s.ctx, spuIDs)
+			if renamed {
+				refreshSearchIndex(s.ctx, spuIDs)
+			}
+		}
+	}
+
+	return &product.UpdateBrandResponse{Success: true}, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/product/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type UpdateCategoryService struct {
+	ctx context.Context
+}
+
+func NewUpdateCategoryService(ctx context.Context) *UpdateCategoryService {
+	return &UpdateCategoryService{ctx: ctx}
+}
+
+// Run 修改分类名称、图标、单位，只更新非空字段；
+// 重命名时同步刷新该分类下商品的详情缓存与搜索索引
+func (s *UpdateCategoryService) Run(req *product.UpdateCategoryRequest) (*product.UpdateCategoryResponse, error) {
+	if req.Id == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "category id is required")
+	}
+	category, err := getCategory(s.ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	renamed := false
+	if req.Name != "" {
+		name, err := checkCategoryName(s.ctx, req.Name, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if name != category.Name {
+			updates["name"] = name
+			renamed = true
+		}
+	}
+	if req.Icon != "" {
+		updates["icon"] = req.Icon
+	}
+	if req.Unit != "" {
+		updates["unit"] = req.Unit
+	}
+	if len(updates) == 0 {
+		return &product.UpdateCategoryResponse{Success: true}, nil
+	}
+
+	if err := model.UpdateCategory(s.ctx, mysql.DB, req.Id, updates); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "update category failed: "+err.Error())
+	}
+	invalidateCategoryTree(s.ctx)
+
+	if renamed {
+		spuIDs, err := model.ListSPUIDsByCategoryID(s.ctx, mysql.DB, req.Id)
+		if err != nil {
+			klog.Warnf("Failed to list products of category %d: %v", req.Id, err)
+		} else if len(spuIDs) > 0 {
+			invalidateProductCaches(s.ctx, spuIDs)
+			refreshSearchIndex(s.ctx, spuIDs)
+		}
+	}
+
+	return &product.UpdateCategoryResponse{Success: true}, nil
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/mysql"
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal/redis"
@@ -9,6 +11,7 @@ import (
 	"github.com/PiaoAdmin/pmall/common/errs"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
 	"github.com/cloudwego/kitex/pkg/klog"
+	"gorm.io/gorm"
 )
 
 type UpdateProductService struct {
@@ -23,6 +26,18 @@ func (s *UpdateProductService) Run(req *product.UpdateProductRequest) (*product.
 	if req.Spu == nil || req.Spu.Id == 0 {
 		return nil, errs.New(errs.ErrParam.Code, "spu id is required")
 	}
+	if err := checkSPUOwner(s.ctx, req.MerchantId, []uint64{req.Spu.Id}); err != nil {
+		return nil, err
+	}
+	if err := resolveMediaRefs(s.ctx, req.MerchantId, req.Spu, nil, req.Detail); err != nil {
+		return nil, err
+	}
+
+	// 修改前的快照用于计算本次变更的差异
+	before, err := loadProductSnapshot(s.ctx, req.Spu.Id)
+	if err != nil {
+		klog.Warnf("Failed to load product snapshot: %v", err)
+	}
 
 	spuUpdates := make(map[string]interface{})
 	if req.Spu.Name != "" {
@@ -38,11 +53,19 @@ func (s *UpdateProductService) Run(req *product.UpdateProductRequest) (*product.
 		spuUpdates["brand_id"] = req.Spu.BrandId
 	}
 	if req.Spu.CategoryId != 0 {
+		if err := s.checkSpecs(req.Spu.Id, req.Spu.CategoryId); err != nil {
+			return nil, err
+		}
 		spuUpdates["category_id"] = req.Spu.CategoryId
 	}
 	if req.Spu.ServiceBits != 0 {
 		spuUpdates["service_bits"] = req.Spu.ServiceBits
 	}
+	// 排序权重只影响展示顺序，其余字段变更都需要重新审核
+	needsAudit := len(spuUpdates) > 0
+	if req.Spu.Sort != 0 {
+		spuUpdates["sort"] = req.Spu.Sort
+	}
 
 	if len(spuUpdates) > 0 {
 		_, err := model.UpdateSPU(s.ctx, mysql.DB, req.Spu.Id, spuUpdates)
@@ -77,6 +100,13 @@ func (s *UpdateProductService) Run(req *product.UpdateProductRequest) (*product.
 			if err != nil {
 				return nil, errs.New(errs.ErrInternal.Code, "update product detail failed: "+err.Error())
 			}
+			needsAudit = true
+		}
+	}
+
+	if needsAudit {
+		if err := submitForAudit(s.ctx, []uint64{req.Spu.Id}); err != nil {
+			return nil, err
 		}
 	}
 
@@ -90,8 +120,46 @@ func (s *UpdateProductService) Run(req *product.UpdateProductRequest) (*product.
 			klog.Warnf("Failed to invalidate product list cache: %v", err)
 		}
 	}()
+	refreshSearchIndex(s.ctx, []uint64{req.Spu.Id})
+	if before != nil {
+		if _, err := recordProductChange(s.ctx, req.Spu.Id, req.OperatorId, model.HistoryActionUpdate, 0, before); err != nil {
+			klog.Warnf("Failed to record product history: %v", err)
+		}
+	}
 
 	return &product.UpdateProductResponse{
 		Success: true,
 	}, nil
 }
+
+// checkSpecs 商品换分类时，按新分类的规格模板校验已有 SKU
+func (s *UpdateProductService) checkSpecs(spuID, categoryID uint64) error {
+	spu, err := model.GetSPUByID(s.ctx, mysql.DB, spuID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.New(errs.ErrRecordNotFound.Code, "product not found")
+		}
+		return errs.New(errs.ErrInternal.Code, "get spu failed: "+err.Error())
+	}
+	if spu.CategoryID == categoryID {
+		return nil
+	}
+	template, err := loadSpecTemplate(s.ctx, categoryID)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "load spec template failed: "+err.Error())
+	}
+	if len(template) == 0 {
+		return nil
+	}
+	skus, err := model.GetSKUsBySpuID(s.ctx, mysql.DB, spuID)
+	if err != nil {
+		return errs.New(errs.ErrInternal.Code, "get skus failed: "+err.Error())
+	}
+	specs := make([]string, 0, len(skus))
+	for _, sku := range skus {
+		specs = append(specs, sku.SkuSpecData)
+	}
+	return validateSkuSpecs(template, specs, func(i int) string {
+		return fmt.Sprintf("sku %d", skus[i].ID)
+	})
+}
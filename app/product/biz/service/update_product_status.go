@@ -24,28 +24,23 @@ func (s *UpdateProductStatusService) Run(req *product.UpdateProductStatusRequest
 	if len(req.Ids) == 0 {
 		return nil, errs.New(errs.ErrParam.Code, "ids is empty")
 	}
-
-	updates := make(map[string]interface{})
-
-	if req.PublishStatus > 0 {
-		if !utils.IsValidPublishStatus(int8(req.PublishStatus)) {
-			return nil, errs.New(errs.ErrParam.Code, "invalid publish_status")
-		}
-		updates["publish_status"] = req.PublishStatus
-	}
-
-	if req.VerifyStatus > 0 {
-		if !utils.IsValidVerifyStatus(int8(req.VerifyStatus)) {
-			return nil, errs.New(errs.ErrParam.Code, "invalid verify_status")
-		}
-		updates["verify_status"] = req.VerifyStatus
+	// 审核状态只能通过 AuditProducts 审核通过/驳回变更，留下审核记录
+	if req.VerifyStatus != 0 {
+		return nil, errs.New(errs.ErrParam.Code, "verify_status can only be changed by audit")
 	}
-
-	if len(updates) == 0 {
+	if req.PublishStatus == 0 {
 		return &product.UpdateProductStatusResponse{
 			Success: true,
 		}, nil
 	}
+	if !utils.IsValidPublishStatus(int8(req.PublishStatus)) {
+		return nil, errs.New(errs.ErrParam.Code, "invalid publish_status")
+	}
+	if err := checkSPUOwner(s.ctx, req.MerchantId, req.Ids); err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{"publish_status": req.PublishStatus}
 
 	err := model.BatchUpdateStatus(s.ctx, mysql.DB, req.Ids, updates)
 	if err != nil {
@@ -66,6 +61,7 @@ func (s *UpdateProductStatusService) Run(req *product.UpdateProductStatusRequest
 			klog.Warnf("Failed to delete hot products cache: %v", err)
 		}
 	}()
+	refreshSearchIndex(s.ctx, req.Ids)
 
 	return &product.UpdateProductStatusResponse{
 		Success: true,
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/common/errs"
+	product "github.com/PiaoAdmin/pmall/rpc_gen/product"
+)
+
+// 上下架接口不能变更审核状态，参数校验失败时不访问数据库
+func TestUpdateProductStatusValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *product.UpdateProductStatusRequest
+	}{
+		{name: "ids 为空", req: &product.UpdateProductStatusRequest{PublishStatus: 1}},
+		{name: "审核通过", req: &product.UpdateProductStatusRequest{Ids: []uint64{1}, VerifyStatus: 1}},
+		{name: "上架并审核通过", req: &product.UpdateProductStatusRequest{Ids: []uint64{1}, PublishStatus: 1, VerifyStatus: 1}},
+		{name: "非法上架状态", req: &product.UpdateProductStatusRequest{Ids: []uint64{1}, PublishStatus: 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewUpdateProductStatusService(context.Background()).Run(tt.req)
+			if err == nil || errs.ConvertErr(err).Code != errs.ErrParam.Code {
+				t.Errorf("expected param error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package sheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+)
+
+func readCSV(data []byte, maxRows int) ([][]string, error) {
+	// Excel 另存为 CSV UTF-8 时会带 BOM
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+
+	var rows [][]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if maxRows > 0 && len(rows) >= maxRows {
+			return nil, tooManyRows(maxRows)
+		}
+		rows = append(rows, record)
+	}
+}
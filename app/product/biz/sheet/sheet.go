@@ -0,0 +1,48 @@
+// Package sheet 读取上传的 CSV / XLSX 表格，只读取第一个工作表的单元格文本，不计算公式。
+package sheet
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ParseFormat 解析表格格式，也接受文件名，按扩展名判断
+func ParseFormat(s string) (Format, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if ext := path.Ext(s); ext != "" {
+		s = ext[1:]
+	}
+	switch Format(s) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported sheet format %q", s)
+	}
+}
+
+// Read 读取全部行，maxRows 大于 0 时超过该行数返回错误。
+// 每行的单元格数可能不同，行尾的空单元格不保证存在
+func Read(f Format, data []byte, maxRows int) ([][]string, error) {
+	switch f {
+	case FormatCSV:
+		return readCSV(data, maxRows)
+	case FormatXLSX:
+		return readXLSX(data, maxRows)
+	default:
+		return nil, fmt.Errorf("unsupported sheet format %q", f)
+	}
+}
+
+func tooManyRows(maxRows int) error {
+	return fmt.Errorf("sheet has more than %d rows", maxRows)
+}
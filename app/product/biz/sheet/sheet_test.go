@@ -0,0 +1,109 @@
+package sheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParseFormat(t *testing.T) {
+	cases := map[string]Format{"csv": FormatCSV, "XLSX": FormatXLSX, "商品.xlsx": FormatXLSX, "products.CSV": FormatCSV}
+	for in, want := range cases {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Errorf("ParseFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "xls", "products.pdf"} {
+		if _, err := ParseFormat(in); err == nil {
+			t.Errorf("ParseFormat(%q) expected error", in)
+		}
+	}
+}
+
+func TestReadCSV(t *testing.T) {
+	data := []byte("\xEF\xBB\xBFname,price\n\"小米,14\",3999\nonly\n")
+	rows, err := Read(FormatCSV, data, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"name", "price"}, {"小米,14", "3999"}, {"only"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %q, want %q", rows, want)
+	}
+	if _, err := Read(FormatCSV, data, 2); err == nil {
+		t.Error("expected row limit error")
+	}
+}
+
+func buildXLSX(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadXLSX(t *testing.T) {
+	data := buildXLSX(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="商品" sheetId="1" r:id="rId3"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId3" Target="worksheets/products.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<si><t>name</t></si><si><t>price</t></si><si><r><t>小米</t></r><r><t>14</t></r></si></sst>`,
+		"xl/worksheets/products.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="D1" t="inlineStr"><is><t>note</t></is></c></row>` +
+			`<row r="3"><c r="A3" t="s"><v>2</v></c><c r="B3"><v>3999.5</v></c><c r="D3" t="inlineStr"><is><t>a&amp;b</t></is></c></row>` +
+			`<row r="4"><c r="B4" t="b"><v>1</v></c></row>` +
+			`</sheetData></worksheet>`,
+	})
+	rows, err := Read(FormatXLSX, data, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"name", "price", "", "note"}, nil, {"小米14", "3999.5", "", "a&b"}, {"", "TRUE"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("got %q, want %q", rows, want)
+	}
+	if _, err := Read(FormatXLSX, data, 3); err == nil {
+		t.Error("expected row limit error")
+	}
+	if _, err := Read(FormatXLSX, []byte("not a zip"), 0); err == nil {
+		t.Error("expected error for invalid file")
+	}
+
+	wide := buildXLSX(t, map[string]string{
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			`<row r="1"><c r="A1" t="inlineStr"><is><t>name</t></is></c></row>` +
+			`<row r="2"><c r="A2" t="inlineStr"><is><t>a</t></is></c><c r="XFD2" s="1"/></row>` +
+			`<row r="3"><c r="A3" t="inlineStr"><is><t>b</t></is></c><c r="C3"><v>1</v></c></row>` +
+			`</sheetData></worksheet>`,
+	})
+	if _, err := Read(FormatXLSX, wide, 0); err == nil {
+		t.Error("expected error for value outside header columns")
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	cases := map[string]int{"A1": 0, "Z3": 25, "AA10": 26, "AZ1": 51, "XFD1": 16383}
+	for ref, want := range cases {
+		if got, err := columnIndex(ref); err != nil || got != want {
+			t.Errorf("columnIndex(%q) = %d, %v; want %d", ref, got, err, want)
+		}
+	}
+	for _, ref := range []string{"XFE1", "ZZZZZZZZZZZZZZ1", "1"} {
+		if _, err := columnIndex(ref); err == nil {
+			t.Errorf("columnIndex(%q) expected error", ref)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package sheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// xlsx 是一组 XML 文件的 zip 包：workbook.xml 列出工作表，
+// 通过 workbook.xml.rels 找到第一个工作表的文件，文本单元格通常引用 sharedStrings.xml。
+
+const (
+	// maxXLSXColumns Excel 的最大列数（XFD）
+	maxXLSXColumns = 16384
+	// maxXLSXPartSize 单个 XML 文件解压后的最大字节数，防止小文件解压出超大内容
+	maxXLSXPartSize = 64 << 20
+)
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRels struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText 共享字符串与内联字符串：纯文本在 t 中，富文本分段在 r/t 中
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t *xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xlsxCell struct {
+	Ref    string    `xml:"r,attr"`
+	Type   string    `xml:"t,attr"`
+	Value  string    `xml:"v"`
+	Inline *xlsxText `xml:"is"`
+}
+
+func readXLSX(data []byte, maxRows int) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid xlsx file: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := readSharedStrings(files["xl/sharedStrings.xml"])
+	if err != nil {
+		return nil, err
+	}
+	sheetFile, ok := files[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("invalid xlsx file: missing %s", sheetPath)
+	}
+	rc, err := openZipPart(sheetFile)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return readSheetRows(io.LimitReader(rc, maxXLSXPartSize), shared, maxRows)
+}
+
+// openZipPart 打开 zip 中的文件，声明的解压大小超过 maxXLSXPartSize 时拒绝；
+// 实际解压内容超过声明大小时 archive/zip 会报错，读取方仍需用 io.LimitReader 限制读取量
+func openZipPart(f *zip.File) (io.ReadCloser, error) {
+	if f.UncompressedSize64 > maxXLSXPartSize {
+		return nil, fmt.Errorf("xlsx part %s is larger than %d MB", f.Name, maxXLSXPartSize>>20)
+	}
+	return f.Open()
+}
+
+func decodeZipXML(f *zip.File, v interface{}) error {
+	rc, err := openZipPart(f)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(io.LimitReader(rc, maxXLSXPartSize)).Decode(v)
+}
+
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	const fallback = "xl/worksheets/sheet1.xml"
+	wbFile, relsFile := files["xl/workbook.xml"], files["xl/_rels/workbook.xml.rels"]
+	if wbFile == nil || relsFile == nil {
+		return fallback, nil
+	}
+	var wb xlsxWorkbook
+	if err := decodeZipXML(wbFile, &wb); err != nil {
+		return "", fmt.Errorf("invalid xlsx workbook: %w", err)
+	}
+	var rels xlsxRels
+	if err := decodeZipXML(relsFile, &rels); err != nil {
+		return "", fmt.Errorf("invalid xlsx workbook rels: %w", err)
+	}
+	if len(wb.Sheets) == 0 {
+		return "", fmt.Errorf("xlsx file has no sheet")
+	}
+	for _, r := range rels.Relationships {
+		if r.ID != wb.Sheets[0].RID {
+			continue
+		}
+		if strings.HasPrefix(r.Target, "/") {
+			return strings.TrimPrefix(r.Target, "/"), nil
+		}
+		return path.Join("xl", r.Target), nil
+	}
+	return fallback, nil
+}
+
+func readSharedStrings(f *zip.File) ([]string, error) {
+	if f == nil {
+		return nil, nil
+	}
+	var sst struct {
+		Items []xlsxText `xml:"si"`
+	}
+	if err := decodeZipXML(f, &sst); err != nil {
+		return nil, fmt.Errorf("invalid xlsx shared strings: %w", err)
+	}
+	shared := make([]string, len(sst.Items))
+	for i := range sst.Items {
+		shared[i] = sst.Items[i].String()
+	}
+	return shared, nil
+}
+
+// readSheetRows 逐行解码工作表，按单元格引用放到对应列，跳过的空行补为空行以保持行号；
+// 第一行为表头，之后的行不能在表头之外的列填写内容，避免个别单元格撑大每一行
+func readSheetRows(r io.Reader, shared []string, maxRows int) ([][]string, error) {
+	dec := xml.NewDecoder(r)
+	var rows [][]string
+	headerWidth := maxXLSXColumns
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid xlsx sheet: %w", err)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+
+		var row struct {
+			Num   int        `xml:"r,attr"`
+			Cells []xlsxCell `xml:"c"`
+		}
+		if err := dec.DecodeElement(&row, &start); err != nil {
+			return nil, fmt.Errorf("invalid xlsx sheet: %w", err)
+		}
+		num := row.Num
+		if num <= len(rows) {
+			num = len(rows) + 1
+		}
+		if maxRows > 0 && num > maxRows {
+			return nil, tooManyRows(maxRows)
+		}
+		for len(rows) < num-1 {
+			rows = append(rows, nil)
+		}
+		if len(rows) > 0 {
+			headerWidth = len(rows[0])
+		}
+
+		var cells []string
+		for i, c := range row.Cells {
+			col := i
+			if c.Ref != "" {
+				if col, err = columnIndex(c.Ref); err != nil {
+					return nil, err
+				}
+			}
+			value, err := cellValue(&c, shared)
+			if err != nil {
+				return nil, err
+			}
+			if col >= headerWidth {
+				// 带格式的空单元格常出现在表头之外，直接忽略
+				if value == "" {
+					continue
+				}
+				return nil, fmt.Errorf("row %d column %d is outside the header columns", num, col+1)
+			}
+			for len(cells) < col {
+				cells = append(cells, "")
+			}
+			if col < len(cells) {
+				cells[col] = value
+			} else {
+				cells = append(cells, value)
+			}
+		}
+		rows = append(rows, cells)
+	}
+}
+
+func cellValue(c *xlsxCell, shared []string) (string, error) {
+	switch c.Type {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(c.Value))
+		if err != nil || i < 0 || i >= len(shared) {
+			return "", fmt.Errorf("invalid shared string index in cell %s", c.Ref)
+		}
+		return shared[i], nil
+	case "inlineStr":
+		if c.Inline == nil {
+			return "", nil
+		}
+		return c.Inline.String(), nil
+	case "b":
+		if c.Value == "1" {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	default:
+		return c.Value, nil
+	}
+}
+
+// columnIndex 单元格引用转为列号：A1 -> 0, Z3 -> 25, AA1 -> 26，超过 XFD 列返回错误
+func columnIndex(ref string) (int, error) {
+	col := 0
+	n := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+		n++
+		if col > maxXLSXColumns {
+			return 0, fmt.Errorf("cell reference %q exceeds column limit", ref)
+		}
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return col - 1, nil
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// gb2312LetterStarts GB2312 一级汉字按拼音排序，每个声母首字的编码作为区间起点，
+// 不存在以 I、U、V 开头的拼音
+var gb2312LetterStarts = []struct {
+	code   uint16
+	letter byte
+}{
+	{0xB0A1, 'A'}, {0xB0C5, 'B'}, {0xB2C1, 'C'}, {0xB4EE, 'D'}, {0xB6EA, 'E'},
+	{0xB7A2, 'F'}, {0xB8C1, 'G'}, {0xB9FE, 'H'}, {0xBBF7, 'J'}, {0xBFA6, 'K'},
+	{0xC0AC, 'L'}, {0xC2E8, 'M'}, {0xC4C3, 'N'}, {0xC5B6, 'O'}, {0xC5BE, 'P'},
+	{0xC6DA, 'Q'}, {0xC8BB, 'R'}, {0xC8F6, 'S'}, {0xCBFA, 'T'}, {0xCDDA, 'W'},
+	{0xCEF4, 'X'}, {0xD1B9, 'Y'}, {0xD4D1, 'Z'},
+}
+
+// gb2312Level1End GB2312 一级汉字的最后一个编码，二级汉字按部首排序，无法推算拼音
+const gb2312Level1End = 0xD7F9
+
+// FirstLetter 推算名称的首字母（大写），英文取首字母，常用汉字取拼音首字母，
+// 无法推算时（数字、符号、生僻字）返回 "#"
+func FirstLetter(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "#"
+	}
+	r := []rune(name)[0]
+	if r < unicode.MaxASCII {
+		if unicode.IsLetter(r) {
+			return string(unicode.ToUpper(r))
+		}
+		return "#"
+	}
+	if !unicode.Is(unicode.Han, r) {
+		return "#"
+	}
+
+	encoded, err := simplifiedchinese.GBK.NewEncoder().String(string(r))
+	if err != nil || len(encoded) != 2 {
+		return "#"
+	}
+	code := uint16(encoded[0])<<8 | uint16(encoded[1])
+	if code < gb2312LetterStarts[0].code || code > gb2312Level1End {
+		return "#"
+	}
+	letter := gb2312LetterStarts[0].letter
+	for _, start := range gb2312LetterStarts {
+		if code < start.code {
+			break
+		}
+		letter = start.letter
+	}
+	return string(letter)
+}
@@ -0,0 +1,28 @@
+package utils
+
+import "testing"
+
+func TestFirstLetter(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"小米", "X"},
+		{"红米", "H"},
+		{"米家", "M"},
+		{"华为", "H"},
+		{"荣耀", "R"},
+		{"啊", "A"},
+		{"座", "Z"},
+		{"apple", "A"},
+		{" Sony", "S"},
+		{"360", "#"},
+		{"亍", "#"}, // 二级汉字
+		{"", "#"},
+	}
+	for _, tt := range tests {
+		if got := FirstLetter(tt.name); got != tt.want {
+			t.Errorf("FirstLetter(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
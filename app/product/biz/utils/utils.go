@@ -27,6 +27,11 @@ func IsValidVerifyStatus(status int8) bool {
 	return status == VerifyStatusUnverified || status == VerifyStatusApproved || status == VerifyStatusNotApproved
 }
 
+// IsOnSale 已上架且审核通过，前台可见、可加购和下单
+func IsOnSale(publishStatus, verifyStatus int8) bool {
+	return publishStatus == PublishStatusPublished && verifyStatus == VerifyStatusApproved
+}
+
 // ==================== 商品服务Bit ====================
 const (
 	ServiceSevenDayReturn     int64 = 1 << iota // 第0位：7天无理由退货
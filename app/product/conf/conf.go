@@ -16,12 +16,16 @@ var (
 )
 
 type Config struct {
-	Env      string
-	Kitex    Kitex    `yaml:"kitex"`
-	MySQL    MySQL    `yaml:"mysql"`
-	Redis    Redis    `yaml:"redis"`
-	MongoDB  MongoDB  `yaml:"mongodb"`
-	Registry Registry `yaml:"registry"`
+	Env       string
+	Kitex     Kitex     `yaml:"kitex"`
+	MySQL     MySQL     `yaml:"mysql"`
+	Redis     Redis     `yaml:"redis"`
+	MongoDB   MongoDB   `yaml:"mongodb"`
+	Registry  Registry  `yaml:"registry"`
+	Search    Search    `yaml:"search"`
+	SearchLog SearchLog `yaml:"search_log"`
+	Schedule  Schedule  `yaml:"schedule"`
+	Notify    Notify    `yaml:"notify"`
 }
 
 type MySQL struct {
@@ -46,6 +50,32 @@ type Registry struct {
 	Password        string   `yaml:"password"`
 }
 
+// Search 商品全文检索配置，driver 为空时关闭索引，搜索走 SQL
+type Search struct {
+	Driver string `yaml:"driver"`
+	Path   string `yaml:"path"` // 索引目录，为空时使用内存索引
+}
+
+// SearchLog 搜索日志与搜索词统计配置
+type SearchLog struct {
+	Enabled         bool     `yaml:"enabled"`
+	IntervalMinutes int      `yaml:"interval_minutes"` // 日志汇总为小时/天统计的间隔
+	RetentionDays   int      `yaml:"retention_days"`   // 原始日志保留天数，按天统计不清理
+	BlockedWords    []string `yaml:"blocked_words"`    // 屏蔽词，包含任一屏蔽词的搜索词不记录也不展示
+}
+
+// Schedule 商品定时上下架/改价任务配置
+type Schedule struct {
+	Enabled         bool `yaml:"enabled"`
+	IntervalSeconds int  `yaml:"interval_seconds"` // 扫描到期任务的间隔
+}
+
+// Notify 降价提醒通知配置，webhook_url 为空时只记录日志
+type Notify struct {
+	WebhookURL     string `yaml:"webhook_url"`
+	TimeoutSeconds int    `yaml:"timeout_seconds"`
+}
+
 type Kitex struct {
 	Service       string `yaml:"service"`
 	Address       string `yaml:"address"`
@@ -93,3 +93,213 @@ func (s *ProductServiceImpl) GetHotProducts(ctx context.Context, req *product.Ge
 	resp, err = service.NewGetHotProductsService(ctx).Run(req)
 	return
 }
+
+// DeleteProduct implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteProduct(ctx context.Context, req *product.DeleteProductRequest) (resp *product.DeleteProductResponse, err error) {
+	resp, err = service.NewDeleteProductService(ctx).Run(req)
+	return
+}
+
+// RestoreProduct implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) RestoreProduct(ctx context.Context, req *product.RestoreProductRequest) (resp *product.RestoreProductResponse, err error) {
+	resp, err = service.NewRestoreProductService(ctx).Run(req)
+	return
+}
+
+// SuggestQueries implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SuggestQueries(ctx context.Context, req *product.SuggestQueriesRequest) (resp *product.SuggestQueriesResponse, err error) {
+	resp, err = service.NewSuggestQueriesService(ctx).Run(req)
+	return
+}
+
+// GetTrendingSearches implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetTrendingSearches(ctx context.Context, req *product.GetTrendingSearchesRequest) (resp *product.GetTrendingSearchesResponse, err error) {
+	resp, err = service.NewGetTrendingSearchesService(ctx).Run(req)
+	return
+}
+
+// GetSearchReport implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetSearchReport(ctx context.Context, req *product.GetSearchReportRequest) (resp *product.GetSearchReportResponse, err error) {
+	resp, err = service.NewGetSearchReportService(ctx).Run(req)
+	return
+}
+
+// CreateCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateCategory(ctx context.Context, req *product.CreateCategoryRequest) (resp *product.CreateCategoryResponse, err error) {
+	resp, err = service.NewCreateCategoryService(ctx).Run(req)
+	return
+}
+
+// UpdateCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateCategory(ctx context.Context, req *product.UpdateCategoryRequest) (resp *product.UpdateCategoryResponse, err error) {
+	resp, err = service.NewUpdateCategoryService(ctx).Run(req)
+	return
+}
+
+// SortCategories implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SortCategories(ctx context.Context, req *product.SortCategoriesRequest) (resp *product.SortCategoriesResponse, err error) {
+	resp, err = service.NewSortCategoriesService(ctx).Run(req)
+	return
+}
+
+// SetCategoryVisibility implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetCategoryVisibility(ctx context.Context, req *product.SetCategoryVisibilityRequest) (resp *product.SetCategoryVisibilityResponse, err error) {
+	resp, err = service.NewSetCategoryVisibilityService(ctx).Run(req)
+	return
+}
+
+// MoveCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) MoveCategory(ctx context.Context, req *product.MoveCategoryRequest) (resp *product.MoveCategoryResponse, err error) {
+	resp, err = service.NewMoveCategoryService(ctx).Run(req)
+	return
+}
+
+// DeleteCategory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteCategory(ctx context.Context, req *product.DeleteCategoryRequest) (resp *product.DeleteCategoryResponse, err error) {
+	resp, err = service.NewDeleteCategoryService(ctx).Run(req)
+	return
+}
+
+// CreateBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateBrand(ctx context.Context, req *product.CreateBrandRequest) (resp *product.CreateBrandResponse, err error) {
+	resp, err = service.NewCreateBrandService(ctx).Run(req)
+	return
+}
+
+// UpdateBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) UpdateBrand(ctx context.Context, req *product.UpdateBrandRequest) (resp *product.UpdateBrandResponse, err error) {
+	resp, err = service.NewUpdateBrandService(ctx).Run(req)
+	return
+}
+
+// DeleteBrand implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) DeleteBrand(ctx context.Context, req *product.DeleteBrandRequest) (resp *product.DeleteBrandResponse, err error) {
+	resp, err = service.NewDeleteBrandService(ctx).Run(req)
+	return
+}
+
+// SetBrandCategories implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SetBrandCategories(ctx context.Context, req *product.SetBrandCategoriesRequest) (resp *product.SetBrandCategoriesResponse, err error) {
+	resp, err = service.NewSetBrandCategoriesService(ctx).Run(req)
+	return
+}
+
+// GetSpecTemplate implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetSpecTemplate(ctx context.Context, req *product.GetSpecTemplateRequest) (resp *product.GetSpecTemplateResponse, err error) {
+	resp, err = service.NewGetSpecTemplateService(ctx).Run(req)
+	return
+}
+
+// SaveSpecTemplate implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SaveSpecTemplate(ctx context.Context, req *product.SaveSpecTemplateRequest) (resp *product.SaveSpecTemplateResponse, err error) {
+	resp, err = service.NewSaveSpecTemplateService(ctx).Run(req)
+	return
+}
+
+// AuditProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) AuditProducts(ctx context.Context, req *product.AuditProductsRequest) (resp *product.AuditProductsResponse, err error) {
+	resp, err = service.NewAuditProductsService(ctx).Run(req)
+	return
+}
+
+// ListAuditQueue implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListAuditQueue(ctx context.Context, req *product.ListAuditQueueRequest) (resp *product.ListAuditQueueResponse, err error) {
+	resp, err = service.NewListAuditQueueService(ctx).Run(req)
+	return
+}
+
+// ListProductAudits implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductAudits(ctx context.Context, req *product.ListProductAuditsRequest) (resp *product.ListProductAuditsResponse, err error) {
+	resp, err = service.NewListProductAuditsService(ctx).Run(req)
+	return
+}
+
+// ListProductHistory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductHistory(ctx context.Context, req *product.ListProductHistoryRequest) (resp *product.ListProductHistoryResponse, err error) {
+	resp, err = service.NewListProductHistoryService(ctx).Run(req)
+	return
+}
+
+// RollbackProduct implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) RollbackProduct(ctx context.Context, req *product.RollbackProductRequest) (resp *product.RollbackProductResponse, err error) {
+	resp, err = service.NewRollbackProductService(ctx).Run(req)
+	return
+}
+
+// CreateProductSchedule implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateProductSchedule(ctx context.Context, req *product.CreateProductScheduleRequest) (resp *product.CreateProductScheduleResponse, err error) {
+	resp, err = service.NewCreateProductScheduleService(ctx).Run(req)
+	return
+}
+
+// ListProductSchedules implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListProductSchedules(ctx context.Context, req *product.ListProductSchedulesRequest) (resp *product.ListProductSchedulesResponse, err error) {
+	resp, err = service.NewListProductSchedulesService(ctx).Run(req)
+	return
+}
+
+// CancelProductSchedule implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CancelProductSchedule(ctx context.Context, req *product.CancelProductScheduleRequest) (resp *product.CancelProductScheduleResponse, err error) {
+	resp, err = service.NewCancelProductScheduleService(ctx).Run(req)
+	return
+}
+
+// ImportProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ImportProducts(ctx context.Context, req *product.ImportProductsRequest) (resp *product.ImportProductsResponse, err error) {
+	resp, err = service.NewImportProductsService(ctx).Run(req)
+	return
+}
+
+// GetImportJob implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetImportJob(ctx context.Context, req *product.GetImportJobRequest) (resp *product.GetImportJobResponse, err error) {
+	resp, err = service.NewGetImportJobService(ctx).Run(req)
+	return
+}
+
+// ExportProducts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ExportProducts(ctx context.Context, req *product.ExportProductsRequest) (resp *product.ExportProductsResponse, err error) {
+	resp, err = service.NewExportProductsService(ctx).Run(req)
+	return
+}
+
+// CreateMediaAsset implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CreateMediaAsset(ctx context.Context, req *product.CreateMediaAssetRequest) (resp *product.CreateMediaAssetResponse, err error) {
+	resp, err = service.NewCreateMediaAssetService(ctx).Run(req)
+	return
+}
+
+// ListMediaAssets implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListMediaAssets(ctx context.Context, req *product.ListMediaAssetsRequest) (resp *product.ListMediaAssetsResponse, err error) {
+	resp, err = service.NewListMediaAssetsService(ctx).Run(req)
+	return
+}
+
+// GetMediaAssets implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) GetMediaAssets(ctx context.Context, req *product.GetMediaAssetsRequest) (resp *product.GetMediaAssetsResponse, err error) {
+	resp, err = service.NewGetMediaAssetsService(ctx).Run(req)
+	return
+}
+
+// ListPriceHistory implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListPriceHistory(ctx context.Context, req *product.ListPriceHistoryRequest) (resp *product.ListPriceHistoryResponse, err error) {
+	resp, err = service.NewListPriceHistoryService(ctx).Run(req)
+	return
+}
+
+// SubscribePriceAlert implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) SubscribePriceAlert(ctx context.Context, req *product.SubscribePriceAlertRequest) (resp *product.SubscribePriceAlertResponse, err error) {
+	resp, err = service.NewSubscribePriceAlertService(ctx).Run(req)
+	return
+}
+
+// CancelPriceAlert implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) CancelPriceAlert(ctx context.Context, req *product.CancelPriceAlertRequest) (resp *product.CancelPriceAlertResponse, err error) {
+	resp, err = service.NewCancelPriceAlertService(ctx).Run(req)
+	return
+}
+
+// ListPriceAlerts implements the ProductServiceImpl interface.
+func (s *ProductServiceImpl) ListPriceAlerts(ctx context.Context, req *product.ListPriceAlertsRequest) (resp *product.ListPriceAlertsResponse, err error) {
+	resp, err = service.NewListPriceAlertsService(ctx).Run(req)
+	return
+}
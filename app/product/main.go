@@ -8,6 +8,9 @@ import (
 	"os"
 
 	"github.com/PiaoAdmin/pmall/app/product/biz/dal"
+	"github.com/PiaoAdmin/pmall/app/product/biz/notify"
+	"github.com/PiaoAdmin/pmall/app/product/biz/rpc"
+	"github.com/PiaoAdmin/pmall/app/product/biz/search"
 	"github.com/PiaoAdmin/pmall/app/product/biz/service"
 	"github.com/PiaoAdmin/pmall/app/product/conf"
 	product "github.com/PiaoAdmin/pmall/rpc_gen/product/productservice"
@@ -20,6 +23,9 @@ import (
 
 func main() {
 	dal.Init()
+	search.Init()
+	rpc.Init()
+	notify.Init()
 	opts := kitexInit()
 
 	logFile, err := os.OpenFile(conf.GetConf().Kitex.LogFileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
@@ -37,6 +43,10 @@ func main() {
 	go func() {
 		ctx := context.Background()
 		warmupService := service.NewCacheWarmUpService(ctx)
+		// 构建商品布隆过滤器，构建完成前详情查询不做拦截
+		if err := warmupService.WarmUpProductBloom(); err != nil {
+			klog.Warnf("Product bloom filter warm-up failed: %v", err)
+		}
 		if err := warmupService.WarmUpHotProducts(); err != nil {
 			klog.Warnf("Hot products cache warm-up failed: %v", err)
 		}
@@ -44,16 +54,41 @@ func main() {
 		if err := warmupService.WarmUpTopProductDetails(20); err != nil {
 			klog.Warnf("Product details cache warm-up failed: %v", err)
 		}
+		// 从商品目录重建搜索联想词
+		if err := warmupService.WarmUpSuggestions(); err != nil {
+			klog.Warnf("Search suggestions warm-up failed: %v", err)
+		}
 		// 启动定时刷新任务
 		service.StartCacheRefreshTask(ctx)
 	}()
 
+	// 搜索日志汇总为搜索词统计
+	service.StartSearchStatsTask(context.Background())
+
+	// 定时上下架与改价
+	service.StartProductScheduleTask(context.Background())
+
+	// 补发投递失败的降价提醒
+	service.StartPriceAlertRetryTask(context.Background())
+
+	// 搜索索引为空时从数据库全量构建
+	go func() {
+		if err := service.NewSearchIndexService(context.Background()).RebuildIfEmpty(); err != nil {
+			klog.Warnf("Search index rebuild failed: %v", err)
+		}
+	}()
+
 	svr := product.NewServer(new(ProductServiceImpl), opts...)
 	err = svr.Run()
 
 	if err != nil {
 		log.Println(err.Error())
 	}
+	if search.Default != nil {
+		if err := search.Default.Close(); err != nil {
+			log.Println(err.Error())
+		}
+	}
 }
 
 func kitexInit() (opts []server.Option) {
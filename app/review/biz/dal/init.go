@@ -0,0 +1,9 @@
+package dal
+
+import (
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+)
+
+func Init() {
+	mysql.Init()
+}
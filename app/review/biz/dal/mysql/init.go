@@ -0,0 +1,22 @@
+package mysql
+
+import (
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/app/review/conf"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+var DB *gorm.DB
+
+func Init() {
+	dsn := conf.GetConf().MySQL.DSN
+	var err error
+	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+	if conf.GetEnv() == "test" {
+		DB.AutoMigrate(&model.Review{}, &model.RatingSummary{}, &model.Question{}, &model.Answer{}, &model.AnswerVote{})
+	}
+}
@@ -0,0 +1,279 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Question 商品问题，审核状态与评价共用
+type Question struct {
+	ID           uint64    `gorm:"primarykey"`
+	SpuID        uint64    `gorm:"not null;index:idx_spu_status,priority:1"`
+	UserID       uint64    `gorm:"not null;index"`
+	Content      string    `gorm:"type:varchar(1000);not null"`
+	Status       int32     `gorm:"type:tinyint;not null;default:0;index:idx_spu_status,priority:2;comment:0待审核 1已通过 2已驳回"`
+	RejectReason string    `gorm:"type:varchar(255);not null;default:''"`
+	AnswerCount  int64     `gorm:"not null;default:0;comment:已通过的回答数"`
+	CreatedAt    time.Time `gorm:"index"`
+	UpdatedAt    time.Time
+}
+
+func (Question) TableName() string {
+	return "qa_question"
+}
+
+// Answer 问题的回答
+type Answer struct {
+	ID           uint64    `gorm:"primarykey"`
+	QuestionID   uint64    `gorm:"not null;index:idx_question_status,priority:1"`
+	SpuID        uint64    `gorm:"not null;index"`
+	UserID       uint64    `gorm:"not null;index"`
+	Content      string    `gorm:"type:varchar(2000);not null"`
+	IsMerchant   bool      `gorm:"not null;default:false;comment:商家回答"`
+	Upvotes      int64     `gorm:"not null;default:0"`
+	Status       int32     `gorm:"type:tinyint;not null;default:0;index:idx_question_status,priority:2;comment:0待审核 1已通过 2已驳回"`
+	RejectReason string    `gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt    time.Time `gorm:"index"`
+	UpdatedAt    time.Time
+}
+
+func (Answer) TableName() string {
+	return "qa_answer"
+}
+
+// AnswerVote 回答点赞记录，每个用户对每条回答只能点赞一次
+type AnswerVote struct {
+	ID        uint64 `gorm:"primarykey"`
+	AnswerID  uint64 `gorm:"not null;uniqueIndex:uk_answer_user,priority:1"`
+	UserID    uint64 `gorm:"not null;uniqueIndex:uk_answer_user,priority:2"`
+	CreatedAt time.Time
+}
+
+func (AnswerVote) TableName() string {
+	return "qa_answer_vote"
+}
+
+// QuestionFilter 问题列表查询条件，零值表示不过滤
+type QuestionFilter struct {
+	SpuID  uint64
+	Status *int32
+}
+
+// AnswerFilter 回答列表查询条件，零值表示不过滤
+type AnswerFilter struct {
+	QuestionID uint64
+	Status     *int32
+}
+
+func GetQuestionByID(ctx context.Context, db *gorm.DB, id uint64) (*Question, error) {
+	var q Question
+	err := db.WithContext(ctx).First(&q, id).Error
+	return &q, err
+}
+
+func GetAnswerByID(ctx context.Context, db *gorm.DB, id uint64) (*Answer, error) {
+	var a Answer
+	err := db.WithContext(ctx).First(&a, id).Error
+	return &a, err
+}
+
+func CreateQuestion(ctx context.Context, db *gorm.DB, q *Question) error {
+	return db.WithContext(ctx).Create(q).Error
+}
+
+// CreateAnswer 创建回答，已通过的回答同时计入问题的回答数
+func CreateAnswer(ctx context.Context, db *gorm.DB, a *Answer) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(a).Error; err != nil {
+			return err
+		}
+		if a.Status == ReviewStatusApproved {
+			return addAnswerCount(tx, a.QuestionID, 1)
+		}
+		return nil
+	})
+}
+
+// ListQuestions 按创建时间倒序分页查询问题
+func ListQuestions(ctx context.Context, db *gorm.DB, filter QuestionFilter, page, pageSize int) ([]*Question, int64, error) {
+	query := db.WithContext(ctx).Model(&Question{})
+	if filter.SpuID > 0 {
+		query = query.Where("spu_id = ?", filter.SpuID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*Question
+	err := query.Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&list).Error
+	return list, total, err
+}
+
+// ListAnswers 分页查询回答，前台按商家回答、点赞数优先，后台按创建时间倒序
+func ListAnswers(ctx context.Context, db *gorm.DB, filter AnswerFilter, byHelpful bool, page, pageSize int) ([]*Answer, int64, error) {
+	query := db.WithContext(ctx).Model(&Answer{})
+	if filter.QuestionID > 0 {
+		query = query.Where("question_id = ?", filter.QuestionID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	order := "created_at DESC, id DESC"
+	if byHelpful {
+		order = "is_merchant DESC, upvotes DESC, id ASC"
+	}
+	var list []*Answer
+	err := query.Order(order).
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&list).Error
+	return list, total, err
+}
+
+// ListTopAnswers 批量获取每个问题最有用的前 limit 条已通过回答
+func ListTopAnswers(ctx context.Context, db *gorm.DB, questionIDs []uint64, limit int) (map[uint64][]*Answer, error) {
+	result := make(map[uint64][]*Answer, len(questionIDs))
+	if len(questionIDs) == 0 {
+		return result, nil
+	}
+	var list []*Answer
+	err := db.WithContext(ctx).Raw(`
+		SELECT * FROM (
+			SELECT a.*, ROW_NUMBER() OVER (
+				PARTITION BY question_id ORDER BY is_merchant DESC, upvotes DESC, id ASC
+			) AS rn
+			FROM qa_answer a
+			WHERE question_id IN ? AND status = ?
+		) t WHERE rn <= ?`,
+		questionIDs, ReviewStatusApproved, limit,
+	).Scan(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range list {
+		result[a.QuestionID] = append(result[a.QuestionID], a)
+	}
+	return result, nil
+}
+
+// ListUpvotedAnswerIDs 返回用户已点赞的回答
+func ListUpvotedAnswerIDs(ctx context.Context, db *gorm.DB, userID uint64, answerIDs []uint64) (map[uint64]bool, error) {
+	voted := make(map[uint64]bool)
+	if userID == 0 || len(answerIDs) == 0 {
+		return voted, nil
+	}
+	var ids []uint64
+	err := db.WithContext(ctx).Model(&AnswerVote{}).
+		Where("user_id = ? AND answer_id IN ?", userID, answerIDs).
+		Pluck("answer_id", &ids).Error
+	for _, id := range ids {
+		voted[id] = true
+	}
+	return voted, err
+}
+
+// UpvoteAnswer 点赞或取消点赞，重复操作不重复计数，返回最新点赞数
+func UpvoteAnswer(ctx context.Context, db *gorm.DB, answerID, userID uint64, cancel bool) (int64, error) {
+	var upvotes int64
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var result *gorm.DB
+		delta := 1
+		if cancel {
+			delta = -1
+			result = tx.Where("answer_id = ? AND user_id = ?", answerID, userID).Delete(&AnswerVote{})
+		} else {
+			result = tx.Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&AnswerVote{AnswerID: answerID, UserID: userID})
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			if err := tx.Model(&Answer{}).Where("id = ?", answerID).
+				UpdateColumn("upvotes", gorm.Expr("upvotes + ?", delta)).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&Answer{}).Where("id = ?", answerID).Pluck("upvotes", &upvotes).Error
+	})
+	return upvotes, err
+}
+
+// ModerateQuestions 批量修改问题审核状态，返回状态实际发生变化的问题
+func ModerateQuestions(ctx context.Context, db *gorm.DB, ids []uint64, status int32, rejectReason string) ([]*Question, error) {
+	var list []*Question
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND status <> ?", ids, status).
+			Find(&list).Error; err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		changed := make([]uint64, 0, len(list))
+		for _, q := range list {
+			changed = append(changed, q.ID)
+		}
+		return tx.Model(&Question{}).Where("id IN ?", changed).Updates(map[string]interface{}{
+			"status":        status,
+			"reject_reason": rejectReason,
+		}).Error
+	})
+	return list, err
+}
+
+// ModerateAnswers 批量修改回答审核状态，状态进入或离开"已通过"时同步调整问题的回答数，
+// 返回状态实际发生变化的回答
+func ModerateAnswers(ctx context.Context, db *gorm.DB, ids []uint64, status int32, rejectReason string) ([]*Answer, error) {
+	var list []*Answer
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND status <> ?", ids, status).
+			Find(&list).Error; err != nil {
+			return err
+		}
+		for _, a := range list {
+			delta := 0
+			if a.Status == ReviewStatusApproved {
+				delta--
+			}
+			if status == ReviewStatusApproved {
+				delta++
+			}
+			if delta != 0 {
+				if err := addAnswerCount(tx, a.QuestionID, delta); err != nil {
+					return err
+				}
+			}
+			if err := tx.Model(a).Updates(map[string]interface{}{
+				"status":        status,
+				"reject_reason": rejectReason,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return list, err
+}
+
+func addAnswerCount(tx *gorm.DB, questionID uint64, delta int) error {
+	return tx.Model(&Question{}).Where("id = ?", questionID).
+		UpdateColumn("answer_count", gorm.Expr("answer_count + ?", delta)).Error
+}
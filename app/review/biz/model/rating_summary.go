@@ -0,0 +1,67 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RatingSummary 商品评分汇总，只统计已通过的评价
+type RatingSummary struct {
+	SpuID       uint64 `gorm:"primarykey;autoIncrement:false"`
+	ReviewCount int64  `gorm:"not null;default:0"`
+	RatingSum   int64  `gorm:"not null;default:0;comment:星级之和"`
+	Rating1     int64  `gorm:"column:rating_1;not null;default:0"`
+	Rating2     int64  `gorm:"column:rating_2;not null;default:0"`
+	Rating3     int64  `gorm:"column:rating_3;not null;default:0"`
+	Rating4     int64  `gorm:"column:rating_4;not null;default:0"`
+	Rating5     int64  `gorm:"column:rating_5;not null;default:0"`
+	UpdatedAt   time.Time
+}
+
+func (RatingSummary) TableName() string {
+	return "review_rating_summary"
+}
+
+// Average 平均星级，保留一位小数
+func (s *RatingSummary) Average() float64 {
+	if s.ReviewCount <= 0 {
+		return 0
+	}
+	return math.Round(float64(s.RatingSum)/float64(s.ReviewCount)*10) / 10
+}
+
+// Counts 1-5 星各自的评价数
+func (s *RatingSummary) Counts() []int64 {
+	return []int64{s.Rating1, s.Rating2, s.Rating3, s.Rating4, s.Rating5}
+}
+
+func GetRatingSummaries(ctx context.Context, db *gorm.DB, spuIDs []uint64) ([]*RatingSummary, error) {
+	var list []*RatingSummary
+	err := db.WithContext(ctx).Where("spu_id IN ?", spuIDs).Find(&list).Error
+	return list, err
+}
+
+// applyRating 将一条评价计入 (delta=1) 或移出 (delta=-1) 评分汇总，rating 需已校验在 1-5
+func applyRating(tx *gorm.DB, spuID uint64, rating int32, delta int) error {
+	column := fmt.Sprintf("rating_%d", rating)
+	summary := map[string]interface{}{
+		"spu_id":       spuID,
+		"review_count": max(delta, 0),
+		"rating_sum":   max(delta, 0) * int(rating),
+		column:         max(delta, 0),
+		"updated_at":   time.Now(),
+	}
+	return tx.Model(&RatingSummary{}).Clauses(clause.OnConflict{
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "review_count"}, Value: gorm.Expr("review_count + ?", delta)},
+			{Column: clause.Column{Name: "rating_sum"}, Value: gorm.Expr("rating_sum + ?", delta*int(rating))},
+			{Column: clause.Column{Name: column}, Value: gorm.Expr(column+" + ?", delta)},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("VALUES(updated_at)")},
+		},
+	}).Create(summary).Error
+}
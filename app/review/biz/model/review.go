@@ -0,0 +1,168 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 评价审核状态
+const (
+	ReviewStatusPending  int32 = 0
+	ReviewStatusApproved int32 = 1
+	ReviewStatusRejected int32 = 2
+)
+
+// Review 商品评价，同一订单的同一 SKU 只能评价一次
+type Review struct {
+	ID           uint64    `gorm:"primarykey"`
+	SpuID        uint64    `gorm:"not null;index:idx_spu_status,priority:1"`
+	SkuID        uint64    `gorm:"not null;uniqueIndex:uk_order_sku,priority:2"`
+	UserID       uint64    `gorm:"not null;index"`
+	OrderID      string    `gorm:"type:varchar(64);not null;uniqueIndex:uk_order_sku,priority:1"`
+	SkuName      string    `gorm:"type:varchar(255);not null;default:''"`
+	Rating       int32     `gorm:"type:tinyint;not null;comment:星级1-5"`
+	Content      string    `gorm:"type:varchar(4000);not null;default:''"`
+	Images       string    `gorm:"type:text;comment:图片URL JSON数组"`
+	Tags         string    `gorm:"type:varchar(512);not null;default:'';comment:标签JSON数组"`
+	HasImages    bool      `gorm:"not null;default:false"`
+	Status       int32     `gorm:"type:tinyint;not null;default:0;index:idx_spu_status,priority:2;comment:0待审核 1已通过 2已驳回"`
+	RejectReason string    `gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt    time.Time `gorm:"index"`
+	UpdatedAt    time.Time
+}
+
+func (Review) TableName() string {
+	return "review"
+}
+
+// SetImages 写入图片列表，同时维护有图标记
+func (r *Review) SetImages(images []string) {
+	r.Images = marshalStrings(images)
+	r.HasImages = len(images) > 0
+}
+
+func (r *Review) GetImages() []string {
+	return unmarshalStrings(r.Images)
+}
+
+func (r *Review) SetTags(tags []string) {
+	r.Tags = marshalStrings(tags)
+}
+
+func (r *Review) GetTags() []string {
+	return unmarshalStrings(r.Tags)
+}
+
+func marshalStrings(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(list)
+	return string(data)
+}
+
+func unmarshalStrings(s string) []string {
+	if s == "" {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal([]byte(s), &list); err != nil {
+		return nil
+	}
+	return list
+}
+
+// ReviewFilter 评价列表查询条件，零值表示不过滤
+type ReviewFilter struct {
+	SpuID      uint64
+	Status     *int32
+	Rating     int32
+	WithImages bool
+}
+
+func ExistsReview(ctx context.Context, db *gorm.DB, orderID string, skuID uint64) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).Model(&Review{}).
+		Where("order_id = ? AND sku_id = ?", orderID, skuID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateReview 创建评价，已通过的评价同时计入评分汇总
+func CreateReview(ctx context.Context, db *gorm.DB, review *Review) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(review).Error; err != nil {
+			return err
+		}
+		if review.Status == ReviewStatusApproved {
+			return applyRating(tx, review.SpuID, review.Rating, 1)
+		}
+		return nil
+	})
+}
+
+// ListReviews 按创建时间倒序分页查询评价
+func ListReviews(ctx context.Context, db *gorm.DB, filter ReviewFilter, page, pageSize int) ([]*Review, int64, error) {
+	query := db.WithContext(ctx).Model(&Review{})
+	if filter.SpuID > 0 {
+		query = query.Where("spu_id = ?", filter.SpuID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.Rating > 0 {
+		query = query.Where("rating = ?", filter.Rating)
+	}
+	if filter.WithImages {
+		query = query.Where("has_images = ?", true)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var list []*Review
+	err := query.Order("created_at DESC, id DESC").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Find(&list).Error
+	return list, total, err
+}
+
+// ModerateReviews 批量修改审核状态，状态进入或离开"已通过"时同步调整评分汇总
+func ModerateReviews(ctx context.Context, db *gorm.DB, ids []uint64, status int32, rejectReason string) (int64, error) {
+	var affected int64
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var list []*Review
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND status <> ?", ids, status).
+			Find(&list).Error; err != nil {
+			return err
+		}
+		for _, r := range list {
+			if r.Status == ReviewStatusApproved {
+				if err := applyRating(tx, r.SpuID, r.Rating, -1); err != nil {
+					return err
+				}
+			}
+			if status == ReviewStatusApproved {
+				if err := applyRating(tx, r.SpuID, r.Rating, 1); err != nil {
+					return err
+				}
+			}
+			if err := tx.Model(r).Updates(map[string]interface{}{
+				"status":        status,
+				"reject_reason": rejectReason,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		affected = int64(len(list))
+		return nil
+	})
+	return affected, err
+}
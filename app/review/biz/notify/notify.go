@@ -0,0 +1,59 @@
+// Package notify 问答通知。问题、回答审核通过后发布事件，
+// 钩子的注册与投递见 common/notify。
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/app/review/conf"
+	"github.com/PiaoAdmin/pmall/common/notify"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// 事件类型
+const (
+	EventQuestionPublished = "question.published" // 问题审核通过，可通知商家与购买过的用户来回答
+	EventAnswerPublished   = "answer.published"   // 回答审核通过，通知提问者
+)
+
+// Event 问答事件
+type Event struct {
+	Type        string    `json:"type"`
+	SpuID       uint64    `json:"spu_id"`
+	QuestionID  uint64    `json:"question_id"`
+	AnswerID    uint64    `json:"answer_id,omitempty"`
+	RecipientID uint64    `json:"recipient_id,omitempty"` // 需要通知的用户，0 表示面向商家/潜在回答者
+	ActorID     uint64    `json:"actor_id"`               // 提问或回答的用户
+	IsMerchant  bool      `json:"is_merchant,omitempty"`
+	Content     string    `json:"content"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+var notifier notify.Notifier[*Event]
+
+// Init 根据配置注册默认钩子
+func Init() {
+	cfg := conf.GetConf().Notify
+	Register(notify.DefaultHook(cfg.WebhookURL, cfg.TimeoutSeconds, logHook))
+}
+
+// Register 注册钩子
+func Register(h notify.Hook[*Event]) {
+	notifier.Register(h)
+}
+
+// Publish 异步执行全部钩子，不阻塞调用方，投递失败只记录日志
+func Publish(ctx context.Context, e *Event) {
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	notifier.Publish(ctx, e, func(err error) {
+		klog.Warnf("Failed to deliver %s event for question %d: %v", e.Type, e.QuestionID, err)
+	})
+}
+
+func logHook(_ context.Context, e *Event) error {
+	klog.Infof("QA event %s: spu=%d question=%d answer=%d recipient=%d", e.Type, e.SpuID, e.QuestionID, e.AnswerID, e.RecipientID)
+	return nil
+}
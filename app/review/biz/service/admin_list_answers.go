@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type AdminListAnswersService struct {
+	ctx context.Context
+}
+
+func NewAdminListAnswersService(ctx context.Context) *AdminListAnswersService {
+	return &AdminListAnswersService{ctx: ctx}
+}
+
+func (s *AdminListAnswersService) Run(req *review.AdminListAnswersRequest) (*review.AdminListAnswersResponse, error) {
+	if req.Status < -1 || req.Status > model.ReviewStatusRejected {
+		return nil, errs.New(errs.ErrParam.Code, "invalid status")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	filter := model.AnswerFilter{QuestionID: req.QuestionId}
+	if req.Status >= 0 {
+		status := req.Status
+		filter.Status = &status
+	}
+	answers, total, err := model.ListAnswers(s.ctx, mysql.DB, filter, false, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list answers failed: "+err.Error())
+	}
+
+	return &review.AdminListAnswersResponse{List: toProtoAnswers(answers, nil), Total: total}, nil
+}
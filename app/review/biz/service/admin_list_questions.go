@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type AdminListQuestionsService struct {
+	ctx context.Context
+}
+
+func NewAdminListQuestionsService(ctx context.Context) *AdminListQuestionsService {
+	return &AdminListQuestionsService{ctx: ctx}
+}
+
+func (s *AdminListQuestionsService) Run(req *review.AdminListQuestionsRequest) (*review.AdminListQuestionsResponse, error) {
+	if req.Status < -1 || req.Status > model.ReviewStatusRejected {
+		return nil, errs.New(errs.ErrParam.Code, "invalid status")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	filter := model.QuestionFilter{SpuID: req.SpuId}
+	if req.Status >= 0 {
+		status := req.Status
+		filter.Status = &status
+	}
+	questions, total, err := model.ListQuestions(s.ctx, mysql.DB, filter, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list questions failed: "+err.Error())
+	}
+
+	list := make([]*review.Question, 0, len(questions))
+	for _, q := range questions {
+		list = append(list, toProtoQuestion(q))
+	}
+	return &review.AdminListQuestionsResponse{List: list, Total: total}, nil
+}
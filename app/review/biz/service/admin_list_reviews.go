@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type AdminListReviewsService struct {
+	ctx context.Context
+}
+
+func NewAdminListReviewsService(ctx context.Context) *AdminListReviewsService {
+	return &AdminListReviewsService{ctx: ctx}
+}
+
+func (s *AdminListReviewsService) Run(req *review.AdminListReviewsRequest) (*review.AdminListReviewsResponse, error) {
+	if req.Status < -1 || req.Status > model.ReviewStatusRejected {
+		return nil, errs.New(errs.ErrParam.Code, "invalid status")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	filter := model.ReviewFilter{SpuID: req.SpuId}
+	if req.Status >= 0 {
+		status := req.Status
+		filter.Status = &status
+	}
+	list, total, err := model.ListReviews(s.ctx, mysql.DB, filter, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list reviews failed: "+err.Error())
+	}
+
+	return &review.AdminListReviewsResponse{List: toProtoReviews(list), Total: total}, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+func toProtoReview(r *model.Review) *review.Review {
+	return &review.Review{
+		Id:           r.ID,
+		SpuId:        r.SpuID,
+		SkuId:        r.SkuID,
+		UserId:       r.UserID,
+		OrderId:      r.OrderID,
+		Rating:       r.Rating,
+		Content:      r.Content,
+		Images:       r.GetImages(),
+		Tags:         r.GetTags(),
+		Status:       r.Status,
+		RejectReason: r.RejectReason,
+		SkuName:      r.SkuName,
+		CreatedAt:    r.CreatedAt.Unix(),
+	}
+}
+
+func toProtoReviews(list []*model.Review) []*review.Review {
+	resp := make([]*review.Review, 0, len(list))
+	for _, r := range list {
+		resp = append(resp, toProtoReview(r))
+	}
+	return resp
+}
+
+func normalizePage(page, pageSize int32) (int, int) {
+	p, ps := int(page), int(pageSize)
+	if p <= 0 {
+		p = 1
+	}
+	if ps <= 0 {
+		ps = 20
+	}
+	if ps > 100 {
+		ps = 100 // 限制最大页面大小
+	}
+	return p, ps
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/app/review/biz/notify"
+	"github.com/PiaoAdmin/pmall/app/review/conf"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"gorm.io/gorm"
+)
+
+type CreateAnswerService struct {
+	ctx context.Context
+}
+
+func NewCreateAnswerService(ctx context.Context) *CreateAnswerService {
+	return &CreateAnswerService{ctx: ctx}
+}
+
+func (s *CreateAnswerService) Run(req *review.CreateAnswerRequest) (*review.CreateAnswerResponse, error) {
+	if req.UserId == 0 || req.QuestionId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user_id and question_id are required")
+	}
+	content, err := normalizeQAContent(req.Content, maxAnswerLen)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := model.GetQuestionByID(s.ctx, mysql.DB, req.QuestionId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "question not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get question failed: "+err.Error())
+	}
+	if q.Status != model.ReviewStatusApproved {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "question not found")
+	}
+
+	// 平台运营和商品所属商家可直接回答，其他用户需购买过该商品
+	isMerchant := req.IsMerchant
+	if !isMerchant && req.MerchantId != 0 {
+		owned, err := ownsSpu(s.ctx, req.MerchantId, q.SpuID)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "check product owner failed: "+err.Error())
+		}
+		isMerchant = owned
+	}
+	if !isMerchant {
+		purchased, err := hasPurchasedSpu(s.ctx, req.UserId, q.SpuID)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, "check purchase failed: "+err.Error())
+		}
+		if !purchased {
+			return nil, errs.New(errs.ErrPermission.Code, "only merchants or buyers of this product can answer")
+		}
+	}
+
+	status := model.ReviewStatusPending
+	if conf.GetConf().Review.AutoApprove {
+		status = model.ReviewStatusApproved
+	}
+	a := &model.Answer{
+		QuestionID: q.ID,
+		SpuID:      q.SpuID,
+		UserID:     req.UserId,
+		Content:    content,
+		IsMerchant: isMerchant,
+		Status:     status,
+	}
+	if err := model.CreateAnswer(s.ctx, mysql.DB, a); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create answer failed: "+err.Error())
+	}
+	if a.Status == model.ReviewStatusApproved {
+		publishAnswer(s.ctx, a, q.UserID)
+	}
+
+	return &review.CreateAnswerResponse{AnswerId: a.ID, Status: a.Status}, nil
+}
+
+// publishAnswer 回答可见后通知提问者，提问者自己回答时不通知
+func publishAnswer(ctx context.Context, a *model.Answer, askerID uint64) {
+	if askerID == a.UserID {
+		return
+	}
+	notify.Publish(ctx, &notify.Event{
+		Type:        notify.EventAnswerPublished,
+		SpuID:       a.SpuID,
+		QuestionID:  a.QuestionID,
+		AnswerID:    a.ID,
+		RecipientID: askerID,
+		ActorID:     a.UserID,
+		IsMerchant:  a.IsMerchant,
+		Content:     a.Content,
+	})
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/app/review/biz/notify"
+	"github.com/PiaoAdmin/pmall/app/review/biz/rpc"
+	"github.com/PiaoAdmin/pmall/app/review/conf"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type CreateQuestionService struct {
+	ctx context.Context
+}
+
+func NewCreateQuestionService(ctx context.Context) *CreateQuestionService {
+	return &CreateQuestionService{ctx: ctx}
+}
+
+func (s *CreateQuestionService) Run(req *review.CreateQuestionRequest) (*review.CreateQuestionResponse, error) {
+	if req.UserId == 0 || req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user_id and spu_id are required")
+	}
+	content, err := normalizeQAContent(req.Content, maxQuestionLen)
+	if err != nil {
+		return nil, err
+	}
+
+	spuResp, err := rpc.ProductClient.GetProductsByIds(s.ctx, &product.GetProductsByIdsRequest{Ids: []uint64{req.SpuId}})
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get product failed: "+err.Error())
+	}
+	if _, ok := spuResp.Products[req.SpuId]; !ok {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "product not found")
+	}
+
+	status := model.ReviewStatusPending
+	if conf.GetConf().Review.AutoApprove {
+		status = model.ReviewStatusApproved
+	}
+	q := &model.Question{
+		SpuID:   req.SpuId,
+		UserID:  req.UserId,
+		Content: content,
+		Status:  status,
+	}
+	if err := model.CreateQuestion(s.ctx, mysql.DB, q); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "create question failed: "+err.Error())
+	}
+	if q.Status == model.ReviewStatusApproved {
+		publishQuestion(s.ctx, q)
+	}
+
+	return &review.CreateQuestionResponse{QuestionId: q.ID, Status: q.Status}, nil
+}
+
+// publishQuestion 问题可见后通知潜在回答者
+func publishQuestion(ctx context.Context, q *model.Question) {
+	notify.Publish(ctx, &notify.Event{
+		Type:       notify.EventQuestionPublished,
+		SpuID:      q.SpuID,
+		QuestionID: q.ID,
+		ActorID:    q.UserID,
+		Content:    q.Content,
+	})
+}
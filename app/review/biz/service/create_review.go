@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/app/review/biz/rpc"
+	"github.com/PiaoAdmin/pmall/app/review/conf"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/common/orderstate"
+	"github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"gorm.io/gorm"
+)
+
+const (
+	maxReviewContentLen = 1000
+	maxReviewImages     = 9
+	maxReviewTags       = 5
+	maxReviewTagLen     = 16
+)
+
+// reviewableOrderStatus 可评价的订单状态，订单服务没有完成态，已支付即可评价
+var reviewableOrderStatus = map[string]bool{
+	orderstate.Paid: true,
+}
+
+type CreateReviewService struct {
+	ctx context.Context
+}
+
+func NewCreateReviewService(ctx context.Context) *CreateReviewService {
+	return &CreateReviewService{ctx: ctx}
+}
+
+func (s *CreateReviewService) Run(req *review.CreateReviewRequest) (*review.CreateReviewResponse, error) {
+	if req.UserId == 0 || req.OrderId == "" || req.SkuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user_id, order_id and sku_id are required")
+	}
+	content, images, tags, err := normalizeReviewInput(req)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := model.ExistsReview(s.ctx, mysql.DB, req.OrderId, req.SkuId)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "check review failed: "+err.Error())
+	}
+	if exists {
+		return nil, errs.New(errs.ErrRecordAlreadyEx.Code, "sku already reviewed in this order")
+	}
+
+	item, err := s.findPurchasedItem(req.UserId, req.OrderId, req.SkuId)
+	if err != nil {
+		return nil, err
+	}
+
+	skuResp, err := rpc.ProductClient.GetSkusByIds(s.ctx, &product.GetSkusByIdsRequest{SkuIds: []uint64{req.SkuId}})
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get sku failed: "+err.Error())
+	}
+	sku, ok := skuResp.Skus[req.SkuId]
+	if !ok {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "sku not found")
+	}
+
+	status := model.ReviewStatusPending
+	if conf.GetConf().Review.AutoApprove {
+		status = model.ReviewStatusApproved
+	}
+	r := &model.Review{
+		SpuID:   sku.SpuId,
+		SkuID:   req.SkuId,
+		UserID:  req.UserId,
+		OrderID: req.OrderId,
+		SkuName: item.SkuName,
+		Rating:  req.Rating,
+		Content: content,
+		Status:  status,
+	}
+	r.SetImages(images)
+	r.SetTags(tags)
+	if err := model.CreateReview(s.ctx, mysql.DB, r); err != nil {
+		if err == gorm.ErrDuplicatedKey || strings.Contains(err.Error(), "Duplicate entry") {
+			return nil, errs.New(errs.ErrRecordAlreadyEx.Code, "sku already reviewed in this order")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "create review failed: "+err.Error())
+	}
+
+	return &review.CreateReviewResponse{ReviewId: r.ID, Status: r.Status}, nil
+}
+
+// findPurchasedItem 校验订单属于该用户、状态可评价且包含该 SKU
+func (s *CreateReviewService) findPurchasedItem(userID uint64, orderID string, skuID uint64) (*order.CartItem, error) {
+	resp, err := rpc.OrderClient.GetOrder(s.ctx, &order.GetOrderReq{UserId: userID, OrderId: orderID})
+	if err != nil {
+		return nil, errs.ConvertErr(err)
+	}
+	if resp.Order == nil {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "order not found")
+	}
+	if !reviewableOrderStatus[resp.Order.Status] {
+		return nil, errs.New(errs.ErrPermission.Code, "order is not paid")
+	}
+	for _, item := range resp.Order.Items {
+		if item.SkuId == skuID {
+			return item, nil
+		}
+	}
+	return nil, errs.New(errs.ErrPermission.Code, "sku not purchased in this order")
+}
+
+// normalizeReviewInput 校验星级并清洗内容、图片与标签
+func normalizeReviewInput(req *review.CreateReviewRequest) (string, []string, []string, error) {
+	if req.Rating < 1 || req.Rating > 5 {
+		return "", nil, nil, errs.New(errs.ErrParam.Code, "rating must be between 1 and 5")
+	}
+	content := strings.TrimSpace(req.Content)
+	if utf8.RuneCountInString(content) > maxReviewContentLen {
+		return "", nil, nil, errs.New(errs.ErrParam.Code, "content too long")
+	}
+
+	images := make([]string, 0, len(req.Images))
+	for _, img := range req.Images {
+		if img = strings.TrimSpace(img); img != "" {
+			images = append(images, img)
+		}
+	}
+	if len(images) > maxReviewImages {
+		return "", nil, nil, errs.New(errs.ErrParam.Code, "too many images")
+	}
+
+	seen := make(map[string]bool, len(req.Tags))
+	tags := make([]string, 0, len(req.Tags))
+	for _, tag := range req.Tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxReviewTagLen {
+			return "", nil, nil, errs.New(errs.ErrParam.Code, "tag too long")
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxReviewTags {
+		return "", nil, nil, errs.New(errs.ErrParam.Code, "too many tags")
+	}
+	return content, images, tags, nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+func TestNormalizeReviewInput(t *testing.T) {
+	content, images, tags, err := normalizeReviewInput(&review.CreateReviewRequest{
+		Rating:  5,
+		Content: "  很好用  ",
+		Images:  []string{"a.jpg", " ", "b.jpg"},
+		Tags:    []string{"物流快", " 物流快 ", "", "质量好"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "很好用" {
+		t.Errorf("unexpected content: %q", content)
+	}
+	if len(images) != 2 {
+		t.Errorf("unexpected images: %v", images)
+	}
+	if len(tags) != 2 || tags[0] != "物流快" || tags[1] != "质量好" {
+		t.Errorf("unexpected tags: %v", tags)
+	}
+
+	invalid := []*review.CreateReviewRequest{
+		{Rating: 0},
+		{Rating: 6},
+		{Rating: 3, Content: strings.Repeat("好", maxReviewContentLen+1)},
+		{Rating: 3, Images: repeatStrings("x.jpg", maxReviewImages+1)},
+		{Rating: 3, Tags: []string{strings.Repeat("长", maxReviewTagLen+1)}},
+		{Rating: 3, Tags: []string{"a", "b", "c", "d", "e", "f"}},
+	}
+	for i, req := range invalid {
+		if _, _, _, err := normalizeReviewInput(req); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
+
+func repeatStrings(s string, n int) []string {
+	list := make([]string, n)
+	for i := range list {
+		list[i] = s
+	}
+	return list
+}
+
+func TestRatingSummaryAverage(t *testing.T) {
+	sum := &model.RatingSummary{ReviewCount: 3, RatingSum: 13, Rating4: 2, Rating5: 1}
+	if avg := sum.Average(); avg != 4.3 {
+		t.Errorf("expected 4.3, got %v", avg)
+	}
+	if counts := sum.Counts(); len(counts) != 5 || counts[3] != 2 || counts[4] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+	if avg := (&model.RatingSummary{}).Average(); avg != 0 {
+		t.Errorf("expected 0 for empty summary, got %v", avg)
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+const maxRatingSummaryBatch = 200
+
+type GetRatingSummariesService struct {
+	ctx context.Context
+}
+
+func NewGetRatingSummariesService(ctx context.Context) *GetRatingSummariesService {
+	return &GetRatingSummariesService{ctx: ctx}
+}
+
+// Run 按请求顺序返回评分汇总，无评价的商品返回零值
+func (s *GetRatingSummariesService) Run(req *review.GetRatingSummariesRequest) (*review.GetRatingSummariesResponse, error) {
+	if len(req.SpuIds) == 0 {
+		return &review.GetRatingSummariesResponse{}, nil
+	}
+	if len(req.SpuIds) > maxRatingSummaryBatch {
+		return nil, errs.New(errs.ErrParam.Code, "too many spu_ids")
+	}
+
+	list, err := model.GetRatingSummaries(s.ctx, mysql.DB, req.SpuIds)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "get rating summaries failed: "+err.Error())
+	}
+	byID := make(map[uint64]*model.RatingSummary, len(list))
+	for _, sum := range list {
+		byID[sum.SpuID] = sum
+	}
+
+	summaries := make([]*review.RatingSummary, 0, len(req.SpuIds))
+	for _, id := range req.SpuIds {
+		sum, ok := byID[id]
+		if !ok {
+			sum = &model.RatingSummary{SpuID: id}
+		}
+		summaries = append(summaries, &review.RatingSummary{
+			SpuId:         id,
+			ReviewCount:   sum.ReviewCount,
+			AverageRating: sum.Average(),
+			RatingCounts:  sum.Counts(),
+		})
+	}
+	return &review.GetRatingSummariesResponse{Summaries: summaries}, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type ListAnswersService struct {
+	ctx context.Context
+}
+
+func NewListAnswersService(ctx context.Context) *ListAnswersService {
+	return &ListAnswersService{ctx: ctx}
+}
+
+// Run 只返回已通过审核的回答，商家回答与点赞多的优先
+func (s *ListAnswersService) Run(req *review.ListAnswersRequest) (*review.ListAnswersResponse, error) {
+	if req.QuestionId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "question_id is required")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	approved := model.ReviewStatusApproved
+	answers, total, err := model.ListAnswers(s.ctx, mysql.DB, model.AnswerFilter{
+		QuestionID: req.QuestionId,
+		Status:     &approved,
+	}, true, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list answers failed: "+err.Error())
+	}
+
+	answerIDs := make([]uint64, 0, len(answers))
+	for _, a := range answers {
+		answerIDs = append(answerIDs, a.ID)
+	}
+	upvoted, err := model.ListUpvotedAnswerIDs(s.ctx, mysql.DB, req.UserId, answerIDs)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list upvotes failed: "+err.Error())
+	}
+
+	return &review.ListAnswersResponse{List: toProtoAnswers(answers, upvoted), Total: total}, nil
+}
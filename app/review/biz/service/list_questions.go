@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type ListQuestionsService struct {
+	ctx context.Context
+}
+
+func NewListQuestionsService(ctx context.Context) *ListQuestionsService {
+	return &ListQuestionsService{ctx: ctx}
+}
+
+// Run 只返回已通过审核的问题，每个问题附带最有用的回答
+func (s *ListQuestionsService) Run(req *review.ListQuestionsRequest) (*review.ListQuestionsResponse, error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu_id is required")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	approved := model.ReviewStatusApproved
+	questions, total, err := model.ListQuestions(s.ctx, mysql.DB, model.QuestionFilter{
+		SpuID:  req.SpuId,
+		Status: &approved,
+	}, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list questions failed: "+err.Error())
+	}
+
+	questionIDs := make([]uint64, 0, len(questions))
+	for _, q := range questions {
+		questionIDs = append(questionIDs, q.ID)
+	}
+	top, err := model.ListTopAnswers(s.ctx, mysql.DB, questionIDs, topAnswerLimit)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list answers failed: "+err.Error())
+	}
+	var answerIDs []uint64
+	for _, answers := range top {
+		for _, a := range answers {
+			answerIDs = append(answerIDs, a.ID)
+		}
+	}
+	upvoted, err := model.ListUpvotedAnswerIDs(s.ctx, mysql.DB, req.UserId, answerIDs)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list upvotes failed: "+err.Error())
+	}
+
+	list := make([]*review.Question, 0, len(questions))
+	for _, q := range questions {
+		item := toProtoQuestion(q)
+		item.TopAnswers = toProtoAnswers(top[q.ID], upvoted)
+		list = append(list, item)
+	}
+	return &review.ListQuestionsResponse{List: list, Total: total}, nil
+}
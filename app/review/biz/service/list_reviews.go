@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type ListReviewsService struct {
+	ctx context.Context
+}
+
+func NewListReviewsService(ctx context.Context) *ListReviewsService {
+	return &ListReviewsService{ctx: ctx}
+}
+
+// Run 只返回已通过审核的评价
+func (s *ListReviewsService) Run(req *review.ListReviewsRequest) (*review.ListReviewsResponse, error) {
+	if req.SpuId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "spu_id is required")
+	}
+	if req.Rating < 0 || req.Rating > 5 {
+		return nil, errs.New(errs.ErrParam.Code, "rating must be between 0 and 5")
+	}
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	approved := model.ReviewStatusApproved
+	list, total, err := model.ListReviews(s.ctx, mysql.DB, model.ReviewFilter{
+		SpuID:      req.SpuId,
+		Status:     &approved,
+		Rating:     req.Rating,
+		WithImages: req.WithImages,
+	}, page, pageSize)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "list reviews failed: "+err.Error())
+	}
+
+	return &review.ListReviewsResponse{List: toProtoReviews(list), Total: total}, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+type ModerateAnswersService struct {
+	ctx context.Context
+}
+
+func NewModerateAnswersService(ctx context.Context) *ModerateAnswersService {
+	return &ModerateAnswersService{ctx: ctx}
+}
+
+func (s *ModerateAnswersService) Run(req *review.ModerateAnswersRequest) (*review.ModerateAnswersResponse, error) {
+	reason, err := validateModeration(req.Ids, req.Status, req.RejectReason)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := model.ModerateAnswers(s.ctx, mysql.DB, req.Ids, req.Status, reason)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "moderate answers failed: "+err.Error())
+	}
+	if req.Status == model.ReviewStatusApproved {
+		s.notifyAskers(changed)
+	}
+	return &review.ModerateAnswersResponse{Affected: int64(len(changed))}, nil
+}
+
+// notifyAskers 新通过审核的回答通知对应的提问者
+func (s *ModerateAnswersService) notifyAskers(changed []*model.Answer) {
+	for _, a := range changed {
+		q, err := model.GetQuestionByID(s.ctx, mysql.DB, a.QuestionID)
+		if err != nil {
+			klog.Warnf("Failed to get question %d for answer notification: %v", a.QuestionID, err)
+			continue
+		}
+		publishAnswer(s.ctx, a, q.UserID)
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+type ModerateQuestionsService struct {
+	ctx context.Context
+}
+
+func NewModerateQuestionsService(ctx context.Context) *ModerateQuestionsService {
+	return &ModerateQuestionsService{ctx: ctx}
+}
+
+func (s *ModerateQuestionsService) Run(req *review.ModerateQuestionsRequest) (*review.ModerateQuestionsResponse, error) {
+	reason, err := validateModeration(req.Ids, req.Status, req.RejectReason)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := model.ModerateQuestions(s.ctx, mysql.DB, req.Ids, req.Status, reason)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "moderate questions failed: "+err.Error())
+	}
+	if req.Status == model.ReviewStatusApproved {
+		for _, q := range changed {
+			publishQuestion(s.ctx, q)
+		}
+	}
+	return &review.ModerateQuestionsResponse{Affected: int64(len(changed))}, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+const (
+	maxModerateBatch   = 100
+	maxRejectReasonLen = 255
+)
+
+type ModerateReviewsService struct {
+	ctx context.Context
+}
+
+func NewModerateReviewsService(ctx context.Context) *ModerateReviewsService {
+	return &ModerateReviewsService{ctx: ctx}
+}
+
+func (s *ModerateReviewsService) Run(req *review.ModerateReviewsRequest) (*review.ModerateReviewsResponse, error) {
+	reason, err := validateModeration(req.Ids, req.Status, req.RejectReason)
+	if err != nil {
+		return nil, err
+	}
+
+	affected, err := model.ModerateReviews(s.ctx, mysql.DB, req.Ids, req.Status, reason)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "moderate reviews failed: "+err.Error())
+	}
+	return &review.ModerateReviewsResponse{Affected: affected}, nil
+}
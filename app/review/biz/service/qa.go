@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/app/review/biz/rpc"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	"github.com/PiaoAdmin/pmall/rpc_gen/order"
+	"github.com/PiaoAdmin/pmall/rpc_gen/product"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+const (
+	maxQuestionLen = 200
+	maxAnswerLen   = 500
+	topAnswerLimit = 2 // 问题列表中每个问题附带的回答数
+)
+
+// normalizeQAContent 去除首尾空白并校验长度
+func normalizeQAContent(content string, maxLen int) (string, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return "", errs.New(errs.ErrParam.Code, "content is required")
+	}
+	if utf8.RuneCountInString(content) > maxLen {
+		return "", errs.New(errs.ErrParam.Code, "content too long")
+	}
+	return content, nil
+}
+
+// ownsSpu 判断商品是否属于该商家
+func ownsSpu(ctx context.Context, merchantID, spuID uint64) (bool, error) {
+	if merchantID == 0 {
+		return false, nil
+	}
+	resp, err := rpc.ProductClient.GetProductsByIds(ctx, &product.GetProductsByIdsRequest{Ids: []uint64{spuID}})
+	if err != nil {
+		return false, err
+	}
+	spu, ok := resp.Products[spuID]
+	return ok && spu.MerchantId == merchantID, nil
+}
+
+// hasPurchasedSpu 判断用户是否有已支付订单包含该商品的任一 SKU
+func hasPurchasedSpu(ctx context.Context, userID, spuID uint64) (bool, error) {
+	resp, err := rpc.OrderClient.ListOrder(ctx, &order.ListOrderReq{UserId: userID})
+	if err != nil {
+		return false, err
+	}
+	seen := make(map[uint64]bool)
+	var skuIDs []uint64
+	for _, o := range resp.Orders {
+		if !reviewableOrderStatus[o.Status] {
+			continue
+		}
+		for _, item := range o.Items {
+			if !seen[item.SkuId] {
+				seen[item.SkuId] = true
+				skuIDs = append(skuIDs, item.SkuId)
+			}
+		}
+	}
+	if len(skuIDs) == 0 {
+		return false, nil
+	}
+
+	skuResp, err := rpc.ProductClient.GetSkusByIds(ctx, &product.GetSkusByIdsRequest{SkuIds: skuIDs})
+	if err != nil {
+		return false, err
+	}
+	for _, sku := range skuResp.Skus {
+		if sku.SpuId == spuID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func toProtoQuestion(q *model.Question) *review.Question {
+	return &review.Question{
+		Id:           q.ID,
+		SpuId:        q.SpuID,
+		UserId:       q.UserID,
+		Content:      q.Content,
+		Status:       q.Status,
+		RejectReason: q.RejectReason,
+		AnswerCount:  q.AnswerCount,
+		CreatedAt:    q.CreatedAt.Unix(),
+	}
+}
+
+func toProtoAnswer(a *model.Answer, upvoted bool) *review.Answer {
+	return &review.Answer{
+		Id:           a.ID,
+		QuestionId:   a.QuestionID,
+		SpuId:        a.SpuID,
+		UserId:       a.UserID,
+		Content:      a.Content,
+		IsMerchant:   a.IsMerchant,
+		Upvotes:      a.Upvotes,
+		Status:       a.Status,
+		RejectReason: a.RejectReason,
+		CreatedAt:    a.CreatedAt.Unix(),
+		Upvoted:      upvoted,
+	}
+}
+
+func toProtoAnswers(list []*model.Answer, upvoted map[uint64]bool) []*review.Answer {
+	resp := make([]*review.Answer, 0, len(list))
+	for _, a := range list {
+		resp = append(resp, toProtoAnswer(a, upvoted[a.ID]))
+	}
+	return resp
+}
+
+// validateModeration 校验批量审核参数，返回驳回原因
+func validateModeration(ids []uint64, status int32, rejectReason string) (string, error) {
+	if len(ids) == 0 || len(ids) > maxModerateBatch {
+		return "", errs.New(errs.ErrParam.Code, "ids must contain 1-100 items")
+	}
+	if status != model.ReviewStatusApproved && status != model.ReviewStatusRejected {
+		return "", errs.New(errs.ErrParam.Code, "status must be 1 (approved) or 2 (rejected)")
+	}
+	if status != model.ReviewStatusRejected {
+		return "", nil
+	}
+	reason := strings.TrimSpace(rejectReason)
+	if utf8.RuneCountInString(reason) > maxRejectReasonLen {
+		return "", errs.New(errs.ErrParam.Code, "reject_reason too long")
+	}
+	return reason, nil
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+)
+
+func TestNormalizeQAContent(t *testing.T) {
+	content, err := normalizeQAContent("  能用多久？ ", maxQuestionLen)
+	if err != nil || content != "能用多久？" {
+		t.Errorf("unexpected result: %q, %v", content, err)
+	}
+	if _, err := normalizeQAContent("   ", maxQuestionLen); err == nil {
+		t.Error("expected error for empty content")
+	}
+	if _, err := normalizeQAContent(strings.Repeat("问", maxQuestionLen+1), maxQuestionLen); err == nil {
+		t.Error("expected error for long content")
+	}
+}
+
+func TestValidateModeration(t *testing.T) {
+	reason, err := validateModeration([]uint64{1}, model.ReviewStatusRejected, " 广告 ")
+	if err != nil || reason != "广告" {
+		t.Errorf("unexpected result: %q, %v", reason, err)
+	}
+	if reason, _ := validateModeration([]uint64{1}, model.ReviewStatusApproved, "ignored"); reason != "" {
+		t.Errorf("approve should drop reject reason, got %q", reason)
+	}
+	if _, err := validateModeration(nil, model.ReviewStatusApproved, ""); err == nil {
+		t.Error("expected error for empty ids")
+	}
+	if _, err := validateModeration([]uint64{1}, model.ReviewStatusPending, ""); err == nil {
+		t.Error("expected error for pending status")
+	}
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/review/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+	"gorm.io/gorm"
+)
+
+type UpvoteAnswerService struct {
+	ctx context.Context
+}
+
+func NewUpvoteAnswerService(ctx context.Context) *UpvoteAnswerService {
+	return &UpvoteAnswerService{ctx: ctx}
+}
+
+func (s *UpvoteAnswerService) Run(req *review.UpvoteAnswerRequest) (*review.UpvoteAnswerResponse, error) {
+	if req.UserId == 0 || req.AnswerId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user_id and answer_id are required")
+	}
+
+	a, err := model.GetAnswerByID(s.ctx, mysql.DB, req.AnswerId)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errs.New(errs.ErrRecordNotFound.Code, "answer not found")
+		}
+		return nil, errs.New(errs.ErrInternal.Code, "get answer failed: "+err.Error())
+	}
+	if a.Status != model.ReviewStatusApproved {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, "answer not found")
+	}
+
+	upvotes, err := model.UpvoteAnswer(s.ctx, mysql.DB, req.AnswerId, req.UserId, req.Cancel)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, "upvote answer failed: "+err.Error())
+	}
+	return &review.UpvoteAnswerResponse{Upvotes: upvotes}, nil
+}
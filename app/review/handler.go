@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/review/biz/service"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+)
+
+// ReviewServiceImpl implements the last service interface defined in the IDL.
+type ReviewServiceImpl struct{}
+
+// CreateReview implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) CreateReview(ctx context.Context, req *review.CreateReviewRequest) (resp *review.CreateReviewResponse, err error) {
+	return service.NewCreateReviewService(ctx).Run(req)
+}
+
+// ListReviews implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) ListReviews(ctx context.Context, req *review.ListReviewsRequest) (resp *review.ListReviewsResponse, err error) {
+	return service.NewListReviewsService(ctx).Run(req)
+}
+
+// GetRatingSummaries implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) GetRatingSummaries(ctx context.Context, req *review.GetRatingSummariesRequest) (resp *review.GetRatingSummariesResponse, err error) {
+	return service.NewGetRatingSummariesService(ctx).Run(req)
+}
+
+// AdminListReviews implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) AdminListReviews(ctx context.Context, req *review.AdminListReviewsRequest) (resp *review.AdminListReviewsResponse, err error) {
+	return service.NewAdminListReviewsService(ctx).Run(req)
+}
+
+// ModerateReviews implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) ModerateReviews(ctx context.Context, req *review.ModerateReviewsRequest) (resp *review.ModerateReviewsResponse, err error) {
+	return service.NewModerateReviewsService(ctx).Run(req)
+}
+
+// CreateQuestion implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) CreateQuestion(ctx context.Context, req *review.CreateQuestionRequest) (resp *review.CreateQuestionResponse, err error) {
+	return service.NewCreateQuestionService(ctx).Run(req)
+}
+
+// CreateAnswer implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) CreateAnswer(ctx context.Context, req *review.CreateAnswerRequest) (resp *review.CreateAnswerResponse, err error) {
+	return service.NewCreateAnswerService(ctx).Run(req)
+}
+
+// ListQuestions implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) ListQuestions(ctx context.Context, req *review.ListQuestionsRequest) (resp *review.ListQuestionsResponse, err error) {
+	return service.NewListQuestionsService(ctx).Run(req)
+}
+
+// ListAnswers implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) ListAnswers(ctx context.Context, req *review.ListAnswersRequest) (resp *review.ListAnswersResponse, err error) {
+	return service.NewListAnswersService(ctx).Run(req)
+}
+
+// UpvoteAnswer implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) UpvoteAnswer(ctx context.Context, req *review.UpvoteAnswerRequest) (resp *review.UpvoteAnswerResponse, err error) {
+	return service.NewUpvoteAnswerService(ctx).Run(req)
+}
+
+// AdminListQuestions implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) AdminListQuestions(ctx context.Context, req *review.AdminListQuestionsRequest) (resp *review.AdminListQuestionsResponse, err error) {
+	return service.NewAdminListQuestionsService(ctx).Run(req)
+}
+
+// AdminListAnswers implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) AdminListAnswers(ctx context.Context, req *review.AdminListAnswersRequest) (resp *review.AdminListAnswersResponse, err error) {
+	return service.NewAdminListAnswersService(ctx).Run(req)
+}
+
+// ModerateQuestions implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) ModerateQuestions(ctx context.Context, req *review.ModerateQuestionsRequest) (resp *review.ModerateQuestionsResponse, err error) {
+	return service.NewModerateQuestionsService(ctx).Run(req)
+}
+
+// ModerateAnswers implements the ReviewServiceImpl interface.
+func (s *ReviewServiceImpl) ModerateAnswers(ctx context.Context, req *review.ModerateAnswersRequest) (resp *review.ModerateAnswersResponse, err error) {
+	return service.NewModerateAnswersService(ctx).Run(req)
+}
@@ -1,8 +1,11 @@
 package mysql
 
 import (
+	"context"
+
 	"github.com/PiaoAdmin/pmall/app/user/biz/model"
 	"github.com/PiaoAdmin/pmall/app/user/conf"
+	"github.com/cloudwego/kitex/pkg/klog"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -19,4 +22,12 @@ func Init() {
 	if conf.GetEnv() == "test" {
 		DB.AutoMigrate(&model.User{})
 	}
+	// 角色表由本服务维护，缺失时自动创建；初始化失败不影响用户登录注册，只记录错误
+	if err := DB.AutoMigrate(&model.Role{}, &model.RolePermission{}, &model.UserRole{}); err != nil {
+		klog.Errorf("Failed to migrate role tables: %v", err)
+		return
+	}
+	if err := model.SeedRoles(context.Background(), DB); err != nil {
+		klog.Errorf("Failed to seed roles: %v", err)
+	}
 }
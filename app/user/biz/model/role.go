@@ -0,0 +1,115 @@
+package model
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/PiaoAdmin/pmall/common/rbac"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Role 后台角色
+type Role struct {
+	Name        string `gorm:"primaryKey;type:varchar(32)"`
+	Description string `gorm:"type:varchar(255)"`
+	CreatedAt   time.Time
+}
+
+func (Role) TableName() string {
+	return "role"
+}
+
+// RolePermission 角色拥有的权限点
+type RolePermission struct {
+	Role       string `gorm:"primaryKey;type:varchar(32)"`
+	Permission string `gorm:"primaryKey;type:varchar(64)"`
+}
+
+func (RolePermission) TableName() string {
+	return "role_permission"
+}
+
+// UserRole 用户与角色的关联，普通买家没有角色
+type UserRole struct {
+	UserID    uint64 `gorm:"primaryKey"`
+	Role      string `gorm:"primaryKey;type:varchar(32)"`
+	CreatedAt time.Time
+}
+
+func (UserRole) TableName() string {
+	return "user_role"
+}
+
+// SeedRoles 写入内置角色及默认权限，已存在的数据保持不变
+func SeedRoles(ctx context.Context, db *gorm.DB) error {
+	roles := make([]Role, 0, len(rbac.DefaultRoles))
+	perms := make([]RolePermission, 0)
+	for name, desc := range rbac.DefaultRoles {
+		roles = append(roles, Role{Name: name, Description: desc})
+		for _, p := range rbac.DefaultRolePermissions[name] {
+			perms = append(perms, RolePermission{Role: name, Permission: p})
+		}
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&roles).Error; err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&perms).Error
+	})
+}
+
+// GetUserRoles 查询用户角色，按名称排序
+func GetUserRoles(ctx context.Context, db *gorm.DB, userID uint64) ([]string, error) {
+	roles := make([]string, 0)
+	err := db.WithContext(ctx).Model(&UserRole{}).
+		Where("user_id = ?", userID).
+		Order("role asc").
+		Pluck("role", &roles).Error
+	return roles, err
+}
+
+// SetUserRoles 将用户角色整体替换为 roles
+func SetUserRoles(ctx context.Context, db *gorm.DB, userID uint64, roles []string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&UserRole{}).Error; err != nil {
+			return err
+		}
+		if len(roles) == 0 {
+			return nil
+		}
+		rows := make([]UserRole, 0, len(roles))
+		for _, r := range roles {
+			rows = append(rows, UserRole{UserID: userID, Role: r})
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// ListRoles 查询全部角色，返回角色及其权限
+func ListRoles(ctx context.Context, db *gorm.DB) ([]Role, map[string][]string, error) {
+	var roles []Role
+	if err := db.WithContext(ctx).Order("name asc").Find(&roles).Error; err != nil {
+		return nil, nil, err
+	}
+	var rows []RolePermission
+	if err := db.WithContext(ctx).Find(&rows).Error; err != nil {
+		return nil, nil, err
+	}
+	perms := make(map[string][]string, len(roles))
+	for _, r := range rows {
+		perms[r.Role] = append(perms[r.Role], r.Permission)
+	}
+	for _, p := range perms {
+		sort.Strings(p)
+	}
+	return roles, perms, nil
+}
+
+// CountRoles 统计存在的角色数量，用于校验分配的角色
+func CountRoles(ctx context.Context, db *gorm.DB, names []string) (int64, error) {
+	var n int64
+	err := db.WithContext(ctx).Model(&Role{}).Where("name IN ?", names).Count(&n).Error
+	return n, err
+}
@@ -26,6 +26,11 @@ func (s *GetUserInfoService) Run(req *user.GetUserInfoRequest) (resp *user.GetUs
 		return nil, errs.New(errs.ErrRecordNotFound.Code, err.Error())
 	}
 
+	roles, err := model.GetUserRoles(s.ctx, mysql.DB, userRow.ID)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, err.Error())
+	}
+
 	return &user.GetUserInfoResponse{
 		User: &user.User{
 			Id:       uint64(userRow.ID),
@@ -34,6 +39,7 @@ func (s *GetUserInfoService) Run(req *user.GetUserInfoRequest) (resp *user.GetUs
 			Phone:    userRow.Phone,
 			Avatar:   userRow.Avatar,
 			Status:   userRow.Status,
+			Roles:    roles,
 		},
 	}, nil
 }
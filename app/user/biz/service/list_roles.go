@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"github.com/PiaoAdmin/pmall/app/user/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/user/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	user "github.com/PiaoAdmin/pmall/rpc_gen/user"
+)
+
+type ListRolesService struct {
+	ctx context.Context
+}
+
+func NewListRolesService(ctx context.Context) *ListRolesService {
+	return &ListRolesService{ctx: ctx}
+}
+
+func (s *ListRolesService) Run(req *user.ListRolesRequest) (resp *user.ListRolesResponse, err error) {
+	roles, perms, err := model.ListRoles(s.ctx, mysql.DB)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, err.Error())
+	}
+
+	resp = &user.ListRolesResponse{Roles: make([]*user.Role, 0, len(roles))}
+	for _, r := range roles {
+		resp.Roles = append(resp.Roles, &user.Role{
+			Name:        r.Name,
+			Description: r.Description,
+			Permissions: perms[r.Name],
+		})
+	}
+	return resp, nil
+}
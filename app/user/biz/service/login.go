@@ -34,6 +34,11 @@ func (s *LoginService) Run(req *user.LoginRequest) (resp *user.LoginResponse, er
 		return nil, errs.New(errs.ErrParam.Code, "password incorrect")
 	}
 
+	roles, err := model.GetUserRoles(s.ctx, mysql.DB, userRow.ID)
+	if err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, err.Error())
+	}
+
 	return &user.LoginResponse{
 		User: &user.User{
 			Id:       uint64(userRow.ID),
@@ -42,6 +47,7 @@ func (s *LoginService) Run(req *user.LoginRequest) (resp *user.LoginResponse, er
 			Phone:    userRow.Phone,
 			Avatar:   userRow.Avatar,
 			Status:   userRow.Status,
+			Roles:    roles,
 		},
 	}, nil
 }
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/PiaoAdmin/pmall/app/user/biz/dal/mysql"
+	"github.com/PiaoAdmin/pmall/app/user/biz/model"
+	"github.com/PiaoAdmin/pmall/common/errs"
+	user "github.com/PiaoAdmin/pmall/rpc_gen/user"
+)
+
+type SetUserRolesService struct {
+	ctx context.Context
+}
+
+func NewSetUserRolesService(ctx context.Context) *SetUserRolesService {
+	return &SetUserRolesService{ctx: ctx}
+}
+
+func (s *SetUserRolesService) Run(req *user.SetUserRolesRequest) (resp *user.SetUserRolesResponse, err error) {
+	if req.UserId == 0 {
+		return nil, errs.New(errs.ErrParam.Code, "user_id is empty")
+	}
+	userRow, err := model.GetById(s.ctx, mysql.DB, req.UserId)
+	if err != nil {
+		return nil, errs.New(errs.ErrRecordNotFound.Code, err.Error())
+	}
+
+	roles := dedupRoles(req.Roles)
+	if len(roles) > 0 {
+		n, err := model.CountRoles(s.ctx, mysql.DB, roles)
+		if err != nil {
+			return nil, errs.New(errs.ErrInternal.Code, err.Error())
+		}
+		if int(n) != len(roles) {
+			return nil, errs.New(errs.ErrParam.Code, "unknown role")
+		}
+	}
+	if err := model.SetUserRoles(s.ctx, mysql.DB, req.UserId, roles); err != nil {
+		return nil, errs.New(errs.ErrInternal.Code, err.Error())
+	}
+
+	return &user.SetUserRolesResponse{
+		User: &user.User{
+			Id:       uint64(userRow.ID),
+			Username: userRow.Username,
+			Email:    userRow.Email,
+			Phone:    userRow.Phone,
+			Avatar:   userRow.Avatar,
+			Status:   userRow.Status,
+			Roles:    roles,
+		},
+	}, nil
+}
+
+func dedupRoles(roles []string) []string {
+	seen := make(map[string]struct{}, len(roles))
+	out := make([]string, 0, len(roles))
+	for _, r := range roles {
+		if r == "" {
+			continue
+		}
+		if _, ok := seen[r]; ok {
+			continue
+		}
+		seen[r] = struct{}{}
+		out = append(out, r)
+	}
+	sort.Strings(out)
+	return out
+}
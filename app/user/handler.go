@@ -39,3 +39,15 @@ func (s *UserServiceImpl) UpdatePassword(ctx context.Context, req *user.UpdatePa
 	resp, err = service.NewUpdatePasswordService(ctx).Run(req)
 	return resp, err
 }
+
+// ListRoles implements the UserServiceImpl interface.
+func (s *UserServiceImpl) ListRoles(ctx context.Context, req *user.ListRolesRequest) (resp *user.ListRolesResponse, err error) {
+	resp, err = service.NewListRolesService(ctx).Run(req)
+	return resp, err
+}
+
+// SetUserRoles implements the UserServiceImpl interface.
+func (s *UserServiceImpl) SetUserRoles(ctx context.Context, req *user.SetUserRolesRequest) (resp *user.SetUserRolesResponse, err error) {
+	resp, err = service.NewSetUserRolesService(ctx).Run(req)
+	return resp, err
+}
@@ -166,3 +166,62 @@ func TestUserFlow(t *testing.T) {
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
+
+func TestUserRoles(t *testing.T) {
+	handler := getHandler()
+	ctx := context.Background()
+
+	randStr := generateRandomString(8)
+	username := "test_role_" + randStr
+	password := "password123"
+	regResp, err := handler.Register(ctx, &user.RegisterRequest{
+		Username:        username,
+		Password:        password,
+		PasswordConfirm: password,
+		Email:           "role_" + randStr + "@example.com",
+	})
+	assert.NoError(t, err)
+	userId := regResp.User.Id
+
+	t.Run("ListRoles", func(t *testing.T) {
+		resp, err := handler.ListRoles(ctx, &user.ListRolesRequest{})
+		assert.NoError(t, err)
+		names := make([]string, 0, len(resp.Roles))
+		for _, r := range resp.Roles {
+			names = append(names, r.Name)
+			assert.NotEmpty(t, r.Permissions)
+		}
+		assert.Subset(t, names, []string{"admin", "operator", "merchant"})
+	})
+
+	t.Run("SetUserRoles", func(t *testing.T) {
+		resp, err := handler.SetUserRoles(ctx, &user.SetUserRolesRequest{
+			UserId: userId,
+			Roles:  []string{"operator", "merchant", "operator"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"merchant", "operator"}, resp.User.Roles)
+
+		loginResp, err := handler.Login(ctx, &user.LoginRequest{Username: username, Password: password})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"merchant", "operator"}, loginResp.User.Roles)
+	})
+
+	t.Run("SetUnknownRole", func(t *testing.T) {
+		resp, err := handler.SetUserRoles(ctx, &user.SetUserRolesRequest{
+			UserId: userId,
+			Roles:  []string{"no_such_role"},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("ClearRoles", func(t *testing.T) {
+		_, err := handler.SetUserRoles(ctx, &user.SetUserRolesRequest{UserId: userId})
+		assert.NoError(t, err)
+
+		infoResp, err := handler.GetUserInfo(ctx, &user.GetUserInfoRequest{UserId: userId})
+		assert.NoError(t, err)
+		assert.Empty(t, infoResp.User.Roles)
+	})
+}
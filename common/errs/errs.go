@@ -72,6 +72,7 @@ var (
 	ErrRecordAlreadyEx = New(40004, "record already exists")
 	ErrAuthFailed      = New(40005, "authorization failed")
 	ErrNotLogin        = New(40006, "user not login")
+	ErrPermission      = New(40007, "permission denied")
 )
 
 func convertErrorTypeToString(code ErrorType) string {
@@ -90,6 +91,8 @@ func convertErrorTypeToString(code ErrorType) string {
 		str = "ERR_AUTH_FAILED"
 	case 40006:
 		str = "ERR_NOT_LOGIN"
+	case 40007:
+		str = "ERR_PERMISSION_DENIED"
 	case 50000:
 		str = "ERR_INTERNAL"
 	}
@@ -0,0 +1,92 @@
+// Package notify 事件通知钩子的公共实现。各服务只定义自己的事件类型，
+// 通过 Notifier 注册钩子并投递事件 (站内信、短信、推送等由下游系统实现)。
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Hook 处理一个事件
+type Hook[E any] func(ctx context.Context, e E) error
+
+// Notifier 保存一类事件的钩子，零值可直接使用
+type Notifier[E any] struct {
+	mu    sync.RWMutex
+	hooks []Hook[E]
+}
+
+// Register 注册钩子
+func (n *Notifier[E]) Register(h Hook[E]) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.hooks = append(n.hooks, h)
+}
+
+// Deliver 同步执行全部钩子，全部成功时返回 nil，否则返回合并后的错误
+func (n *Notifier[E]) Deliver(ctx context.Context, e E) error {
+	n.mu.RLock()
+	list := n.hooks
+	n.mu.RUnlock()
+
+	var errs []error
+	for _, h := range list {
+		if err := h(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Publish 异步执行全部钩子，不阻塞调用方，投递失败时调用 onError
+func (n *Notifier[E]) Publish(ctx context.Context, e E, onError func(error)) {
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := n.Deliver(ctx, e); err != nil && onError != nil {
+			onError(err)
+		}
+	}()
+}
+
+// DefaultHook 按配置选择默认钩子：webhookURL 为空时使用 fallback (通常只记录日志)，
+// 否则以 webhook 投递，timeoutSeconds 不大于 0 时超时为 3 秒
+func DefaultHook[E any](webhookURL string, timeoutSeconds int, fallback Hook[E]) Hook[E] {
+	if webhookURL == "" {
+		return fallback
+	}
+	timeout := 3 * time.Second
+	if timeoutSeconds > 0 {
+		timeout = time.Duration(timeoutSeconds) * time.Second
+	}
+	return WebhookHook[E](webhookURL, &http.Client{Timeout: timeout})
+}
+
+// WebhookHook 以 JSON POST 投递事件，非 2xx 响应视为失败
+func WebhookHook[E any](url string, client *http.Client) Hook[E] {
+	return func(ctx context.Context, e E) error {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
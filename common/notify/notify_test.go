@@ -0,0 +1,68 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testEvent struct {
+	Type string `json:"type"`
+	ID   uint64 `json:"id"`
+}
+
+func TestWebhookHook(t *testing.T) {
+	var got testEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected content type: %s", r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+	}))
+	defer srv.Close()
+
+	hook := WebhookHook[*testEvent](srv.URL, srv.Client())
+	if err := hook(context.Background(), &testEvent{Type: "a.b", ID: 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Type != "a.b" || got.ID != 2 {
+		t.Errorf("unexpected event: %+v", got)
+	}
+
+	fail := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer fail.Close()
+	if err := WebhookHook[*testEvent](fail.URL, fail.Client())(context.Background(), &testEvent{}); err == nil {
+		t.Error("expected error for non-2xx status")
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	var n Notifier[int]
+	if err := n.Deliver(context.Background(), 1); err != nil {
+		t.Fatalf("no hooks: %v", err)
+	}
+
+	calls := 0
+	errFail := errors.New("fail")
+	n.Register(func(_ context.Context, e int) error { calls++; return errFail })
+	n.Register(func(_ context.Context, e int) error { calls++; return nil })
+	if err := n.Deliver(context.Background(), 1); !errors.Is(err, errFail) {
+		t.Errorf("expected hook error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("a failing hook should not stop the others, got %d calls", calls)
+	}
+
+	done := make(chan error, 1)
+	n.Publish(context.Background(), 1, func(err error) { done <- err })
+	if err := <-done; !errors.Is(err, errFail) {
+		t.Errorf("publish: expected hook error, got %v", err)
+	}
+}
@@ -0,0 +1,8 @@
+// Package orderstate 订单状态。订单服务写入，评价、网关等服务按同一组常量判断订单状态。
+package orderstate
+
+const (
+	Placed   = "placed"   // 已下单，待支付
+	Paid     = "paid"     // 已支付
+	Canceled = "canceled" // 已取消
+)
@@ -0,0 +1,62 @@
+// Package rbac 定义后台角色与权限，用户服务据此初始化角色数据，网关据此校验管理接口权限。
+package rbac
+
+import "strings"
+
+// 内置角色
+const (
+	RoleAdmin    = "admin"    // 超级管理员，拥有全部权限
+	RoleOperator = "operator" // 运营，管理商品与订单
+	RoleMerchant = "merchant" // 商家，管理自己的商品与库存
+)
+
+// 权限点，格式为 <资源>:<操作>
+const (
+	PermAll            = "*"
+	PermAdminAccess    = "admin:access" // 访问管理后台接口
+	PermProductWrite   = "product:write"
+	PermSkuWrite       = "sku:write"
+	PermOrderExport    = "order:export"
+	PermUserRole       = "user:role"       // 查看角色、为用户分配角色
+	PermSearchReport   = "search:report"   // 查看搜索词报表
+	PermReviewModerate = "review:moderate" // 审核商品评价与问答
+	PermCategoryWrite  = "category:write"  // 维护商品分类树
+	PermBrandWrite     = "brand:write"     // 维护品牌及品牌关联分类
+	PermProductAudit   = "product:audit"   // 审核商品上架
+	PermProductAny     = "product:any"     // 管理所有商家的商品，没有该权限时只能管理自己的商品
+)
+
+// DefaultRoles 内置角色说明
+var DefaultRoles = map[string]string{
+	RoleAdmin:    "超级管理员",
+	RoleOperator: "运营",
+	RoleMerchant: "商家",
+}
+
+// DefaultRolePermissions 内置角色的默认权限
+var DefaultRolePermissions = map[string][]string{
+	RoleAdmin:    {PermAll},
+	RoleOperator: {PermAdminAccess, PermProductWrite, PermSkuWrite, PermOrderExport, PermSearchReport, PermReviewModerate, PermCategoryWrite, PermBrandWrite, PermProductAudit, PermProductAny},
+	RoleMerchant: {PermAdminAccess, PermProductWrite, PermSkuWrite},
+}
+
+// Match 判断授予的权限 granted 是否覆盖 required，支持 "*" 与 "<资源>:*" 通配
+func Match(granted, required string) bool {
+	if granted == PermAll || granted == required {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(granted, ":*"); ok {
+		return strings.HasPrefix(required, prefix+":")
+	}
+	return false
+}
+
+// Allowed 判断权限集合是否包含 required
+func Allowed(granted []string, required string) bool {
+	for _, g := range granted {
+		if Match(g, required) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package rbac
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		granted, required string
+		want              bool
+	}{
+		{PermAll, PermProductWrite, true},
+		{PermProductWrite, PermProductWrite, true},
+		{"product:*", PermProductWrite, true},
+		{"product:*", PermSkuWrite, false},
+		{"product", "product:write", false},
+		{PermSkuWrite, PermProductWrite, false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.granted, tt.required); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.granted, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultRolePermissions(t *testing.T) {
+	for role := range DefaultRoles {
+		perms, ok := DefaultRolePermissions[role]
+		if !ok {
+			t.Fatalf("role %s has no default permissions", role)
+		}
+		if !Allowed(perms, PermAdminAccess) {
+			t.Errorf("role %s cannot access admin endpoints", role)
+		}
+	}
+	if Allowed(DefaultRolePermissions[RoleMerchant], PermOrderExport) {
+		t.Error("merchant should not export orders")
+	}
+	if Allowed(DefaultRolePermissions[RoleMerchant], PermProductAny) {
+		t.Error("merchant should only manage own products")
+	}
+}
@@ -312,9 +312,153 @@ func (x *ClearCartResponse) GetSuccess() bool {
 	return false
 }
 
+// 5. 再次购买
+type ReorderRequest struct {
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`  // 用户ID
+	OrderId string `protobuf:"bytes,2,opt,name=order_id" json:"order_id,omitempty"` // 历史订单ID
+}
+
+func (x *ReorderRequest) Reset() { *x = ReorderRequest{} }
+
+func (x *ReorderRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ReorderRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ReorderRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReorderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// 未加入购物车的商品
+type ReorderSkippedItem struct {
+	SkuId    uint64 `protobuf:"varint,1,opt,name=sku_id" json:"sku_id,omitempty"`
+	SkuName  string `protobuf:"bytes,2,opt,name=sku_name" json:"sku_name,omitempty"`  // 下单时的SKU名称
+	Quantity int32  `protobuf:"varint,3,opt,name=quantity" json:"quantity,omitempty"` // 原订单数量
+	Reason   string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`      // delisted: 已下架、已删除或未审核通过, out_of_stock: 无库存
+}
+
+func (x *ReorderSkippedItem) Reset() { *x = ReorderSkippedItem{} }
+
+func (x *ReorderSkippedItem) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ReorderSkippedItem) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ReorderSkippedItem) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ReorderSkippedItem) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *ReorderSkippedItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReorderSkippedItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 价格与原订单不同的商品
+type ReorderPriceChange struct {
+	SkuId    uint64 `protobuf:"varint,1,opt,name=sku_id" json:"sku_id,omitempty"`
+	SkuName  string `protobuf:"bytes,2,opt,name=sku_name" json:"sku_name,omitempty"`
+	OldPrice string `protobuf:"bytes,3,opt,name=old_price" json:"old_price,omitempty"` // 下单时价格
+	NewPrice string `protobuf:"bytes,4,opt,name=new_price" json:"new_price,omitempty"` // 当前价格
+}
+
+func (x *ReorderPriceChange) Reset() { *x = ReorderPriceChange{} }
+
+func (x *ReorderPriceChange) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ReorderPriceChange) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ReorderPriceChange) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ReorderPriceChange) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *ReorderPriceChange) GetOldPrice() string {
+	if x != nil {
+		return x.OldPrice
+	}
+	return ""
+}
+
+func (x *ReorderPriceChange) GetNewPrice() string {
+	if x != nil {
+		return x.NewPrice
+	}
+	return ""
+}
+
+type ReorderResponse struct {
+	AddedItems        []*CartItem           `protobuf:"bytes,1,rep,name=added_items" json:"added_items,omitempty"` // 已加入购物车的商品，库存不足时按剩余库存加入
+	SkippedItems      []*ReorderSkippedItem `protobuf:"bytes,2,rep,name=skipped_items" json:"skipped_items,omitempty"`
+	PriceChangedItems []*ReorderPriceChange `protobuf:"bytes,3,rep,name=price_changed_items" json:"price_changed_items,omitempty"`
+}
+
+func (x *ReorderResponse) Reset() { *x = ReorderResponse{} }
+
+func (x *ReorderResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ReorderResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ReorderResponse) GetAddedItems() []*CartItem {
+	if x != nil {
+		return x.AddedItems
+	}
+	return nil
+}
+
+func (x *ReorderResponse) GetSkippedItems() []*ReorderSkippedItem {
+	if x != nil {
+		return x.SkippedItems
+	}
+	return nil
+}
+
+func (x *ReorderResponse) GetPriceChangedItems() []*ReorderPriceChange {
+	if x != nil {
+		return x.PriceChangedItems
+	}
+	return nil
+}
+
 type CartService interface {
 	AddToCart(ctx context.Context, req *AddToCartRequest) (res *AddToCartResponse, err error)
 	RemoveFromCart(ctx context.Context, req *RemoveFromCartRequest) (res *RemoveFromCartResponse, err error)
 	GetCartDetails(ctx context.Context, req *GetCartDetailsRequest) (res *GetCartDetailsResponse, err error)
 	ClearCart(ctx context.Context, req *ClearCartRequest) (res *ClearCartResponse, err error)
+	Reorder(ctx context.Context, req *ReorderRequest) (res *ReorderResponse, err error)
 }
@@ -43,6 +43,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"Reorder": kitex.NewMethodInfo(
+		reorderHandler,
+		newReorderArgs,
+		newReorderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -553,6 +560,117 @@ func (p *ClearCartResult) GetResult() interface{} {
 	return p.Success
 }
 
+func reorderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(cart.ReorderRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(cart.CartService).Reorder(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ReorderArgs:
+		success, err := handler.(cart.CartService).Reorder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReorderResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newReorderArgs() interface{} {
+	return &ReorderArgs{}
+}
+
+func newReorderResult() interface{} {
+	return &ReorderResult{}
+}
+
+type ReorderArgs struct {
+	Req *cart.ReorderRequest
+}
+
+func (p *ReorderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReorderArgs) Unmarshal(in []byte) error {
+	msg := new(cart.ReorderRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReorderArgs_Req_DEFAULT *cart.ReorderRequest
+
+func (p *ReorderArgs) GetReq() *cart.ReorderRequest {
+	if !p.IsSetReq() {
+		return ReorderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReorderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReorderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReorderResult struct {
+	Success *cart.ReorderResponse
+}
+
+var ReorderResult_Success_DEFAULT *cart.ReorderResponse
+
+func (p *ReorderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReorderResult) Unmarshal(in []byte) error {
+	msg := new(cart.ReorderResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReorderResult) GetSuccess() *cart.ReorderResponse {
+	if !p.IsSetSuccess() {
+		return ReorderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReorderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*cart.ReorderResponse)
+}
+
+func (p *ReorderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReorderResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -602,3 +720,13 @@ func (p *kClient) ClearCart(ctx context.Context, Req *cart.ClearCartRequest) (r
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) Reorder(ctx context.Context, Req *cart.ReorderRequest) (r *cart.ReorderResponse, err error) {
+	var _args ReorderArgs
+	_args.Req = Req
+	var _result ReorderResult
+	if err = p.c.Call(ctx, "Reorder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -15,6 +15,7 @@ type Client interface {
 	RemoveFromCart(ctx context.Context, Req *cart.RemoveFromCartRequest, callOptions ...callopt.Option) (r *cart.RemoveFromCartResponse, err error)
 	GetCartDetails(ctx context.Context, Req *cart.GetCartDetailsRequest, callOptions ...callopt.Option) (r *cart.GetCartDetailsResponse, err error)
 	ClearCart(ctx context.Context, Req *cart.ClearCartRequest, callOptions ...callopt.Option) (r *cart.ClearCartResponse, err error)
+	Reorder(ctx context.Context, Req *cart.ReorderRequest, callOptions ...callopt.Option) (r *cart.ReorderResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -65,3 +66,8 @@ func (p *kCartServiceClient) ClearCart(ctx context.Context, Req *cart.ClearCartR
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ClearCart(ctx, Req)
 }
+
+func (p *kCartServiceClient) Reorder(ctx context.Context, Req *cart.ReorderRequest, callOptions ...callopt.Option) (r *cart.ReorderResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Reorder(ctx, Req)
+}
@@ -172,7 +172,7 @@ type Order struct {
 	UserId          uint64      `protobuf:"varint,3,opt,name=user_id" json:"user_id,omitempty"`
 	Email           string      `protobuf:"bytes,4,opt,name=email" json:"email,omitempty"`
 	ShippingAddress *Address    `protobuf:"bytes,5,opt,name=shipping_address" json:"shipping_address,omitempty"`
-	Status          string      `protobuf:"bytes,6,opt,name=status" json:"status,omitempty"`
+	Status          string      `protobuf:"bytes,6,opt,name=status" json:"status,omitempty"` // placed / paid / canceled，processing 表示已下单但尚未落库
 	CreatedAt       int32       `protobuf:"varint,7,opt,name=created_at" json:"created_at,omitempty"`
 }
 
@@ -232,7 +232,9 @@ func (x *Order) GetCreatedAt() int32 {
 }
 
 type ListOrderReq struct {
-	UserId uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Page     int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"` // 从 1 开始，page_size 为 0 时返回全部订单
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
 }
 
 func (x *ListOrderReq) Reset() { *x = ListOrderReq{} }
@@ -248,6 +250,20 @@ func (x *ListOrderReq) GetUserId() uint64 {
 	return 0
 }
 
+func (x *ListOrderReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListOrderReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type ListOrderResp struct {
 	Orders []*Order `protobuf:"bytes,1,rep,name=orders" json:"orders,omitempty"`
 }
@@ -265,6 +281,48 @@ func (x *ListOrderResp) GetOrders() []*Order {
 	return nil
 }
 
+type GetOrderReq struct {
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id" json:"order_id,omitempty"`
+}
+
+func (x *GetOrderReq) Reset() { *x = GetOrderReq{} }
+
+func (x *GetOrderReq) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetOrderReq) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetOrderReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderResp struct {
+	Order *Order `protobuf:"bytes,1,opt,name=order" json:"order,omitempty"`
+}
+
+func (x *GetOrderResp) Reset() { *x = GetOrderResp{} }
+
+func (x *GetOrderResp) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetOrderResp) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetOrderResp) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 type MarkOrderPaidReq struct {
 	UserId  uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
 	OrderId string `protobuf:"bytes,2,opt,name=order_id" json:"order_id,omitempty"`
@@ -307,6 +365,162 @@ func (x *MarkOrderPaidResp) GetSuccess() bool {
 	return false
 }
 
+type ExportOrdersReq struct {
+	UserId    uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`       // 为 0 时导出全部用户订单（管理员）
+	Status    string `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`          // 为空时不过滤
+	StartTime int64  `protobuf:"varint,3,opt,name=start_time" json:"start_time,omitempty"` // 下单时间范围，unix 秒，为 0 时不限制
+	EndTime   int64  `protobuf:"varint,4,opt,name=end_time" json:"end_time,omitempty"`
+	Cursor    string `protobuf:"bytes,5,opt,name=cursor" json:"cursor,omitempty"` // 上一批返回的 next_cursor，首批为空
+	Limit     int32  `protobuf:"varint,6,opt,name=limit" json:"limit,omitempty"`  // 每批订单数
+}
+
+func (x *ExportOrdersReq) Reset() { *x = ExportOrdersReq{} }
+
+func (x *ExportOrdersReq) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ExportOrdersReq) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportOrdersReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExportOrdersReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportOrdersReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ExportOrdersReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ExportOrdersReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ExportOrdersReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ExportOrdersResp struct {
+	Orders     []*Order `protobuf:"bytes,1,rep,name=orders" json:"orders,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor" json:"next_cursor,omitempty"` // 为空表示已导出完毕
+}
+
+func (x *ExportOrdersResp) Reset() { *x = ExportOrdersResp{} }
+
+func (x *ExportOrdersResp) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ExportOrdersResp) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportOrdersResp) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ExportOrdersResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetInvoiceReq struct {
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id" json:"order_id,omitempty"`
+	Format  string `protobuf:"bytes,3,opt,name=format" json:"format,omitempty"` // pdf（默认）/ html
+}
+
+func (x *GetInvoiceReq) Reset() { *x = GetInvoiceReq{} }
+
+func (x *GetInvoiceReq) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetInvoiceReq) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetInvoiceReq) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetInvoiceReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetInvoiceReq) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type GetInvoiceResp struct {
+	InvoiceNo   string `protobuf:"bytes,1,opt,name=invoice_no" json:"invoice_no,omitempty"`
+	FileName    string `protobuf:"bytes,2,opt,name=file_name" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type" json:"content_type,omitempty"`
+	Content     []byte `protobuf:"bytes,4,opt,name=content" json:"content,omitempty"`
+}
+
+func (x *GetInvoiceResp) Reset() { *x = GetInvoiceResp{} }
+
+func (x *GetInvoiceResp) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetInvoiceResp) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetInvoiceResp) GetInvoiceNo() string {
+	if x != nil {
+		return x.InvoiceNo
+	}
+	return ""
+}
+
+func (x *GetInvoiceResp) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *GetInvoiceResp) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetInvoiceResp) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
 type CancelOrderReq struct {
 	OrderId string `protobuf:"bytes,1,opt,name=order_id" json:"order_id,omitempty"`
 }
@@ -343,7 +557,10 @@ func (x *CancelOrderResp) GetSuccess() bool {
 
 type OrderService interface {
 	ListOrder(ctx context.Context, req *ListOrderReq) (res *ListOrderResp, err error)
+	GetOrder(ctx context.Context, req *GetOrderReq) (res *GetOrderResp, err error)
 	CancelOrder(ctx context.Context, req *CancelOrderReq) (res *CancelOrderResp, err error)
 	PlaceOrder(ctx context.Context, req *PlaceOrderReq) (res *PlaceOrderResp, err error)
 	MarkOrderPaid(ctx context.Context, req *MarkOrderPaidReq) (res *MarkOrderPaidResp, err error)
+	ExportOrders(ctx context.Context, req *ExportOrdersReq) (res *ExportOrdersResp, err error)
+	GetInvoice(ctx context.Context, req *GetInvoiceReq) (res *GetInvoiceResp, err error)
 }
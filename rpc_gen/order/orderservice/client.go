@@ -12,9 +12,12 @@ import (
 // Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
 type Client interface {
 	ListOrder(ctx context.Context, Req *order.ListOrderReq, callOptions ...callopt.Option) (r *order.ListOrderResp, err error)
+	GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error)
 	CancelOrder(ctx context.Context, Req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error)
 	PlaceOrder(ctx context.Context, Req *order.PlaceOrderReq, callOptions ...callopt.Option) (r *order.PlaceOrderResp, err error)
 	MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq, callOptions ...callopt.Option) (r *order.MarkOrderPaidResp, err error)
+	ExportOrders(ctx context.Context, Req *order.ExportOrdersReq, callOptions ...callopt.Option) (r *order.ExportOrdersResp, err error)
+	GetInvoice(ctx context.Context, Req *order.GetInvoiceReq, callOptions ...callopt.Option) (r *order.GetInvoiceResp, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -51,6 +54,11 @@ func (p *kOrderServiceClient) ListOrder(ctx context.Context, Req *order.ListOrde
 	return p.kClient.ListOrder(ctx, Req)
 }
 
+func (p *kOrderServiceClient) GetOrder(ctx context.Context, Req *order.GetOrderReq, callOptions ...callopt.Option) (r *order.GetOrderResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetOrder(ctx, Req)
+}
+
 func (p *kOrderServiceClient) CancelOrder(ctx context.Context, Req *order.CancelOrderReq, callOptions ...callopt.Option) (r *order.CancelOrderResp, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.CancelOrder(ctx, Req)
@@ -65,3 +73,13 @@ func (p *kOrderServiceClient) MarkOrderPaid(ctx context.Context, Req *order.Mark
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.MarkOrderPaid(ctx, Req)
 }
+
+func (p *kOrderServiceClient) ExportOrders(ctx context.Context, Req *order.ExportOrdersReq, callOptions ...callopt.Option) (r *order.ExportOrdersResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportOrders(ctx, Req)
+}
+
+func (p *kOrderServiceClient) GetInvoice(ctx context.Context, Req *order.GetInvoiceReq, callOptions ...callopt.Option) (r *order.GetInvoiceResp, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetInvoice(ctx, Req)
+}
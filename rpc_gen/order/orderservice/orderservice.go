@@ -22,6 +22,13 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"GetOrder": kitex.NewMethodInfo(
+		getOrderHandler,
+		newGetOrderArgs,
+		newGetOrderResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"CancelOrder": kitex.NewMethodInfo(
 		cancelOrderHandler,
 		newCancelOrderArgs,
@@ -43,6 +50,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"ExportOrders": kitex.NewMethodInfo(
+		exportOrdersHandler,
+		newExportOrdersArgs,
+		newExportOrdersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetInvoice": kitex.NewMethodInfo(
+		getInvoiceHandler,
+		newGetInvoiceArgs,
+		newGetInvoiceResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -220,6 +241,117 @@ func (p *ListOrderResult) GetResult() interface{} {
 	return p.Success
 }
 
+func getOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.GetOrderReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).GetOrder(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetOrderArgs:
+		success, err := handler.(order.OrderService).GetOrder(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetOrderResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetOrderArgs() interface{} {
+	return &GetOrderArgs{}
+}
+
+func newGetOrderResult() interface{} {
+	return &GetOrderResult{}
+}
+
+type GetOrderArgs struct {
+	Req *order.GetOrderReq
+}
+
+func (p *GetOrderArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetOrderArgs) Unmarshal(in []byte) error {
+	msg := new(order.GetOrderReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetOrderArgs_Req_DEFAULT *order.GetOrderReq
+
+func (p *GetOrderArgs) GetReq() *order.GetOrderReq {
+	if !p.IsSetReq() {
+		return GetOrderArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetOrderArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetOrderArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetOrderResult struct {
+	Success *order.GetOrderResp
+}
+
+var GetOrderResult_Success_DEFAULT *order.GetOrderResp
+
+func (p *GetOrderResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetOrderResult) Unmarshal(in []byte) error {
+	msg := new(order.GetOrderResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetOrderResult) GetSuccess() *order.GetOrderResp {
+	if !p.IsSetSuccess() {
+		return GetOrderResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetOrderResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.GetOrderResp)
+}
+
+func (p *GetOrderResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetOrderResult) GetResult() interface{} {
+	return p.Success
+}
+
 func cancelOrderHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
@@ -553,6 +685,228 @@ func (p *MarkOrderPaidResult) GetResult() interface{} {
 	return p.Success
 }
 
+func exportOrdersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.ExportOrdersReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).ExportOrders(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ExportOrdersArgs:
+		success, err := handler.(order.OrderService).ExportOrders(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportOrdersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newExportOrdersArgs() interface{} {
+	return &ExportOrdersArgs{}
+}
+
+func newExportOrdersResult() interface{} {
+	return &ExportOrdersResult{}
+}
+
+type ExportOrdersArgs struct {
+	Req *order.ExportOrdersReq
+}
+
+func (p *ExportOrdersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportOrdersArgs) Unmarshal(in []byte) error {
+	msg := new(order.ExportOrdersReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportOrdersArgs_Req_DEFAULT *order.ExportOrdersReq
+
+func (p *ExportOrdersArgs) GetReq() *order.ExportOrdersReq {
+	if !p.IsSetReq() {
+		return ExportOrdersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportOrdersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportOrdersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportOrdersResult struct {
+	Success *order.ExportOrdersResp
+}
+
+var ExportOrdersResult_Success_DEFAULT *order.ExportOrdersResp
+
+func (p *ExportOrdersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportOrdersResult) Unmarshal(in []byte) error {
+	msg := new(order.ExportOrdersResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportOrdersResult) GetSuccess() *order.ExportOrdersResp {
+	if !p.IsSetSuccess() {
+		return ExportOrdersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportOrdersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.ExportOrdersResp)
+}
+
+func (p *ExportOrdersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportOrdersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getInvoiceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(order.GetInvoiceReq)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(order.OrderService).GetInvoice(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetInvoiceArgs:
+		success, err := handler.(order.OrderService).GetInvoice(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetInvoiceResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetInvoiceArgs() interface{} {
+	return &GetInvoiceArgs{}
+}
+
+func newGetInvoiceResult() interface{} {
+	return &GetInvoiceResult{}
+}
+
+type GetInvoiceArgs struct {
+	Req *order.GetInvoiceReq
+}
+
+func (p *GetInvoiceArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetInvoiceArgs) Unmarshal(in []byte) error {
+	msg := new(order.GetInvoiceReq)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetInvoiceArgs_Req_DEFAULT *order.GetInvoiceReq
+
+func (p *GetInvoiceArgs) GetReq() *order.GetInvoiceReq {
+	if !p.IsSetReq() {
+		return GetInvoiceArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetInvoiceArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetInvoiceArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetInvoiceResult struct {
+	Success *order.GetInvoiceResp
+}
+
+var GetInvoiceResult_Success_DEFAULT *order.GetInvoiceResp
+
+func (p *GetInvoiceResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetInvoiceResult) Unmarshal(in []byte) error {
+	msg := new(order.GetInvoiceResp)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetInvoiceResult) GetSuccess() *order.GetInvoiceResp {
+	if !p.IsSetSuccess() {
+		return GetInvoiceResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetInvoiceResult) SetSuccess(x interface{}) {
+	p.Success = x.(*order.GetInvoiceResp)
+}
+
+func (p *GetInvoiceResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetInvoiceResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -573,6 +927,16 @@ func (p *kClient) ListOrder(ctx context.Context, Req *order.ListOrderReq) (r *or
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetOrder(ctx context.Context, Req *order.GetOrderReq) (r *order.GetOrderResp, err error) {
+	var _args GetOrderArgs
+	_args.Req = Req
+	var _result GetOrderResult
+	if err = p.c.Call(ctx, "GetOrder", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) CancelOrder(ctx context.Context, Req *order.CancelOrderReq) (r *order.CancelOrderResp, err error) {
 	var _args CancelOrderArgs
 	_args.Req = Req
@@ -602,3 +966,23 @@ func (p *kClient) MarkOrderPaid(ctx context.Context, Req *order.MarkOrderPaidReq
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ExportOrders(ctx context.Context, Req *order.ExportOrdersReq) (r *order.ExportOrdersResp, err error) {
+	var _args ExportOrdersArgs
+	_args.Req = Req
+	var _result ExportOrdersResult
+	if err = p.c.Call(ctx, "ExportOrders", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetInvoice(ctx context.Context, Req *order.GetInvoiceReq) (r *order.GetInvoiceResp, err error) {
+	var _args GetInvoiceArgs
+	_args.Req = Req
+	var _result GetInvoiceResult
+	if err = p.c.Call(ctx, "GetInvoice", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
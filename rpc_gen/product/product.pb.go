@@ -11,20 +11,22 @@ import (
 // 消息结构定义 (Messages)
 // SPU 模型
 type ProductSPU struct {
-	Id            uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	BrandId       uint64 `protobuf:"varint,2,opt,name=brand_id" json:"brand_id,omitempty"`
-	CategoryId    uint64 `protobuf:"varint,3,opt,name=category_id" json:"category_id,omitempty"`
-	Name          string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
-	SubTitle      string `protobuf:"bytes,5,opt,name=sub_title" json:"sub_title,omitempty"`
-	MainImage     string `protobuf:"bytes,6,opt,name=main_image" json:"main_image,omitempty"`
-	PublishStatus int32  `protobuf:"varint,7,opt,name=publish_status" json:"publish_status,omitempty"`
-	VerifyStatus  int32  `protobuf:"varint,8,opt,name=verify_status" json:"verify_status,omitempty"`
-	LowPrice      string `protobuf:"bytes,9,opt,name=low_price" json:"low_price,omitempty"` // Decimal 推荐用 string 传输以防精度丢失
-	HighPrice     string `protobuf:"bytes,10,opt,name=high_price" json:"high_price,omitempty"`
-	SaleCount     int32  `protobuf:"varint,11,opt,name=sale_count" json:"sale_count,omitempty"`
-	Sort          int32  `protobuf:"varint,12,opt,name=sort" json:"sort,omitempty"`
-	ServiceBits   int64  `protobuf:"varint,13,opt,name=service_bits" json:"service_bits,omitempty"`
-	Version       int32  `protobuf:"varint,14,opt,name=version" json:"version,omitempty"`
+	Id               uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	BrandId          uint64 `protobuf:"varint,2,opt,name=brand_id" json:"brand_id,omitempty"`
+	CategoryId       uint64 `protobuf:"varint,3,opt,name=category_id" json:"category_id,omitempty"`
+	Name             string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	SubTitle         string `protobuf:"bytes,5,opt,name=sub_title" json:"sub_title,omitempty"`
+	MainImage        string `protobuf:"bytes,6,opt,name=main_image" json:"main_image,omitempty"`
+	PublishStatus    int32  `protobuf:"varint,7,opt,name=publish_status" json:"publish_status,omitempty"`
+	VerifyStatus     int32  `protobuf:"varint,8,opt,name=verify_status" json:"verify_status,omitempty"`
+	LowPrice         string `protobuf:"bytes,9,opt,name=low_price" json:"low_price,omitempty"` // Decimal 推荐用 string 传输以防精度丢失
+	HighPrice        string `protobuf:"bytes,10,opt,name=high_price" json:"high_price,omitempty"`
+	SaleCount        int32  `protobuf:"varint,11,opt,name=sale_count" json:"sale_count,omitempty"`
+	Sort             int32  `protobuf:"varint,12,opt,name=sort" json:"sort,omitempty"`
+	ServiceBits      int64  `protobuf:"varint,13,opt,name=service_bits" json:"service_bits,omitempty"`
+	Version          int32  `protobuf:"varint,14,opt,name=version" json:"version,omitempty"`
+	MainImageAssetId uint64 `protobuf:"varint,15,opt,name=main_image_asset_id" json:"main_image_asset_id,omitempty"` // 写入时使用，非 0 时以媒体资源的地址作为 main_image
+	MerchantId       uint64 `protobuf:"varint,16,opt,name=merchant_id" json:"merchant_id,omitempty"`                 // 只读，所属商家，0 为平台商品
 }
 
 func (x *ProductSPU) Reset() { *x = ProductSPU{} }
@@ -131,14 +133,30 @@ func (x *ProductSPU) GetVersion() int32 {
 	return 0
 }
 
+func (x *ProductSPU) GetMainImageAssetId() uint64 {
+	if x != nil {
+		return x.MainImageAssetId
+	}
+	return 0
+}
+
+func (x *ProductSPU) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 // SPU detail
 type ProductDetail struct {
-	Description   string   `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`         // 富文本描述
-	Images        []string `protobuf:"bytes,2,rep,name=images" json:"images,omitempty"`                   // 商品图
-	Videos        []string `protobuf:"bytes,3,rep,name=videos" json:"videos,omitempty"`                   // 商品视频
-	MarketTagJson string   `protobuf:"bytes,4,opt,name=market_tag_json" json:"market_tag_json,omitempty"` // 营销标签 JSON 字符串
-	TechTagJson   string   `protobuf:"bytes,5,opt,name=tech_tag_json" json:"tech_tag_json,omitempty"`     // 技术参数 JSON 字符串
-	FaqJson       string   `protobuf:"bytes,6,opt,name=faq_json" json:"faq_json,omitempty"`               // 常见问题 JSON 字符串
+	Description   string   `protobuf:"bytes,1,opt,name=description" json:"description,omitempty"`                 // 富文本描述
+	Images        []string `protobuf:"bytes,2,rep,name=images" json:"images,omitempty"`                           // 商品图
+	Videos        []string `protobuf:"bytes,3,rep,name=videos" json:"videos,omitempty"`                           // 商品视频
+	MarketTagJson string   `protobuf:"bytes,4,opt,name=market_tag_json" json:"market_tag_json,omitempty"`         // 营销标签 JSON 字符串
+	TechTagJson   string   `protobuf:"bytes,5,opt,name=tech_tag_json" json:"tech_tag_json,omitempty"`             // 技术参数 JSON 字符串
+	FaqJson       string   `protobuf:"bytes,6,opt,name=faq_json" json:"faq_json,omitempty"`                       // 常见问题 JSON 字符串
+	ImageAssetIds []uint64 `protobuf:"varint,7,rep,packed,name=image_asset_ids" json:"image_asset_ids,omitempty"` // 写入时使用，非空时按顺序解析为 images
+	VideoAssetIds []uint64 `protobuf:"varint,8,rep,packed,name=video_asset_ids" json:"video_asset_ids,omitempty"` // 写入时使用，非空时按顺序解析为 videos
 }
 
 func (x *ProductDetail) Reset() { *x = ProductDetail{} }
@@ -189,20 +207,36 @@ func (x *ProductDetail) GetFaqJson() string {
 	return ""
 }
 
+func (x *ProductDetail) GetImageAssetIds() []uint64 {
+	if x != nil {
+		return x.ImageAssetIds
+	}
+	return nil
+}
+
+func (x *ProductDetail) GetVideoAssetIds() []uint64 {
+	if x != nil {
+		return x.VideoAssetIds
+	}
+	return nil
+}
+
 // SKU 模型
 type ProductSKU struct {
-	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	SpuId       uint64 `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`
-	SkuCode     string `protobuf:"bytes,3,opt,name=sku_code" json:"sku_code,omitempty"`
-	Name        string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
-	SubTitle    string `protobuf:"bytes,5,opt,name=sub_title" json:"sub_title,omitempty"`
-	MainImage   string `protobuf:"bytes,6,opt,name=main_image" json:"main_image,omitempty"`
-	Price       string `protobuf:"bytes,7,opt,name=price" json:"price,omitempty"`
-	MarketPrice string `protobuf:"bytes,8,opt,name=market_price" json:"market_price,omitempty"`
-	Stock       int32  `protobuf:"varint,9,opt,name=stock" json:"stock,omitempty"`
-	LockStock   int32  `protobuf:"varint,10,opt,name=lock_stock" json:"lock_stock,omitempty"`
-	SkuSpecData string `protobuf:"bytes,11,opt,name=sku_spec_data" json:"sku_spec_data,omitempty"` // JSON 字符串
-	Version     int32  `protobuf:"varint,12,opt,name=version" json:"version,omitempty"`
+	Id               uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	SpuId            uint64 `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`
+	SkuCode          string `protobuf:"bytes,3,opt,name=sku_code" json:"sku_code,omitempty"`
+	Name             string `protobuf:"bytes,4,opt,name=name" json:"name,omitempty"`
+	SubTitle         string `protobuf:"bytes,5,opt,name=sub_title" json:"sub_title,omitempty"`
+	MainImage        string `protobuf:"bytes,6,opt,name=main_image" json:"main_image,omitempty"`
+	Price            string `protobuf:"bytes,7,opt,name=price" json:"price,omitempty"`
+	MarketPrice      string `protobuf:"bytes,8,opt,name=market_price" json:"market_price,omitempty"`
+	Stock            int32  `protobuf:"varint,9,opt,name=stock" json:"stock,omitempty"`
+	LockStock        int32  `protobuf:"varint,10,opt,name=lock_stock" json:"lock_stock,omitempty"`
+	SkuSpecData      string `protobuf:"bytes,11,opt,name=sku_spec_data" json:"sku_spec_data,omitempty"` // JSON 字符串
+	Version          int32  `protobuf:"varint,12,opt,name=version" json:"version,omitempty"`
+	MainImageAssetId uint64 `protobuf:"varint,13,opt,name=main_image_asset_id" json:"main_image_asset_id,omitempty"` // 写入时使用，非 0 时以媒体资源的地址作为 main_image
+	OnSale           bool   `protobuf:"varint,14,opt,name=on_sale" json:"on_sale,omitempty"`                         // 只读，SKU 及所属商品均已上架且审核通过，可加购和下单
 }
 
 func (x *ProductSKU) Reset() { *x = ProductSKU{} }
@@ -295,16 +329,31 @@ func (x *ProductSKU) GetVersion() int32 {
 	return 0
 }
 
+func (x *ProductSKU) GetMainImageAssetId() uint64 {
+	if x != nil {
+		return x.MainImageAssetId
+	}
+	return 0
+}
+
+func (x *ProductSKU) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
 // 分类模型
 type Category struct {
-	Id       uint64      `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	ParentId uint64      `protobuf:"varint,2,opt,name=parent_id" json:"parent_id,omitempty"`
-	Name     string      `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
-	Level    int32       `protobuf:"varint,4,opt,name=level" json:"level,omitempty"`
-	Icon     string      `protobuf:"bytes,5,opt,name=icon" json:"icon,omitempty"`
-	Unit     string      `protobuf:"bytes,6,opt,name=unit" json:"unit,omitempty"`
-	Sort     int32       `protobuf:"varint,7,opt,name=sort" json:"sort,omitempty"`
-	Children []*Category `protobuf:"bytes,8,rep,name=children" json:"children,omitempty"` // 树形结构返回时用
+	Id         uint64      `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ParentId   uint64      `protobuf:"varint,2,opt,name=parent_id" json:"parent_id,omitempty"`
+	Name       string      `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Level      int32       `protobuf:"varint,4,opt,name=level" json:"level,omitempty"`
+	Icon       string      `protobuf:"bytes,5,opt,name=icon" json:"icon,omitempty"`
+	Unit       string      `protobuf:"bytes,6,opt,name=unit" json:"unit,omitempty"`
+	Sort       int32       `protobuf:"varint,7,opt,name=sort" json:"sort,omitempty"`
+	Children   []*Category `protobuf:"bytes,8,rep,name=children" json:"children,omitempty"`        // 树形结构返回时用
+	ShowStatus bool        `protobuf:"varint,9,opt,name=show_status" json:"show_status,omitempty"` // 是否显示
 }
 
 func (x *Category) Reset() { *x = Category{} }
@@ -369,13 +418,22 @@ func (x *Category) GetChildren() []*Category {
 	return nil
 }
 
+func (x *Category) GetShowStatus() bool {
+	if x != nil {
+		return x.ShowStatus
+	}
+	return false
+}
+
 // 品牌模型
 type Brand struct {
-	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
-	FirstLetter string `protobuf:"bytes,3,opt,name=first_letter" json:"first_letter,omitempty"`
-	Logo        string `protobuf:"bytes,4,opt,name=logo" json:"logo,omitempty"`
-	Sort        int32  `protobuf:"varint,5,opt,name=sort" json:"sort,omitempty"`
+	Id          uint64   `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	FirstLetter string   `protobuf:"bytes,3,opt,name=first_letter" json:"first_letter,omitempty"`
+	Logo        string   `protobuf:"bytes,4,opt,name=logo" json:"logo,omitempty"`
+	Sort        int32    `protobuf:"varint,5,opt,name=sort" json:"sort,omitempty"`
+	ShowStatus  bool     `protobuf:"varint,6,opt,name=show_status" json:"show_status,omitempty"`          // 是否显示
+	CategoryIds []uint64 `protobuf:"varint,7,rep,packed,name=category_ids" json:"category_ids,omitempty"` // 关联的分类，仅后台列表返回
 }
 
 func (x *Brand) Reset() { *x = Brand{} }
@@ -419,12 +477,154 @@ func (x *Brand) GetSort() int32 {
 	return 0
 }
 
+func (x *Brand) GetShowStatus() bool {
+	if x != nil {
+		return x.ShowStatus
+	}
+	return false
+}
+
+func (x *Brand) GetCategoryIds() []uint64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+// 规格属性 (分类规格模板的一项)
+type SpecAttribute struct {
+	CategoryId uint64   `protobuf:"varint,1,opt,name=category_id" json:"category_id,omitempty"` // 定义该属性的分类，继承自上级分类时与所查询的分类不同
+	Name       string   `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Type       string   `protobuf:"bytes,3,opt,name=type" json:"type,omitempty"`              // enum(枚举) / text(文本) / number(数值)
+	Values     []string `protobuf:"bytes,4,rep,name=values" json:"values,omitempty"`          // 可选值，enum 类型必填
+	Filterable bool     `protobuf:"varint,5,opt,name=filterable" json:"filterable,omitempty"` // 是否作为搜索筛选项
+	Sales      bool     `protobuf:"varint,6,opt,name=sales" json:"sales,omitempty"`           // 是否销售属性 (区分 SKU，每个 SKU 必填，用于前台规格选择)
+	Sort       int32    `protobuf:"varint,7,opt,name=sort" json:"sort,omitempty"`
+}
+
+func (x *SpecAttribute) Reset() { *x = SpecAttribute{} }
+
+func (x *SpecAttribute) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SpecAttribute) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SpecAttribute) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SpecAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecAttribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SpecAttribute) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SpecAttribute) GetFilterable() bool {
+	if x != nil {
+		return x.Filterable
+	}
+	return false
+}
+
+func (x *SpecAttribute) GetSales() bool {
+	if x != nil {
+		return x.Sales
+	}
+	return false
+}
+
+func (x *SpecAttribute) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+// 前台规格选择器，只包含商品 SKU 实际用到的取值，按模板顺序排列
+type SpecSelector struct {
+	Name   string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty"`
+}
+
+func (x *SpecSelector) Reset() { *x = SpecSelector{} }
+
+func (x *SpecSelector) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SpecSelector) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SpecSelector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecSelector) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 商品评分汇总 (来自评价服务，只统计已通过的评价)
+type ProductRating struct {
+	ReviewCount   int64   `protobuf:"varint,1,opt,name=review_count" json:"review_count,omitempty"`
+	AverageRating float64 `protobuf:"fixed64,2,opt,name=average_rating" json:"average_rating,omitempty"`     // 平均星级，保留一位小数
+	RatingCounts  []int64 `protobuf:"varint,3,rep,packed,name=rating_counts" json:"rating_counts,omitempty"` // 1-5 星各自的评价数
+}
+
+func (x *ProductRating) Reset() { *x = ProductRating{} }
+
+func (x *ProductRating) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ProductRating) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ProductRating) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *ProductRating) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *ProductRating) GetRatingCounts() []int64 {
+	if x != nil {
+		return x.RatingCounts
+	}
+	return nil
+}
+
 // --- 请求与响应定义 ---
 // 1. CreateProduct
 type CreateProductRequest struct {
-	Spu    *ProductSPU    `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`       // 基础信息
-	Skus   []*ProductSKU  `protobuf:"bytes,2,rep,name=skus" json:"skus,omitempty"`     // 对应的规格列表
-	Detail *ProductDetail `protobuf:"bytes,3,opt,name=detail" json:"detail,omitempty"` // 详细信息
+	Spu        *ProductSPU    `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`                  // 基础信息
+	Skus       []*ProductSKU  `protobuf:"bytes,2,rep,name=skus" json:"skus,omitempty"`                // 对应的规格列表
+	Detail     *ProductDetail `protobuf:"bytes,3,opt,name=detail" json:"detail,omitempty"`            // 详细信息
+	OperatorId uint64         `protobuf:"varint,4,opt,name=operator_id" json:"operator_id,omitempty"` // 操作人，记入变更历史
+	MerchantId uint64         `protobuf:"varint,5,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商品所属商家，0 为平台商品
 }
 
 func (x *CreateProductRequest) Reset() { *x = CreateProductRequest{} }
@@ -454,6 +654,20 @@ func (x *CreateProductRequest) GetDetail() *ProductDetail {
 	return nil
 }
 
+func (x *CreateProductRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type CreateProductResponse struct {
 	SpuId uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
 }
@@ -475,8 +689,10 @@ func (x *CreateProductResponse) GetSpuId() uint64 {
 
 // 2. UpdateProduct
 type UpdateProductRequest struct {
-	Spu    *ProductSPU    `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`       // 只更新非空字段
-	Detail *ProductDetail `protobuf:"bytes,2,opt,name=detail" json:"detail,omitempty"` // 详细信息
+	Spu        *ProductSPU    `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`                  // 只更新非空字段
+	Detail     *ProductDetail `protobuf:"bytes,2,opt,name=detail" json:"detail,omitempty"`            // 详细信息
+	OperatorId uint64         `protobuf:"varint,3,opt,name=operator_id" json:"operator_id,omitempty"` // 操作人，记入变更历史
+	MerchantId uint64         `protobuf:"varint,4,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
 }
 
 func (x *UpdateProductRequest) Reset() { *x = UpdateProductRequest{} }
@@ -499,6 +715,20 @@ func (x *UpdateProductRequest) GetDetail() *ProductDetail {
 	return nil
 }
 
+func (x *UpdateProductRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type UpdateProductResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
 }
@@ -522,7 +752,8 @@ func (x *UpdateProductResponse) GetSuccess() bool {
 type UpdateProductStatusRequest struct {
 	Ids           []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
 	PublishStatus int32    `protobuf:"varint,2,opt,name=publish_status" json:"publish_status,omitempty"` // 0:不变更, 1:上架, 2:下架
-	VerifyStatus  int32    `protobuf:"varint,3,opt,name=verify_status" json:"verify_status,omitempty"`   // 0:不变更, 1:审核通过...
+	VerifyStatus  int32    `protobuf:"varint,3,opt,name=verify_status" json:"verify_status,omitempty"`   // 已废弃，必须为 0，审核状态只能通过 AuditProducts 变更
+	MerchantId    uint64   `protobuf:"varint,4,opt,name=merchant_id" json:"merchant_id,omitempty"`       // 商家范围，非 0 时只能操作该商家的商品
 }
 
 func (x *UpdateProductStatusRequest) Reset() { *x = UpdateProductStatusRequest{} }
@@ -554,6 +785,13 @@ func (x *UpdateProductStatusRequest) GetVerifyStatus() int32 {
 	return 0
 }
 
+func (x *UpdateProductStatusRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type UpdateProductStatusResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
 }
@@ -575,7 +813,8 @@ func (x *UpdateProductStatusResponse) GetSuccess() bool {
 
 // 4. GetProductDetail
 type GetProductDetailRequest struct {
-	Id uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Id                 uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	IncludeUnavailable bool   `protobuf:"varint,2,opt,name=include_unavailable" json:"include_unavailable,omitempty"` // 后台预览使用，默认只返回已上架且审核通过的商品
 }
 
 func (x *GetProductDetailRequest) Reset() { *x = GetProductDetailRequest{} }
@@ -593,12 +832,21 @@ func (x *GetProductDetailRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *GetProductDetailRequest) GetIncludeUnavailable() bool {
+	if x != nil {
+		return x.IncludeUnavailable
+	}
+	return false
+}
+
 type GetProductDetailResponse struct {
-	Spu      *ProductSPU    `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`
-	Skus     []*ProductSKU  `protobuf:"bytes,2,rep,name=skus" json:"skus,omitempty"`
-	Category *Category      `protobuf:"bytes,3,opt,name=category" json:"category,omitempty"`
-	Brand    *Brand         `protobuf:"bytes,4,opt,name=brand" json:"brand,omitempty"`
-	Detail   *ProductDetail `protobuf:"bytes,5,opt,name=detail" json:"detail,omitempty"`
+	Spu           *ProductSPU     `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`
+	Skus          []*ProductSKU   `protobuf:"bytes,2,rep,name=skus" json:"skus,omitempty"`
+	Category      *Category       `protobuf:"bytes,3,opt,name=category" json:"category,omitempty"`
+	Brand         *Brand          `protobuf:"bytes,4,opt,name=brand" json:"brand,omitempty"`
+	Detail        *ProductDetail  `protobuf:"bytes,5,opt,name=detail" json:"detail,omitempty"`
+	Rating        *ProductRating  `protobuf:"bytes,6,opt,name=rating" json:"rating,omitempty"`                 // 评价服务不可用时为空
+	SpecSelectors []*SpecSelector `protobuf:"bytes,7,rep,name=spec_selectors" json:"spec_selectors,omitempty"` // 分类未配置规格模板时为空
 }
 
 func (x *GetProductDetailResponse) Reset() { *x = GetProductDetailResponse{} }
@@ -644,6 +892,20 @@ func (x *GetProductDetailResponse) GetDetail() *ProductDetail {
 	return nil
 }
 
+func (x *GetProductDetailResponse) GetRating() *ProductRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *GetProductDetailResponse) GetSpecSelectors() []*SpecSelector {
+	if x != nil {
+		return x.SpecSelectors
+	}
+	return nil
+}
+
 // 5. ListProducts (后台筛选)
 type ListProductsRequest struct {
 	Page          int32  `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
@@ -652,7 +914,9 @@ type ListProductsRequest struct {
 	CategoryId    uint64 `protobuf:"varint,4,opt,name=category_id" json:"category_id,omitempty"`
 	BrandId       uint64 `protobuf:"varint,5,opt,name=brand_id" json:"brand_id,omitempty"`
 	PublishStatus int32  `protobuf:"varint,6,opt,name=publish_status" json:"publish_status,omitempty"` // -1表示全部
-	VerifyStatus  int32  `protobuf:"varint,7,opt,name=verify_status" json:"verify_status,omitempty"`
+	VerifyStatus  int32  `protobuf:"varint,7,opt,name=verify_status" json:"verify_status,omitempty"`   // -1表示全部
+	Deleted       bool   `protobuf:"varint,8,opt,name=deleted" json:"deleted,omitempty"`               // true 时只查询已删除的商品
+	MerchantId    uint64 `protobuf:"varint,9,opt,name=merchant_id" json:"merchant_id,omitempty"`       // 商家范围，非 0 时只查询该商家的商品
 }
 
 func (x *ListProductsRequest) Reset() { *x = ListProductsRequest{} }
@@ -710,6 +974,20 @@ func (x *ListProductsRequest) GetVerifyStatus() int32 {
 	return 0
 }
 
+func (x *ListProductsRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type ListProductsResponse struct {
 	List  []*ProductSPU `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
 	Total int64         `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
@@ -735,6 +1013,98 @@ func (x *ListProductsResponse) GetTotal() int64 {
 	return 0
 }
 
+// DeleteProduct
+type DeleteProductRequest struct {
+	Ids        []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	MerchantId uint64   `protobuf:"varint,2,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
+}
+
+func (x *DeleteProductRequest) Reset() { *x = DeleteProductRequest{} }
+
+func (x *DeleteProductRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DeleteProductRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteProductRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteProductRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	Affected int64 `protobuf:"varint,1,opt,name=affected" json:"affected,omitempty"` // 实际删除数量
+}
+
+func (x *DeleteProductResponse) Reset() { *x = DeleteProductResponse{} }
+
+func (x *DeleteProductResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DeleteProductResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteProductResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// RestoreProduct
+type RestoreProductRequest struct {
+	Ids        []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	MerchantId uint64   `protobuf:"varint,2,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
+}
+
+func (x *RestoreProductRequest) Reset() { *x = RestoreProductRequest{} }
+
+func (x *RestoreProductRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RestoreProductRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RestoreProductRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *RestoreProductRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type RestoreProductResponse struct {
+	Affected int64 `protobuf:"varint,1,opt,name=affected" json:"affected,omitempty"` // 实际恢复数量
+}
+
+func (x *RestoreProductResponse) Reset() { *x = RestoreProductResponse{} }
+
+func (x *RestoreProductResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RestoreProductResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RestoreProductResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
 // 6. GetProductsByIds
 type GetProductsByIdsRequest struct {
 	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
@@ -811,7 +1181,9 @@ func (x *GetSkusByIdsResponse) GetSkus() map[uint64]*ProductSKU {
 
 // 8. BatchUpdateSku
 type BatchUpdateSkuRequest struct {
-	Skus []*ProductSKU `protobuf:"bytes,1,rep,name=skus" json:"skus,omitempty"` // 根据 sku_id 更新 price/stock
+	Skus       []*ProductSKU `protobuf:"bytes,1,rep,name=skus" json:"skus,omitempty"`                // 根据 sku_id 更新 price/stock
+	OperatorId uint64        `protobuf:"varint,2,opt,name=operator_id" json:"operator_id,omitempty"` // 操作人，记入变更历史
+	MerchantId uint64        `protobuf:"varint,3,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
 }
 
 func (x *BatchUpdateSkuRequest) Reset() { *x = BatchUpdateSkuRequest{} }
@@ -829,6 +1201,20 @@ func (x *BatchUpdateSkuRequest) GetSkus() []*ProductSKU {
 	return nil
 }
 
+func (x *BatchUpdateSkuRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *BatchUpdateSkuRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
 type BatchUpdateSkuResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
 }
@@ -961,7 +1347,8 @@ func (x *ReleaseStockResponse) GetSuccess() bool {
 
 // 11. ListCategories
 type ListCategoriesRequest struct {
-	ParentId uint64 `protobuf:"varint,1,opt,name=parent_id" json:"parent_id,omitempty"` // 0 获取所有一级，或者 -1 获取全树
+	ParentId      uint64 `protobuf:"varint,1,opt,name=parent_id" json:"parent_id,omitempty"`           // 0 获取所有一级，或者 -1 获取全树
+	IncludeHidden bool   `protobuf:"varint,2,opt,name=include_hidden" json:"include_hidden,omitempty"` // 后台管理用，包含隐藏的分类
 }
 
 func (x *ListCategoriesRequest) Reset() { *x = ListCategoriesRequest{} }
@@ -979,6 +1366,13 @@ func (x *ListCategoriesRequest) GetParentId() uint64 {
 	return 0
 }
 
+func (x *ListCategoriesRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
 type ListCategoriesResponse struct {
 	Categories []*Category `protobuf:"bytes,1,rep,name=categories" json:"categories,omitempty"`
 }
@@ -1000,9 +1394,11 @@ func (x *ListCategoriesResponse) GetCategories() []*Category {
 
 // 12. ListBrands
 type ListBrandsRequest struct {
-	Page     int32 `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
-}
+	Page          int32  `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
+	CategoryId    uint64 `protobuf:"varint,3,opt,name=category_id" json:"category_id,omitempty"`       // 只返回关联到该分类（含子分类）的品牌，0 表示不过滤
+	IncludeHidden bool   `protobuf:"varint,4,opt,name=include_hidden" json:"include_hidden,omitempty"` // 后台管理用，包含隐藏的品牌并返回关联分类
+}
 
 func (x *ListBrandsRequest) Reset() { *x = ListBrandsRequest{} }
 
@@ -1024,6 +1420,20 @@ func (x *ListBrandsRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *ListBrandsRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListBrandsRequest) GetIncludeHidden() bool {
+	if x != nil {
+		return x.IncludeHidden
+	}
+	return false
+}
+
 type ListBrandsResponse struct {
 	Brands []*Brand `protobuf:"bytes,1,rep,name=brands" json:"brands,omitempty"`
 	Total  int64    `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
@@ -1051,14 +1461,18 @@ func (x *ListBrandsResponse) GetTotal() int64 {
 
 // 13. SearchProducts (C端商品搜索，返回可购买的 SKU)
 type SearchProductsRequest struct {
-	Page       int32  `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
-	PageSize   int32  `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
-	Keyword    string `protobuf:"bytes,3,opt,name=keyword" json:"keyword,omitempty"`          // 搜索关键词
-	CategoryId uint64 `protobuf:"varint,4,opt,name=category_id" json:"category_id,omitempty"` // 分类筛选
-	BrandId    uint64 `protobuf:"varint,5,opt,name=brand_id" json:"brand_id,omitempty"`       // 品牌筛选
-	MinPrice   string `protobuf:"bytes,6,opt,name=min_price" json:"min_price,omitempty"`      // 最低价格
-	MaxPrice   string `protobuf:"bytes,7,opt,name=max_price" json:"max_price,omitempty"`      // 最高价格
-	SortType   int32  `protobuf:"varint,8,opt,name=sort_type" json:"sort_type,omitempty"`     // 排序: 0-综合, 1-价格升序, 2-价格降序, 3-销量降序
+	Page        int32         `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	PageSize    int32         `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
+	Keyword     string        `protobuf:"bytes,3,opt,name=keyword" json:"keyword,omitempty"`                    // 搜索关键词
+	CategoryId  uint64        `protobuf:"varint,4,opt,name=category_id" json:"category_id,omitempty"`           // 分类筛选
+	BrandId     uint64        `protobuf:"varint,5,opt,name=brand_id" json:"brand_id,omitempty"`                 // 品牌筛选
+	MinPrice    string        `protobuf:"bytes,6,opt,name=min_price" json:"min_price,omitempty"`                // 最低价格
+	MaxPrice    string        `protobuf:"bytes,7,opt,name=max_price" json:"max_price,omitempty"`                // 最高价格
+	SortType    int32         `protobuf:"varint,8,opt,name=sort_type" json:"sort_type,omitempty"`               // 排序: 0-综合, 1-价格升序, 2-价格降序, 3-销量降序
+	BrandIds    []uint64      `protobuf:"varint,9,rep,packed,name=brand_ids" json:"brand_ids,omitempty"`        // 品牌多选（分面筛选），多个品牌为或关系
+	CategoryIds []uint64      `protobuf:"varint,10,rep,packed,name=category_ids" json:"category_ids,omitempty"` // 分类多选（分面筛选），多个分类为或关系
+	Specs       []*SpecFilter `protobuf:"bytes,11,rep,name=specs" json:"specs,omitempty"`                       // 规格筛选，同一规格的多个值为或关系，不同规格之间为且关系
+	UserId      uint64        `protobuf:"varint,12,opt,name=user_id" json:"user_id,omitempty"`                  // 搜索用户，未登录为 0，仅用于搜索日志
 }
 
 func (x *SearchProductsRequest) Reset() { *x = SearchProductsRequest{} }
@@ -1125,9 +1539,38 @@ func (x *SearchProductsRequest) GetSortType() int32 {
 	return 0
 }
 
+func (x *SearchProductsRequest) GetBrandIds() []uint64 {
+	if x != nil {
+		return x.BrandIds
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetCategoryIds() []uint64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetSpecs() []*SpecFilter {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+func (x *SearchProductsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type SearchProductsResponse struct {
-	List  []*SearchProductItem `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
-	Total int64                `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	List   []*SearchProductItem `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total  int64                `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	Facets []*SearchFacet       `protobuf:"bytes,3,rep,name=facets" json:"facets,omitempty"` // 分面统计，仅全文检索返回
 }
 
 func (x *SearchProductsResponse) Reset() { *x = SearchProductsResponse{} }
@@ -1152,16 +1595,125 @@ func (x *SearchProductsResponse) GetTotal() int64 {
 	return 0
 }
 
+func (x *SearchProductsResponse) GetFacets() []*SearchFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// 规格筛选条件，如 name=颜色, values=[曜石黑, 雪山白]
+type SpecFilter struct {
+	Name   string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values" json:"values,omitempty"`
+}
+
+func (x *SpecFilter) Reset() { *x = SpecFilter{} }
+
+func (x *SpecFilter) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SpecFilter) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SpecFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// 分面统计，已选中的分面按去掉自身筛选后的结果集统计，便于多选
+type SearchFacet struct {
+	Field  string              `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"` // 分面类型: brand, category, price, spec
+	Name   string              `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`   // 规格名，field 为 spec 时有值
+	Values []*SearchFacetValue `protobuf:"bytes,3,rep,name=values" json:"values,omitempty"`
+}
+
+func (x *SearchFacet) Reset() { *x = SearchFacet{} }
+
+func (x *SearchFacet) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SearchFacet) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SearchFacet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SearchFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchFacet) GetValues() []*SearchFacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SearchFacetValue struct {
+	Value    string `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`        // 筛选值：品牌/分类 ID、价格区间（如 1000-2000，上限为空表示不封顶）、规格值
+	Label    string `protobuf:"bytes,2,opt,name=label" json:"label,omitempty"`        // 展示名称
+	Count    int64  `protobuf:"varint,3,opt,name=count" json:"count,omitempty"`       // 命中的 SKU 数
+	Selected bool   `protobuf:"varint,4,opt,name=selected" json:"selected,omitempty"` // 是否已在请求中选中
+}
+
+func (x *SearchFacetValue) Reset() { *x = SearchFacetValue{} }
+
+func (x *SearchFacetValue) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SearchFacetValue) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SearchFacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SearchFacetValue) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SearchFacetValue) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SearchFacetValue) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
 // 搜索结果项：SKU + 关联的 SPU 基本信息
 type SearchProductItem struct {
-	Sku          *ProductSKU `protobuf:"bytes,1,opt,name=sku" json:"sku,omitempty"`                        // SKU 详细信息
-	SpuId        uint64      `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`                 // 关联的 SPU ID
-	SpuName      string      `protobuf:"bytes,3,opt,name=spu_name" json:"spu_name,omitempty"`              // SPU 名称
-	BrandId      uint64      `protobuf:"varint,4,opt,name=brand_id" json:"brand_id,omitempty"`             // 品牌 ID
-	BrandName    string      `protobuf:"bytes,5,opt,name=brand_name" json:"brand_name,omitempty"`          // 品牌名称
-	CategoryId   uint64      `protobuf:"varint,6,opt,name=category_id" json:"category_id,omitempty"`       // 分类 ID
-	CategoryName string      `protobuf:"bytes,7,opt,name=category_name" json:"category_name,omitempty"`    // 分类名称
-	SpuSaleCount int32       `protobuf:"varint,8,opt,name=spu_sale_count" json:"spu_sale_count,omitempty"` // SPU 总销量
+	Sku          *ProductSKU       `protobuf:"bytes,1,opt,name=sku" json:"sku,omitempty"`                                                                                         // SKU 详细信息
+	SpuId        uint64            `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`                                                                                  // 关联的 SPU ID
+	SpuName      string            `protobuf:"bytes,3,opt,name=spu_name" json:"spu_name,omitempty"`                                                                               // SPU 名称
+	BrandId      uint64            `protobuf:"varint,4,opt,name=brand_id" json:"brand_id,omitempty"`                                                                              // 品牌 ID
+	BrandName    string            `protobuf:"bytes,5,opt,name=brand_name" json:"brand_name,omitempty"`                                                                           // 品牌名称
+	CategoryId   uint64            `protobuf:"varint,6,opt,name=category_id" json:"category_id,omitempty"`                                                                        // 分类 ID
+	CategoryName string            `protobuf:"bytes,7,opt,name=category_name" json:"category_name,omitempty"`                                                                     // 分类名称
+	SpuSaleCount int32             `protobuf:"varint,8,opt,name=spu_sale_count" json:"spu_sale_count,omitempty"`                                                                  // SPU 总销量
+	Highlights   map[string]string `protobuf:"bytes,9,rep,name=highlights" json:"highlights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 关键词高亮片段，字段名 -> 片段（<mark> 包裹），仅全文检索返回
 }
 
 func (x *SearchProductItem) Reset() { *x = SearchProductItem{} }
@@ -1226,6 +1778,13 @@ func (x *SearchProductItem) GetSpuSaleCount() int32 {
 	return 0
 }
 
+func (x *SearchProductItem) GetHighlights() map[string]string {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
 // 14. GetHotProducts (获取热门商品)
 type GetHotProductsRequest struct {
 	Limit int32 `protobuf:"varint,1,opt,name=limit" json:"limit,omitempty"` // 返回数量限制，默认10，最大100
@@ -1323,19 +1882,2809 @@ func (x *HotProductInfo) GetSaleCount() int32 {
 	return 0
 }
 
+// 15. SuggestQueries (搜索联想)
+type SuggestQueriesRequest struct {
+	Prefix string `protobuf:"bytes,1,opt,name=prefix" json:"prefix,omitempty"` // 用户已输入的前缀
+	Limit  int32  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`  // 返回数量限制，默认10，最大20
+}
+
+func (x *SuggestQueriesRequest) Reset() { *x = SuggestQueriesRequest{} }
+
+func (x *SuggestQueriesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SuggestQueriesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SuggestQueriesRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SuggestQueriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SuggestQueriesResponse struct {
+	Suggestions []*QuerySuggestion `protobuf:"bytes,1,rep,name=suggestions" json:"suggestions,omitempty"`
+}
+
+func (x *SuggestQueriesResponse) Reset() { *x = SuggestQueriesResponse{} }
+
+func (x *SuggestQueriesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SuggestQueriesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SuggestQueriesResponse) GetSuggestions() []*QuerySuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+// 联想词
+type QuerySuggestion struct {
+	Text string `protobuf:"bytes,1,opt,name=text" json:"text,omitempty"` // 补全文本
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"` // 来源：query(历史搜索) / product / brand / category
+}
+
+func (x *QuerySuggestion) Reset() { *x = QuerySuggestion{} }
+
+func (x *QuerySuggestion) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *QuerySuggestion) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *QuerySuggestion) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuerySuggestion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// 16. GetTrendingSearches (热搜榜)
+type GetTrendingSearchesRequest struct {
+	Period string `protobuf:"bytes,1,opt,name=period" json:"period,omitempty"` // 统计窗口：hour(最近一小时) / day(最近24小时)，默认 day
+	Limit  int32  `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`  // 返回数量限制，默认10，最大50
+}
+
+func (x *GetTrendingSearchesRequest) Reset() { *x = GetTrendingSearchesRequest{} }
+
+func (x *GetTrendingSearchesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetTrendingSearchesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetTrendingSearchesRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetTrendingSearchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTrendingSearchesResponse struct {
+	Items []*TrendingSearch `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+}
+
+func (x *GetTrendingSearchesResponse) Reset() { *x = GetTrendingSearchesResponse{} }
+
+func (x *GetTrendingSearchesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetTrendingSearchesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetTrendingSearchesResponse) GetItems() []*TrendingSearch {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 热搜词
+type TrendingSearch struct {
+	Keyword     string `protobuf:"bytes,1,opt,name=keyword" json:"keyword,omitempty"`
+	SearchCount int64  `protobuf:"varint,2,opt,name=search_count" json:"search_count,omitempty"` // 窗口内有结果的搜索次数
+}
+
+func (x *TrendingSearch) Reset() { *x = TrendingSearch{} }
+
+func (x *TrendingSearch) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *TrendingSearch) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *TrendingSearch) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *TrendingSearch) GetSearchCount() int64 {
+	if x != nil {
+		return x.SearchCount
+	}
+	return 0
+}
+
+// 17. GetSearchReport (后台搜索词报表)
+type GetSearchReportRequest struct {
+	Days           int32 `protobuf:"varint,1,opt,name=days" json:"days,omitempty"`                         // 统计最近多少天（含今天），默认7，最大90
+	Limit          int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`                       // 返回数量限制，默认50，最大500
+	ZeroResultOnly bool  `protobuf:"varint,3,opt,name=zero_result_only" json:"zero_result_only,omitempty"` // 只返回出现过零结果的搜索词，按零结果次数排序
+}
+
+func (x *GetSearchReportRequest) Reset() { *x = GetSearchReportRequest{} }
+
+func (x *GetSearchReportRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetSearchReportRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetSearchReportRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetSearchReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSearchReportRequest) GetZeroResultOnly() bool {
+	if x != nil {
+		return x.ZeroResultOnly
+	}
+	return false
+}
+
+type GetSearchReportResponse struct {
+	Items []*SearchQueryStat `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+}
+
+func (x *GetSearchReportResponse) Reset() { *x = GetSearchReportResponse{} }
+
+func (x *GetSearchReportResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetSearchReportResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetSearchReportResponse) GetItems() []*SearchQueryStat {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 搜索词统计
+type SearchQueryStat struct {
+	Keyword         string  `protobuf:"bytes,1,opt,name=keyword" json:"keyword,omitempty"`
+	SearchCount     int64   `protobuf:"varint,2,opt,name=search_count" json:"search_count,omitempty"`           // 搜索次数（只统计首页）
+	ZeroResultCount int64   `protobuf:"varint,3,opt,name=zero_result_count" json:"zero_result_count,omitempty"` // 零结果次数
+	AvgResultCount  float64 `protobuf:"fixed64,4,opt,name=avg_result_count" json:"avg_result_count,omitempty"`  // 平均结果数
+	PeriodUserSum   int64   `protobuf:"varint,5,opt,name=period_user_sum" json:"period_user_sum,omitempty"`     // 各统计周期内登录用户去重数之和，同一用户在多个周期搜索会重复计入，不是去重用户数
+}
+
+func (x *SearchQueryStat) Reset() { *x = SearchQueryStat{} }
+
+func (x *SearchQueryStat) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SearchQueryStat) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SearchQueryStat) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchQueryStat) GetSearchCount() int64 {
+	if x != nil {
+		return x.SearchCount
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetZeroResultCount() int64 {
+	if x != nil {
+		return x.ZeroResultCount
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetAvgResultCount() float64 {
+	if x != nil {
+		return x.AvgResultCount
+	}
+	return 0
+}
+
+func (x *SearchQueryStat) GetPeriodUserSum() int64 {
+	if x != nil {
+		return x.PeriodUserSum
+	}
+	return 0
+}
+
+// 18. CreateCategory
+type CreateCategoryRequest struct {
+	ParentId uint64 `protobuf:"varint,1,opt,name=parent_id" json:"parent_id,omitempty"` // 0 表示一级分类
+	Name     string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Icon     string `protobuf:"bytes,3,opt,name=icon" json:"icon,omitempty"`
+	Unit     string `protobuf:"bytes,4,opt,name=unit" json:"unit,omitempty"`
+	Sort     int32  `protobuf:"varint,5,opt,name=sort" json:"sort,omitempty"`
+}
+
+func (x *CreateCategoryRequest) Reset() { *x = CreateCategoryRequest{} }
+
+func (x *CreateCategoryRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateCategoryRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateCategoryRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type CreateCategoryResponse struct {
+	Id    uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Level int32  `protobuf:"varint,2,opt,name=level" json:"level,omitempty"`
+}
+
+func (x *CreateCategoryResponse) Reset() { *x = CreateCategoryResponse{} }
+
+func (x *CreateCategoryResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateCategoryResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateCategoryResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateCategoryResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// 19. UpdateCategory (只更新非空字段)
+type UpdateCategoryRequest struct {
+	Id   uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Icon string `protobuf:"bytes,3,opt,name=icon" json:"icon,omitempty"`
+	Unit string `protobuf:"bytes,4,opt,name=unit" json:"unit,omitempty"`
+}
+
+func (x *UpdateCategoryRequest) Reset() { *x = UpdateCategoryRequest{} }
+
+func (x *UpdateCategoryRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UpdateCategoryRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UpdateCategoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *UpdateCategoryResponse) Reset() { *x = UpdateCategoryResponse{} }
+
+func (x *UpdateCategoryResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *UpdateCategoryResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UpdateCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 20. SortCategories (按 ids 顺序重排同一父分类下的子分类)
+type SortCategoriesRequest struct {
+	ParentId uint64   `protobuf:"varint,1,opt,name=parent_id" json:"parent_id,omitempty"`
+	Ids      []uint64 `protobuf:"varint,2,rep,packed,name=ids" json:"ids,omitempty"`
+}
+
+func (x *SortCategoriesRequest) Reset() { *x = SortCategoriesRequest{} }
+
+func (x *SortCategoriesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SortCategoriesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SortCategoriesRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *SortCategoriesRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type SortCategoriesResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *SortCategoriesResponse) Reset() { *x = SortCategoriesResponse{} }
+
+func (x *SortCategoriesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SortCategoriesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SortCategoriesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 21. SetCategoryVisibility
+type SetCategoryVisibilityRequest struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ShowStatus bool   `protobuf:"varint,2,opt,name=show_status" json:"show_status,omitempty"`
+}
+
+func (x *SetCategoryVisibilityRequest) Reset() { *x = SetCategoryVisibilityRequest{} }
+
+func (x *SetCategoryVisibilityRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SetCategoryVisibilityRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetCategoryVisibilityRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetCategoryVisibilityRequest) GetShowStatus() bool {
+	if x != nil {
+		return x.ShowStatus
+	}
+	return false
+}
+
+type SetCategoryVisibilityResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *SetCategoryVisibilityResponse) Reset() { *x = SetCategoryVisibilityResponse{} }
+
+func (x *SetCategoryVisibilityResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SetCategoryVisibilityResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetCategoryVisibilityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 22. MoveCategory (连同子树一起移动，层级随之调整)
+type MoveCategoryRequest struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	ParentId uint64 `protobuf:"varint,2,opt,name=parent_id" json:"parent_id,omitempty"` // 新的父分类，0 表示移为一级分类
+}
+
+func (x *MoveCategoryRequest) Reset() { *x = MoveCategoryRequest{} }
+
+func (x *MoveCategoryRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *MoveCategoryRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *MoveCategoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveCategoryRequest) GetParentId() uint64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type MoveCategoryResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *MoveCategoryResponse) Reset() { *x = MoveCategoryResponse{} }
+
+func (x *MoveCategoryResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *MoveCategoryResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *MoveCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 23. DeleteCategory
+type DeleteCategoryRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (x *DeleteCategoryRequest) Reset() { *x = DeleteCategoryRequest{} }
+
+func (x *DeleteCategoryRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DeleteCategoryRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteCategoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *DeleteCategoryResponse) Reset() { *x = DeleteCategoryResponse{} }
+
+func (x *DeleteCategoryResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *DeleteCategoryResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 24. CreateBrand
+type CreateBrandRequest struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	FirstLetter string   `protobuf:"bytes,2,opt,name=first_letter" json:"first_letter,omitempty"` // 为空时按名称推算，中文取拼音首字母
+	Logo        string   `protobuf:"bytes,3,opt,name=logo" json:"logo,omitempty"`
+	Sort        int32    `protobuf:"varint,4,opt,name=sort" json:"sort,omitempty"`
+	CategoryIds []uint64 `protobuf:"varint,5,rep,packed,name=category_ids" json:"category_ids,omitempty"`
+}
+
+func (x *CreateBrandRequest) Reset() { *x = CreateBrandRequest{} }
+
+func (x *CreateBrandRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *CreateBrandRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateBrandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBrandRequest) GetFirstLetter() string {
+	if x != nil {
+		return x.FirstLetter
+	}
+	return ""
+}
+
+func (x *CreateBrandRequest) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *CreateBrandRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *CreateBrandRequest) GetCategoryIds() []uint64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type CreateBrandResponse struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	FirstLetter string `protobuf:"bytes,2,opt,name=first_letter" json:"first_letter,omitempty"`
+}
+
+func (x *CreateBrandResponse) Reset() { *x = CreateBrandResponse{} }
+
+func (x *CreateBrandResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *CreateBrandResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateBrandResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateBrandResponse) GetFirstLetter() string {
+	if x != nil {
+		return x.FirstLetter
+	}
+	return ""
+}
+
+// 25. UpdateBrand (字符串字段只更新非空值)
+type UpdateBrandRequest struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"` // 重命名且未指定首字母时重新推算首字母
+	FirstLetter string `protobuf:"bytes,3,opt,name=first_letter" json:"first_letter,omitempty"`
+	Logo        string `protobuf:"bytes,4,opt,name=logo" json:"logo,omitempty"`
+	Sort        int32  `protobuf:"varint,5,opt,name=sort" json:"sort,omitempty"`               // -1 表示不变更
+	ShowStatus  int32  `protobuf:"varint,6,opt,name=show_status" json:"show_status,omitempty"` // 0:不变更, 1:显示, 2:隐藏
+}
+
+func (x *UpdateBrandRequest) Reset() { *x = UpdateBrandRequest{} }
+
+func (x *UpdateBrandRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UpdateBrandRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UpdateBrandRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBrandRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateBrandRequest) GetFirstLetter() string {
+	if x != nil {
+		return x.FirstLetter
+	}
+	return ""
+}
+
+func (x *UpdateBrandRequest) GetLogo() string {
+	if x != nil {
+		return x.Logo
+	}
+	return ""
+}
+
+func (x *UpdateBrandRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *UpdateBrandRequest) GetShowStatus() int32 {
+	if x != nil {
+		return x.ShowStatus
+	}
+	return 0
+}
+
+type UpdateBrandResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *UpdateBrandResponse) Reset() { *x = UpdateBrandResponse{} }
+
+func (x *UpdateBrandResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UpdateBrandResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UpdateBrandResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 26. DeleteBrand
+type DeleteBrandRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (x *DeleteBrandRequest) Reset() { *x = DeleteBrandRequest{} }
+
+func (x *DeleteBrandRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DeleteBrandRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteBrandRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteBrandResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *DeleteBrandResponse) Reset() { *x = DeleteBrandResponse{} }
+
+func (x *DeleteBrandResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *DeleteBrandResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *DeleteBrandResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 27. SetBrandCategories
+type SetBrandCategoriesRequest struct {
+	BrandId     uint64   `protobuf:"varint,1,opt,name=brand_id" json:"brand_id,omitempty"`
+	CategoryIds []uint64 `protobuf:"varint,2,rep,packed,name=category_ids" json:"category_ids,omitempty"` // 为空表示清除全部关联
+}
+
+func (x *SetBrandCategoriesRequest) Reset() { *x = SetBrandCategoriesRequest{} }
+
+func (x *SetBrandCategoriesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SetBrandCategoriesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetBrandCategoriesRequest) GetBrandId() uint64 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *SetBrandCategoriesRequest) GetCategoryIds() []uint64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type SetBrandCategoriesResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *SetBrandCategoriesResponse) Reset() { *x = SetBrandCategoriesResponse{} }
+
+func (x *SetBrandCategoriesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SetBrandCategoriesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetBrandCategoriesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 28. GetSpecTemplate
+type GetSpecTemplateRequest struct {
+	CategoryId uint64 `protobuf:"varint,1,opt,name=category_id" json:"category_id,omitempty"`
+}
+
+func (x *GetSpecTemplateRequest) Reset() { *x = GetSpecTemplateRequest{} }
+
+func (x *GetSpecTemplateRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetSpecTemplateRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetSpecTemplateRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type GetSpecTemplateResponse struct {
+	Attributes []*SpecAttribute `protobuf:"bytes,1,rep,name=attributes" json:"attributes,omitempty"`
+}
+
+func (x *GetSpecTemplateResponse) Reset() { *x = GetSpecTemplateResponse{} }
+
+func (x *GetSpecTemplateResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetSpecTemplateResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetSpecTemplateResponse) GetAttributes() []*SpecAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// 29. SaveSpecTemplate
+type SaveSpecTemplateRequest struct {
+	CategoryId uint64           `protobuf:"varint,1,opt,name=category_id" json:"category_id,omitempty"`
+	Attributes []*SpecAttribute `protobuf:"bytes,2,rep,name=attributes" json:"attributes,omitempty"` // 只包含分类自身的属性，为空表示清除
+}
+
+func (x *SaveSpecTemplateRequest) Reset() { *x = SaveSpecTemplateRequest{} }
+
+func (x *SaveSpecTemplateRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SaveSpecTemplateRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SaveSpecTemplateRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SaveSpecTemplateRequest) GetAttributes() []*SpecAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type SaveSpecTemplateResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *SaveSpecTemplateResponse) Reset() { *x = SaveSpecTemplateResponse{} }
+
+func (x *SaveSpecTemplateResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SaveSpecTemplateResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SaveSpecTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 30. AuditProducts
+type AuditProductsRequest struct {
+	Ids          []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	VerifyStatus int32    `protobuf:"varint,2,opt,name=verify_status" json:"verify_status,omitempty"` // 1-通过, 2-驳回
+	Reason       string   `protobuf:"bytes,3,opt,name=reason" json:"reason,omitempty"`                // 驳回时必填
+	OperatorId   uint64   `protobuf:"varint,4,opt,name=operator_id" json:"operator_id,omitempty"`
+}
+
+func (x *AuditProductsRequest) Reset() { *x = AuditProductsRequest{} }
+
+func (x *AuditProductsRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *AuditProductsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AuditProductsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *AuditProductsRequest) GetVerifyStatus() int32 {
+	if x != nil {
+		return x.VerifyStatus
+	}
+	return 0
+}
+
+func (x *AuditProductsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditProductsRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type AuditProductsResponse struct {
+	Affected int64 `protobuf:"varint,1,opt,name=affected" json:"affected,omitempty"` // 实际审核数量，不在待审核状态的商品被跳过
+}
+
+func (x *AuditProductsResponse) Reset() { *x = AuditProductsResponse{} }
+
+func (x *AuditProductsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AuditProductsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AuditProductsResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 31. ListAuditQueue
+type ListAuditQueueRequest struct {
+	Page     int32 `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
+}
+
+func (x *ListAuditQueueRequest) Reset() { *x = ListAuditQueueRequest{} }
+
+func (x *ListAuditQueueRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAuditQueueRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAuditQueueRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditQueueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditQueueResponse struct {
+	List  []*ProductSPU `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64         `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListAuditQueueResponse) Reset() { *x = ListAuditQueueResponse{} }
+
+func (x *ListAuditQueueResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListAuditQueueResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAuditQueueResponse) GetList() []*ProductSPU {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListAuditQueueResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 32. ListProductAudits
+type ListProductAuditsRequest struct {
+	SpuId uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+}
+
+func (x *ListProductAuditsRequest) Reset() { *x = ListProductAuditsRequest{} }
+
+func (x *ListProductAuditsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListProductAuditsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListProductAuditsRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+type ListProductAuditsResponse struct {
+	List []*ProductAudit `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"` // 按时间倒序
+}
+
+func (x *ListProductAuditsResponse) Reset() { *x = ListProductAuditsResponse{} }
+
+func (x *ListProductAuditsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListProductAuditsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListProductAuditsResponse) GetList() []*ProductAudit {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 商品审核记录
+type ProductAudit struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	SpuId        uint64 `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`
+	VerifyStatus int32  `protobuf:"varint,3,opt,name=verify_status" json:"verify_status,omitempty"` // 0-提交审核, 1-通过, 2-驳回
+	Reason       string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+	OperatorId   uint64 `protobuf:"varint,5,opt,name=operator_id" json:"operator_id,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,6,opt,name=created_at" json:"created_at,omitempty"` // unix 秒
+}
+
+func (x *ProductAudit) Reset() { *x = ProductAudit{} }
+
+func (x *ProductAudit) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ProductAudit) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ProductAudit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductAudit) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ProductAudit) GetVerifyStatus() int32 {
+	if x != nil {
+		return x.VerifyStatus
+	}
+	return 0
+}
+
+func (x *ProductAudit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ProductAudit) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ProductAudit) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 33. ListProductHistory
+type ListProductHistoryRequest struct {
+	SpuId      uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+	Page       int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
+	MerchantId uint64 `protobuf:"varint,4,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能查询该商家的商品
+}
+
+func (x *ListProductHistoryRequest) Reset() { *x = ListProductHistoryRequest{} }
+
+func (x *ListProductHistoryRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListProductHistoryRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListProductHistoryRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ListProductHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductHistoryRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListProductHistoryResponse struct {
+	List  []*ProductVersion `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"` // 按版本号倒序
+	Total int64             `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListProductHistoryResponse) Reset() { *x = ListProductHistoryResponse{} }
+
+func (x *ListProductHistoryResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListProductHistoryResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListProductHistoryResponse) GetList() []*ProductVersion {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListProductHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 34. RollbackProduct
+type RollbackProductRequest struct {
+	SpuId      uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+	Version    int32  `protobuf:"varint,2,opt,name=version" json:"version,omitempty"` // 回滚到的版本
+	OperatorId uint64 `protobuf:"varint,3,opt,name=operator_id" json:"operator_id,omitempty"`
+	MerchantId uint64 `protobuf:"varint,4,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
+}
+
+func (x *RollbackProductRequest) Reset() { *x = RollbackProductRequest{} }
+
+func (x *RollbackProductRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RollbackProductRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RollbackProductRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *RollbackProductRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackProductRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *RollbackProductRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type RollbackProductResponse struct {
+	Version int32 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"` // 回滚后生成的新版本号
+}
+
+func (x *RollbackProductResponse) Reset() { *x = RollbackProductResponse{} }
+
+func (x *RollbackProductResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *RollbackProductResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RollbackProductResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// 商品版本，记录一次变更后的状态与相对上一版本的差异
+type ProductVersion struct {
+	Version       int32          `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	OperatorId    uint64         `protobuf:"varint,2,opt,name=operator_id" json:"operator_id,omitempty"`
+	Action        string         `protobuf:"bytes,3,opt,name=action" json:"action,omitempty"` // baseline(开始记录前的状态) / create / update / update_sku / rollback
+	Changes       []*FieldChange `protobuf:"bytes,4,rep,name=changes" json:"changes,omitempty"`
+	SourceVersion int32          `protobuf:"varint,5,opt,name=source_version" json:"source_version,omitempty"` // 回滚时为回滚到的版本
+	CreatedAt     int64          `protobuf:"varint,6,opt,name=created_at" json:"created_at,omitempty"`         // unix 秒
+}
+
+func (x *ProductVersion) Reset() { *x = ProductVersion{} }
+
+func (x *ProductVersion) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ProductVersion) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ProductVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ProductVersion) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ProductVersion) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProductVersion) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ProductVersion) GetSourceVersion() int32 {
+	if x != nil {
+		return x.SourceVersion
+	}
+	return 0
+}
+
+func (x *ProductVersion) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 字段变更，field 如 spu.name、sku.123.price、detail.description
+type FieldChange struct {
+	Field    string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	OldValue string `protobuf:"bytes,2,opt,name=old_value" json:"old_value,omitempty"`
+	NewValue string `protobuf:"bytes,3,opt,name=new_value" json:"new_value,omitempty"`
+}
+
+func (x *FieldChange) Reset() { *x = FieldChange{} }
+
+func (x *FieldChange) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *FieldChange) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// 35. CreateProductSchedule
+type CreateProductScheduleRequest struct {
+	TargetType  string `protobuf:"bytes,1,opt,name=target_type" json:"target_type,omitempty"` // spu / sku
+	TargetId    uint64 `protobuf:"varint,2,opt,name=target_id" json:"target_id,omitempty"`
+	Action      string `protobuf:"bytes,3,opt,name=action" json:"action,omitempty"`             // publish / unpublish / price (price 只支持 sku)
+	RunAt       int64  `protobuf:"varint,4,opt,name=run_at" json:"run_at,omitempty"`            // 执行时间 unix 秒，必须晚于当前时间
+	Price       string `protobuf:"bytes,5,opt,name=price" json:"price,omitempty"`               // action=price 时必填
+	MarketPrice string `protobuf:"bytes,6,opt,name=market_price" json:"market_price,omitempty"` // action=price 时可选，为空不修改
+	OperatorId  uint64 `protobuf:"varint,7,opt,name=operator_id" json:"operator_id,omitempty"`
+	MerchantId  uint64 `protobuf:"varint,8,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
+}
+
+func (x *CreateProductScheduleRequest) Reset() { *x = CreateProductScheduleRequest{} }
+
+func (x *CreateProductScheduleRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateProductScheduleRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateProductScheduleRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *CreateProductScheduleRequest) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *CreateProductScheduleRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CreateProductScheduleRequest) GetRunAt() int64 {
+	if x != nil {
+		return x.RunAt
+	}
+	return 0
+}
+
+func (x *CreateProductScheduleRequest) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CreateProductScheduleRequest) GetMarketPrice() string {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return ""
+}
+
+func (x *CreateProductScheduleRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateProductScheduleRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type CreateProductScheduleResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (x *CreateProductScheduleResponse) Reset() { *x = CreateProductScheduleResponse{} }
+
+func (x *CreateProductScheduleResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateProductScheduleResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateProductScheduleResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 36. ListProductSchedules
+type ListProductSchedulesRequest struct {
+	TargetType string `protobuf:"bytes,1,opt,name=target_type" json:"target_type,omitempty"` // 为空不筛选
+	TargetId   uint64 `protobuf:"varint,2,opt,name=target_id" json:"target_id,omitempty"`    // 0 不筛选
+	Status     int32  `protobuf:"varint,3,opt,name=status" json:"status,omitempty"`          // -1 不筛选
+	Page       int32  `protobuf:"varint,4,opt,name=page" json:"page,omitempty"`
+	PageSize   int32  `protobuf:"varint,5,opt,name=page_size" json:"page_size,omitempty"`
+	MerchantId uint64 `protobuf:"varint,6,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只查询该商家商品的定时任务
+}
+
+func (x *ListProductSchedulesRequest) Reset() { *x = ListProductSchedulesRequest{} }
+
+func (x *ListProductSchedulesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListProductSchedulesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListProductSchedulesRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ListProductSchedulesRequest) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ListProductSchedulesRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListProductSchedulesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductSchedulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductSchedulesRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListProductSchedulesResponse struct {
+	List  []*ProductSchedule `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"` // 按执行时间倒序
+	Total int64              `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListProductSchedulesResponse) Reset() { *x = ListProductSchedulesResponse{} }
+
+func (x *ListProductSchedulesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListProductSchedulesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListProductSchedulesResponse) GetList() []*ProductSchedule {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListProductSchedulesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 37. CancelProductSchedule
+type CancelProductScheduleRequest struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	MerchantId uint64 `protobuf:"varint,2,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能操作该商家的商品
+}
+
+func (x *CancelProductScheduleRequest) Reset() { *x = CancelProductScheduleRequest{} }
+
+func (x *CancelProductScheduleRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CancelProductScheduleRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CancelProductScheduleRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelProductScheduleRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type CancelProductScheduleResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *CancelProductScheduleResponse) Reset() { *x = CancelProductScheduleResponse{} }
+
+func (x *CancelProductScheduleResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CancelProductScheduleResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CancelProductScheduleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 商品定时任务
+type ProductSchedule struct {
+	Id          uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	TargetType  string `protobuf:"bytes,2,opt,name=target_type" json:"target_type,omitempty"`
+	TargetId    uint64 `protobuf:"varint,3,opt,name=target_id" json:"target_id,omitempty"`
+	Action      string `protobuf:"bytes,4,opt,name=action" json:"action,omitempty"`
+	Price       string `protobuf:"bytes,5,opt,name=price" json:"price,omitempty"`
+	MarketPrice string `protobuf:"bytes,6,opt,name=market_price" json:"market_price,omitempty"`
+	RunAt       int64  `protobuf:"varint,7,opt,name=run_at" json:"run_at,omitempty"`
+	Status      int32  `protobuf:"varint,8,opt,name=status" json:"status,omitempty"`            // 0-待执行, 1-执行中, 2-已执行, 3-执行失败, 4-已取消
+	Error       string `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`               // 执行失败原因
+	ExecutedAt  int64  `protobuf:"varint,10,opt,name=executed_at" json:"executed_at,omitempty"` // 未执行时为 0
+	OperatorId  uint64 `protobuf:"varint,11,opt,name=operator_id" json:"operator_id,omitempty"`
+	CreatedAt   int64  `protobuf:"varint,12,opt,name=created_at" json:"created_at,omitempty"`
+}
+
+func (x *ProductSchedule) Reset() { *x = ProductSchedule{} }
+
+func (x *ProductSchedule) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ProductSchedule) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ProductSchedule) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductSchedule) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ProductSchedule) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ProductSchedule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ProductSchedule) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *ProductSchedule) GetMarketPrice() string {
+	if x != nil {
+		return x.MarketPrice
+	}
+	return ""
+}
+
+func (x *ProductSchedule) GetRunAt() int64 {
+	if x != nil {
+		return x.RunAt
+	}
+	return 0
+}
+
+func (x *ProductSchedule) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProductSchedule) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProductSchedule) GetExecutedAt() int64 {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return 0
+}
+
+func (x *ProductSchedule) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ProductSchedule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 38. ImportProducts
+type ImportProductsRequest struct {
+	File       []byte `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+	Format     string `protobuf:"bytes,2,opt,name=format" json:"format,omitempty"`    // csv / xlsx，也可以传文件名
+	DryRun     bool   `protobuf:"varint,3,opt,name=dry_run" json:"dry_run,omitempty"` // true 时只校验并生成报告，不创建商品
+	OperatorId uint64 `protobuf:"varint,4,opt,name=operator_id" json:"operator_id,omitempty"`
+	MerchantId uint64 `protobuf:"varint,5,opt,name=merchant_id" json:"merchant_id,omitempty"` // 导入商品所属商家，0 为平台商品
+}
+
+func (x *ImportProductsRequest) Reset() { *x = ImportProductsRequest{} }
+
+func (x *ImportProductsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ImportProductsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ImportProductsRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportProductsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ImportProductsRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ImportProductsResponse struct {
+	Job *ImportJob `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+}
+
+func (x *ImportProductsResponse) Reset() { *x = ImportProductsResponse{} }
+
+func (x *ImportProductsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ImportProductsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ImportProductsResponse) GetJob() *ImportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 39. GetImportJob
+type GetImportJobRequest struct {
+	Id         uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	MerchantId uint64 `protobuf:"varint,2,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只能查询该商家的导入任务
+}
+
+func (x *GetImportJobRequest) Reset() { *x = GetImportJobRequest{} }
+
+func (x *GetImportJobRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetImportJobRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetImportJobRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetImportJobRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetImportJobResponse struct {
+	Job *ImportJob `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+}
+
+func (x *GetImportJobResponse) Reset() { *x = GetImportJobResponse{} }
+
+func (x *GetImportJobResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *GetImportJobResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetImportJobResponse) GetJob() *ImportJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// 40. ExportProducts
+type ExportProductsRequest struct {
+	Keyword       string `protobuf:"bytes,1,opt,name=keyword" json:"keyword,omitempty"`
+	CategoryId    uint64 `protobuf:"varint,2,opt,name=category_id" json:"category_id,omitempty"`
+	BrandId       uint64 `protobuf:"varint,3,opt,name=brand_id" json:"brand_id,omitempty"`
+	PublishStatus int32  `protobuf:"varint,4,opt,name=publish_status" json:"publish_status,omitempty"` // -1表示全部
+	VerifyStatus  int32  `protobuf:"varint,5,opt,name=verify_status" json:"verify_status,omitempty"`   // -1表示全部
+	Deleted       bool   `protobuf:"varint,6,opt,name=deleted" json:"deleted,omitempty"`               // true 时只导出已删除的商品
+	Cursor        uint64 `protobuf:"varint,7,opt,name=cursor" json:"cursor,omitempty"`                 // 上一批返回的 next_cursor，首批为 0
+	Limit         int32  `protobuf:"varint,8,opt,name=limit" json:"limit,omitempty"`                   // 每批商品数
+	MerchantId    uint64 `protobuf:"varint,9,opt,name=merchant_id" json:"merchant_id,omitempty"`       // 商家范围，非 0 时只导出该商家的商品
+}
+
+func (x *ExportProductsRequest) Reset() { *x = ExportProductsRequest{} }
+
+func (x *ExportProductsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ExportProductsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportProductsRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *ExportProductsRequest) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetBrandId() uint64 {
+	if x != nil {
+		return x.BrandId
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetPublishStatus() int32 {
+	if x != nil {
+		return x.PublishStatus
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetVerifyStatus() int32 {
+	if x != nil {
+		return x.VerifyStatus
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ExportProductsRequest) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ExportProductsRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ExportProductsResponse struct {
+	List       []*ExportedProduct `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	NextCursor uint64             `protobuf:"varint,2,opt,name=next_cursor" json:"next_cursor,omitempty"` // 为 0 表示已导出完毕
+}
+
+func (x *ExportProductsResponse) Reset() { *x = ExportProductsResponse{} }
+
+func (x *ExportProductsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ExportProductsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportProductsResponse) GetList() []*ExportedProduct {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ExportProductsResponse) GetNextCursor() uint64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+// 导出的商品，包含全部 SKU 与详情
+type ExportedProduct struct {
+	Spu    *ProductSPU    `protobuf:"bytes,1,opt,name=spu" json:"spu,omitempty"`
+	Skus   []*ProductSKU  `protobuf:"bytes,2,rep,name=skus" json:"skus,omitempty"`
+	Detail *ProductDetail `protobuf:"bytes,3,opt,name=detail" json:"detail,omitempty"`
+}
+
+func (x *ExportedProduct) Reset() { *x = ExportedProduct{} }
+
+func (x *ExportedProduct) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ExportedProduct) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ExportedProduct) GetSpu() *ProductSPU {
+	if x != nil {
+		return x.Spu
+	}
+	return nil
+}
+
+func (x *ExportedProduct) GetSkus() []*ProductSKU {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *ExportedProduct) GetDetail() *ProductDetail {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+// 商品导入任务
+type ImportJob struct {
+	Id              uint64            `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Format          string            `protobuf:"bytes,2,opt,name=format" json:"format,omitempty"`
+	DryRun          bool              `protobuf:"varint,3,opt,name=dry_run" json:"dry_run,omitempty"`
+	Status          int32             `protobuf:"varint,4,opt,name=status" json:"status,omitempty"`                     // 0-待执行, 1-执行中, 2-已完成, 3-失败
+	TotalRows       int32             `protobuf:"varint,5,opt,name=total_rows" json:"total_rows,omitempty"`             // 数据行数，不含表头
+	ErrorRows       int32             `protobuf:"varint,6,opt,name=error_rows" json:"error_rows,omitempty"`             // 有错误的行数
+	TotalProducts   int32             `protobuf:"varint,7,opt,name=total_products" json:"total_products,omitempty"`     // 文件中的商品数 (按 spu_ref 分组)
+	ValidProducts   int32             `protobuf:"varint,8,opt,name=valid_products" json:"valid_products,omitempty"`     // 全部行校验通过的商品数
+	CreatedProducts int32             `protobuf:"varint,9,opt,name=created_products" json:"created_products,omitempty"` // 实际创建的商品数，dry_run 时为 0
+	Errors          []*ImportRowError `protobuf:"bytes,10,rep,name=errors" json:"errors,omitempty"`
+	Error           string            `protobuf:"bytes,11,opt,name=error" json:"error,omitempty"` // 任务整体失败的原因，如文件无法解析
+	OperatorId      uint64            `protobuf:"varint,12,opt,name=operator_id" json:"operator_id,omitempty"`
+	CreatedAt       int64             `protobuf:"varint,13,opt,name=created_at" json:"created_at,omitempty"`
+	FinishedAt      int64             `protobuf:"varint,14,opt,name=finished_at" json:"finished_at,omitempty"` // 未结束时为 0
+}
+
+func (x *ImportJob) Reset() { *x = ImportJob{} }
+
+func (x *ImportJob) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ImportJob) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ImportJob) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportJob) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportJob) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ImportJob) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetErrorRows() int32 {
+	if x != nil {
+		return x.ErrorRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetTotalProducts() int32 {
+	if x != nil {
+		return x.TotalProducts
+	}
+	return 0
+}
+
+func (x *ImportJob) GetValidProducts() int32 {
+	if x != nil {
+		return x.ValidProducts
+	}
+	return 0
+}
+
+func (x *ImportJob) GetCreatedProducts() int32 {
+	if x != nil {
+		return x.CreatedProducts
+	}
+	return 0
+}
+
+func (x *ImportJob) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportJob) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *ImportJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ImportJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+// 导入错误，row 为表格中的行号 (表头为第 1 行)
+type ImportRowError struct {
+	Row     int32  `protobuf:"varint,1,opt,name=row" json:"row,omitempty"`
+	Column  string `protobuf:"bytes,2,opt,name=column" json:"column,omitempty"` // 为空表示整行或整个商品的错误
+	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+}
+
+func (x *ImportRowError) Reset() { *x = ImportRowError{} }
+
+func (x *ImportRowError) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ImportRowError) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 41. CreateMediaAsset
+type CreateMediaAssetRequest struct {
+	Asset *MediaAsset `protobuf:"bytes,1,opt,name=asset" json:"asset,omitempty"` // id 与 created_at 由服务端生成
+}
+
+func (x *CreateMediaAssetRequest) Reset() { *x = CreateMediaAssetRequest{} }
+
+func (x *CreateMediaAssetRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateMediaAssetRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateMediaAssetRequest) GetAsset() *MediaAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+type CreateMediaAssetResponse struct {
+	Asset *MediaAsset `protobuf:"bytes,1,opt,name=asset" json:"asset,omitempty"`
+}
+
+func (x *CreateMediaAssetResponse) Reset() { *x = CreateMediaAssetResponse{} }
+
+func (x *CreateMediaAssetResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateMediaAssetResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateMediaAssetResponse) GetAsset() *MediaAsset {
+	if x != nil {
+		return x.Asset
+	}
+	return nil
+}
+
+// 42. ListMediaAssets
+type ListMediaAssetsRequest struct {
+	Kind       string `protobuf:"bytes,1,opt,name=kind" json:"kind,omitempty"` // image / video，为空不筛选
+	Page       int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
+	MerchantId uint64 `protobuf:"varint,4,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时只查询该商家上传的资源
+}
+
+func (x *ListMediaAssetsRequest) Reset() { *x = ListMediaAssetsRequest{} }
+
+func (x *ListMediaAssetsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListMediaAssetsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListMediaAssetsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListMediaAssetsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMediaAssetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMediaAssetsRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListMediaAssetsResponse struct {
+	List  []*MediaAsset `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"` // 按上传时间倒序
+	Total int64         `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListMediaAssetsResponse) Reset() { *x = ListMediaAssetsResponse{} }
+
+func (x *ListMediaAssetsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListMediaAssetsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListMediaAssetsResponse) GetList() []*MediaAsset {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListMediaAssetsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 43. GetMediaAssets
+type GetMediaAssetsRequest struct {
+	Ids        []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	MerchantId uint64   `protobuf:"varint,2,opt,name=merchant_id" json:"merchant_id,omitempty"` // 商家范围，非 0 时不返回其他商家上传的资源
+}
+
+func (x *GetMediaAssetsRequest) Reset() { *x = GetMediaAssetsRequest{} }
+
+func (x *GetMediaAssetsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetMediaAssetsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetMediaAssetsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetMediaAssetsRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type GetMediaAssetsResponse struct {
+	Assets map[uint64]*MediaAsset `protobuf:"bytes,1,rep,name=assets" json:"assets,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 不存在的 ID 不在其中
+}
+
+func (x *GetMediaAssetsResponse) Reset() { *x = GetMediaAssetsResponse{} }
+
+func (x *GetMediaAssetsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetMediaAssetsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetMediaAssetsResponse) GetAssets() map[uint64]*MediaAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+// 媒体资源，图片带缩略图与 WebP 版本
+type MediaAsset struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Kind         string `protobuf:"bytes,2,opt,name=kind" json:"kind,omitempty"` // image / video
+	ContentType  string `protobuf:"bytes,3,opt,name=content_type" json:"content_type,omitempty"`
+	Size         int64  `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`   // 原文件字节数
+	Width        int32  `protobuf:"varint,5,opt,name=width" json:"width,omitempty"` // 视频为 0
+	Height       int32  `protobuf:"varint,6,opt,name=height" json:"height,omitempty"`
+	StorageKey   string `protobuf:"bytes,7,opt,name=storage_key" json:"storage_key,omitempty"` // 原文件在存储中的 key
+	Url          string `protobuf:"bytes,8,opt,name=url" json:"url,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,9,opt,name=thumbnail_url" json:"thumbnail_url,omitempty"`
+	WebpUrl      string `protobuf:"bytes,10,opt,name=webp_url" json:"webp_url,omitempty"`
+	OriginalName string `protobuf:"bytes,11,opt,name=original_name" json:"original_name,omitempty"`
+	OperatorId   uint64 `protobuf:"varint,12,opt,name=operator_id" json:"operator_id,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,13,opt,name=created_at" json:"created_at,omitempty"`
+}
+
+func (x *MediaAsset) Reset() { *x = MediaAsset{} }
+
+func (x *MediaAsset) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *MediaAsset) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *MediaAsset) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MediaAsset) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MediaAsset) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *MediaAsset) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MediaAsset) GetStorageKey() string {
+	if x != nil {
+		return x.StorageKey
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetWebpUrl() string {
+	if x != nil {
+		return x.WebpUrl
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetOriginalName() string {
+	if x != nil {
+		return x.OriginalName
+	}
+	return ""
+}
+
+func (x *MediaAsset) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *MediaAsset) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 44. ListPriceHistory
+type ListPriceHistoryRequest struct {
+	SpuId uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+	SkuId uint64 `protobuf:"varint,2,opt,name=sku_id" json:"sku_id,omitempty"` // 0 表示商品下全部 SKU
+	Since int64  `protobuf:"varint,3,opt,name=since" json:"since,omitempty"`   // unix 秒，0 表示不限
+	Limit int32  `protobuf:"varint,4,opt,name=limit" json:"limit,omitempty"`   // 默认 200，最大 1000，超出时返回最近的记录
+}
+
+func (x *ListPriceHistoryRequest) Reset() { *x = ListPriceHistoryRequest{} }
+
+func (x *ListPriceHistoryRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListPriceHistoryRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListPriceHistoryRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPriceHistoryResponse struct {
+	List []*SkuPriceChange `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"` // 按时间正序
+}
+
+func (x *ListPriceHistoryResponse) Reset() { *x = ListPriceHistoryResponse{} }
+
+func (x *ListPriceHistoryResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListPriceHistoryResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListPriceHistoryResponse) GetList() []*SkuPriceChange {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// SKU 价格变动，创建商品时记录初始价格，old_price 为空
+type SkuPriceChange struct {
+	SkuId          uint64 `protobuf:"varint,1,opt,name=sku_id" json:"sku_id,omitempty"`
+	OldPrice       string `protobuf:"bytes,2,opt,name=old_price" json:"old_price,omitempty"`
+	NewPrice       string `protobuf:"bytes,3,opt,name=new_price" json:"new_price,omitempty"`
+	OldMarketPrice string `protobuf:"bytes,4,opt,name=old_market_price" json:"old_market_price,omitempty"`
+	NewMarketPrice string `protobuf:"bytes,5,opt,name=new_market_price" json:"new_market_price,omitempty"`
+	Source         string `protobuf:"bytes,6,opt,name=source" json:"source,omitempty"` // create / manual / schedule / rollback
+	OperatorId     uint64 `protobuf:"varint,7,opt,name=operator_id" json:"operator_id,omitempty"`
+	CreatedAt      int64  `protobuf:"varint,8,opt,name=created_at" json:"created_at,omitempty"`
+}
+
+func (x *SkuPriceChange) Reset() { *x = SkuPriceChange{} }
+
+func (x *SkuPriceChange) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SkuPriceChange) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SkuPriceChange) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SkuPriceChange) GetOldPrice() string {
+	if x != nil {
+		return x.OldPrice
+	}
+	return ""
+}
+
+func (x *SkuPriceChange) GetNewPrice() string {
+	if x != nil {
+		return x.NewPrice
+	}
+	return ""
+}
+
+func (x *SkuPriceChange) GetOldMarketPrice() string {
+	if x != nil {
+		return x.OldMarketPrice
+	}
+	return ""
+}
+
+func (x *SkuPriceChange) GetNewMarketPrice() string {
+	if x != nil {
+		return x.NewMarketPrice
+	}
+	return ""
+}
+
+func (x *SkuPriceChange) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SkuPriceChange) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SkuPriceChange) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 45. SubscribePriceAlert
+type SubscribePriceAlertRequest struct {
+	UserId      uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	SkuId       uint64 `protobuf:"varint,2,opt,name=sku_id" json:"sku_id,omitempty"`
+	TargetPrice string `protobuf:"bytes,3,opt,name=target_price" json:"target_price,omitempty"` // 需低于当前售价
+}
+
+func (x *SubscribePriceAlertRequest) Reset() { *x = SubscribePriceAlertRequest{} }
+
+func (x *SubscribePriceAlertRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SubscribePriceAlertRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SubscribePriceAlertRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubscribePriceAlertRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SubscribePriceAlertRequest) GetTargetPrice() string {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return ""
+}
+
+type SubscribePriceAlertResponse struct {
+	Alert *PriceAlert `protobuf:"bytes,1,opt,name=alert" json:"alert,omitempty"`
+}
+
+func (x *SubscribePriceAlertResponse) Reset() { *x = SubscribePriceAlertResponse{} }
+
+func (x *SubscribePriceAlertResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *SubscribePriceAlertResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SubscribePriceAlertResponse) GetAlert() *PriceAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+// 46. CancelPriceAlert
+type CancelPriceAlertRequest struct {
+	UserId uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	SkuId  uint64 `protobuf:"varint,2,opt,name=sku_id" json:"sku_id,omitempty"`
+}
+
+func (x *CancelPriceAlertRequest) Reset() { *x = CancelPriceAlertRequest{} }
+
+func (x *CancelPriceAlertRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CancelPriceAlertRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CancelPriceAlertRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CancelPriceAlertRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+type CancelPriceAlertResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+}
+
+func (x *CancelPriceAlertResponse) Reset() { *x = CancelPriceAlertResponse{} }
+
+func (x *CancelPriceAlertResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CancelPriceAlertResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CancelPriceAlertResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 47. ListPriceAlerts
+type ListPriceAlertsRequest struct {
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Page     int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
+}
+
+func (x *ListPriceAlertsRequest) Reset() { *x = ListPriceAlertsRequest{} }
+
+func (x *ListPriceAlertsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListPriceAlertsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListPriceAlertsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListPriceAlertsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPriceAlertsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPriceAlertsResponse struct {
+	List  []*PriceAlert `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"` // 最近订阅的在前
+	Total int64         `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListPriceAlertsResponse) Reset() { *x = ListPriceAlertsResponse{} }
+
+func (x *ListPriceAlertsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListPriceAlertsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListPriceAlertsResponse) GetList() []*PriceAlert {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListPriceAlertsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 降价提醒，价格降到目标价及以下时通知一次
+type PriceAlert struct {
+	Id            uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	UserId        uint64 `protobuf:"varint,2,opt,name=user_id" json:"user_id,omitempty"`
+	SkuId         uint64 `protobuf:"varint,3,opt,name=sku_id" json:"sku_id,omitempty"`
+	SpuId         uint64 `protobuf:"varint,4,opt,name=spu_id" json:"spu_id,omitempty"`
+	TargetPrice   string `protobuf:"bytes,5,opt,name=target_price" json:"target_price,omitempty"`
+	Status        int32  `protobuf:"varint,6,opt,name=status" json:"status,omitempty"`                // 0:等待降价, 1:已通知
+	NotifiedPrice string `protobuf:"bytes,7,opt,name=notified_price" json:"notified_price,omitempty"` // 触发通知时的售价
+	NotifiedAt    int64  `protobuf:"varint,8,opt,name=notified_at" json:"notified_at,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,9,opt,name=created_at" json:"created_at,omitempty"`
+	SkuName       string `protobuf:"bytes,10,opt,name=sku_name" json:"sku_name,omitempty"`           // 列表接口返回
+	MainImage     string `protobuf:"bytes,11,opt,name=main_image" json:"main_image,omitempty"`       // 列表接口返回
+	CurrentPrice  string `protobuf:"bytes,12,opt,name=current_price" json:"current_price,omitempty"` // 列表接口返回
+}
+
+func (x *PriceAlert) Reset() { *x = PriceAlert{} }
+
+func (x *PriceAlert) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *PriceAlert) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *PriceAlert) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetTargetPrice() string {
+	if x != nil {
+		return x.TargetPrice
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetNotifiedPrice() string {
+	if x != nil {
+		return x.NotifiedPrice
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetNotifiedAt() int64 {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PriceAlert) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetMainImage() string {
+	if x != nil {
+		return x.MainImage
+	}
+	return ""
+}
+
+func (x *PriceAlert) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
 type ProductService interface {
 	CreateProduct(ctx context.Context, req *CreateProductRequest) (res *CreateProductResponse, err error)
 	UpdateProduct(ctx context.Context, req *UpdateProductRequest) (res *UpdateProductResponse, err error)
 	UpdateProductStatus(ctx context.Context, req *UpdateProductStatusRequest) (res *UpdateProductStatusResponse, err error)
 	GetProductDetail(ctx context.Context, req *GetProductDetailRequest) (res *GetProductDetailResponse, err error)
 	ListProducts(ctx context.Context, req *ListProductsRequest) (res *ListProductsResponse, err error)
+	DeleteProduct(ctx context.Context, req *DeleteProductRequest) (res *DeleteProductResponse, err error)
+	RestoreProduct(ctx context.Context, req *RestoreProductRequest) (res *RestoreProductResponse, err error)
 	GetProductsByIds(ctx context.Context, req *GetProductsByIdsRequest) (res *GetProductsByIdsResponse, err error)
+	AuditProducts(ctx context.Context, req *AuditProductsRequest) (res *AuditProductsResponse, err error)
+	ListAuditQueue(ctx context.Context, req *ListAuditQueueRequest) (res *ListAuditQueueResponse, err error)
+	ListProductAudits(ctx context.Context, req *ListProductAuditsRequest) (res *ListProductAuditsResponse, err error)
+	ListProductHistory(ctx context.Context, req *ListProductHistoryRequest) (res *ListProductHistoryResponse, err error)
+	RollbackProduct(ctx context.Context, req *RollbackProductRequest) (res *RollbackProductResponse, err error)
+	CreateProductSchedule(ctx context.Context, req *CreateProductScheduleRequest) (res *CreateProductScheduleResponse, err error)
+	ListProductSchedules(ctx context.Context, req *ListProductSchedulesRequest) (res *ListProductSchedulesResponse, err error)
+	CancelProductSchedule(ctx context.Context, req *CancelProductScheduleRequest) (res *CancelProductScheduleResponse, err error)
+	ImportProducts(ctx context.Context, req *ImportProductsRequest) (res *ImportProductsResponse, err error)
+	GetImportJob(ctx context.Context, req *GetImportJobRequest) (res *GetImportJobResponse, err error)
+	ExportProducts(ctx context.Context, req *ExportProductsRequest) (res *ExportProductsResponse, err error)
+	CreateMediaAsset(ctx context.Context, req *CreateMediaAssetRequest) (res *CreateMediaAssetResponse, err error)
+	ListMediaAssets(ctx context.Context, req *ListMediaAssetsRequest) (res *ListMediaAssetsResponse, err error)
+	GetMediaAssets(ctx context.Context, req *GetMediaAssetsRequest) (res *GetMediaAssetsResponse, err error)
+	ListPriceHistory(ctx context.Context, req *ListPriceHistoryRequest) (res *ListPriceHistoryResponse, err error)
+	SubscribePriceAlert(ctx context.Context, req *SubscribePriceAlertRequest) (res *SubscribePriceAlertResponse, err error)
+	CancelPriceAlert(ctx context.Context, req *CancelPriceAlertRequest) (res *CancelPriceAlertResponse, err error)
+	ListPriceAlerts(ctx context.Context, req *ListPriceAlertsRequest) (res *ListPriceAlertsResponse, err error)
 	GetSkusByIds(ctx context.Context, req *GetSkusByIdsRequest) (res *GetSkusByIdsResponse, err error)
 	BatchUpdateSku(ctx context.Context, req *BatchUpdateSkuRequest) (res *BatchUpdateSkuResponse, err error)
 	DeductStock(ctx context.Context, req *DeductStockRequest) (res *DeductStockResponse, err error)
 	ReleaseStock(ctx context.Context, req *ReleaseStockRequest) (res *ReleaseStockResponse, err error)
 	ListCategories(ctx context.Context, req *ListCategoriesRequest) (res *ListCategoriesResponse, err error)
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest) (res *CreateCategoryResponse, err error)
+	UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (res *UpdateCategoryResponse, err error)
+	SortCategories(ctx context.Context, req *SortCategoriesRequest) (res *SortCategoriesResponse, err error)
+	SetCategoryVisibility(ctx context.Context, req *SetCategoryVisibilityRequest) (res *SetCategoryVisibilityResponse, err error)
+	MoveCategory(ctx context.Context, req *MoveCategoryRequest) (res *MoveCategoryResponse, err error)
+	DeleteCategory(ctx context.Context, req *DeleteCategoryRequest) (res *DeleteCategoryResponse, err error)
+	GetSpecTemplate(ctx context.Context, req *GetSpecTemplateRequest) (res *GetSpecTemplateResponse, err error)
+	SaveSpecTemplate(ctx context.Context, req *SaveSpecTemplateRequest) (res *SaveSpecTemplateResponse, err error)
 	ListBrands(ctx context.Context, req *ListBrandsRequest) (res *ListBrandsResponse, err error)
+	CreateBrand(ctx context.Context, req *CreateBrandRequest) (res *CreateBrandResponse, err error)
+	UpdateBrand(ctx context.Context, req *UpdateBrandRequest) (res *UpdateBrandResponse, err error)
+	DeleteBrand(ctx context.Context, req *DeleteBrandRequest) (res *DeleteBrandResponse, err error)
+	SetBrandCategories(ctx context.Context, req *SetBrandCategoriesRequest) (res *SetBrandCategoriesResponse, err error)
 	SearchProducts(ctx context.Context, req *SearchProductsRequest) (res *SearchProductsResponse, err error)
 	GetHotProducts(ctx context.Context, req *GetHotProductsRequest) (res *GetHotProductsResponse, err error)
+	SuggestQueries(ctx context.Context, req *SuggestQueriesRequest) (res *SuggestQueriesResponse, err error)
+	GetTrendingSearches(ctx context.Context, req *GetTrendingSearchesRequest) (res *GetTrendingSearchesResponse, err error)
+	GetSearchReport(ctx context.Context, req *GetSearchReportRequest) (res *GetSearchReportResponse, err error)
 }
@@ -16,15 +16,50 @@ type Client interface {
 	UpdateProductStatus(ctx context.Context, Req *product.UpdateProductStatusRequest, callOptions ...callopt.Option) (r *product.UpdateProductStatusResponse, err error)
 	GetProductDetail(ctx context.Context, Req *product.GetProductDetailRequest, callOptions ...callopt.Option) (r *product.GetProductDetailResponse, err error)
 	ListProducts(ctx context.Context, Req *product.ListProductsRequest, callOptions ...callopt.Option) (r *product.ListProductsResponse, err error)
+	DeleteProduct(ctx context.Context, Req *product.DeleteProductRequest, callOptions ...callopt.Option) (r *product.DeleteProductResponse, err error)
+	RestoreProduct(ctx context.Context, Req *product.RestoreProductRequest, callOptions ...callopt.Option) (r *product.RestoreProductResponse, err error)
 	GetProductsByIds(ctx context.Context, Req *product.GetProductsByIdsRequest, callOptions ...callopt.Option) (r *product.GetProductsByIdsResponse, err error)
+	AuditProducts(ctx context.Context, Req *product.AuditProductsRequest, callOptions ...callopt.Option) (r *product.AuditProductsResponse, err error)
+	ListAuditQueue(ctx context.Context, Req *product.ListAuditQueueRequest, callOptions ...callopt.Option) (r *product.ListAuditQueueResponse, err error)
+	ListProductAudits(ctx context.Context, Req *product.ListProductAuditsRequest, callOptions ...callopt.Option) (r *product.ListProductAuditsResponse, err error)
+	ListProductHistory(ctx context.Context, Req *product.ListProductHistoryRequest, callOptions ...callopt.Option) (r *product.ListProductHistoryResponse, err error)
+	RollbackProduct(ctx context.Context, Req *product.RollbackProductRequest, callOptions ...callopt.Option) (r *product.RollbackProductResponse, err error)
+	CreateProductSchedule(ctx context.Context, Req *product.CreateProductScheduleRequest, callOptions ...callopt.Option) (r *product.CreateProductScheduleResponse, err error)
+	ListProductSchedules(ctx context.Context, Req *product.ListProductSchedulesRequest, callOptions ...callopt.Option) (r *product.ListProductSchedulesResponse, err error)
+	CancelProductSchedule(ctx context.Context, Req *product.CancelProductScheduleRequest, callOptions ...callopt.Option) (r *product.CancelProductScheduleResponse, err error)
+	ImportProducts(ctx context.Context, Req *product.ImportProductsRequest, callOptions ...callopt.Option) (r *product.ImportProductsResponse, err error)
+	GetImportJob(ctx context.Context, Req *product.GetImportJobRequest, callOptions ...callopt.Option) (r *product.GetImportJobResponse, err error)
+	ExportProducts(ctx context.Context, Req *product.ExportProductsRequest, callOptions ...callopt.Option) (r *product.ExportProductsResponse, err error)
+	CreateMediaAsset(ctx context.Context, Req *product.CreateMediaAssetRequest, callOptions ...callopt.Option) (r *product.CreateMediaAssetResponse, err error)
+	ListMediaAssets(ctx context.Context, Req *product.ListMediaAssetsRequest, callOptions ...callopt.Option) (r *product.ListMediaAssetsResponse, err error)
+	GetMediaAssets(ctx context.Context, Req *product.GetMediaAssetsRequest, callOptions ...callopt.Option) (r *product.GetMediaAssetsResponse, err error)
+	ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryRequest, callOptions ...callopt.Option) (r *product.ListPriceHistoryResponse, err error)
+	SubscribePriceAlert(ctx context.Context, Req *product.SubscribePriceAlertRequest, callOptions ...callopt.Option) (r *product.SubscribePriceAlertResponse, err error)
+	CancelPriceAlert(ctx context.Context, Req *product.CancelPriceAlertRequest, callOptions ...callopt.Option) (r *product.CancelPriceAlertResponse, err error)
+	ListPriceAlerts(ctx context.Context, Req *product.ListPriceAlertsRequest, callOptions ...callopt.Option) (r *product.ListPriceAlertsResponse, err error)
 	GetSkusByIds(ctx context.Context, Req *product.GetSkusByIdsRequest, callOptions ...callopt.Option) (r *product.GetSkusByIdsResponse, err error)
 	BatchUpdateSku(ctx context.Context, Req *product.BatchUpdateSkuRequest, callOptions ...callopt.Option) (r *product.BatchUpdateSkuResponse, err error)
 	DeductStock(ctx context.Context, Req *product.DeductStockRequest, callOptions ...callopt.Option) (r *product.DeductStockResponse, err error)
 	ReleaseStock(ctx context.Context, Req *product.ReleaseStockRequest, callOptions ...callopt.Option) (r *product.ReleaseStockResponse, err error)
 	ListCategories(ctx context.Context, Req *product.ListCategoriesRequest, callOptions ...callopt.Option) (r *product.ListCategoriesResponse, err error)
+	CreateCategory(ctx context.Context, Req *product.CreateCategoryRequest, callOptions ...callopt.Option) (r *product.CreateCategoryResponse, err error)
+	UpdateCategory(ctx context.Context, Req *product.UpdateCategoryRequest, callOptions ...callopt.Option) (r *product.UpdateCategoryResponse, err error)
+	SortCategories(ctx context.Context, Req *product.SortCategoriesRequest, callOptions ...callopt.Option) (r *product.SortCategoriesResponse, err error)
+	SetCategoryVisibility(ctx context.Context, Req *product.SetCategoryVisibilityRequest, callOptions ...callopt.Option) (r *product.SetCategoryVisibilityResponse, err error)
+	MoveCategory(ctx context.Context, Req *product.MoveCategoryRequest, callOptions ...callopt.Option) (r *product.MoveCategoryResponse, err error)
+	DeleteCategory(ctx context.Context, Req *product.DeleteCategoryRequest, callOptions ...callopt.Option) (r *product.DeleteCategoryResponse, err error)
+	GetSpecTemplate(ctx context.Context, Req *product.GetSpecTemplateRequest, callOptions ...callopt.Option) (r *product.GetSpecTemplateResponse, err error)
+	SaveSpecTemplate(ctx context.Context, Req *product.SaveSpecTemplateRequest, callOptions ...callopt.Option) (r *product.SaveSpecTemplateResponse, err error)
 	ListBrands(ctx context.Context, Req *product.ListBrandsRequest, callOptions ...callopt.Option) (r *product.ListBrandsResponse, err error)
+	CreateBrand(ctx context.Context, Req *product.CreateBrandRequest, callOptions ...callopt.Option) (r *product.CreateBrandResponse, err error)
+	UpdateBrand(ctx context.Context, Req *product.UpdateBrandRequest, callOptions ...callopt.Option) (r *product.UpdateBrandResponse, err error)
+	DeleteBrand(ctx context.Context, Req *product.DeleteBrandRequest, callOptions ...callopt.Option) (r *product.DeleteBrandResponse, err error)
+	SetBrandCategories(ctx context.Context, Req *product.SetBrandCategoriesRequest, callOptions ...callopt.Option) (r *product.SetBrandCategoriesResponse, err error)
 	SearchProducts(ctx context.Context, Req *product.SearchProductsRequest, callOptions ...callopt.Option) (r *product.SearchProductsResponse, err error)
 	GetHotProducts(ctx context.Context, Req *product.GetHotProductsRequest, callOptions ...callopt.Option) (r *product.GetHotProductsResponse, err error)
+	SuggestQueries(ctx context.Context, Req *product.SuggestQueriesRequest, callOptions ...callopt.Option) (r *product.SuggestQueriesResponse, err error)
+	GetTrendingSearches(ctx context.Context, Req *product.GetTrendingSearchesRequest, callOptions ...callopt.Option) (r *product.GetTrendingSearchesResponse, err error)
+	GetSearchReport(ctx context.Context, Req *product.GetSearchReportRequest, callOptions ...callopt.Option) (r *product.GetSearchReportResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -81,11 +116,111 @@ func (p *kProductServiceClient) ListProducts(ctx context.Context, Req *product.L
 	return p.kClient.ListProducts(ctx, Req)
 }
 
+func (p *kProductServiceClient) DeleteProduct(ctx context.Context, Req *product.DeleteProductRequest, callOptions ...callopt.Option) (r *product.DeleteProductResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteProduct(ctx, Req)
+}
+
+func (p *kProductServiceClient) RestoreProduct(ctx context.Context, Req *product.RestoreProductRequest, callOptions ...callopt.Option) (r *product.RestoreProductResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RestoreProduct(ctx, Req)
+}
+
 func (p *kProductServiceClient) GetProductsByIds(ctx context.Context, Req *product.GetProductsByIdsRequest, callOptions ...callopt.Option) (r *product.GetProductsByIdsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetProductsByIds(ctx, Req)
 }
 
+func (p *kProductServiceClient) AuditProducts(ctx context.Context, Req *product.AuditProductsRequest, callOptions ...callopt.Option) (r *product.AuditProductsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AuditProducts(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListAuditQueue(ctx context.Context, Req *product.ListAuditQueueRequest, callOptions ...callopt.Option) (r *product.ListAuditQueueResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAuditQueue(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListProductAudits(ctx context.Context, Req *product.ListProductAuditsRequest, callOptions ...callopt.Option) (r *product.ListProductAuditsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductAudits(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListProductHistory(ctx context.Context, Req *product.ListProductHistoryRequest, callOptions ...callopt.Option) (r *product.ListProductHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductHistory(ctx, Req)
+}
+
+func (p *kProductServiceClient) RollbackProduct(ctx context.Context, Req *product.RollbackProductRequest, callOptions ...callopt.Option) (r *product.RollbackProductResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RollbackProduct(ctx, Req)
+}
+
+func (p *kProductServiceClient) CreateProductSchedule(ctx context.Context, Req *product.CreateProductScheduleRequest, callOptions ...callopt.Option) (r *product.CreateProductScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateProductSchedule(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListProductSchedules(ctx context.Context, Req *product.ListProductSchedulesRequest, callOptions ...callopt.Option) (r *product.ListProductSchedulesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListProductSchedules(ctx, Req)
+}
+
+func (p *kProductServiceClient) CancelProductSchedule(ctx context.Context, Req *product.CancelProductScheduleRequest, callOptions ...callopt.Option) (r *product.CancelProductScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelProductSchedule(ctx, Req)
+}
+
+func (p *kProductServiceClient) ImportProducts(ctx context.Context, Req *product.ImportProductsRequest, callOptions ...callopt.Option) (r *product.ImportProductsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ImportProducts(ctx, Req)
+}
+
+func (p *kProductServiceClient) GetImportJob(ctx context.Context, Req *product.GetImportJobRequest, callOptions ...callopt.Option) (r *product.GetImportJobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetImportJob(ctx, Req)
+}
+
+func (p *kProductServiceClient) ExportProducts(ctx context.Context, Req *product.ExportProductsRequest, callOptions ...callopt.Option) (r *product.ExportProductsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ExportProducts(ctx, Req)
+}
+
+func (p *kProductServiceClient) CreateMediaAsset(ctx context.Context, Req *product.CreateMediaAssetRequest, callOptions ...callopt.Option) (r *product.CreateMediaAssetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateMediaAsset(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListMediaAssets(ctx context.Context, Req *product.ListMediaAssetsRequest, callOptions ...callopt.Option) (r *product.ListMediaAssetsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListMediaAssets(ctx, Req)
+}
+
+func (p *kProductServiceClient) GetMediaAssets(ctx context.Context, Req *product.GetMediaAssetsRequest, callOptions ...callopt.Option) (r *product.GetMediaAssetsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetMediaAssets(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryRequest, callOptions ...callopt.Option) (r *product.ListPriceHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPriceHistory(ctx, Req)
+}
+
+func (p *kProductServiceClient) SubscribePriceAlert(ctx context.Context, Req *product.SubscribePriceAlertRequest, callOptions ...callopt.Option) (r *product.SubscribePriceAlertResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribePriceAlert(ctx, Req)
+}
+
+func (p *kProductServiceClient) CancelPriceAlert(ctx context.Context, Req *product.CancelPriceAlertRequest, callOptions ...callopt.Option) (r *product.CancelPriceAlertResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CancelPriceAlert(ctx, Req)
+}
+
+func (p *kProductServiceClient) ListPriceAlerts(ctx context.Context, Req *product.ListPriceAlertsRequest, callOptions ...callopt.Option) (r *product.ListPriceAlertsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListPriceAlerts(ctx, Req)
+}
+
 func (p *kProductServiceClient) GetSkusByIds(ctx context.Context, Req *product.GetSkusByIdsRequest, callOptions ...callopt.Option) (r *product.GetSkusByIdsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetSkusByIds(ctx, Req)
@@ -111,11 +246,71 @@ func (p *kProductServiceClient) ListCategories(ctx context.Context, Req *product
 	return p.kClient.ListCategories(ctx, Req)
 }
 
+func (p *kProductServiceClient) CreateCategory(ctx context.Context, Req *product.CreateCategoryRequest, callOptions ...callopt.Option) (r *product.CreateCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateCategory(ctx, Req)
+}
+
+func (p *kProductServiceClient) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryRequest, callOptions ...callopt.Option) (r *product.UpdateCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateCategory(ctx, Req)
+}
+
+func (p *kProductServiceClient) SortCategories(ctx context.Context, Req *product.SortCategoriesRequest, callOptions ...callopt.Option) (r *product.SortCategoriesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SortCategories(ctx, Req)
+}
+
+func (p *kProductServiceClient) SetCategoryVisibility(ctx context.Context, Req *product.SetCategoryVisibilityRequest, callOptions ...callopt.Option) (r *product.SetCategoryVisibilityResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetCategoryVisibility(ctx, Req)
+}
+
+func (p *kProductServiceClient) MoveCategory(ctx context.Context, Req *product.MoveCategoryRequest, callOptions ...callopt.Option) (r *product.MoveCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MoveCategory(ctx, Req)
+}
+
+func (p *kProductServiceClient) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryRequest, callOptions ...callopt.Option) (r *product.DeleteCategoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteCategory(ctx, Req)
+}
+
+func (p *kProductServiceClient) GetSpecTemplate(ctx context.Context, Req *product.GetSpecTemplateRequest, callOptions ...callopt.Option) (r *product.GetSpecTemplateResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSpecTemplate(ctx, Req)
+}
+
+func (p *kProductServiceClient) SaveSpecTemplate(ctx context.Context, Req *product.SaveSpecTemplateRequest, callOptions ...callopt.Option) (r *product.SaveSpecTemplateResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SaveSpecTemplate(ctx, Req)
+}
+
 func (p *kProductServiceClient) ListBrands(ctx context.Context, Req *product.ListBrandsRequest, callOptions ...callopt.Option) (r *product.ListBrandsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.ListBrands(ctx, Req)
 }
 
+func (p *kProductServiceClient) CreateBrand(ctx context.Context, Req *product.CreateBrandRequest, callOptions ...callopt.Option) (r *product.CreateBrandResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateBrand(ctx, Req)
+}
+
+func (p *kProductServiceClient) UpdateBrand(ctx context.Context, Req *product.UpdateBrandRequest, callOptions ...callopt.Option) (r *product.UpdateBrandResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateBrand(ctx, Req)
+}
+
+func (p *kProductServiceClient) DeleteBrand(ctx context.Context, Req *product.DeleteBrandRequest, callOptions ...callopt.Option) (r *product.DeleteBrandResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteBrand(ctx, Req)
+}
+
+func (p *kProductServiceClient) SetBrandCategories(ctx context.Context, Req *product.SetBrandCategoriesRequest, callOptions ...callopt.Option) (r *product.SetBrandCategoriesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetBrandCategories(ctx, Req)
+}
+
 func (p *kProductServiceClient) SearchProducts(ctx context.Context, Req *product.SearchProductsRequest, callOptions ...callopt.Option) (r *product.SearchProductsResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SearchProducts(ctx, Req)
@@ -125,3 +320,18 @@ func (p *kProductServiceClient) GetHotProducts(ctx context.Context, Req *product
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetHotProducts(ctx, Req)
 }
+
+func (p *kProductServiceClient) SuggestQueries(ctx context.Context, Req *product.SuggestQueriesRequest, callOptions ...callopt.Option) (r *product.SuggestQueriesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SuggestQueries(ctx, Req)
+}
+
+func (p *kProductServiceClient) GetTrendingSearches(ctx context.Context, Req *product.GetTrendingSearchesRequest, callOptions ...callopt.Option) (r *product.GetTrendingSearchesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTrendingSearches(ctx, Req)
+}
+
+func (p *kProductServiceClient) GetSearchReport(ctx context.Context, Req *product.GetSearchReportRequest, callOptions ...callopt.Option) (r *product.GetSearchReportResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetSearchReport(ctx, Req)
+}
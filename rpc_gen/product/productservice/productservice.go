@@ -50,6 +50,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"DeleteProduct": kitex.NewMethodInfo(
+		deleteProductHandler,
+		newDeleteProductArgs,
+		newDeleteProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RestoreProduct": kitex.NewMethodInfo(
+		restoreProductHandler,
+		newRestoreProductArgs,
+		newRestoreProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"GetProductsByIds": kitex.NewMethodInfo(
 		getProductsByIdsHandler,
 		newGetProductsByIdsArgs,
@@ -57,6 +71,132 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"AuditProducts": kitex.NewMethodInfo(
+		auditProductsHandler,
+		newAuditProductsArgs,
+		newAuditProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAuditQueue": kitex.NewMethodInfo(
+		listAuditQueueHandler,
+		newListAuditQueueArgs,
+		newListAuditQueueResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListProductAudits": kitex.NewMethodInfo(
+		listProductAuditsHandler,
+		newListProductAuditsArgs,
+		newListProductAuditsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListProductHistory": kitex.NewMethodInfo(
+		listProductHistoryHandler,
+		newListProductHistoryArgs,
+		newListProductHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"RollbackProduct": kitex.NewMethodInfo(
+		rollbackProductHandler,
+		newRollbackProductArgs,
+		newRollbackProductResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateProductSchedule": kitex.NewMethodInfo(
+		createProductScheduleHandler,
+		newCreateProductScheduleArgs,
+		newCreateProductScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListProductSchedules": kitex.NewMethodInfo(
+		listProductSchedulesHandler,
+		newListProductSchedulesArgs,
+		newListProductSchedulesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CancelProductSchedule": kitex.NewMethodInfo(
+		cancelProductScheduleHandler,
+		newCancelProductScheduleArgs,
+		newCancelProductScheduleResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ImportProducts": kitex.NewMethodInfo(
+		importProductsHandler,
+		newImportProductsArgs,
+		newImportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetImportJob": kitex.NewMethodInfo(
+		getImportJobHandler,
+		newGetImportJobArgs,
+		newGetImportJobResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ExportProducts": kitex.NewMethodInfo(
+		exportProductsHandler,
+		newExportProductsArgs,
+		newExportProductsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateMediaAsset": kitex.NewMethodInfo(
+		createMediaAssetHandler,
+		newCreateMediaAssetArgs,
+		newCreateMediaAssetResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListMediaAssets": kitex.NewMethodInfo(
+		listMediaAssetsHandler,
+		newListMediaAssetsArgs,
+		newListMediaAssetsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetMediaAssets": kitex.NewMethodInfo(
+		getMediaAssetsHandler,
+		newGetMediaAssetsArgs,
+		newGetMediaAssetsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListPriceHistory": kitex.NewMethodInfo(
+		listPriceHistoryHandler,
+		newListPriceHistoryArgs,
+		newListPriceHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SubscribePriceAlert": kitex.NewMethodInfo(
+		subscribePriceAlertHandler,
+		newSubscribePriceAlertArgs,
+		newSubscribePriceAlertResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CancelPriceAlert": kitex.NewMethodInfo(
+		cancelPriceAlertHandler,
+		newCancelPriceAlertArgs,
+		newCancelPriceAlertResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListPriceAlerts": kitex.NewMethodInfo(
+		listPriceAlertsHandler,
+		newListPriceAlertsArgs,
+		newListPriceAlertsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"GetSkusByIds": kitex.NewMethodInfo(
 		getSkusByIdsHandler,
 		newGetSkusByIdsArgs,
@@ -92,6 +232,62 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"CreateCategory": kitex.NewMethodInfo(
+		createCategoryHandler,
+		newCreateCategoryArgs,
+		newCreateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateCategory": kitex.NewMethodInfo(
+		updateCategoryHandler,
+		newUpdateCategoryArgs,
+		newUpdateCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SortCategories": kitex.NewMethodInfo(
+		sortCategoriesHandler,
+		newSortCategoriesArgs,
+		newSortCategoriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetCategoryVisibility": kitex.NewMethodInfo(
+		setCategoryVisibilityHandler,
+		newSetCategoryVisibilityArgs,
+		newSetCategoryVisibilityResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"MoveCategory": kitex.NewMethodInfo(
+		moveCategoryHandler,
+		newMoveCategoryArgs,
+		newMoveCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteCategory": kitex.NewMethodInfo(
+		deleteCategoryHandler,
+		newDeleteCategoryArgs,
+		newDeleteCategoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetSpecTemplate": kitex.NewMethodInfo(
+		getSpecTemplateHandler,
+		newGetSpecTemplateArgs,
+		newGetSpecTemplateResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SaveSpecTemplate": kitex.NewMethodInfo(
+		saveSpecTemplateHandler,
+		newSaveSpecTemplateArgs,
+		newSaveSpecTemplateResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"ListBrands": kitex.NewMethodInfo(
 		listBrandsHandler,
 		newListBrandsArgs,
@@ -99,6 +295,34 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"CreateBrand": kitex.NewMethodInfo(
+		createBrandHandler,
+		newCreateBrandArgs,
+		newCreateBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpdateBrand": kitex.NewMethodInfo(
+		updateBrandHandler,
+		newUpdateBrandArgs,
+		newUpdateBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"DeleteBrand": kitex.NewMethodInfo(
+		deleteBrandHandler,
+		newDeleteBrandArgs,
+		newDeleteBrandResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetBrandCategories": kitex.NewMethodInfo(
+		setBrandCategoriesHandler,
+		newSetBrandCategoriesArgs,
+		newSetBrandCategoriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 	"SearchProducts": kitex.NewMethodInfo(
 		searchProductsHandler,
 		newSearchProductsArgs,
@@ -113,6 +337,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"SuggestQueries": kitex.NewMethodInfo(
+		suggestQueriesHandler,
+		newSuggestQueriesArgs,
+		newSuggestQueriesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetTrendingSearches": kitex.NewMethodInfo(
+		getTrendingSearchesHandler,
+		newGetTrendingSearchesArgs,
+		newGetTrendingSearchesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetSearchReport": kitex.NewMethodInfo(
+		getSearchReportHandler,
+		newGetSearchReportArgs,
+		newGetSearchReportResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -734,52 +979,52 @@ func (p *ListProductsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getProductsByIdsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func deleteProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.GetProductsByIdsRequest)
+		req := new(product.DeleteProductRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).GetProductsByIds(ctx, req)
+		resp, err := handler.(product.ProductService).DeleteProduct(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetProductsByIdsArgs:
-		success, err := handler.(product.ProductService).GetProductsByIds(ctx, s.Req)
+	case *DeleteProductArgs:
+		success, err := handler.(product.ProductService).DeleteProduct(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetProductsByIdsResult)
+		realResult := result.(*DeleteProductResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetProductsByIdsArgs() interface{} {
-	return &GetProductsByIdsArgs{}
+func newDeleteProductArgs() interface{} {
+	return &DeleteProductArgs{}
 }
 
-func newGetProductsByIdsResult() interface{} {
-	return &GetProductsByIdsResult{}
+func newDeleteProductResult() interface{} {
+	return &DeleteProductResult{}
 }
 
-type GetProductsByIdsArgs struct {
-	Req *product.GetProductsByIdsRequest
+type DeleteProductArgs struct {
+	Req *product.DeleteProductRequest
 }
 
-func (p *GetProductsByIdsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *DeleteProductArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetProductsByIdsArgs) Unmarshal(in []byte) error {
-	msg := new(product.GetProductsByIdsRequest)
+func (p *DeleteProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteProductRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -787,38 +1032,38 @@ func (p *GetProductsByIdsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetProductsByIdsArgs_Req_DEFAULT *product.GetProductsByIdsRequest
+var DeleteProductArgs_Req_DEFAULT *product.DeleteProductRequest
 
-func (p *GetProductsByIdsArgs) GetReq() *product.GetProductsByIdsRequest {
+func (p *DeleteProductArgs) GetReq() *product.DeleteProductRequest {
 	if !p.IsSetReq() {
-		return GetProductsByIdsArgs_Req_DEFAULT
+		return DeleteProductArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetProductsByIdsArgs) IsSetReq() bool {
+func (p *DeleteProductArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetProductsByIdsArgs) GetFirstArgument() interface{} {
+func (p *DeleteProductArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetProductsByIdsResult struct {
-	Success *product.GetProductsByIdsResponse
+type DeleteProductResult struct {
+	Success *product.DeleteProductResponse
 }
 
-var GetProductsByIdsResult_Success_DEFAULT *product.GetProductsByIdsResponse
+var DeleteProductResult_Success_DEFAULT *product.DeleteProductResponse
 
-func (p *GetProductsByIdsResult) Marshal(out []byte) ([]byte, error) {
+func (p *DeleteProductResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetProductsByIdsResult) Unmarshal(in []byte) error {
-	msg := new(product.GetProductsByIdsResponse)
+func (p *DeleteProductResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteProductResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -826,71 +1071,71 @@ func (p *GetProductsByIdsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetProductsByIdsResult) GetSuccess() *product.GetProductsByIdsResponse {
+func (p *DeleteProductResult) GetSuccess() *product.DeleteProductResponse {
 	if !p.IsSetSuccess() {
-		return GetProductsByIdsResult_Success_DEFAULT
+		return DeleteProductResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetProductsByIdsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.GetProductsByIdsResponse)
+func (p *DeleteProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteProductResponse)
 }
 
-func (p *GetProductsByIdsResult) IsSetSuccess() bool {
+func (p *DeleteProductResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetProductsByIdsResult) GetResult() interface{} {
+func (p *DeleteProductResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getSkusByIdsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func restoreProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.GetSkusByIdsRequest)
+		req := new(product.RestoreProductRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).GetSkusByIds(ctx, req)
+		resp, err := handler.(product.ProductService).RestoreProduct(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetSkusByIdsArgs:
-		success, err := handler.(product.ProductService).GetSkusByIds(ctx, s.Req)
+	case *RestoreProductArgs:
+		success, err := handler.(product.ProductService).RestoreProduct(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetSkusByIdsResult)
+		realResult := result.(*RestoreProductResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetSkusByIdsArgs() interface{} {
-	return &GetSkusByIdsArgs{}
+func newRestoreProductArgs() interface{} {
+	return &RestoreProductArgs{}
 }
 
-func newGetSkusByIdsResult() interface{} {
-	return &GetSkusByIdsResult{}
+func newRestoreProductResult() interface{} {
+	return &RestoreProductResult{}
 }
 
-type GetSkusByIdsArgs struct {
-	Req *product.GetSkusByIdsRequest
+type RestoreProductArgs struct {
+	Req *product.RestoreProductRequest
 }
 
-func (p *GetSkusByIdsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RestoreProductArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetSkusByIdsArgs) Unmarshal(in []byte) error {
-	msg := new(product.GetSkusByIdsRequest)
+func (p *RestoreProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.RestoreProductRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -898,38 +1143,38 @@ func (p *GetSkusByIdsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetSkusByIdsArgs_Req_DEFAULT *product.GetSkusByIdsRequest
+var RestoreProductArgs_Req_DEFAULT *product.RestoreProductRequest
 
-func (p *GetSkusByIdsArgs) GetReq() *product.GetSkusByIdsRequest {
+func (p *RestoreProductArgs) GetReq() *product.RestoreProductRequest {
 	if !p.IsSetReq() {
-		return GetSkusByIdsArgs_Req_DEFAULT
+		return RestoreProductArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetSkusByIdsArgs) IsSetReq() bool {
+func (p *RestoreProductArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetSkusByIdsArgs) GetFirstArgument() interface{} {
+func (p *RestoreProductArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetSkusByIdsResult struct {
-	Success *product.GetSkusByIdsResponse
+type RestoreProductResult struct {
+	Success *product.RestoreProductResponse
 }
 
-var GetSkusByIdsResult_Success_DEFAULT *product.GetSkusByIdsResponse
+var RestoreProductResult_Success_DEFAULT *product.RestoreProductResponse
 
-func (p *GetSkusByIdsResult) Marshal(out []byte) ([]byte, error) {
+func (p *RestoreProductResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetSkusByIdsResult) Unmarshal(in []byte) error {
-	msg := new(product.GetSkusByIdsResponse)
+func (p *RestoreProductResult) Unmarshal(in []byte) error {
+	msg := new(product.RestoreProductResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -937,71 +1182,71 @@ func (p *GetSkusByIdsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetSkusByIdsResult) GetSuccess() *product.GetSkusByIdsResponse {
+func (p *RestoreProductResult) GetSuccess() *product.RestoreProductResponse {
 	if !p.IsSetSuccess() {
-		return GetSkusByIdsResult_Success_DEFAULT
+		return RestoreProductResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetSkusByIdsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.GetSkusByIdsResponse)
+func (p *RestoreProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.RestoreProductResponse)
 }
 
-func (p *GetSkusByIdsResult) IsSetSuccess() bool {
+func (p *RestoreProductResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetSkusByIdsResult) GetResult() interface{} {
+func (p *RestoreProductResult) GetResult() interface{} {
 	return p.Success
 }
 
-func batchUpdateSkuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func getProductsByIdsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.BatchUpdateSkuRequest)
+		req := new(product.GetProductsByIdsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).BatchUpdateSku(ctx, req)
+		resp, err := handler.(product.ProductService).GetProductsByIds(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *BatchUpdateSkuArgs:
-		success, err := handler.(product.ProductService).BatchUpdateSku(ctx, s.Req)
+	case *GetProductsByIdsArgs:
+		success, err := handler.(product.ProductService).GetProductsByIds(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*BatchUpdateSkuResult)
+		realResult := result.(*GetProductsByIdsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newBatchUpdateSkuArgs() interface{} {
-	return &BatchUpdateSkuArgs{}
+func newGetProductsByIdsArgs() interface{} {
+	return &GetProductsByIdsArgs{}
 }
 
-func newBatchUpdateSkuResult() interface{} {
-	return &BatchUpdateSkuResult{}
+func newGetProductsByIdsResult() interface{} {
+	return &GetProductsByIdsResult{}
 }
 
-type BatchUpdateSkuArgs struct {
-	Req *product.BatchUpdateSkuRequest
+type GetProductsByIdsArgs struct {
+	Req *product.GetProductsByIdsRequest
 }
 
-func (p *BatchUpdateSkuArgs) Marshal(out []byte) ([]byte, error) {
+func (p *GetProductsByIdsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *BatchUpdateSkuArgs) Unmarshal(in []byte) error {
-	msg := new(product.BatchUpdateSkuRequest)
+func (p *GetProductsByIdsArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetProductsByIdsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1009,38 +1254,38 @@ func (p *BatchUpdateSkuArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var BatchUpdateSkuArgs_Req_DEFAULT *product.BatchUpdateSkuRequest
+var GetProductsByIdsArgs_Req_DEFAULT *product.GetProductsByIdsRequest
 
-func (p *BatchUpdateSkuArgs) GetReq() *product.BatchUpdateSkuRequest {
+func (p *GetProductsByIdsArgs) GetReq() *product.GetProductsByIdsRequest {
 	if !p.IsSetReq() {
-		return BatchUpdateSkuArgs_Req_DEFAULT
+		return GetProductsByIdsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *BatchUpdateSkuArgs) IsSetReq() bool {
+func (p *GetProductsByIdsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *BatchUpdateSkuArgs) GetFirstArgument() interface{} {
+func (p *GetProductsByIdsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type BatchUpdateSkuResult struct {
-	Success *product.BatchUpdateSkuResponse
+type GetProductsByIdsResult struct {
+	Success *product.GetProductsByIdsResponse
 }
 
-var BatchUpdateSkuResult_Success_DEFAULT *product.BatchUpdateSkuResponse
+var GetProductsByIdsResult_Success_DEFAULT *product.GetProductsByIdsResponse
 
-func (p *BatchUpdateSkuResult) Marshal(out []byte) ([]byte, error) {
+func (p *GetProductsByIdsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *BatchUpdateSkuResult) Unmarshal(in []byte) error {
-	msg := new(product.BatchUpdateSkuResponse)
+func (p *GetProductsByIdsResult) Unmarshal(in []byte) error {
+	msg := new(product.GetProductsByIdsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1048,71 +1293,71 @@ func (p *BatchUpdateSkuResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *BatchUpdateSkuResult) GetSuccess() *product.BatchUpdateSkuResponse {
+func (p *GetProductsByIdsResult) GetSuccess() *product.GetProductsByIdsResponse {
 	if !p.IsSetSuccess() {
-		return BatchUpdateSkuResult_Success_DEFAULT
+		return GetProductsByIdsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *BatchUpdateSkuResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.BatchUpdateSkuResponse)
+func (p *GetProductsByIdsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetProductsByIdsResponse)
 }
 
-func (p *BatchUpdateSkuResult) IsSetSuccess() bool {
+func (p *GetProductsByIdsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *BatchUpdateSkuResult) GetResult() interface{} {
+func (p *GetProductsByIdsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func deductStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func auditProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.DeductStockRequest)
+		req := new(product.AuditProductsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).DeductStock(ctx, req)
+		resp, err := handler.(product.ProductService).AuditProducts(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *DeductStockArgs:
-		success, err := handler.(product.ProductService).DeductStock(ctx, s.Req)
+	case *AuditProductsArgs:
+		success, err := handler.(product.ProductService).AuditProducts(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*DeductStockResult)
+		realResult := result.(*AuditProductsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newDeductStockArgs() interface{} {
-	return &DeductStockArgs{}
+func newAuditProductsArgs() interface{} {
+	return &AuditProductsArgs{}
 }
 
-func newDeductStockResult() interface{} {
-	return &DeductStockResult{}
+func newAuditProductsResult() interface{} {
+	return &AuditProductsResult{}
 }
 
-type DeductStockArgs struct {
-	Req *product.DeductStockRequest
+type AuditProductsArgs struct {
+	Req *product.AuditProductsRequest
 }
 
-func (p *DeductStockArgs) Marshal(out []byte) ([]byte, error) {
+func (p *AuditProductsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *DeductStockArgs) Unmarshal(in []byte) error {
-	msg := new(product.DeductStockRequest)
+func (p *AuditProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.AuditProductsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1120,38 +1365,38 @@ func (p *DeductStockArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var DeductStockArgs_Req_DEFAULT *product.DeductStockRequest
+var AuditProductsArgs_Req_DEFAULT *product.AuditProductsRequest
 
-func (p *DeductStockArgs) GetReq() *product.DeductStockRequest {
+func (p *AuditProductsArgs) GetReq() *product.AuditProductsRequest {
 	if !p.IsSetReq() {
-		return DeductStockArgs_Req_DEFAULT
+		return AuditProductsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *DeductStockArgs) IsSetReq() bool {
+func (p *AuditProductsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *DeductStockArgs) GetFirstArgument() interface{} {
+func (p *AuditProductsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type DeductStockResult struct {
-	Success *product.DeductStockResponse
+type AuditProductsResult struct {
+	Success *product.AuditProductsResponse
 }
 
-var DeductStockResult_Success_DEFAULT *product.DeductStockResponse
+var AuditProductsResult_Success_DEFAULT *product.AuditProductsResponse
 
-func (p *DeductStockResult) Marshal(out []byte) ([]byte, error) {
+func (p *AuditProductsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *DeductStockResult) Unmarshal(in []byte) error {
-	msg := new(product.DeductStockResponse)
+func (p *AuditProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.AuditProductsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1159,71 +1404,71 @@ func (p *DeductStockResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *DeductStockResult) GetSuccess() *product.DeductStockResponse {
+func (p *AuditProductsResult) GetSuccess() *product.AuditProductsResponse {
 	if !p.IsSetSuccess() {
-		return DeductStockResult_Success_DEFAULT
+		return AuditProductsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *DeductStockResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.DeductStockResponse)
+func (p *AuditProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.AuditProductsResponse)
 }
 
-func (p *DeductStockResult) IsSetSuccess() bool {
+func (p *AuditProductsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *DeductStockResult) GetResult() interface{} {
+func (p *AuditProductsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func releaseStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listAuditQueueHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.ReleaseStockRequest)
+		req := new(product.ListAuditQueueRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).ReleaseStock(ctx, req)
+		resp, err := handler.(product.ProductService).ListAuditQueue(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ReleaseStockArgs:
-		success, err := handler.(product.ProductService).ReleaseStock(ctx, s.Req)
+	case *ListAuditQueueArgs:
+		success, err := handler.(product.ProductService).ListAuditQueue(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ReleaseStockResult)
+		realResult := result.(*ListAuditQueueResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newReleaseStockArgs() interface{} {
-	return &ReleaseStockArgs{}
+func newListAuditQueueArgs() interface{} {
+	return &ListAuditQueueArgs{}
 }
 
-func newReleaseStockResult() interface{} {
-	return &ReleaseStockResult{}
+func newListAuditQueueResult() interface{} {
+	return &ListAuditQueueResult{}
 }
 
-type ReleaseStockArgs struct {
-	Req *product.ReleaseStockRequest
+type ListAuditQueueArgs struct {
+	Req *product.ListAuditQueueRequest
 }
 
-func (p *ReleaseStockArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListAuditQueueArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ReleaseStockArgs) Unmarshal(in []byte) error {
-	msg := new(product.ReleaseStockRequest)
+func (p *ListAuditQueueArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListAuditQueueRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1231,38 +1476,38 @@ func (p *ReleaseStockArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ReleaseStockArgs_Req_DEFAULT *product.ReleaseStockRequest
+var ListAuditQueueArgs_Req_DEFAULT *product.ListAuditQueueRequest
 
-func (p *ReleaseStockArgs) GetReq() *product.ReleaseStockRequest {
+func (p *ListAuditQueueArgs) GetReq() *product.ListAuditQueueRequest {
 	if !p.IsSetReq() {
-		return ReleaseStockArgs_Req_DEFAULT
+		return ListAuditQueueArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ReleaseStockArgs) IsSetReq() bool {
+func (p *ListAuditQueueArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ReleaseStockArgs) GetFirstArgument() interface{} {
+func (p *ListAuditQueueArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ReleaseStockResult struct {
-	Success *product.ReleaseStockResponse
+type ListAuditQueueResult struct {
+	Success *product.ListAuditQueueResponse
 }
 
-var ReleaseStockResult_Success_DEFAULT *product.ReleaseStockResponse
+var ListAuditQueueResult_Success_DEFAULT *product.ListAuditQueueResponse
 
-func (p *ReleaseStockResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListAuditQueueResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ReleaseStockResult) Unmarshal(in []byte) error {
-	msg := new(product.ReleaseStockResponse)
+func (p *ListAuditQueueResult) Unmarshal(in []byte) error {
+	msg := new(product.ListAuditQueueResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1270,71 +1515,71 @@ func (p *ReleaseStockResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ReleaseStockResult) GetSuccess() *product.ReleaseStockResponse {
+func (p *ListAuditQueueResult) GetSuccess() *product.ListAuditQueueResponse {
 	if !p.IsSetSuccess() {
-		return ReleaseStockResult_Success_DEFAULT
+		return ListAuditQueueResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ReleaseStockResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.ReleaseStockResponse)
+func (p *ListAuditQueueResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListAuditQueueResponse)
 }
 
-func (p *ReleaseStockResult) IsSetSuccess() bool {
+func (p *ListAuditQueueResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ReleaseStockResult) GetResult() interface{} {
+func (p *ListAuditQueueResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listProductAuditsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.ListCategoriesRequest)
+		req := new(product.ListProductAuditsRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).ListCategories(ctx, req)
+		resp, err := handler.(product.ProductService).ListProductAudits(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListCategoriesArgs:
-		success, err := handler.(product.ProductService).ListCategories(ctx, s.Req)
+	case *ListProductAuditsArgs:
+		success, err := handler.(product.ProductService).ListProductAudits(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListCategoriesResult)
+		realResult := result.(*ListProductAuditsResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListCategoriesArgs() interface{} {
-	return &ListCategoriesArgs{}
+func newListProductAuditsArgs() interface{} {
+	return &ListProductAuditsArgs{}
 }
 
-func newListCategoriesResult() interface{} {
-	return &ListCategoriesResult{}
+func newListProductAuditsResult() interface{} {
+	return &ListProductAuditsResult{}
 }
 
-type ListCategoriesArgs struct {
-	Req *product.ListCategoriesRequest
+type ListProductAuditsArgs struct {
+	Req *product.ListProductAuditsRequest
 }
 
-func (p *ListCategoriesArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListProductAuditsArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListCategoriesArgs) Unmarshal(in []byte) error {
-	msg := new(product.ListCategoriesRequest)
+func (p *ListProductAuditsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListProductAuditsRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1342,38 +1587,38 @@ func (p *ListCategoriesArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListCategoriesArgs_Req_DEFAULT *product.ListCategoriesRequest
+var ListProductAuditsArgs_Req_DEFAULT *product.ListProductAuditsRequest
 
-func (p *ListCategoriesArgs) GetReq() *product.ListCategoriesRequest {
+func (p *ListProductAuditsArgs) GetReq() *product.ListProductAuditsRequest {
 	if !p.IsSetReq() {
-		return ListCategoriesArgs_Req_DEFAULT
+		return ListProductAuditsArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListCategoriesArgs) IsSetReq() bool {
+func (p *ListProductAuditsArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListCategoriesArgs) GetFirstArgument() interface{} {
+func (p *ListProductAuditsArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListCategoriesResult struct {
-	Success *product.ListCategoriesResponse
+type ListProductAuditsResult struct {
+	Success *product.ListProductAuditsResponse
 }
 
-var ListCategoriesResult_Success_DEFAULT *product.ListCategoriesResponse
+var ListProductAuditsResult_Success_DEFAULT *product.ListProductAuditsResponse
 
-func (p *ListCategoriesResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListProductAuditsResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListCategoriesResult) Unmarshal(in []byte) error {
-	msg := new(product.ListCategoriesResponse)
+func (p *ListProductAuditsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListProductAuditsResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1381,71 +1626,71 @@ func (p *ListCategoriesResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListCategoriesResult) GetSuccess() *product.ListCategoriesResponse {
+func (p *ListProductAuditsResult) GetSuccess() *product.ListProductAuditsResponse {
 	if !p.IsSetSuccess() {
-		return ListCategoriesResult_Success_DEFAULT
+		return ListProductAuditsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListCategoriesResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.ListCategoriesResponse)
+func (p *ListProductAuditsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListProductAuditsResponse)
 }
 
-func (p *ListCategoriesResult) IsSetSuccess() bool {
+func (p *ListProductAuditsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListCategoriesResult) GetResult() interface{} {
+func (p *ListProductAuditsResult) GetResult() interface{} {
 	return p.Success
 }
 
-func listBrandsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func listProductHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.ListBrandsRequest)
+		req := new(product.ListProductHistoryRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).ListBrands(ctx, req)
+		resp, err := handler.(product.ProductService).ListProductHistory(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *ListBrandsArgs:
-		success, err := handler.(product.ProductService).ListBrands(ctx, s.Req)
+	case *ListProductHistoryArgs:
+		success, err := handler.(product.ProductService).ListProductHistory(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*ListBrandsResult)
+		realResult := result.(*ListProductHistoryResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newListBrandsArgs() interface{} {
-	return &ListBrandsArgs{}
+func newListProductHistoryArgs() interface{} {
+	return &ListProductHistoryArgs{}
 }
 
-func newListBrandsResult() interface{} {
-	return &ListBrandsResult{}
+func newListProductHistoryResult() interface{} {
+	return &ListProductHistoryResult{}
 }
 
-type ListBrandsArgs struct {
-	Req *product.ListBrandsRequest
+type ListProductHistoryArgs struct {
+	Req *product.ListProductHistoryRequest
 }
 
-func (p *ListBrandsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *ListProductHistoryArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *ListBrandsArgs) Unmarshal(in []byte) error {
-	msg := new(product.ListBrandsRequest)
+func (p *ListProductHistoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListProductHistoryRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1453,38 +1698,38 @@ func (p *ListBrandsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var ListBrandsArgs_Req_DEFAULT *product.ListBrandsRequest
+var ListProductHistoryArgs_Req_DEFAULT *product.ListProductHistoryRequest
 
-func (p *ListBrandsArgs) GetReq() *product.ListBrandsRequest {
+func (p *ListProductHistoryArgs) GetReq() *product.ListProductHistoryRequest {
 	if !p.IsSetReq() {
-		return ListBrandsArgs_Req_DEFAULT
+		return ListProductHistoryArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *ListBrandsArgs) IsSetReq() bool {
+func (p *ListProductHistoryArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *ListBrandsArgs) GetFirstArgument() interface{} {
+func (p *ListProductHistoryArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type ListBrandsResult struct {
-	Success *product.ListBrandsResponse
+type ListProductHistoryResult struct {
+	Success *product.ListProductHistoryResponse
 }
 
-var ListBrandsResult_Success_DEFAULT *product.ListBrandsResponse
+var ListProductHistoryResult_Success_DEFAULT *product.ListProductHistoryResponse
 
-func (p *ListBrandsResult) Marshal(out []byte) ([]byte, error) {
+func (p *ListProductHistoryResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *ListBrandsResult) Unmarshal(in []byte) error {
-	msg := new(product.ListBrandsResponse)
+func (p *ListProductHistoryResult) Unmarshal(in []byte) error {
+	msg := new(product.ListProductHistoryResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1492,71 +1737,71 @@ func (p *ListBrandsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *ListBrandsResult) GetSuccess() *product.ListBrandsResponse {
+func (p *ListProductHistoryResult) GetSuccess() *product.ListProductHistoryResponse {
 	if !p.IsSetSuccess() {
-		return ListBrandsResult_Success_DEFAULT
+		return ListProductHistoryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *ListBrandsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.ListBrandsResponse)
+func (p *ListProductHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListProductHistoryResponse)
 }
 
-func (p *ListBrandsResult) IsSetSuccess() bool {
+func (p *ListProductHistoryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *ListBrandsResult) GetResult() interface{} {
+func (p *ListProductHistoryResult) GetResult() interface{} {
 	return p.Success
 }
 
-func searchProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func rollbackProductHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.SearchProductsRequest)
+		req := new(product.RollbackProductRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).SearchProducts(ctx, req)
+		resp, err := handler.(product.ProductService).RollbackProduct(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *SearchProductsArgs:
-		success, err := handler.(product.ProductService).SearchProducts(ctx, s.Req)
+	case *RollbackProductArgs:
+		success, err := handler.(product.ProductService).RollbackProduct(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*SearchProductsResult)
+		realResult := result.(*RollbackProductResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newSearchProductsArgs() interface{} {
-	return &SearchProductsArgs{}
+func newRollbackProductArgs() interface{} {
+	return &RollbackProductArgs{}
 }
 
-func newSearchProductsResult() interface{} {
-	return &SearchProductsResult{}
+func newRollbackProductResult() interface{} {
+	return &RollbackProductResult{}
 }
 
-type SearchProductsArgs struct {
-	Req *product.SearchProductsRequest
+type RollbackProductArgs struct {
+	Req *product.RollbackProductRequest
 }
 
-func (p *SearchProductsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *RollbackProductArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *SearchProductsArgs) Unmarshal(in []byte) error {
-	msg := new(product.SearchProductsRequest)
+func (p *RollbackProductArgs) Unmarshal(in []byte) error {
+	msg := new(product.RollbackProductRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1564,38 +1809,38 @@ func (p *SearchProductsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var SearchProductsArgs_Req_DEFAULT *product.SearchProductsRequest
+var RollbackProductArgs_Req_DEFAULT *product.RollbackProductRequest
 
-func (p *SearchProductsArgs) GetReq() *product.SearchProductsRequest {
+func (p *RollbackProductArgs) GetReq() *product.RollbackProductRequest {
 	if !p.IsSetReq() {
-		return SearchProductsArgs_Req_DEFAULT
+		return RollbackProductArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *SearchProductsArgs) IsSetReq() bool {
+func (p *RollbackProductArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *SearchProductsArgs) GetFirstArgument() interface{} {
+func (p *RollbackProductArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type SearchProductsResult struct {
-	Success *product.SearchProductsResponse
+type RollbackProductResult struct {
+	Success *product.RollbackProductResponse
 }
 
-var SearchProductsResult_Success_DEFAULT *product.SearchProductsResponse
+var RollbackProductResult_Success_DEFAULT *product.RollbackProductResponse
 
-func (p *SearchProductsResult) Marshal(out []byte) ([]byte, error) {
+func (p *RollbackProductResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *SearchProductsResult) Unmarshal(in []byte) error {
-	msg := new(product.SearchProductsResponse)
+func (p *RollbackProductResult) Unmarshal(in []byte) error {
+	msg := new(product.RollbackProductResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1603,71 +1848,71 @@ func (p *SearchProductsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *SearchProductsResult) GetSuccess() *product.SearchProductsResponse {
+func (p *RollbackProductResult) GetSuccess() *product.RollbackProductResponse {
 	if !p.IsSetSuccess() {
-		return SearchProductsResult_Success_DEFAULT
+		return RollbackProductResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *SearchProductsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.SearchProductsResponse)
+func (p *RollbackProductResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.RollbackProductResponse)
 }
 
-func (p *SearchProductsResult) IsSetSuccess() bool {
+func (p *RollbackProductResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SearchProductsResult) GetResult() interface{} {
+func (p *RollbackProductResult) GetResult() interface{} {
 	return p.Success
 }
 
-func getHotProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+func createProductScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	switch s := arg.(type) {
 	case *streaming.Args:
 		st := s.Stream
-		req := new(product.GetHotProductsRequest)
+		req := new(product.CreateProductScheduleRequest)
 		if err := st.RecvMsg(req); err != nil {
 			return err
 		}
-		resp, err := handler.(product.ProductService).GetHotProducts(ctx, req)
+		resp, err := handler.(product.ProductService).CreateProductSchedule(ctx, req)
 		if err != nil {
 			return err
 		}
 		return st.SendMsg(resp)
-	case *GetHotProductsArgs:
-		success, err := handler.(product.ProductService).GetHotProducts(ctx, s.Req)
+	case *CreateProductScheduleArgs:
+		success, err := handler.(product.ProductService).CreateProductSchedule(ctx, s.Req)
 		if err != nil {
 			return err
 		}
-		realResult := result.(*GetHotProductsResult)
+		realResult := result.(*CreateProductScheduleResult)
 		realResult.Success = success
 		return nil
 	default:
 		return errInvalidMessageType
 	}
 }
-func newGetHotProductsArgs() interface{} {
-	return &GetHotProductsArgs{}
+func newCreateProductScheduleArgs() interface{} {
+	return &CreateProductScheduleArgs{}
 }
 
-func newGetHotProductsResult() interface{} {
-	return &GetHotProductsResult{}
+func newCreateProductScheduleResult() interface{} {
+	return &CreateProductScheduleResult{}
 }
 
-type GetHotProductsArgs struct {
-	Req *product.GetHotProductsRequest
+type CreateProductScheduleArgs struct {
+	Req *product.CreateProductScheduleRequest
 }
 
-func (p *GetHotProductsArgs) Marshal(out []byte) ([]byte, error) {
+func (p *CreateProductScheduleArgs) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetReq() {
 		return out, nil
 	}
 	return proto.Marshal(p.Req)
 }
 
-func (p *GetHotProductsArgs) Unmarshal(in []byte) error {
-	msg := new(product.GetHotProductsRequest)
+func (p *CreateProductScheduleArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateProductScheduleRequest)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1675,38 +1920,38 @@ func (p *GetHotProductsArgs) Unmarshal(in []byte) error {
 	return nil
 }
 
-var GetHotProductsArgs_Req_DEFAULT *product.GetHotProductsRequest
+var CreateProductScheduleArgs_Req_DEFAULT *product.CreateProductScheduleRequest
 
-func (p *GetHotProductsArgs) GetReq() *product.GetHotProductsRequest {
+func (p *CreateProductScheduleArgs) GetReq() *product.CreateProductScheduleRequest {
 	if !p.IsSetReq() {
-		return GetHotProductsArgs_Req_DEFAULT
+		return CreateProductScheduleArgs_Req_DEFAULT
 	}
 	return p.Req
 }
 
-func (p *GetHotProductsArgs) IsSetReq() bool {
+func (p *CreateProductScheduleArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *GetHotProductsArgs) GetFirstArgument() interface{} {
+func (p *CreateProductScheduleArgs) GetFirstArgument() interface{} {
 	return p.Req
 }
 
-type GetHotProductsResult struct {
-	Success *product.GetHotProductsResponse
+type CreateProductScheduleResult struct {
+	Success *product.CreateProductScheduleResponse
 }
 
-var GetHotProductsResult_Success_DEFAULT *product.GetHotProductsResponse
+var CreateProductScheduleResult_Success_DEFAULT *product.CreateProductScheduleResponse
 
-func (p *GetHotProductsResult) Marshal(out []byte) ([]byte, error) {
+func (p *CreateProductScheduleResult) Marshal(out []byte) ([]byte, error) {
 	if !p.IsSetSuccess() {
 		return out, nil
 	}
 	return proto.Marshal(p.Success)
 }
 
-func (p *GetHotProductsResult) Unmarshal(in []byte) error {
-	msg := new(product.GetHotProductsResponse)
+func (p *CreateProductScheduleResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateProductScheduleResponse)
 	if err := proto.Unmarshal(in, msg); err != nil {
 		return err
 	}
@@ -1714,100 +1959,4185 @@ func (p *GetHotProductsResult) Unmarshal(in []byte) error {
 	return nil
 }
 
-func (p *GetHotProductsResult) GetSuccess() *product.GetHotProductsResponse {
+func (p *CreateProductScheduleResult) GetSuccess() *product.CreateProductScheduleResponse {
 	if !p.IsSetSuccess() {
-		return GetHotProductsResult_Success_DEFAULT
+		return CreateProductScheduleResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-func (p *GetHotProductsResult) SetSuccess(x interface{}) {
-	p.Success = x.(*product.GetHotProductsResponse)
+func (p *CreateProductScheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateProductScheduleResponse)
 }
 
-func (p *GetHotProductsResult) IsSetSuccess() bool {
+func (p *CreateProductScheduleResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *GetHotProductsResult) GetResult() interface{} {
+func (p *CreateProductScheduleResult) GetResult() interface{} {
 	return p.Success
 }
 
-type kClient struct {
-	c client.Client
+func listProductSchedulesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListProductSchedulesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListProductSchedules(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListProductSchedulesArgs:
+		success, err := handler.(product.ProductService).ListProductSchedules(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListProductSchedulesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListProductSchedulesArgs() interface{} {
+	return &ListProductSchedulesArgs{}
 }
 
-func newServiceClient(c client.Client) *kClient {
-	return &kClient{
-		c: c,
-	}
+func newListProductSchedulesResult() interface{} {
+	return &ListProductSchedulesResult{}
 }
 
-func (p *kClient) CreateProduct(ctx context.Context, Req *product.CreateProductRequest) (r *product.CreateProductResponse, err error) {
-	var _args CreateProductArgs
-	_args.Req = Req
-	var _result CreateProductResult
-	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+type ListProductSchedulesArgs struct {
+	Req *product.ListProductSchedulesRequest
 }
 
-func (p *kClient) UpdateProduct(ctx context.Context, Req *product.UpdateProductRequest) (r *product.UpdateProductResponse, err error) {
-	var _args UpdateProductArgs
-	_args.Req = Req
-	var _result UpdateProductResult
-	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
-		return
+func (p *ListProductSchedulesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
 	}
-	return _result.GetSuccess(), nil
+	return proto.Marshal(p.Req)
 }
 
-func (p *kClient) UpdateProductStatus(ctx context.Context, Req *product.UpdateProductStatusRequest) (r *product.UpdateProductStatusResponse, err error) {
-	var _args UpdateProductStatusArgs
-	_args.Req = Req
-	var _result UpdateProductStatusResult
-	if err = p.c.Call(ctx, "UpdateProductStatus", &_args, &_result); err != nil {
-		return
+func (p *ListProductSchedulesArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListProductSchedulesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
 	}
-	return _result.GetSuccess(), nil
+	p.Req = msg
+	return nil
 }
 
-func (p *kClient) GetProductDetail(ctx context.Context, Req *product.GetProductDetailRequest) (r *product.GetProductDetailResponse, err error) {
-	var _args GetProductDetailArgs
-	_args.Req = Req
-	var _result GetProductDetailResult
-	if err = p.c.Call(ctx, "GetProductDetail", &_args, &_result); err != nil {
-		return
+var ListProductSchedulesArgs_Req_DEFAULT *product.ListProductSchedulesRequest
+
+func (p *ListProductSchedulesArgs) GetReq() *product.ListProductSchedulesRequest {
+	if !p.IsSetReq() {
+		return ListProductSchedulesArgs_Req_DEFAULT
 	}
-	return _result.GetSuccess(), nil
+	return p.Req
 }
 
-func (p *kClient) ListProducts(ctx context.Context, Req *product.ListProductsRequest) (r *product.ListProductsResponse, err error) {
-	var _args ListProductsArgs
-	_args.Req = Req
-	var _result ListProductsResult
-	if err = p.c.Call(ctx, "ListProducts", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *ListProductSchedulesArgs) IsSetReq() bool {
+	return p.Req != nil
 }
 
-func (p *kClient) GetProductsByIds(ctx context.Context, Req *product.GetProductsByIdsRequest) (r *product.GetProductsByIdsResponse, err error) {
-	var _args GetProductsByIdsArgs
-	_args.Req = Req
-	var _result GetProductsByIdsResult
-	if err = p.c.Call(ctx, "GetProductsByIds", &_args, &_result); err != nil {
-		return
-	}
-	return _result.GetSuccess(), nil
+func (p *ListProductSchedulesArgs) GetFirstArgument() interface{} {
+	return p.Req
 }
 
-func (p *kClient) GetSkusByIds(ctx context.Context, Req *product.GetSkusByIdsRequest) (r *product.GetSkusByIdsResponse, err error) {
-	var _args GetSkusByIdsArgs
-	_args.Req = Req
-	var _result GetSkusByIdsResult
-	if err = p.c.Call(ctx, "GetSkusByIds", &_args, &_result); err != nil {
+type ListProductSchedulesResult struct {
+	Success *product.ListProductSchedulesResponse
+}
+
+var ListProductSchedulesResult_Success_DEFAULT *product.ListProductSchedulesResponse
+
+func (p *ListProductSchedulesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListProductSchedulesResult) Unmarshal(in []byte) error {
+	msg := new(product.ListProductSchedulesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListProductSchedulesResult) GetSuccess() *product.ListProductSchedulesResponse {
+	if !p.IsSetSuccess() {
+		return ListProductSchedulesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListProductSchedulesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListProductSchedulesResponse)
+}
+
+func (p *ListProductSchedulesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListProductSchedulesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelProductScheduleHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CancelProductScheduleRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CancelProductSchedule(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelProductScheduleArgs:
+		success, err := handler.(product.ProductService).CancelProductSchedule(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelProductScheduleResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelProductScheduleArgs() interface{} {
+	return &CancelProductScheduleArgs{}
+}
+
+func newCancelProductScheduleResult() interface{} {
+	return &CancelProductScheduleResult{}
+}
+
+type CancelProductScheduleArgs struct {
+	Req *product.CancelProductScheduleRequest
+}
+
+func (p *CancelProductScheduleArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelProductScheduleArgs) Unmarshal(in []byte) error {
+	msg := new(product.CancelProductScheduleRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelProductScheduleArgs_Req_DEFAULT *product.CancelProductScheduleRequest
+
+func (p *CancelProductScheduleArgs) GetReq() *product.CancelProductScheduleRequest {
+	if !p.IsSetReq() {
+		return CancelProductScheduleArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelProductScheduleArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelProductScheduleArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelProductScheduleResult struct {
+	Success *product.CancelProductScheduleResponse
+}
+
+var CancelProductScheduleResult_Success_DEFAULT *product.CancelProductScheduleResponse
+
+func (p *CancelProductScheduleResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelProductScheduleResult) Unmarshal(in []byte) error {
+	msg := new(product.CancelProductScheduleResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelProductScheduleResult) GetSuccess() *product.CancelProductScheduleResponse {
+	if !p.IsSetSuccess() {
+		return CancelProductScheduleResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelProductScheduleResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CancelProductScheduleResponse)
+}
+
+func (p *CancelProductScheduleResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelProductScheduleResult) GetResult() interface{} {
+	return p.Success
+}
+
+func importProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ImportProductsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ImportProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ImportProductsArgs:
+		success, err := handler.(product.ProductService).ImportProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ImportProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newImportProductsArgs() interface{} {
+	return &ImportProductsArgs{}
+}
+
+func newImportProductsResult() interface{} {
+	return &ImportProductsResult{}
+}
+
+type ImportProductsArgs struct {
+	Req *product.ImportProductsRequest
+}
+
+func (p *ImportProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ImportProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ImportProductsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ImportProductsArgs_Req_DEFAULT *product.ImportProductsRequest
+
+func (p *ImportProductsArgs) GetReq() *product.ImportProductsRequest {
+	if !p.IsSetReq() {
+		return ImportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ImportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ImportProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ImportProductsResult struct {
+	Success *product.ImportProductsResponse
+}
+
+var ImportProductsResult_Success_DEFAULT *product.ImportProductsResponse
+
+func (p *ImportProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ImportProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.ImportProductsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ImportProductsResult) GetSuccess() *product.ImportProductsResponse {
+	if !p.IsSetSuccess() {
+		return ImportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ImportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ImportProductsResponse)
+}
+
+func (p *ImportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ImportProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getImportJobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetImportJobRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetImportJob(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetImportJobArgs:
+		success, err := handler.(product.ProductService).GetImportJob(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetImportJobResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetImportJobArgs() interface{} {
+	return &GetImportJobArgs{}
+}
+
+func newGetImportJobResult() interface{} {
+	return &GetImportJobResult{}
+}
+
+type GetImportJobArgs struct {
+	Req *product.GetImportJobRequest
+}
+
+func (p *GetImportJobArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetImportJobArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetImportJobRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetImportJobArgs_Req_DEFAULT *product.GetImportJobRequest
+
+func (p *GetImportJobArgs) GetReq() *product.GetImportJobRequest {
+	if !p.IsSetReq() {
+		return GetImportJobArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetImportJobArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetImportJobArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetImportJobResult struct {
+	Success *product.GetImportJobResponse
+}
+
+var GetImportJobResult_Success_DEFAULT *product.GetImportJobResponse
+
+func (p *GetImportJobResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetImportJobResult) Unmarshal(in []byte) error {
+	msg := new(product.GetImportJobResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetImportJobResult) GetSuccess() *product.GetImportJobResponse {
+	if !p.IsSetSuccess() {
+		return GetImportJobResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetImportJobResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetImportJobResponse)
+}
+
+func (p *GetImportJobResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetImportJobResult) GetResult() interface{} {
+	return p.Success
+}
+
+func exportProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ExportProductsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ExportProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ExportProductsArgs:
+		success, err := handler.(product.ProductService).ExportProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ExportProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newExportProductsArgs() interface{} {
+	return &ExportProductsArgs{}
+}
+
+func newExportProductsResult() interface{} {
+	return &ExportProductsResult{}
+}
+
+type ExportProductsArgs struct {
+	Req *product.ExportProductsRequest
+}
+
+func (p *ExportProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ExportProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ExportProductsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ExportProductsArgs_Req_DEFAULT *product.ExportProductsRequest
+
+func (p *ExportProductsArgs) GetReq() *product.ExportProductsRequest {
+	if !p.IsSetReq() {
+		return ExportProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ExportProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ExportProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ExportProductsResult struct {
+	Success *product.ExportProductsResponse
+}
+
+var ExportProductsResult_Success_DEFAULT *product.ExportProductsResponse
+
+func (p *ExportProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ExportProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.ExportProductsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ExportProductsResult) GetSuccess() *product.ExportProductsResponse {
+	if !p.IsSetSuccess() {
+		return ExportProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ExportProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ExportProductsResponse)
+}
+
+func (p *ExportProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ExportProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createMediaAssetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateMediaAssetRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CreateMediaAsset(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateMediaAssetArgs:
+		success, err := handler.(product.ProductService).CreateMediaAsset(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateMediaAssetResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateMediaAssetArgs() interface{} {
+	return &CreateMediaAssetArgs{}
+}
+
+func newCreateMediaAssetResult() interface{} {
+	return &CreateMediaAssetResult{}
+}
+
+type CreateMediaAssetArgs struct {
+	Req *product.CreateMediaAssetRequest
+}
+
+func (p *CreateMediaAssetArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateMediaAssetArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateMediaAssetRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateMediaAssetArgs_Req_DEFAULT *product.CreateMediaAssetRequest
+
+func (p *CreateMediaAssetArgs) GetReq() *product.CreateMediaAssetRequest {
+	if !p.IsSetReq() {
+		return CreateMediaAssetArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateMediaAssetArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateMediaAssetArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateMediaAssetResult struct {
+	Success *product.CreateMediaAssetResponse
+}
+
+var CreateMediaAssetResult_Success_DEFAULT *product.CreateMediaAssetResponse
+
+func (p *CreateMediaAssetResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateMediaAssetResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateMediaAssetResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateMediaAssetResult) GetSuccess() *product.CreateMediaAssetResponse {
+	if !p.IsSetSuccess() {
+		return CreateMediaAssetResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateMediaAssetResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateMediaAssetResponse)
+}
+
+func (p *CreateMediaAssetResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateMediaAssetResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listMediaAssetsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListMediaAssetsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListMediaAssets(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListMediaAssetsArgs:
+		success, err := handler.(product.ProductService).ListMediaAssets(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListMediaAssetsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListMediaAssetsArgs() interface{} {
+	return &ListMediaAssetsArgs{}
+}
+
+func newListMediaAssetsResult() interface{} {
+	return &ListMediaAssetsResult{}
+}
+
+type ListMediaAssetsArgs struct {
+	Req *product.ListMediaAssetsRequest
+}
+
+func (p *ListMediaAssetsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListMediaAssetsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListMediaAssetsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListMediaAssetsArgs_Req_DEFAULT *product.ListMediaAssetsRequest
+
+func (p *ListMediaAssetsArgs) GetReq() *product.ListMediaAssetsRequest {
+	if !p.IsSetReq() {
+		return ListMediaAssetsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListMediaAssetsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListMediaAssetsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListMediaAssetsResult struct {
+	Success *product.ListMediaAssetsResponse
+}
+
+var ListMediaAssetsResult_Success_DEFAULT *product.ListMediaAssetsResponse
+
+func (p *ListMediaAssetsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListMediaAssetsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListMediaAssetsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListMediaAssetsResult) GetSuccess() *product.ListMediaAssetsResponse {
+	if !p.IsSetSuccess() {
+		return ListMediaAssetsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListMediaAssetsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListMediaAssetsResponse)
+}
+
+func (p *ListMediaAssetsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListMediaAssetsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getMediaAssetsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetMediaAssetsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetMediaAssets(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetMediaAssetsArgs:
+		success, err := handler.(product.ProductService).GetMediaAssets(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetMediaAssetsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetMediaAssetsArgs() interface{} {
+	return &GetMediaAssetsArgs{}
+}
+
+func newGetMediaAssetsResult() interface{} {
+	return &GetMediaAssetsResult{}
+}
+
+type GetMediaAssetsArgs struct {
+	Req *product.GetMediaAssetsRequest
+}
+
+func (p *GetMediaAssetsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetMediaAssetsArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetMediaAssetsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetMediaAssetsArgs_Req_DEFAULT *product.GetMediaAssetsRequest
+
+func (p *GetMediaAssetsArgs) GetReq() *product.GetMediaAssetsRequest {
+	if !p.IsSetReq() {
+		return GetMediaAssetsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetMediaAssetsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetMediaAssetsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetMediaAssetsResult struct {
+	Success *product.GetMediaAssetsResponse
+}
+
+var GetMediaAssetsResult_Success_DEFAULT *product.GetMediaAssetsResponse
+
+func (p *GetMediaAssetsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetMediaAssetsResult) Unmarshal(in []byte) error {
+	msg := new(product.GetMediaAssetsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetMediaAssetsResult) GetSuccess() *product.GetMediaAssetsResponse {
+	if !p.IsSetSuccess() {
+		return GetMediaAssetsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetMediaAssetsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetMediaAssetsResponse)
+}
+
+func (p *GetMediaAssetsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetMediaAssetsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPriceHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListPriceHistoryRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListPriceHistory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListPriceHistoryArgs:
+		success, err := handler.(product.ProductService).ListPriceHistory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPriceHistoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListPriceHistoryArgs() interface{} {
+	return &ListPriceHistoryArgs{}
+}
+
+func newListPriceHistoryResult() interface{} {
+	return &ListPriceHistoryResult{}
+}
+
+type ListPriceHistoryArgs struct {
+	Req *product.ListPriceHistoryRequest
+}
+
+func (p *ListPriceHistoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPriceHistoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListPriceHistoryRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPriceHistoryArgs_Req_DEFAULT *product.ListPriceHistoryRequest
+
+func (p *ListPriceHistoryArgs) GetReq() *product.ListPriceHistoryRequest {
+	if !p.IsSetReq() {
+		return ListPriceHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPriceHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPriceHistoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPriceHistoryResult struct {
+	Success *product.ListPriceHistoryResponse
+}
+
+var ListPriceHistoryResult_Success_DEFAULT *product.ListPriceHistoryResponse
+
+func (p *ListPriceHistoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPriceHistoryResult) Unmarshal(in []byte) error {
+	msg := new(product.ListPriceHistoryResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPriceHistoryResult) GetSuccess() *product.ListPriceHistoryResponse {
+	if !p.IsSetSuccess() {
+		return ListPriceHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPriceHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListPriceHistoryResponse)
+}
+
+func (p *ListPriceHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPriceHistoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func subscribePriceAlertHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SubscribePriceAlertRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SubscribePriceAlert(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SubscribePriceAlertArgs:
+		success, err := handler.(product.ProductService).SubscribePriceAlert(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SubscribePriceAlertResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSubscribePriceAlertArgs() interface{} {
+	return &SubscribePriceAlertArgs{}
+}
+
+func newSubscribePriceAlertResult() interface{} {
+	return &SubscribePriceAlertResult{}
+}
+
+type SubscribePriceAlertArgs struct {
+	Req *product.SubscribePriceAlertRequest
+}
+
+func (p *SubscribePriceAlertArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SubscribePriceAlertArgs) Unmarshal(in []byte) error {
+	msg := new(product.SubscribePriceAlertRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SubscribePriceAlertArgs_Req_DEFAULT *product.SubscribePriceAlertRequest
+
+func (p *SubscribePriceAlertArgs) GetReq() *product.SubscribePriceAlertRequest {
+	if !p.IsSetReq() {
+		return SubscribePriceAlertArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SubscribePriceAlertArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SubscribePriceAlertArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SubscribePriceAlertResult struct {
+	Success *product.SubscribePriceAlertResponse
+}
+
+var SubscribePriceAlertResult_Success_DEFAULT *product.SubscribePriceAlertResponse
+
+func (p *SubscribePriceAlertResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SubscribePriceAlertResult) Unmarshal(in []byte) error {
+	msg := new(product.SubscribePriceAlertResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SubscribePriceAlertResult) GetSuccess() *product.SubscribePriceAlertResponse {
+	if !p.IsSetSuccess() {
+		return SubscribePriceAlertResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SubscribePriceAlertResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SubscribePriceAlertResponse)
+}
+
+func (p *SubscribePriceAlertResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SubscribePriceAlertResult) GetResult() interface{} {
+	return p.Success
+}
+
+func cancelPriceAlertHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CancelPriceAlertRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CancelPriceAlert(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CancelPriceAlertArgs:
+		success, err := handler.(product.ProductService).CancelPriceAlert(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CancelPriceAlertResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCancelPriceAlertArgs() interface{} {
+	return &CancelPriceAlertArgs{}
+}
+
+func newCancelPriceAlertResult() interface{} {
+	return &CancelPriceAlertResult{}
+}
+
+type CancelPriceAlertArgs struct {
+	Req *product.CancelPriceAlertRequest
+}
+
+func (p *CancelPriceAlertArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CancelPriceAlertArgs) Unmarshal(in []byte) error {
+	msg := new(product.CancelPriceAlertRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CancelPriceAlertArgs_Req_DEFAULT *product.CancelPriceAlertRequest
+
+func (p *CancelPriceAlertArgs) GetReq() *product.CancelPriceAlertRequest {
+	if !p.IsSetReq() {
+		return CancelPriceAlertArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CancelPriceAlertArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CancelPriceAlertArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CancelPriceAlertResult struct {
+	Success *product.CancelPriceAlertResponse
+}
+
+var CancelPriceAlertResult_Success_DEFAULT *product.CancelPriceAlertResponse
+
+func (p *CancelPriceAlertResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CancelPriceAlertResult) Unmarshal(in []byte) error {
+	msg := new(product.CancelPriceAlertResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CancelPriceAlertResult) GetSuccess() *product.CancelPriceAlertResponse {
+	if !p.IsSetSuccess() {
+		return CancelPriceAlertResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CancelPriceAlertResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CancelPriceAlertResponse)
+}
+
+func (p *CancelPriceAlertResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CancelPriceAlertResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listPriceAlertsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListPriceAlertsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListPriceAlerts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListPriceAlertsArgs:
+		success, err := handler.(product.ProductService).ListPriceAlerts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListPriceAlertsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListPriceAlertsArgs() interface{} {
+	return &ListPriceAlertsArgs{}
+}
+
+func newListPriceAlertsResult() interface{} {
+	return &ListPriceAlertsResult{}
+}
+
+type ListPriceAlertsArgs struct {
+	Req *product.ListPriceAlertsRequest
+}
+
+func (p *ListPriceAlertsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListPriceAlertsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListPriceAlertsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListPriceAlertsArgs_Req_DEFAULT *product.ListPriceAlertsRequest
+
+func (p *ListPriceAlertsArgs) GetReq() *product.ListPriceAlertsRequest {
+	if !p.IsSetReq() {
+		return ListPriceAlertsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListPriceAlertsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListPriceAlertsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListPriceAlertsResult struct {
+	Success *product.ListPriceAlertsResponse
+}
+
+var ListPriceAlertsResult_Success_DEFAULT *product.ListPriceAlertsResponse
+
+func (p *ListPriceAlertsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListPriceAlertsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListPriceAlertsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListPriceAlertsResult) GetSuccess() *product.ListPriceAlertsResponse {
+	if !p.IsSetSuccess() {
+		return ListPriceAlertsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListPriceAlertsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListPriceAlertsResponse)
+}
+
+func (p *ListPriceAlertsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListPriceAlertsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getSkusByIdsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetSkusByIdsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetSkusByIds(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetSkusByIdsArgs:
+		success, err := handler.(product.ProductService).GetSkusByIds(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetSkusByIdsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetSkusByIdsArgs() interface{} {
+	return &GetSkusByIdsArgs{}
+}
+
+func newGetSkusByIdsResult() interface{} {
+	return &GetSkusByIdsResult{}
+}
+
+type GetSkusByIdsArgs struct {
+	Req *product.GetSkusByIdsRequest
+}
+
+func (p *GetSkusByIdsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetSkusByIdsArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetSkusByIdsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetSkusByIdsArgs_Req_DEFAULT *product.GetSkusByIdsRequest
+
+func (p *GetSkusByIdsArgs) GetReq() *product.GetSkusByIdsRequest {
+	if !p.IsSetReq() {
+		return GetSkusByIdsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetSkusByIdsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetSkusByIdsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetSkusByIdsResult struct {
+	Success *product.GetSkusByIdsResponse
+}
+
+var GetSkusByIdsResult_Success_DEFAULT *product.GetSkusByIdsResponse
+
+func (p *GetSkusByIdsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetSkusByIdsResult) Unmarshal(in []byte) error {
+	msg := new(product.GetSkusByIdsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetSkusByIdsResult) GetSuccess() *product.GetSkusByIdsResponse {
+	if !p.IsSetSuccess() {
+		return GetSkusByIdsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetSkusByIdsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetSkusByIdsResponse)
+}
+
+func (p *GetSkusByIdsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetSkusByIdsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func batchUpdateSkuHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.BatchUpdateSkuRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).BatchUpdateSku(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *BatchUpdateSkuArgs:
+		success, err := handler.(product.ProductService).BatchUpdateSku(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*BatchUpdateSkuResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newBatchUpdateSkuArgs() interface{} {
+	return &BatchUpdateSkuArgs{}
+}
+
+func newBatchUpdateSkuResult() interface{} {
+	return &BatchUpdateSkuResult{}
+}
+
+type BatchUpdateSkuArgs struct {
+	Req *product.BatchUpdateSkuRequest
+}
+
+func (p *BatchUpdateSkuArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *BatchUpdateSkuArgs) Unmarshal(in []byte) error {
+	msg := new(product.BatchUpdateSkuRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var BatchUpdateSkuArgs_Req_DEFAULT *product.BatchUpdateSkuRequest
+
+func (p *BatchUpdateSkuArgs) GetReq() *product.BatchUpdateSkuRequest {
+	if !p.IsSetReq() {
+		return BatchUpdateSkuArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BatchUpdateSkuArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *BatchUpdateSkuArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type BatchUpdateSkuResult struct {
+	Success *product.BatchUpdateSkuResponse
+}
+
+var BatchUpdateSkuResult_Success_DEFAULT *product.BatchUpdateSkuResponse
+
+func (p *BatchUpdateSkuResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *BatchUpdateSkuResult) Unmarshal(in []byte) error {
+	msg := new(product.BatchUpdateSkuResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BatchUpdateSkuResult) GetSuccess() *product.BatchUpdateSkuResponse {
+	if !p.IsSetSuccess() {
+		return BatchUpdateSkuResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BatchUpdateSkuResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.BatchUpdateSkuResponse)
+}
+
+func (p *BatchUpdateSkuResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BatchUpdateSkuResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deductStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeductStockRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).DeductStock(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeductStockArgs:
+		success, err := handler.(product.ProductService).DeductStock(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeductStockResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeductStockArgs() interface{} {
+	return &DeductStockArgs{}
+}
+
+func newDeductStockResult() interface{} {
+	return &DeductStockResult{}
+}
+
+type DeductStockArgs struct {
+	Req *product.DeductStockRequest
+}
+
+func (p *DeductStockArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeductStockArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeductStockRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeductStockArgs_Req_DEFAULT *product.DeductStockRequest
+
+func (p *DeductStockArgs) GetReq() *product.DeductStockRequest {
+	if !p.IsSetReq() {
+		return DeductStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeductStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeductStockArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeductStockResult struct {
+	Success *product.DeductStockResponse
+}
+
+var DeductStockResult_Success_DEFAULT *product.DeductStockResponse
+
+func (p *DeductStockResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeductStockResult) Unmarshal(in []byte) error {
+	msg := new(product.DeductStockResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeductStockResult) GetSuccess() *product.DeductStockResponse {
+	if !p.IsSetSuccess() {
+		return DeductStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeductStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeductStockResponse)
+}
+
+func (p *DeductStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeductStockResult) GetResult() interface{} {
+	return p.Success
+}
+
+func releaseStockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ReleaseStockRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ReleaseStock(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ReleaseStockArgs:
+		success, err := handler.(product.ProductService).ReleaseStock(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ReleaseStockResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newReleaseStockArgs() interface{} {
+	return &ReleaseStockArgs{}
+}
+
+func newReleaseStockResult() interface{} {
+	return &ReleaseStockResult{}
+}
+
+type ReleaseStockArgs struct {
+	Req *product.ReleaseStockRequest
+}
+
+func (p *ReleaseStockArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ReleaseStockArgs) Unmarshal(in []byte) error {
+	msg := new(product.ReleaseStockRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ReleaseStockArgs_Req_DEFAULT *product.ReleaseStockRequest
+
+func (p *ReleaseStockArgs) GetReq() *product.ReleaseStockRequest {
+	if !p.IsSetReq() {
+		return ReleaseStockArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ReleaseStockArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ReleaseStockArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ReleaseStockResult struct {
+	Success *product.ReleaseStockResponse
+}
+
+var ReleaseStockResult_Success_DEFAULT *product.ReleaseStockResponse
+
+func (p *ReleaseStockResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ReleaseStockResult) Unmarshal(in []byte) error {
+	msg := new(product.ReleaseStockResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ReleaseStockResult) GetSuccess() *product.ReleaseStockResponse {
+	if !p.IsSetSuccess() {
+		return ReleaseStockResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ReleaseStockResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ReleaseStockResponse)
+}
+
+func (p *ReleaseStockResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ReleaseStockResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListCategoriesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListCategories(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListCategoriesArgs:
+		success, err := handler.(product.ProductService).ListCategories(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListCategoriesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListCategoriesArgs() interface{} {
+	return &ListCategoriesArgs{}
+}
+
+func newListCategoriesResult() interface{} {
+	return &ListCategoriesResult{}
+}
+
+type ListCategoriesArgs struct {
+	Req *product.ListCategoriesRequest
+}
+
+func (p *ListCategoriesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListCategoriesArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListCategoriesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListCategoriesArgs_Req_DEFAULT *product.ListCategoriesRequest
+
+func (p *ListCategoriesArgs) GetReq() *product.ListCategoriesRequest {
+	if !p.IsSetReq() {
+		return ListCategoriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListCategoriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListCategoriesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListCategoriesResult struct {
+	Success *product.ListCategoriesResponse
+}
+
+var ListCategoriesResult_Success_DEFAULT *product.ListCategoriesResponse
+
+func (p *ListCategoriesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListCategoriesResult) Unmarshal(in []byte) error {
+	msg := new(product.ListCategoriesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListCategoriesResult) GetSuccess() *product.ListCategoriesResponse {
+	if !p.IsSetSuccess() {
+		return ListCategoriesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListCategoriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListCategoriesResponse)
+}
+
+func (p *ListCategoriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListCategoriesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateCategoryRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CreateCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateCategoryArgs:
+		success, err := handler.(product.ProductService).CreateCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateCategoryArgs() interface{} {
+	return &CreateCategoryArgs{}
+}
+
+func newCreateCategoryResult() interface{} {
+	return &CreateCategoryResult{}
+}
+
+type CreateCategoryArgs struct {
+	Req *product.CreateCategoryRequest
+}
+
+func (p *CreateCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateCategoryRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateCategoryArgs_Req_DEFAULT *product.CreateCategoryRequest
+
+func (p *CreateCategoryArgs) GetReq() *product.CreateCategoryRequest {
+	if !p.IsSetReq() {
+		return CreateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateCategoryResult struct {
+	Success *product.CreateCategoryResponse
+}
+
+var CreateCategoryResult_Success_DEFAULT *product.CreateCategoryResponse
+
+func (p *CreateCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateCategoryResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateCategoryResult) GetSuccess() *product.CreateCategoryResponse {
+	if !p.IsSetSuccess() {
+		return CreateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateCategoryResponse)
+}
+
+func (p *CreateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateCategoryRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).UpdateCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateCategoryArgs:
+		success, err := handler.(product.ProductService).UpdateCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateCategoryArgs() interface{} {
+	return &UpdateCategoryArgs{}
+}
+
+func newUpdateCategoryResult() interface{} {
+	return &UpdateCategoryResult{}
+}
+
+type UpdateCategoryArgs struct {
+	Req *product.UpdateCategoryRequest
+}
+
+func (p *UpdateCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateCategoryRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateCategoryArgs_Req_DEFAULT *product.UpdateCategoryRequest
+
+func (p *UpdateCategoryArgs) GetReq() *product.UpdateCategoryRequest {
+	if !p.IsSetReq() {
+		return UpdateCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateCategoryResult struct {
+	Success *product.UpdateCategoryResponse
+}
+
+var UpdateCategoryResult_Success_DEFAULT *product.UpdateCategoryResponse
+
+func (p *UpdateCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateCategoryResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateCategoryResult) GetSuccess() *product.UpdateCategoryResponse {
+	if !p.IsSetSuccess() {
+		return UpdateCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateCategoryResponse)
+}
+
+func (p *UpdateCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func sortCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SortCategoriesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SortCategories(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SortCategoriesArgs:
+		success, err := handler.(product.ProductService).SortCategories(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SortCategoriesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSortCategoriesArgs() interface{} {
+	return &SortCategoriesArgs{}
+}
+
+func newSortCategoriesResult() interface{} {
+	return &SortCategoriesResult{}
+}
+
+type SortCategoriesArgs struct {
+	Req *product.SortCategoriesRequest
+}
+
+func (p *SortCategoriesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SortCategoriesArgs) Unmarshal(in []byte) error {
+	msg := new(product.SortCategoriesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SortCategoriesArgs_Req_DEFAULT *product.SortCategoriesRequest
+
+func (p *SortCategoriesArgs) GetReq() *product.SortCategoriesRequest {
+	if !p.IsSetReq() {
+		return SortCategoriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SortCategoriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SortCategoriesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SortCategoriesResult struct {
+	Success *product.SortCategoriesResponse
+}
+
+var SortCategoriesResult_Success_DEFAULT *product.SortCategoriesResponse
+
+func (p *SortCategoriesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SortCategoriesResult) Unmarshal(in []byte) error {
+	msg := new(product.SortCategoriesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SortCategoriesResult) GetSuccess() *product.SortCategoriesResponse {
+	if !p.IsSetSuccess() {
+		return SortCategoriesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SortCategoriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SortCategoriesResponse)
+}
+
+func (p *SortCategoriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SortCategoriesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setCategoryVisibilityHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SetCategoryVisibilityRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SetCategoryVisibility(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetCategoryVisibilityArgs:
+		success, err := handler.(product.ProductService).SetCategoryVisibility(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetCategoryVisibilityResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetCategoryVisibilityArgs() interface{} {
+	return &SetCategoryVisibilityArgs{}
+}
+
+func newSetCategoryVisibilityResult() interface{} {
+	return &SetCategoryVisibilityResult{}
+}
+
+type SetCategoryVisibilityArgs struct {
+	Req *product.SetCategoryVisibilityRequest
+}
+
+func (p *SetCategoryVisibilityArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetCategoryVisibilityArgs) Unmarshal(in []byte) error {
+	msg := new(product.SetCategoryVisibilityRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetCategoryVisibilityArgs_Req_DEFAULT *product.SetCategoryVisibilityRequest
+
+func (p *SetCategoryVisibilityArgs) GetReq() *product.SetCategoryVisibilityRequest {
+	if !p.IsSetReq() {
+		return SetCategoryVisibilityArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetCategoryVisibilityArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetCategoryVisibilityArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetCategoryVisibilityResult struct {
+	Success *product.SetCategoryVisibilityResponse
+}
+
+var SetCategoryVisibilityResult_Success_DEFAULT *product.SetCategoryVisibilityResponse
+
+func (p *SetCategoryVisibilityResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetCategoryVisibilityResult) Unmarshal(in []byte) error {
+	msg := new(product.SetCategoryVisibilityResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetCategoryVisibilityResult) GetSuccess() *product.SetCategoryVisibilityResponse {
+	if !p.IsSetSuccess() {
+		return SetCategoryVisibilityResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetCategoryVisibilityResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SetCategoryVisibilityResponse)
+}
+
+func (p *SetCategoryVisibilityResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetCategoryVisibilityResult) GetResult() interface{} {
+	return p.Success
+}
+
+func moveCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.MoveCategoryRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).MoveCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *MoveCategoryArgs:
+		success, err := handler.(product.ProductService).MoveCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*MoveCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newMoveCategoryArgs() interface{} {
+	return &MoveCategoryArgs{}
+}
+
+func newMoveCategoryResult() interface{} {
+	return &MoveCategoryResult{}
+}
+
+type MoveCategoryArgs struct {
+	Req *product.MoveCategoryRequest
+}
+
+func (p *MoveCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *MoveCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.MoveCategoryRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var MoveCategoryArgs_Req_DEFAULT *product.MoveCategoryRequest
+
+func (p *MoveCategoryArgs) GetReq() *product.MoveCategoryRequest {
+	if !p.IsSetReq() {
+		return MoveCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *MoveCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *MoveCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type MoveCategoryResult struct {
+	Success *product.MoveCategoryResponse
+}
+
+var MoveCategoryResult_Success_DEFAULT *product.MoveCategoryResponse
+
+func (p *MoveCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *MoveCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.MoveCategoryResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *MoveCategoryResult) GetSuccess() *product.MoveCategoryResponse {
+	if !p.IsSetSuccess() {
+		return MoveCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *MoveCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.MoveCategoryResponse)
+}
+
+func (p *MoveCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *MoveCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteCategoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteCategoryRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).DeleteCategory(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteCategoryArgs:
+		success, err := handler.(product.ProductService).DeleteCategory(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteCategoryResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteCategoryArgs() interface{} {
+	return &DeleteCategoryArgs{}
+}
+
+func newDeleteCategoryResult() interface{} {
+	return &DeleteCategoryResult{}
+}
+
+type DeleteCategoryArgs struct {
+	Req *product.DeleteCategoryRequest
+}
+
+func (p *DeleteCategoryArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteCategoryArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteCategoryRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteCategoryArgs_Req_DEFAULT *product.DeleteCategoryRequest
+
+func (p *DeleteCategoryArgs) GetReq() *product.DeleteCategoryRequest {
+	if !p.IsSetReq() {
+		return DeleteCategoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteCategoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteCategoryArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteCategoryResult struct {
+	Success *product.DeleteCategoryResponse
+}
+
+var DeleteCategoryResult_Success_DEFAULT *product.DeleteCategoryResponse
+
+func (p *DeleteCategoryResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteCategoryResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteCategoryResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteCategoryResult) GetSuccess() *product.DeleteCategoryResponse {
+	if !p.IsSetSuccess() {
+		return DeleteCategoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteCategoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteCategoryResponse)
+}
+
+func (p *DeleteCategoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteCategoryResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getSpecTemplateHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetSpecTemplateRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetSpecTemplate(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetSpecTemplateArgs:
+		success, err := handler.(product.ProductService).GetSpecTemplate(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetSpecTemplateResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetSpecTemplateArgs() interface{} {
+	return &GetSpecTemplateArgs{}
+}
+
+func newGetSpecTemplateResult() interface{} {
+	return &GetSpecTemplateResult{}
+}
+
+type GetSpecTemplateArgs struct {
+	Req *product.GetSpecTemplateRequest
+}
+
+func (p *GetSpecTemplateArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetSpecTemplateArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetSpecTemplateRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetSpecTemplateArgs_Req_DEFAULT *product.GetSpecTemplateRequest
+
+func (p *GetSpecTemplateArgs) GetReq() *product.GetSpecTemplateRequest {
+	if !p.IsSetReq() {
+		return GetSpecTemplateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetSpecTemplateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetSpecTemplateArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetSpecTemplateResult struct {
+	Success *product.GetSpecTemplateResponse
+}
+
+var GetSpecTemplateResult_Success_DEFAULT *product.GetSpecTemplateResponse
+
+func (p *GetSpecTemplateResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetSpecTemplateResult) Unmarshal(in []byte) error {
+	msg := new(product.GetSpecTemplateResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetSpecTemplateResult) GetSuccess() *product.GetSpecTemplateResponse {
+	if !p.IsSetSuccess() {
+		return GetSpecTemplateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetSpecTemplateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetSpecTemplateResponse)
+}
+
+func (p *GetSpecTemplateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetSpecTemplateResult) GetResult() interface{} {
+	return p.Success
+}
+
+func saveSpecTemplateHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SaveSpecTemplateRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SaveSpecTemplate(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SaveSpecTemplateArgs:
+		success, err := handler.(product.ProductService).SaveSpecTemplate(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SaveSpecTemplateResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSaveSpecTemplateArgs() interface{} {
+	return &SaveSpecTemplateArgs{}
+}
+
+func newSaveSpecTemplateResult() interface{} {
+	return &SaveSpecTemplateResult{}
+}
+
+type SaveSpecTemplateArgs struct {
+	Req *product.SaveSpecTemplateRequest
+}
+
+func (p *SaveSpecTemplateArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SaveSpecTemplateArgs) Unmarshal(in []byte) error {
+	msg := new(product.SaveSpecTemplateRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SaveSpecTemplateArgs_Req_DEFAULT *product.SaveSpecTemplateRequest
+
+func (p *SaveSpecTemplateArgs) GetReq() *product.SaveSpecTemplateRequest {
+	if !p.IsSetReq() {
+		return SaveSpecTemplateArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SaveSpecTemplateArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SaveSpecTemplateArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SaveSpecTemplateResult struct {
+	Success *product.SaveSpecTemplateResponse
+}
+
+var SaveSpecTemplateResult_Success_DEFAULT *product.SaveSpecTemplateResponse
+
+func (p *SaveSpecTemplateResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SaveSpecTemplateResult) Unmarshal(in []byte) error {
+	msg := new(product.SaveSpecTemplateResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SaveSpecTemplateResult) GetSuccess() *product.SaveSpecTemplateResponse {
+	if !p.IsSetSuccess() {
+		return SaveSpecTemplateResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SaveSpecTemplateResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SaveSpecTemplateResponse)
+}
+
+func (p *SaveSpecTemplateResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SaveSpecTemplateResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listBrandsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.ListBrandsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).ListBrands(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListBrandsArgs:
+		success, err := handler.(product.ProductService).ListBrands(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListBrandsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListBrandsArgs() interface{} {
+	return &ListBrandsArgs{}
+}
+
+func newListBrandsResult() interface{} {
+	return &ListBrandsResult{}
+}
+
+type ListBrandsArgs struct {
+	Req *product.ListBrandsRequest
+}
+
+func (p *ListBrandsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListBrandsArgs) Unmarshal(in []byte) error {
+	msg := new(product.ListBrandsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListBrandsArgs_Req_DEFAULT *product.ListBrandsRequest
+
+func (p *ListBrandsArgs) GetReq() *product.ListBrandsRequest {
+	if !p.IsSetReq() {
+		return ListBrandsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListBrandsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListBrandsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListBrandsResult struct {
+	Success *product.ListBrandsResponse
+}
+
+var ListBrandsResult_Success_DEFAULT *product.ListBrandsResponse
+
+func (p *ListBrandsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListBrandsResult) Unmarshal(in []byte) error {
+	msg := new(product.ListBrandsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListBrandsResult) GetSuccess() *product.ListBrandsResponse {
+	if !p.IsSetSuccess() {
+		return ListBrandsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListBrandsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.ListBrandsResponse)
+}
+
+func (p *ListBrandsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListBrandsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.CreateBrandRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).CreateBrand(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateBrandArgs:
+		success, err := handler.(product.ProductService).CreateBrand(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateBrandResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateBrandArgs() interface{} {
+	return &CreateBrandArgs{}
+}
+
+func newCreateBrandResult() interface{} {
+	return &CreateBrandResult{}
+}
+
+type CreateBrandArgs struct {
+	Req *product.CreateBrandRequest
+}
+
+func (p *CreateBrandArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateBrandArgs) Unmarshal(in []byte) error {
+	msg := new(product.CreateBrandRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateBrandArgs_Req_DEFAULT *product.CreateBrandRequest
+
+func (p *CreateBrandArgs) GetReq() *product.CreateBrandRequest {
+	if !p.IsSetReq() {
+		return CreateBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateBrandArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateBrandResult struct {
+	Success *product.CreateBrandResponse
+}
+
+var CreateBrandResult_Success_DEFAULT *product.CreateBrandResponse
+
+func (p *CreateBrandResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateBrandResult) Unmarshal(in []byte) error {
+	msg := new(product.CreateBrandResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateBrandResult) GetSuccess() *product.CreateBrandResponse {
+	if !p.IsSetSuccess() {
+		return CreateBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.CreateBrandResponse)
+}
+
+func (p *CreateBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateBrandResult) GetResult() interface{} {
+	return p.Success
+}
+
+func updateBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.UpdateBrandRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).UpdateBrand(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpdateBrandArgs:
+		success, err := handler.(product.ProductService).UpdateBrand(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpdateBrandResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpdateBrandArgs() interface{} {
+	return &UpdateBrandArgs{}
+}
+
+func newUpdateBrandResult() interface{} {
+	return &UpdateBrandResult{}
+}
+
+type UpdateBrandArgs struct {
+	Req *product.UpdateBrandRequest
+}
+
+func (p *UpdateBrandArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpdateBrandArgs) Unmarshal(in []byte) error {
+	msg := new(product.UpdateBrandRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpdateBrandArgs_Req_DEFAULT *product.UpdateBrandRequest
+
+func (p *UpdateBrandArgs) GetReq() *product.UpdateBrandRequest {
+	if !p.IsSetReq() {
+		return UpdateBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpdateBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpdateBrandArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpdateBrandResult struct {
+	Success *product.UpdateBrandResponse
+}
+
+var UpdateBrandResult_Success_DEFAULT *product.UpdateBrandResponse
+
+func (p *UpdateBrandResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpdateBrandResult) Unmarshal(in []byte) error {
+	msg := new(product.UpdateBrandResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpdateBrandResult) GetSuccess() *product.UpdateBrandResponse {
+	if !p.IsSetSuccess() {
+		return UpdateBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpdateBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.UpdateBrandResponse)
+}
+
+func (p *UpdateBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpdateBrandResult) GetResult() interface{} {
+	return p.Success
+}
+
+func deleteBrandHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.DeleteBrandRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).DeleteBrand(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *DeleteBrandArgs:
+		success, err := handler.(product.ProductService).DeleteBrand(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*DeleteBrandResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newDeleteBrandArgs() interface{} {
+	return &DeleteBrandArgs{}
+}
+
+func newDeleteBrandResult() interface{} {
+	return &DeleteBrandResult{}
+}
+
+type DeleteBrandArgs struct {
+	Req *product.DeleteBrandRequest
+}
+
+func (p *DeleteBrandArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *DeleteBrandArgs) Unmarshal(in []byte) error {
+	msg := new(product.DeleteBrandRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var DeleteBrandArgs_Req_DEFAULT *product.DeleteBrandRequest
+
+func (p *DeleteBrandArgs) GetReq() *product.DeleteBrandRequest {
+	if !p.IsSetReq() {
+		return DeleteBrandArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *DeleteBrandArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *DeleteBrandArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type DeleteBrandResult struct {
+	Success *product.DeleteBrandResponse
+}
+
+var DeleteBrandResult_Success_DEFAULT *product.DeleteBrandResponse
+
+func (p *DeleteBrandResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *DeleteBrandResult) Unmarshal(in []byte) error {
+	msg := new(product.DeleteBrandResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *DeleteBrandResult) GetSuccess() *product.DeleteBrandResponse {
+	if !p.IsSetSuccess() {
+		return DeleteBrandResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *DeleteBrandResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.DeleteBrandResponse)
+}
+
+func (p *DeleteBrandResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *DeleteBrandResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setBrandCategoriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SetBrandCategoriesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SetBrandCategories(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetBrandCategoriesArgs:
+		success, err := handler.(product.ProductService).SetBrandCategories(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetBrandCategoriesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetBrandCategoriesArgs() interface{} {
+	return &SetBrandCategoriesArgs{}
+}
+
+func newSetBrandCategoriesResult() interface{} {
+	return &SetBrandCategoriesResult{}
+}
+
+type SetBrandCategoriesArgs struct {
+	Req *product.SetBrandCategoriesRequest
+}
+
+func (p *SetBrandCategoriesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetBrandCategoriesArgs) Unmarshal(in []byte) error {
+	msg := new(product.SetBrandCategoriesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetBrandCategoriesArgs_Req_DEFAULT *product.SetBrandCategoriesRequest
+
+func (p *SetBrandCategoriesArgs) GetReq() *product.SetBrandCategoriesRequest {
+	if !p.IsSetReq() {
+		return SetBrandCategoriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetBrandCategoriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetBrandCategoriesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetBrandCategoriesResult struct {
+	Success *product.SetBrandCategoriesResponse
+}
+
+var SetBrandCategoriesResult_Success_DEFAULT *product.SetBrandCategoriesResponse
+
+func (p *SetBrandCategoriesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetBrandCategoriesResult) Unmarshal(in []byte) error {
+	msg := new(product.SetBrandCategoriesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetBrandCategoriesResult) GetSuccess() *product.SetBrandCategoriesResponse {
+	if !p.IsSetSuccess() {
+		return SetBrandCategoriesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetBrandCategoriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SetBrandCategoriesResponse)
+}
+
+func (p *SetBrandCategoriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetBrandCategoriesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func searchProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SearchProductsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SearchProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SearchProductsArgs:
+		success, err := handler.(product.ProductService).SearchProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SearchProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSearchProductsArgs() interface{} {
+	return &SearchProductsArgs{}
+}
+
+func newSearchProductsResult() interface{} {
+	return &SearchProductsResult{}
+}
+
+type SearchProductsArgs struct {
+	Req *product.SearchProductsRequest
+}
+
+func (p *SearchProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SearchProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.SearchProductsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SearchProductsArgs_Req_DEFAULT *product.SearchProductsRequest
+
+func (p *SearchProductsArgs) GetReq() *product.SearchProductsRequest {
+	if !p.IsSetReq() {
+		return SearchProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SearchProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SearchProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SearchProductsResult struct {
+	Success *product.SearchProductsResponse
+}
+
+var SearchProductsResult_Success_DEFAULT *product.SearchProductsResponse
+
+func (p *SearchProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SearchProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.SearchProductsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SearchProductsResult) GetSuccess() *product.SearchProductsResponse {
+	if !p.IsSetSuccess() {
+		return SearchProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SearchProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SearchProductsResponse)
+}
+
+func (p *SearchProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SearchProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getHotProductsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetHotProductsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetHotProducts(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetHotProductsArgs:
+		success, err := handler.(product.ProductService).GetHotProducts(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetHotProductsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetHotProductsArgs() interface{} {
+	return &GetHotProductsArgs{}
+}
+
+func newGetHotProductsResult() interface{} {
+	return &GetHotProductsResult{}
+}
+
+type GetHotProductsArgs struct {
+	Req *product.GetHotProductsRequest
+}
+
+func (p *GetHotProductsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetHotProductsArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetHotProductsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetHotProductsArgs_Req_DEFAULT *product.GetHotProductsRequest
+
+func (p *GetHotProductsArgs) GetReq() *product.GetHotProductsRequest {
+	if !p.IsSetReq() {
+		return GetHotProductsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetHotProductsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetHotProductsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetHotProductsResult struct {
+	Success *product.GetHotProductsResponse
+}
+
+var GetHotProductsResult_Success_DEFAULT *product.GetHotProductsResponse
+
+func (p *GetHotProductsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetHotProductsResult) Unmarshal(in []byte) error {
+	msg := new(product.GetHotProductsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetHotProductsResult) GetSuccess() *product.GetHotProductsResponse {
+	if !p.IsSetSuccess() {
+		return GetHotProductsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetHotProductsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetHotProductsResponse)
+}
+
+func (p *GetHotProductsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetHotProductsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func suggestQueriesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.SuggestQueriesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).SuggestQueries(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SuggestQueriesArgs:
+		success, err := handler.(product.ProductService).SuggestQueries(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SuggestQueriesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSuggestQueriesArgs() interface{} {
+	return &SuggestQueriesArgs{}
+}
+
+func newSuggestQueriesResult() interface{} {
+	return &SuggestQueriesResult{}
+}
+
+type SuggestQueriesArgs struct {
+	Req *product.SuggestQueriesRequest
+}
+
+func (p *SuggestQueriesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SuggestQueriesArgs) Unmarshal(in []byte) error {
+	msg := new(product.SuggestQueriesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SuggestQueriesArgs_Req_DEFAULT *product.SuggestQueriesRequest
+
+func (p *SuggestQueriesArgs) GetReq() *product.SuggestQueriesRequest {
+	if !p.IsSetReq() {
+		return SuggestQueriesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SuggestQueriesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SuggestQueriesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SuggestQueriesResult struct {
+	Success *product.SuggestQueriesResponse
+}
+
+var SuggestQueriesResult_Success_DEFAULT *product.SuggestQueriesResponse
+
+func (p *SuggestQueriesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SuggestQueriesResult) Unmarshal(in []byte) error {
+	msg := new(product.SuggestQueriesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SuggestQueriesResult) GetSuccess() *product.SuggestQueriesResponse {
+	if !p.IsSetSuccess() {
+		return SuggestQueriesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SuggestQueriesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.SuggestQueriesResponse)
+}
+
+func (p *SuggestQueriesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SuggestQueriesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getTrendingSearchesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetTrendingSearchesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetTrendingSearches(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetTrendingSearchesArgs:
+		success, err := handler.(product.ProductService).GetTrendingSearches(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetTrendingSearchesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetTrendingSearchesArgs() interface{} {
+	return &GetTrendingSearchesArgs{}
+}
+
+func newGetTrendingSearchesResult() interface{} {
+	return &GetTrendingSearchesResult{}
+}
+
+type GetTrendingSearchesArgs struct {
+	Req *product.GetTrendingSearchesRequest
+}
+
+func (p *GetTrendingSearchesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetTrendingSearchesArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetTrendingSearchesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetTrendingSearchesArgs_Req_DEFAULT *product.GetTrendingSearchesRequest
+
+func (p *GetTrendingSearchesArgs) GetReq() *product.GetTrendingSearchesRequest {
+	if !p.IsSetReq() {
+		return GetTrendingSearchesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetTrendingSearchesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetTrendingSearchesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetTrendingSearchesResult struct {
+	Success *product.GetTrendingSearchesResponse
+}
+
+var GetTrendingSearchesResult_Success_DEFAULT *product.GetTrendingSearchesResponse
+
+func (p *GetTrendingSearchesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetTrendingSearchesResult) Unmarshal(in []byte) error {
+	msg := new(product.GetTrendingSearchesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetTrendingSearchesResult) GetSuccess() *product.GetTrendingSearchesResponse {
+	if !p.IsSetSuccess() {
+		return GetTrendingSearchesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetTrendingSearchesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetTrendingSearchesResponse)
+}
+
+func (p *GetTrendingSearchesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetTrendingSearchesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getSearchReportHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(product.GetSearchReportRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(product.ProductService).GetSearchReport(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetSearchReportArgs:
+		success, err := handler.(product.ProductService).GetSearchReport(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetSearchReportResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetSearchReportArgs() interface{} {
+	return &GetSearchReportArgs{}
+}
+
+func newGetSearchReportResult() interface{} {
+	return &GetSearchReportResult{}
+}
+
+type GetSearchReportArgs struct {
+	Req *product.GetSearchReportRequest
+}
+
+func (p *GetSearchReportArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetSearchReportArgs) Unmarshal(in []byte) error {
+	msg := new(product.GetSearchReportRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetSearchReportArgs_Req_DEFAULT *product.GetSearchReportRequest
+
+func (p *GetSearchReportArgs) GetReq() *product.GetSearchReportRequest {
+	if !p.IsSetReq() {
+		return GetSearchReportArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetSearchReportArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetSearchReportArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetSearchReportResult struct {
+	Success *product.GetSearchReportResponse
+}
+
+var GetSearchReportResult_Success_DEFAULT *product.GetSearchReportResponse
+
+func (p *GetSearchReportResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetSearchReportResult) Unmarshal(in []byte) error {
+	msg := new(product.GetSearchReportResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetSearchReportResult) GetSuccess() *product.GetSearchReportResponse {
+	if !p.IsSetSuccess() {
+		return GetSearchReportResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetSearchReportResult) SetSuccess(x interface{}) {
+	p.Success = x.(*product.GetSearchReportResponse)
+}
+
+func (p *GetSearchReportResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetSearchReportResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreateProduct(ctx context.Context, Req *product.CreateProductRequest) (r *product.CreateProductResponse, err error) {
+	var _args CreateProductArgs
+	_args.Req = Req
+	var _result CreateProductResult
+	if err = p.c.Call(ctx, "CreateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProduct(ctx context.Context, Req *product.UpdateProductRequest) (r *product.UpdateProductResponse, err error) {
+	var _args UpdateProductArgs
+	_args.Req = Req
+	var _result UpdateProductResult
+	if err = p.c.Call(ctx, "UpdateProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateProductStatus(ctx context.Context, Req *product.UpdateProductStatusRequest) (r *product.UpdateProductStatusResponse, err error) {
+	var _args UpdateProductStatusArgs
+	_args.Req = Req
+	var _result UpdateProductStatusResult
+	if err = p.c.Call(ctx, "UpdateProductStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProductDetail(ctx context.Context, Req *product.GetProductDetailRequest) (r *product.GetProductDetailResponse, err error) {
+	var _args GetProductDetailArgs
+	_args.Req = Req
+	var _result GetProductDetailResult
+	if err = p.c.Call(ctx, "GetProductDetail", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProducts(ctx context.Context, Req *product.ListProductsRequest) (r *product.ListProductsResponse, err error) {
+	var _args ListProductsArgs
+	_args.Req = Req
+	var _result ListProductsResult
+	if err = p.c.Call(ctx, "ListProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteProduct(ctx context.Context, Req *product.DeleteProductRequest) (r *product.DeleteProductResponse, err error) {
+	var _args DeleteProductArgs
+	_args.Req = Req
+	var _result DeleteProductResult
+	if err = p.c.Call(ctx, "DeleteProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RestoreProduct(ctx context.Context, Req *product.RestoreProductRequest) (r *product.RestoreProductResponse, err error) {
+	var _args RestoreProductArgs
+	_args.Req = Req
+	var _result RestoreProductResult
+	if err = p.c.Call(ctx, "RestoreProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProductsByIds(ctx context.Context, Req *product.GetProductsByIdsRequest) (r *product.GetProductsByIdsResponse, err error) {
+	var _args GetProductsByIdsArgs
+	_args.Req = Req
+	var _result GetProductsByIdsResult
+	if err = p.c.Call(ctx, "GetProductsByIds", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AuditProducts(ctx context.Context, Req *product.AuditProductsRequest) (r *product.AuditProductsResponse, err error) {
+	var _args AuditProductsArgs
+	_args.Req = Req
+	var _result AuditProductsResult
+	if err = p.c.Call(ctx, "AuditProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAuditQueue(ctx context.Context, Req *product.ListAuditQueueRequest) (r *product.ListAuditQueueResponse, err error) {
+	var _args ListAuditQueueArgs
+	_args.Req = Req
+	var _result ListAuditQueueResult
+	if err = p.c.Call(ctx, "ListAuditQueue", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductAudits(ctx context.Context, Req *product.ListProductAuditsRequest) (r *product.ListProductAuditsResponse, err error) {
+	var _args ListProductAuditsArgs
+	_args.Req = Req
+	var _result ListProductAuditsResult
+	if err = p.c.Call(ctx, "ListProductAudits", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductHistory(ctx context.Context, Req *product.ListProductHistoryRequest) (r *product.ListProductHistoryResponse, err error) {
+	var _args ListProductHistoryArgs
+	_args.Req = Req
+	var _result ListProductHistoryResult
+	if err = p.c.Call(ctx, "ListProductHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RollbackProduct(ctx context.Context, Req *product.RollbackProductRequest) (r *product.RollbackProductResponse, err error) {
+	var _args RollbackProductArgs
+	_args.Req = Req
+	var _result RollbackProductResult
+	if err = p.c.Call(ctx, "RollbackProduct", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateProductSchedule(ctx context.Context, Req *product.CreateProductScheduleRequest) (r *product.CreateProductScheduleResponse, err error) {
+	var _args CreateProductScheduleArgs
+	_args.Req = Req
+	var _result CreateProductScheduleResult
+	if err = p.c.Call(ctx, "CreateProductSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListProductSchedules(ctx context.Context, Req *product.ListProductSchedulesRequest) (r *product.ListProductSchedulesResponse, err error) {
+	var _args ListProductSchedulesArgs
+	_args.Req = Req
+	var _result ListProductSchedulesResult
+	if err = p.c.Call(ctx, "ListProductSchedules", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelProductSchedule(ctx context.Context, Req *product.CancelProductScheduleRequest) (r *product.CancelProductScheduleResponse, err error) {
+	var _args CancelProductScheduleArgs
+	_args.Req = Req
+	var _result CancelProductScheduleResult
+	if err = p.c.Call(ctx, "CancelProductSchedule", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ImportProducts(ctx context.Context, Req *product.ImportProductsRequest) (r *product.ImportProductsResponse, err error) {
+	var _args ImportProductsArgs
+	_args.Req = Req
+	var _result ImportProductsResult
+	if err = p.c.Call(ctx, "ImportProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetImportJob(ctx context.Context, Req *product.GetImportJobRequest) (r *product.GetImportJobResponse, err error) {
+	var _args GetImportJobArgs
+	_args.Req = Req
+	var _result GetImportJobResult
+	if err = p.c.Call(ctx, "GetImportJob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ExportProducts(ctx context.Context, Req *product.ExportProductsRequest) (r *product.ExportProductsResponse, err error) {
+	var _args ExportProductsArgs
+	_args.Req = Req
+	var _result ExportProductsResult
+	if err = p.c.Call(ctx, "ExportProducts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateMediaAsset(ctx context.Context, Req *product.CreateMediaAssetRequest) (r *product.CreateMediaAssetResponse, err error) {
+	var _args CreateMediaAssetArgs
+	_args.Req = Req
+	var _result CreateMediaAssetResult
+	if err = p.c.Call(ctx, "CreateMediaAsset", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListMediaAssets(ctx context.Context, Req *product.ListMediaAssetsRequest) (r *product.ListMediaAssetsResponse, err error) {
+	var _args ListMediaAssetsArgs
+	_args.Req = Req
+	var _result ListMediaAssetsResult
+	if err = p.c.Call(ctx, "ListMediaAssets", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetMediaAssets(ctx context.Context, Req *product.GetMediaAssetsRequest) (r *product.GetMediaAssetsResponse, err error) {
+	var _args GetMediaAssetsArgs
+	_args.Req = Req
+	var _result GetMediaAssetsResult
+	if err = p.c.Call(ctx, "GetMediaAssets", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPriceHistory(ctx context.Context, Req *product.ListPriceHistoryRequest) (r *product.ListPriceHistoryResponse, err error) {
+	var _args ListPriceHistoryArgs
+	_args.Req = Req
+	var _result ListPriceHistoryResult
+	if err = p.c.Call(ctx, "ListPriceHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubscribePriceAlert(ctx context.Context, Req *product.SubscribePriceAlertRequest) (r *product.SubscribePriceAlertResponse, err error) {
+	var _args SubscribePriceAlertArgs
+	_args.Req = Req
+	var _result SubscribePriceAlertResult
+	if err = p.c.Call(ctx, "SubscribePriceAlert", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CancelPriceAlert(ctx context.Context, Req *product.CancelPriceAlertRequest) (r *product.CancelPriceAlertResponse, err error) {
+	var _args CancelPriceAlertArgs
+	_args.Req = Req
+	var _result CancelPriceAlertResult
+	if err = p.c.Call(ctx, "CancelPriceAlert", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListPriceAlerts(ctx context.Context, Req *product.ListPriceAlertsRequest) (r *product.ListPriceAlertsResponse, err error) {
+	var _args ListPriceAlertsArgs
+	_args.Req = Req
+	var _result ListPriceAlertsResult
+	if err = p.c.Call(ctx, "ListPriceAlerts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSkusByIds(ctx context.Context, Req *product.GetSkusByIdsRequest) (r *product.GetSkusByIdsResponse, err error) {
+	var _args GetSkusByIdsArgs
+	_args.Req = Req
+	var _result GetSkusByIdsResult
+	if err = p.c.Call(ctx, "GetSkusByIds", &_args, &_result); err != nil {
 		return
 	}
 	return _result.GetSuccess(), nil
@@ -1853,6 +6183,86 @@ func (p *kClient) ListCategories(ctx context.Context, Req *product.ListCategorie
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CreateCategory(ctx context.Context, Req *product.CreateCategoryRequest) (r *product.CreateCategoryResponse, err error) {
+	var _args CreateCategoryArgs
+	_args.Req = Req
+	var _result CreateCategoryResult
+	if err = p.c.Call(ctx, "CreateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateCategory(ctx context.Context, Req *product.UpdateCategoryRequest) (r *product.UpdateCategoryResponse, err error) {
+	var _args UpdateCategoryArgs
+	_args.Req = Req
+	var _result UpdateCategoryResult
+	if err = p.c.Call(ctx, "UpdateCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SortCategories(ctx context.Context, Req *product.SortCategoriesRequest) (r *product.SortCategoriesResponse, err error) {
+	var _args SortCategoriesArgs
+	_args.Req = Req
+	var _result SortCategoriesResult
+	if err = p.c.Call(ctx, "SortCategories", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetCategoryVisibility(ctx context.Context, Req *product.SetCategoryVisibilityRequest) (r *product.SetCategoryVisibilityResponse, err error) {
+	var _args SetCategoryVisibilityArgs
+	_args.Req = Req
+	var _result SetCategoryVisibilityResult
+	if err = p.c.Call(ctx, "SetCategoryVisibility", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MoveCategory(ctx context.Context, Req *product.MoveCategoryRequest) (r *product.MoveCategoryResponse, err error) {
+	var _args MoveCategoryArgs
+	_args.Req = Req
+	var _result MoveCategoryResult
+	if err = p.c.Call(ctx, "MoveCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteCategory(ctx context.Context, Req *product.DeleteCategoryRequest) (r *product.DeleteCategoryResponse, err error) {
+	var _args DeleteCategoryArgs
+	_args.Req = Req
+	var _result DeleteCategoryResult
+	if err = p.c.Call(ctx, "DeleteCategory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSpecTemplate(ctx context.Context, Req *product.GetSpecTemplateRequest) (r *product.GetSpecTemplateResponse, err error) {
+	var _args GetSpecTemplateArgs
+	_args.Req = Req
+	var _result GetSpecTemplateResult
+	if err = p.c.Call(ctx, "GetSpecTemplate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SaveSpecTemplate(ctx context.Context, Req *product.SaveSpecTemplateRequest) (r *product.SaveSpecTemplateResponse, err error) {
+	var _args SaveSpecTemplateArgs
+	_args.Req = Req
+	var _result SaveSpecTemplateResult
+	if err = p.c.Call(ctx, "SaveSpecTemplate", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) ListBrands(ctx context.Context, Req *product.ListBrandsRequest) (r *product.ListBrandsResponse, err error) {
 	var _args ListBrandsArgs
 	_args.Req = Req
@@ -1863,6 +6273,46 @@ func (p *kClient) ListBrands(ctx context.Context, Req *product.ListBrandsRequest
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CreateBrand(ctx context.Context, Req *product.CreateBrandRequest) (r *product.CreateBrandResponse, err error) {
+	var _args CreateBrandArgs
+	_args.Req = Req
+	var _result CreateBrandResult
+	if err = p.c.Call(ctx, "CreateBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdateBrand(ctx context.Context, Req *product.UpdateBrandRequest) (r *product.UpdateBrandResponse, err error) {
+	var _args UpdateBrandArgs
+	_args.Req = Req
+	var _result UpdateBrandResult
+	if err = p.c.Call(ctx, "UpdateBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteBrand(ctx context.Context, Req *product.DeleteBrandRequest) (r *product.DeleteBrandResponse, err error) {
+	var _args DeleteBrandArgs
+	_args.Req = Req
+	var _result DeleteBrandResult
+	if err = p.c.Call(ctx, "DeleteBrand", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetBrandCategories(ctx context.Context, Req *product.SetBrandCategoriesRequest) (r *product.SetBrandCategoriesResponse, err error) {
+	var _args SetBrandCategoriesArgs
+	_args.Req = Req
+	var _result SetBrandCategoriesResult
+	if err = p.c.Call(ctx, "SetBrandCategories", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) SearchProducts(ctx context.Context, Req *product.SearchProductsRequest) (r *product.SearchProductsResponse, err error) {
 	var _args SearchProductsArgs
 	_args.Req = Req
@@ -1882,3 +6332,33 @@ func (p *kClient) GetHotProducts(ctx context.Context, Req *product.GetHotProduct
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) SuggestQueries(ctx context.Context, Req *product.SuggestQueriesRequest) (r *product.SuggestQueriesResponse, err error) {
+	var _args SuggestQueriesArgs
+	_args.Req = Req
+	var _result SuggestQueriesResult
+	if err = p.c.Call(ctx, "SuggestQueries", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTrendingSearches(ctx context.Context, Req *product.GetTrendingSearchesRequest) (r *product.GetTrendingSearchesResponse, err error) {
+	var _args GetTrendingSearchesArgs
+	_args.Req = Req
+	var _result GetTrendingSearchesResult
+	if err = p.c.Call(ctx, "GetTrendingSearches", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetSearchReport(ctx context.Context, Req *product.GetSearchReportRequest) (r *product.GetSearchReportResponse, err error) {
+	var _args GetSearchReportArgs
+	_args.Req = Req
+	var _result GetSearchReportResult
+	if err = p.c.Call(ctx, "GetSearchReport", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
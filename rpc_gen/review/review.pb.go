@@ -0,0 +1,1270 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package review
+
+import (
+	"context"
+
+	"github.com/cloudwego/prutal"
+)
+
+// 评价
+type Review struct {
+	Id           uint64   `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	SpuId        uint64   `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`
+	SkuId        uint64   `protobuf:"varint,3,opt,name=sku_id" json:"sku_id,omitempty"`
+	UserId       uint64   `protobuf:"varint,4,opt,name=user_id" json:"user_id,omitempty"`
+	OrderId      string   `protobuf:"bytes,5,opt,name=order_id" json:"order_id,omitempty"`
+	Rating       int32    `protobuf:"varint,6,opt,name=rating" json:"rating,omitempty"` // 星级 1-5
+	Content      string   `protobuf:"bytes,7,opt,name=content" json:"content,omitempty"`
+	Images       []string `protobuf:"bytes,8,rep,name=images" json:"images,omitempty"`                // 图片 URL
+	Tags         []string `protobuf:"bytes,9,rep,name=tags" json:"tags,omitempty"`                    // 评价标签，如 "物流快"、"质量好"
+	Status       int32    `protobuf:"varint,10,opt,name=status" json:"status,omitempty"`              // 审核状态: 0-待审核, 1-已通过, 2-已驳回
+	RejectReason string   `protobuf:"bytes,11,opt,name=reject_reason" json:"reject_reason,omitempty"` // 驳回原因
+	SkuName      string   `protobuf:"bytes,12,opt,name=sku_name" json:"sku_name,omitempty"`           // 下单时的 SKU 名称
+	CreatedAt    int64    `protobuf:"varint,13,opt,name=created_at" json:"created_at,omitempty"`      // unix 秒
+}
+
+func (x *Review) Reset() { *x = Review{} }
+
+func (x *Review) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *Review) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *Review) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *Review) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *Review) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Review) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Review) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Review) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Review) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Review) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *Review) GetSkuName() string {
+	if x != nil {
+		return x.SkuName
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 商品评分汇总，只统计已通过的评价
+type RatingSummary struct {
+	SpuId         uint64  `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+	ReviewCount   int64   `protobuf:"varint,2,opt,name=review_count" json:"review_count,omitempty"`
+	AverageRating float64 `protobuf:"fixed64,3,opt,name=average_rating" json:"average_rating,omitempty"`     // 平均星级，保留一位小数，无评价时为 0
+	RatingCounts  []int64 `protobuf:"varint,4,rep,packed,name=rating_counts" json:"rating_counts,omitempty"` // 1-5 星各自的评价数，下标 0 为 1 星
+}
+
+func (x *RatingSummary) Reset() { *x = RatingSummary{} }
+
+func (x *RatingSummary) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *RatingSummary) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *RatingSummary) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+func (x *RatingSummary) GetRatingCounts() []int64 {
+	if x != nil {
+		return x.RatingCounts
+	}
+	return nil
+}
+
+// 商品问题
+type Question struct {
+	Id           uint64    `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	SpuId        uint64    `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`
+	UserId       uint64    `protobuf:"varint,3,opt,name=user_id" json:"user_id,omitempty"`
+	Content      string    `protobuf:"bytes,4,opt,name=content" json:"content,omitempty"`
+	Status       int32     `protobuf:"varint,5,opt,name=status" json:"status,omitempty"` // 审核状态: 0-待审核, 1-已通过, 2-已驳回
+	RejectReason string    `protobuf:"bytes,6,opt,name=reject_reason" json:"reject_reason,omitempty"`
+	AnswerCount  int64     `protobuf:"varint,7,opt,name=answer_count" json:"answer_count,omitempty"` // 已通过的回答数
+	CreatedAt    int64     `protobuf:"varint,8,opt,name=created_at" json:"created_at,omitempty"`
+	TopAnswers   []*Answer `protobuf:"bytes,9,rep,name=top_answers" json:"top_answers,omitempty"` // 列表接口返回的最有用回答
+}
+
+func (x *Question) Reset() { *x = Question{} }
+
+func (x *Question) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *Question) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *Question) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Question) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *Question) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Question) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Question) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Question) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *Question) GetAnswerCount() int64 {
+	if x != nil {
+		return x.AnswerCount
+	}
+	return 0
+}
+
+func (x *Question) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Question) GetTopAnswers() []*Answer {
+	if x != nil {
+		return x.TopAnswers
+	}
+	return nil
+}
+
+// 问题的回答
+type Answer struct {
+	Id           uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	QuestionId   uint64 `protobuf:"varint,2,opt,name=question_id" json:"question_id,omitempty"`
+	SpuId        uint64 `protobuf:"varint,3,opt,name=spu_id" json:"spu_id,omitempty"`
+	UserId       uint64 `protobuf:"varint,4,opt,name=user_id" json:"user_id,omitempty"`
+	Content      string `protobuf:"bytes,5,opt,name=content" json:"content,omitempty"`
+	IsMerchant   bool   `protobuf:"varint,6,opt,name=is_merchant" json:"is_merchant,omitempty"` // 商家回答
+	Upvotes      int64  `protobuf:"varint,7,opt,name=upvotes" json:"upvotes,omitempty"`
+	Status       int32  `protobuf:"varint,8,opt,name=status" json:"status,omitempty"` // 审核状态: 0-待审核, 1-已通过, 2-已驳回
+	RejectReason string `protobuf:"bytes,9,opt,name=reject_reason" json:"reject_reason,omitempty"`
+	CreatedAt    int64  `protobuf:"varint,10,opt,name=created_at" json:"created_at,omitempty"`
+	Upvoted      bool   `protobuf:"varint,11,opt,name=upvoted" json:"upvoted,omitempty"` // 当前用户是否已点赞
+}
+
+func (x *Answer) Reset() { *x = Answer{} }
+
+func (x *Answer) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *Answer) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *Answer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Answer) GetQuestionId() uint64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *Answer) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *Answer) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Answer) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Answer) GetIsMerchant() bool {
+	if x != nil {
+		return x.IsMerchant
+	}
+	return false
+}
+
+func (x *Answer) GetUpvotes() int64 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *Answer) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Answer) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *Answer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Answer) GetUpvoted() bool {
+	if x != nil {
+		return x.Upvoted
+	}
+	return false
+}
+
+// 1. CreateReview
+type CreateReviewRequest struct {
+	UserId  uint64   `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	OrderId string   `protobuf:"bytes,2,opt,name=order_id" json:"order_id,omitempty"`
+	SkuId   uint64   `protobuf:"varint,3,opt,name=sku_id" json:"sku_id,omitempty"`
+	Rating  int32    `protobuf:"varint,4,opt,name=rating" json:"rating,omitempty"`  // 星级 1-5
+	Content string   `protobuf:"bytes,5,opt,name=content" json:"content,omitempty"` // 评价内容，最多 1000 字
+	Images  []string `protobuf:"bytes,6,rep,name=images" json:"images,omitempty"`   // 最多 9 张
+	Tags    []string `protobuf:"bytes,7,rep,name=tags" json:"tags,omitempty"`       // 最多 5 个，每个最多 16 字
+}
+
+func (x *CreateReviewRequest) Reset() { *x = CreateReviewRequest{} }
+
+func (x *CreateReviewRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *CreateReviewRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateReviewRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreateReviewRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type CreateReviewResponse struct {
+	ReviewId uint64 `protobuf:"varint,1,opt,name=review_id" json:"review_id,omitempty"`
+	Status   int32  `protobuf:"varint,2,opt,name=status" json:"status,omitempty"` // 创建后的审核状态
+}
+
+func (x *CreateReviewResponse) Reset() { *x = CreateReviewResponse{} }
+
+func (x *CreateReviewResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *CreateReviewResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateReviewResponse) GetReviewId() uint64 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+func (x *CreateReviewResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 2. ListReviews
+type ListReviewsRequest struct {
+	SpuId      uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+	Page       int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
+	Rating     int32  `protobuf:"varint,4,opt,name=rating" json:"rating,omitempty"`           // 按星级筛选，0 为全部
+	WithImages bool   `protobuf:"varint,5,opt,name=with_images" json:"with_images,omitempty"` // 只看有图评价
+}
+
+func (x *ListReviewsRequest) Reset() { *x = ListReviewsRequest{} }
+
+func (x *ListReviewsRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListReviewsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListReviewsRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetWithImages() bool {
+	if x != nil {
+		return x.WithImages
+	}
+	return false
+}
+
+type ListReviewsResponse struct {
+	List  []*Review `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64     `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListReviewsResponse) Reset() { *x = ListReviewsResponse{} }
+
+func (x *ListReviewsResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListReviewsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListReviewsResponse) GetList() []*Review {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 3. GetRatingSummaries
+type GetRatingSummariesRequest struct {
+	SpuIds []uint64 `protobuf:"varint,1,rep,packed,name=spu_ids" json:"spu_ids,omitempty"`
+}
+
+func (x *GetRatingSummariesRequest) Reset() { *x = GetRatingSummariesRequest{} }
+
+func (x *GetRatingSummariesRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetRatingSummariesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetRatingSummariesRequest) GetSpuIds() []uint64 {
+	if x != nil {
+		return x.SpuIds
+	}
+	return nil
+}
+
+type GetRatingSummariesResponse struct {
+	Summaries []*RatingSummary `protobuf:"bytes,1,rep,name=summaries" json:"summaries,omitempty"` // 无评价的商品也会返回，计数为 0
+}
+
+func (x *GetRatingSummariesResponse) Reset() { *x = GetRatingSummariesResponse{} }
+
+func (x *GetRatingSummariesResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *GetRatingSummariesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *GetRatingSummariesResponse) GetSummaries() []*RatingSummary {
+	if x != nil {
+		return x.Summaries
+	}
+	return nil
+}
+
+// 4. AdminListReviews
+type AdminListReviewsRequest struct {
+	Page     int32  `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
+	Status   int32  `protobuf:"varint,3,opt,name=status" json:"status,omitempty"` // 审核状态，-1 为全部
+	SpuId    uint64 `protobuf:"varint,4,opt,name=spu_id" json:"spu_id,omitempty"` // 为 0 时不过滤
+}
+
+func (x *AdminListReviewsRequest) Reset() { *x = AdminListReviewsRequest{} }
+
+func (x *AdminListReviewsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AdminListReviewsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AdminListReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListReviewsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminListReviewsRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+type AdminListReviewsResponse struct {
+	List  []*Review `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64     `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *AdminListReviewsResponse) Reset() { *x = AdminListReviewsResponse{} }
+
+func (x *AdminListReviewsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AdminListReviewsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AdminListReviewsResponse) GetList() []*Review {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminListReviewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 5. ModerateReviews
+type ModerateReviewsRequest struct {
+	Ids          []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	Status       int32    `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`              // 1-通过, 2-驳回
+	RejectReason string   `protobuf:"bytes,3,opt,name=reject_reason" json:"reject_reason,omitempty"` // 驳回时填写
+}
+
+func (x *ModerateReviewsRequest) Reset() { *x = ModerateReviewsRequest{} }
+
+func (x *ModerateReviewsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ModerateReviewsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ModerateReviewsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ModerateReviewsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ModerateReviewsRequest) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+type ModerateReviewsResponse struct {
+	Affected int64 `protobuf:"varint,1,opt,name=affected" json:"affected,omitempty"`
+}
+
+func (x *ModerateReviewsResponse) Reset() { *x = ModerateReviewsResponse{} }
+
+func (x *ModerateReviewsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ModerateReviewsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ModerateReviewsResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 6. CreateQuestion
+type CreateQuestionRequest struct {
+	UserId  uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	SpuId   uint64 `protobuf:"varint,2,opt,name=spu_id" json:"spu_id,omitempty"`
+	Content string `protobuf:"bytes,3,opt,name=content" json:"content,omitempty"` // 最多 200 字
+}
+
+func (x *CreateQuestionRequest) Reset() { *x = CreateQuestionRequest{} }
+
+func (x *CreateQuestionRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateQuestionRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateQuestionRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type CreateQuestionResponse struct {
+	QuestionId uint64 `protobuf:"varint,1,opt,name=question_id" json:"question_id,omitempty"`
+	Status     int32  `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
+}
+
+func (x *CreateQuestionResponse) Reset() { *x = CreateQuestionResponse{} }
+
+func (x *CreateQuestionResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *CreateQuestionResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateQuestionResponse) GetQuestionId() uint64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *CreateQuestionResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 7. CreateAnswer
+type CreateAnswerRequest struct {
+	UserId     uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	QuestionId uint64 `protobuf:"varint,2,opt,name=question_id" json:"question_id,omitempty"`
+	Content    string `protobuf:"bytes,3,opt,name=content" json:"content,omitempty"`          // 最多 500 字
+	IsMerchant bool   `protobuf:"varint,4,opt,name=is_merchant" json:"is_merchant,omitempty"` // 由网关根据用户权限设置，平台运营以官方身份回答，无需购买记录
+	MerchantId uint64 `protobuf:"varint,5,opt,name=merchant_id" json:"merchant_id,omitempty"` // 由网关设置，回答者是该商品的所属商家时以商家身份回答
+}
+
+func (x *CreateAnswerRequest) Reset() { *x = CreateAnswerRequest{} }
+
+func (x *CreateAnswerRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *CreateAnswerRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateAnswerRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAnswerRequest) GetQuestionId() uint64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *CreateAnswerRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateAnswerRequest) GetIsMerchant() bool {
+	if x != nil {
+		return x.IsMerchant
+	}
+	return false
+}
+
+func (x *CreateAnswerRequest) GetMerchantId() uint64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type CreateAnswerResponse struct {
+	AnswerId uint64 `protobuf:"varint,1,opt,name=answer_id" json:"answer_id,omitempty"`
+	Status   int32  `protobuf:"varint,2,opt,name=status" json:"status,omitempty"`
+}
+
+func (x *CreateAnswerResponse) Reset() { *x = CreateAnswerResponse{} }
+
+func (x *CreateAnswerResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *CreateAnswerResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *CreateAnswerResponse) GetAnswerId() uint64 {
+	if x != nil {
+		return x.AnswerId
+	}
+	return 0
+}
+
+func (x *CreateAnswerResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 8. ListQuestions
+type ListQuestionsRequest struct {
+	SpuId    uint64 `protobuf:"varint,1,opt,name=spu_id" json:"spu_id,omitempty"`
+	Page     int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
+	UserId   uint64 `protobuf:"varint,4,opt,name=user_id" json:"user_id,omitempty"` // 可选，用于标记回答是否已点赞
+}
+
+func (x *ListQuestionsRequest) Reset() { *x = ListQuestionsRequest{} }
+
+func (x *ListQuestionsRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListQuestionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListQuestionsRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+func (x *ListQuestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuestionsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListQuestionsResponse struct {
+	List  []*Question `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64       `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListQuestionsResponse) Reset() { *x = ListQuestionsResponse{} }
+
+func (x *ListQuestionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ListQuestionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListQuestionsResponse) GetList() []*Question {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListQuestionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 9. ListAnswers
+type ListAnswersRequest struct {
+	QuestionId uint64 `protobuf:"varint,1,opt,name=question_id" json:"question_id,omitempty"`
+	Page       int32  `protobuf:"varint,2,opt,name=page" json:"page,omitempty"`
+	PageSize   int32  `protobuf:"varint,3,opt,name=page_size" json:"page_size,omitempty"`
+	UserId     uint64 `protobuf:"varint,4,opt,name=user_id" json:"user_id,omitempty"` // 可选，用于标记回答是否已点赞
+}
+
+func (x *ListAnswersRequest) Reset() { *x = ListAnswersRequest{} }
+
+func (x *ListAnswersRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListAnswersRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAnswersRequest) GetQuestionId() uint64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *ListAnswersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAnswersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAnswersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListAnswersResponse struct {
+	List  []*Answer `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64     `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *ListAnswersResponse) Reset() { *x = ListAnswersResponse{} }
+
+func (x *ListAnswersResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListAnswersResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListAnswersResponse) GetList() []*Answer {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListAnswersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 10. UpvoteAnswer
+type UpvoteAnswerRequest struct {
+	UserId   uint64 `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	AnswerId uint64 `protobuf:"varint,2,opt,name=answer_id" json:"answer_id,omitempty"`
+	Cancel   bool   `protobuf:"varint,3,opt,name=cancel" json:"cancel,omitempty"` // 取消点赞
+}
+
+func (x *UpvoteAnswerRequest) Reset() { *x = UpvoteAnswerRequest{} }
+
+func (x *UpvoteAnswerRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UpvoteAnswerRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UpvoteAnswerRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpvoteAnswerRequest) GetAnswerId() uint64 {
+	if x != nil {
+		return x.AnswerId
+	}
+	return 0
+}
+
+func (x *UpvoteAnswerRequest) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
+type UpvoteAnswerResponse struct {
+	Upvotes int64 `protobuf:"varint,1,opt,name=upvotes" json:"upvotes,omitempty"`
+}
+
+func (x *UpvoteAnswerResponse) Reset() { *x = UpvoteAnswerResponse{} }
+
+func (x *UpvoteAnswerResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *UpvoteAnswerResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *UpvoteAnswerResponse) GetUpvotes() int64 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+// 11. AdminListQuestions
+type AdminListQuestionsRequest struct {
+	Page     int32  `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	PageSize int32  `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
+	Status   int32  `protobuf:"varint,3,opt,name=status" json:"status,omitempty"` // 审核状态，-1 为全部
+	SpuId    uint64 `protobuf:"varint,4,opt,name=spu_id" json:"spu_id,omitempty"` // 为 0 时不过滤
+}
+
+func (x *AdminListQuestionsRequest) Reset() { *x = AdminListQuestionsRequest{} }
+
+func (x *AdminListQuestionsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AdminListQuestionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AdminListQuestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListQuestionsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminListQuestionsRequest) GetSpuId() uint64 {
+	if x != nil {
+		return x.SpuId
+	}
+	return 0
+}
+
+type AdminListQuestionsResponse struct {
+	List  []*Question `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64       `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *AdminListQuestionsResponse) Reset() { *x = AdminListQuestionsResponse{} }
+
+func (x *AdminListQuestionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AdminListQuestionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AdminListQuestionsResponse) GetList() []*Question {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminListQuestionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 12. AdminListAnswers
+type AdminListAnswersRequest struct {
+	Page       int32  `protobuf:"varint,1,opt,name=page" json:"page,omitempty"`
+	PageSize   int32  `protobuf:"varint,2,opt,name=page_size" json:"page_size,omitempty"`
+	Status     int32  `protobuf:"varint,3,opt,name=status" json:"status,omitempty"`           // 审核状态，-1 为全部
+	QuestionId uint64 `protobuf:"varint,4,opt,name=question_id" json:"question_id,omitempty"` // 为 0 时不过滤
+}
+
+func (x *AdminListAnswersRequest) Reset() { *x = AdminListAnswersRequest{} }
+
+func (x *AdminListAnswersRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AdminListAnswersRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AdminListAnswersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListAnswersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListAnswersRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AdminListAnswersRequest) GetQuestionId() uint64 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+type AdminListAnswersResponse struct {
+	List  []*Answer `protobuf:"bytes,1,rep,name=list" json:"list,omitempty"`
+	Total int64     `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+}
+
+func (x *AdminListAnswersResponse) Reset() { *x = AdminListAnswersResponse{} }
+
+func (x *AdminListAnswersResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *AdminListAnswersResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *AdminListAnswersResponse) GetList() []*Answer {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *AdminListAnswersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 13. ModerateQuestions
+type ModerateQuestionsRequest struct {
+	Ids          []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	Status       int32    `protobuf:"varint,2,opt,name=status" json:"status,omitempty"` // 1-通过, 2-驳回
+	RejectReason string   `protobuf:"bytes,3,opt,name=reject_reason" json:"reject_reason,omitempty"`
+}
+
+func (x *ModerateQuestionsRequest) Reset() { *x = ModerateQuestionsRequest{} }
+
+func (x *ModerateQuestionsRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ModerateQuestionsRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ModerateQuestionsRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ModerateQuestionsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ModerateQuestionsRequest) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+type ModerateQuestionsResponse struct {
+	Affected int64 `protobuf:"varint,1,opt,name=affected" json:"affected,omitempty"`
+}
+
+func (x *ModerateQuestionsResponse) Reset() { *x = ModerateQuestionsResponse{} }
+
+func (x *ModerateQuestionsResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ModerateQuestionsResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ModerateQuestionsResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+// 14. ModerateAnswers
+type ModerateAnswersRequest struct {
+	Ids          []uint64 `protobuf:"varint,1,rep,packed,name=ids" json:"ids,omitempty"`
+	Status       int32    `protobuf:"varint,2,opt,name=status" json:"status,omitempty"` // 1-通过, 2-驳回
+	RejectReason string   `protobuf:"bytes,3,opt,name=reject_reason" json:"reject_reason,omitempty"`
+}
+
+func (x *ModerateAnswersRequest) Reset() { *x = ModerateAnswersRequest{} }
+
+func (x *ModerateAnswersRequest) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ModerateAnswersRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ModerateAnswersRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ModerateAnswersRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ModerateAnswersRequest) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+type ModerateAnswersResponse struct {
+	Affected int64 `protobuf:"varint,1,opt,name=affected" json:"affected,omitempty"`
+}
+
+func (x *ModerateAnswersResponse) Reset() { *x = ModerateAnswersResponse{} }
+
+func (x *ModerateAnswersResponse) Marshal(in []byte) ([]byte, error) {
+	return prutal.MarshalAppend(in, x)
+}
+
+func (x *ModerateAnswersResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ModerateAnswersResponse) GetAffected() int64 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+type ReviewService interface {
+	CreateReview(ctx context.Context, req *CreateReviewRequest) (res *CreateReviewResponse, err error)
+	ListReviews(ctx context.Context, req *ListReviewsRequest) (res *ListReviewsResponse, err error)
+	GetRatingSummaries(ctx context.Context, req *GetRatingSummariesRequest) (res *GetRatingSummariesResponse, err error)
+	AdminListReviews(ctx context.Context, req *AdminListReviewsRequest) (res *AdminListReviewsResponse, err error)
+	ModerateReviews(ctx context.Context, req *ModerateReviewsRequest) (res *ModerateReviewsResponse, err error)
+	CreateQuestion(ctx context.Context, req *CreateQuestionRequest) (res *CreateQuestionResponse, err error)
+	CreateAnswer(ctx context.Context, req *CreateAnswerRequest) (res *CreateAnswerResponse, err error)
+	ListQuestions(ctx context.Context, req *ListQuestionsRequest) (res *ListQuestionsResponse, err error)
+	ListAnswers(ctx context.Context, req *ListAnswersRequest) (res *ListAnswersResponse, err error)
+	UpvoteAnswer(ctx context.Context, req *UpvoteAnswerRequest) (res *UpvoteAnswerResponse, err error)
+	AdminListQuestions(ctx context.Context, req *AdminListQuestionsRequest) (res *AdminListQuestionsResponse, err error)
+	AdminListAnswers(ctx context.Context, req *AdminListAnswersRequest) (res *AdminListAnswersResponse, err error)
+	ModerateQuestions(ctx context.Context, req *ModerateQuestionsRequest) (res *ModerateQuestionsResponse, err error)
+	ModerateAnswers(ctx context.Context, req *ModerateAnswersRequest) (res *ModerateAnswersResponse, err error)
+}
@@ -0,0 +1,127 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package reviewservice
+
+import (
+	"context"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+	client "github.com/cloudwego/kitex/client"
+	callopt "github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	CreateReview(ctx context.Context, Req *review.CreateReviewRequest, callOptions ...callopt.Option) (r *review.CreateReviewResponse, err error)
+	ListReviews(ctx context.Context, Req *review.ListReviewsRequest, callOptions ...callopt.Option) (r *review.ListReviewsResponse, err error)
+	GetRatingSummaries(ctx context.Context, Req *review.GetRatingSummariesRequest, callOptions ...callopt.Option) (r *review.GetRatingSummariesResponse, err error)
+	AdminListReviews(ctx context.Context, Req *review.AdminListReviewsRequest, callOptions ...callopt.Option) (r *review.AdminListReviewsResponse, err error)
+	ModerateReviews(ctx context.Context, Req *review.ModerateReviewsRequest, callOptions ...callopt.Option) (r *review.ModerateReviewsResponse, err error)
+	CreateQuestion(ctx context.Context, Req *review.CreateQuestionRequest, callOptions ...callopt.Option) (r *review.CreateQuestionResponse, err error)
+	CreateAnswer(ctx context.Context, Req *review.CreateAnswerRequest, callOptions ...callopt.Option) (r *review.CreateAnswerResponse, err error)
+	ListQuestions(ctx context.Context, Req *review.ListQuestionsRequest, callOptions ...callopt.Option) (r *review.ListQuestionsResponse, err error)
+	ListAnswers(ctx context.Context, Req *review.ListAnswersRequest, callOptions ...callopt.Option) (r *review.ListAnswersResponse, err error)
+	UpvoteAnswer(ctx context.Context, Req *review.UpvoteAnswerRequest, callOptions ...callopt.Option) (r *review.UpvoteAnswerResponse, err error)
+	AdminListQuestions(ctx context.Context, Req *review.AdminListQuestionsRequest, callOptions ...callopt.Option) (r *review.AdminListQuestionsResponse, err error)
+	AdminListAnswers(ctx context.Context, Req *review.AdminListAnswersRequest, callOptions ...callopt.Option) (r *review.AdminListAnswersResponse, err error)
+	ModerateQuestions(ctx context.Context, Req *review.ModerateQuestionsRequest, callOptions ...callopt.Option) (r *review.ModerateQuestionsResponse, err error)
+	ModerateAnswers(ctx context.Context, Req *review.ModerateAnswersRequest, callOptions ...callopt.Option) (r *review.ModerateAnswersResponse, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kReviewServiceClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kReviewServiceClient struct {
+	*kClient
+}
+
+func (p *kReviewServiceClient) CreateReview(ctx context.Context, Req *review.CreateReviewRequest, callOptions ...callopt.Option) (r *review.CreateReviewResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateReview(ctx, Req)
+}
+
+func (p *kReviewServiceClient) ListReviews(ctx context.Context, Req *review.ListReviewsRequest, callOptions ...callopt.Option) (r *review.ListReviewsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListReviews(ctx, Req)
+}
+
+func (p *kReviewServiceClient) GetRatingSummaries(ctx context.Context, Req *review.GetRatingSummariesRequest, callOptions ...callopt.Option) (r *review.GetRatingSummariesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetRatingSummaries(ctx, Req)
+}
+
+func (p *kReviewServiceClient) AdminListReviews(ctx context.Context, Req *review.AdminListReviewsRequest, callOptions ...callopt.Option) (r *review.AdminListReviewsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AdminListReviews(ctx, Req)
+}
+
+func (p *kReviewServiceClient) ModerateReviews(ctx context.Context, Req *review.ModerateReviewsRequest, callOptions ...callopt.Option) (r *review.ModerateReviewsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ModerateReviews(ctx, Req)
+}
+
+func (p *kReviewServiceClient) CreateQuestion(ctx context.Context, Req *review.CreateQuestionRequest, callOptions ...callopt.Option) (r *review.CreateQuestionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateQuestion(ctx, Req)
+}
+
+func (p *kReviewServiceClient) CreateAnswer(ctx context.Context, Req *review.CreateAnswerRequest, callOptions ...callopt.Option) (r *review.CreateAnswerResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateAnswer(ctx, Req)
+}
+
+func (p *kReviewServiceClient) ListQuestions(ctx context.Context, Req *review.ListQuestionsRequest, callOptions ...callopt.Option) (r *review.ListQuestionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListQuestions(ctx, Req)
+}
+
+func (p *kReviewServiceClient) ListAnswers(ctx context.Context, Req *review.ListAnswersRequest, callOptions ...callopt.Option) (r *review.ListAnswersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListAnswers(ctx, Req)
+}
+
+func (p *kReviewServiceClient) UpvoteAnswer(ctx context.Context, Req *review.UpvoteAnswerRequest, callOptions ...callopt.Option) (r *review.UpvoteAnswerResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpvoteAnswer(ctx, Req)
+}
+
+func (p *kReviewServiceClient) AdminListQuestions(ctx context.Context, Req *review.AdminListQuestionsRequest, callOptions ...callopt.Option) (r *review.AdminListQuestionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AdminListQuestions(ctx, Req)
+}
+
+func (p *kReviewServiceClient) AdminListAnswers(ctx context.Context, Req *review.AdminListAnswersRequest, callOptions ...callopt.Option) (r *review.AdminListAnswersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AdminListAnswers(ctx, Req)
+}
+
+func (p *kReviewServiceClient) ModerateQuestions(ctx context.Context, Req *review.ModerateQuestionsRequest, callOptions ...callopt.Option) (r *review.ModerateQuestionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ModerateQuestions(ctx, Req)
+}
+
+func (p *kReviewServiceClient) ModerateAnswers(ctx context.Context, Req *review.ModerateAnswersRequest, callOptions ...callopt.Option) (r *review.ModerateAnswersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ModerateAnswers(ctx, Req)
+}
@@ -0,0 +1,1884 @@
+// Code generated by Kitex v0.15.4. DO NOT EDIT.
+
+package reviewservice
+
+import (
+	"context"
+	"errors"
+	review "github.com/PiaoAdmin/pmall/rpc_gen/review"
+	client "github.com/cloudwego/kitex/client"
+	kitex "github.com/cloudwego/kitex/pkg/serviceinfo"
+	streaming "github.com/cloudwego/kitex/pkg/streaming"
+	proto "github.com/cloudwego/prutal"
+)
+
+var errInvalidMessageType = errors.New("invalid message type for service method handler")
+
+var serviceMethods = map[string]kitex.MethodInfo{
+	"CreateReview": kitex.NewMethodInfo(
+		createReviewHandler,
+		newCreateReviewArgs,
+		newCreateReviewResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListReviews": kitex.NewMethodInfo(
+		listReviewsHandler,
+		newListReviewsArgs,
+		newListReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"GetRatingSummaries": kitex.NewMethodInfo(
+		getRatingSummariesHandler,
+		newGetRatingSummariesArgs,
+		newGetRatingSummariesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AdminListReviews": kitex.NewMethodInfo(
+		adminListReviewsHandler,
+		newAdminListReviewsArgs,
+		newAdminListReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ModerateReviews": kitex.NewMethodInfo(
+		moderateReviewsHandler,
+		newModerateReviewsArgs,
+		newModerateReviewsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateQuestion": kitex.NewMethodInfo(
+		createQuestionHandler,
+		newCreateQuestionArgs,
+		newCreateQuestionResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"CreateAnswer": kitex.NewMethodInfo(
+		createAnswerHandler,
+		newCreateAnswerArgs,
+		newCreateAnswerResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListQuestions": kitex.NewMethodInfo(
+		listQuestionsHandler,
+		newListQuestionsArgs,
+		newListQuestionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ListAnswers": kitex.NewMethodInfo(
+		listAnswersHandler,
+		newListAnswersArgs,
+		newListAnswersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"UpvoteAnswer": kitex.NewMethodInfo(
+		upvoteAnswerHandler,
+		newUpvoteAnswerArgs,
+		newUpvoteAnswerResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AdminListQuestions": kitex.NewMethodInfo(
+		adminListQuestionsHandler,
+		newAdminListQuestionsArgs,
+		newAdminListQuestionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"AdminListAnswers": kitex.NewMethodInfo(
+		adminListAnswersHandler,
+		newAdminListAnswersArgs,
+		newAdminListAnswersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ModerateQuestions": kitex.NewMethodInfo(
+		moderateQuestionsHandler,
+		newModerateQuestionsArgs,
+		newModerateQuestionsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"ModerateAnswers": kitex.NewMethodInfo(
+		moderateAnswersHandler,
+		newModerateAnswersArgs,
+		newModerateAnswersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+}
+
+var (
+	reviewServiceServiceInfo                = NewServiceInfo()
+	reviewServiceServiceInfoForClient       = NewServiceInfoForClient()
+	reviewServiceServiceInfoForStreamClient = NewServiceInfoForStreamClient()
+)
+
+// for server
+func serviceInfo() *kitex.ServiceInfo {
+	return reviewServiceServiceInfo
+}
+
+// for stream client
+func serviceInfoForStreamClient() *kitex.ServiceInfo {
+	return reviewServiceServiceInfoForStreamClient
+}
+
+// for client
+func serviceInfoForClient() *kitex.ServiceInfo {
+	return reviewServiceServiceInfoForClient
+}
+
+// NewServiceInfo creates a new ServiceInfo containing all methods
+func NewServiceInfo() *kitex.ServiceInfo {
+	return newServiceInfo(false, true, true)
+}
+
+// NewServiceInfo creates a new ServiceInfo containing non-streaming methods
+func NewServiceInfoForClient() *kitex.ServiceInfo {
+	return newServiceInfo(false, false, true)
+}
+func NewServiceInfoForStreamClient() *kitex.ServiceInfo {
+	return newServiceInfo(true, true, false)
+}
+
+func newServiceInfo(hasStreaming bool, keepStreamingMethods bool, keepNonStreamingMethods bool) *kitex.ServiceInfo {
+	serviceName := "ReviewService"
+	handlerType := (*review.ReviewService)(nil)
+	methods := map[string]kitex.MethodInfo{}
+	for name, m := range serviceMethods {
+		if m.IsStreaming() && !keepStreamingMethods {
+			continue
+		}
+		if !m.IsStreaming() && !keepNonStreamingMethods {
+			continue
+		}
+		methods[name] = m
+	}
+	extra := map[string]interface{}{
+		"PackageName": "review",
+	}
+	if hasStreaming {
+		extra["streaming"] = hasStreaming
+	}
+	svcInfo := &kitex.ServiceInfo{
+		ServiceName:     serviceName,
+		HandlerType:     handlerType,
+		Methods:         methods,
+		PayloadCodec:    kitex.Protobuf,
+		KiteXGenVersion: "v0.15.4",
+		Extra:           extra,
+	}
+	return svcInfo
+}
+
+func createReviewHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.CreateReviewRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).CreateReview(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateReviewArgs:
+		success, err := handler.(review.ReviewService).CreateReview(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateReviewResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateReviewArgs() interface{} {
+	return &CreateReviewArgs{}
+}
+
+func newCreateReviewResult() interface{} {
+	return &CreateReviewResult{}
+}
+
+type CreateReviewArgs struct {
+	Req *review.CreateReviewRequest
+}
+
+func (p *CreateReviewArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateReviewArgs) Unmarshal(in []byte) error {
+	msg := new(review.CreateReviewRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateReviewArgs_Req_DEFAULT *review.CreateReviewRequest
+
+func (p *CreateReviewArgs) GetReq() *review.CreateReviewRequest {
+	if !p.IsSetReq() {
+		return CreateReviewArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateReviewArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateReviewArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateReviewResult struct {
+	Success *review.CreateReviewResponse
+}
+
+var CreateReviewResult_Success_DEFAULT *review.CreateReviewResponse
+
+func (p *CreateReviewResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateReviewResult) Unmarshal(in []byte) error {
+	msg := new(review.CreateReviewResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateReviewResult) GetSuccess() *review.CreateReviewResponse {
+	if !p.IsSetSuccess() {
+		return CreateReviewResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateReviewResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.CreateReviewResponse)
+}
+
+func (p *CreateReviewResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateReviewResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.ListReviewsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).ListReviews(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListReviewsArgs:
+		success, err := handler.(review.ReviewService).ListReviews(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListReviewsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListReviewsArgs() interface{} {
+	return &ListReviewsArgs{}
+}
+
+func newListReviewsResult() interface{} {
+	return &ListReviewsResult{}
+}
+
+type ListReviewsArgs struct {
+	Req *review.ListReviewsRequest
+}
+
+func (p *ListReviewsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListReviewsArgs) Unmarshal(in []byte) error {
+	msg := new(review.ListReviewsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListReviewsArgs_Req_DEFAULT *review.ListReviewsRequest
+
+func (p *ListReviewsArgs) GetReq() *review.ListReviewsRequest {
+	if !p.IsSetReq() {
+		return ListReviewsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListReviewsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListReviewsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListReviewsResult struct {
+	Success *review.ListReviewsResponse
+}
+
+var ListReviewsResult_Success_DEFAULT *review.ListReviewsResponse
+
+func (p *ListReviewsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListReviewsResult) Unmarshal(in []byte) error {
+	msg := new(review.ListReviewsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListReviewsResult) GetSuccess() *review.ListReviewsResponse {
+	if !p.IsSetSuccess() {
+		return ListReviewsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListReviewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.ListReviewsResponse)
+}
+
+func (p *ListReviewsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListReviewsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func getRatingSummariesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.GetRatingSummariesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).GetRatingSummaries(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *GetRatingSummariesArgs:
+		success, err := handler.(review.ReviewService).GetRatingSummaries(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*GetRatingSummariesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newGetRatingSummariesArgs() interface{} {
+	return &GetRatingSummariesArgs{}
+}
+
+func newGetRatingSummariesResult() interface{} {
+	return &GetRatingSummariesResult{}
+}
+
+type GetRatingSummariesArgs struct {
+	Req *review.GetRatingSummariesRequest
+}
+
+func (p *GetRatingSummariesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *GetRatingSummariesArgs) Unmarshal(in []byte) error {
+	msg := new(review.GetRatingSummariesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var GetRatingSummariesArgs_Req_DEFAULT *review.GetRatingSummariesRequest
+
+func (p *GetRatingSummariesArgs) GetReq() *review.GetRatingSummariesRequest {
+	if !p.IsSetReq() {
+		return GetRatingSummariesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *GetRatingSummariesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *GetRatingSummariesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type GetRatingSummariesResult struct {
+	Success *review.GetRatingSummariesResponse
+}
+
+var GetRatingSummariesResult_Success_DEFAULT *review.GetRatingSummariesResponse
+
+func (p *GetRatingSummariesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *GetRatingSummariesResult) Unmarshal(in []byte) error {
+	msg := new(review.GetRatingSummariesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *GetRatingSummariesResult) GetSuccess() *review.GetRatingSummariesResponse {
+	if !p.IsSetSuccess() {
+		return GetRatingSummariesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *GetRatingSummariesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.GetRatingSummariesResponse)
+}
+
+func (p *GetRatingSummariesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *GetRatingSummariesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func adminListReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.AdminListReviewsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).AdminListReviews(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AdminListReviewsArgs:
+		success, err := handler.(review.ReviewService).AdminListReviews(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AdminListReviewsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAdminListReviewsArgs() interface{} {
+	return &AdminListReviewsArgs{}
+}
+
+func newAdminListReviewsResult() interface{} {
+	return &AdminListReviewsResult{}
+}
+
+type AdminListReviewsArgs struct {
+	Req *review.AdminListReviewsRequest
+}
+
+func (p *AdminListReviewsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AdminListReviewsArgs) Unmarshal(in []byte) error {
+	msg := new(review.AdminListReviewsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AdminListReviewsArgs_Req_DEFAULT *review.AdminListReviewsRequest
+
+func (p *AdminListReviewsArgs) GetReq() *review.AdminListReviewsRequest {
+	if !p.IsSetReq() {
+		return AdminListReviewsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AdminListReviewsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminListReviewsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AdminListReviewsResult struct {
+	Success *review.AdminListReviewsResponse
+}
+
+var AdminListReviewsResult_Success_DEFAULT *review.AdminListReviewsResponse
+
+func (p *AdminListReviewsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AdminListReviewsResult) Unmarshal(in []byte) error {
+	msg := new(review.AdminListReviewsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AdminListReviewsResult) GetSuccess() *review.AdminListReviewsResponse {
+	if !p.IsSetSuccess() {
+		return AdminListReviewsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AdminListReviewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.AdminListReviewsResponse)
+}
+
+func (p *AdminListReviewsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminListReviewsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func moderateReviewsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.ModerateReviewsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).ModerateReviews(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ModerateReviewsArgs:
+		success, err := handler.(review.ReviewService).ModerateReviews(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ModerateReviewsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newModerateReviewsArgs() interface{} {
+	return &ModerateReviewsArgs{}
+}
+
+func newModerateReviewsResult() interface{} {
+	return &ModerateReviewsResult{}
+}
+
+type ModerateReviewsArgs struct {
+	Req *review.ModerateReviewsRequest
+}
+
+func (p *ModerateReviewsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ModerateReviewsArgs) Unmarshal(in []byte) error {
+	msg := new(review.ModerateReviewsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ModerateReviewsArgs_Req_DEFAULT *review.ModerateReviewsRequest
+
+func (p *ModerateReviewsArgs) GetReq() *review.ModerateReviewsRequest {
+	if !p.IsSetReq() {
+		return ModerateReviewsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ModerateReviewsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerateReviewsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ModerateReviewsResult struct {
+	Success *review.ModerateReviewsResponse
+}
+
+var ModerateReviewsResult_Success_DEFAULT *review.ModerateReviewsResponse
+
+func (p *ModerateReviewsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ModerateReviewsResult) Unmarshal(in []byte) error {
+	msg := new(review.ModerateReviewsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ModerateReviewsResult) GetSuccess() *review.ModerateReviewsResponse {
+	if !p.IsSetSuccess() {
+		return ModerateReviewsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ModerateReviewsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.ModerateReviewsResponse)
+}
+
+func (p *ModerateReviewsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerateReviewsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createQuestionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.CreateQuestionRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).CreateQuestion(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateQuestionArgs:
+		success, err := handler.(review.ReviewService).CreateQuestion(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateQuestionResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateQuestionArgs() interface{} {
+	return &CreateQuestionArgs{}
+}
+
+func newCreateQuestionResult() interface{} {
+	return &CreateQuestionResult{}
+}
+
+type CreateQuestionArgs struct {
+	Req *review.CreateQuestionRequest
+}
+
+func (p *CreateQuestionArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateQuestionArgs) Unmarshal(in []byte) error {
+	msg := new(review.CreateQuestionRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateQuestionArgs_Req_DEFAULT *review.CreateQuestionRequest
+
+func (p *CreateQuestionArgs) GetReq() *review.CreateQuestionRequest {
+	if !p.IsSetReq() {
+		return CreateQuestionArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateQuestionArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateQuestionArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateQuestionResult struct {
+	Success *review.CreateQuestionResponse
+}
+
+var CreateQuestionResult_Success_DEFAULT *review.CreateQuestionResponse
+
+func (p *CreateQuestionResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateQuestionResult) Unmarshal(in []byte) error {
+	msg := new(review.CreateQuestionResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateQuestionResult) GetSuccess() *review.CreateQuestionResponse {
+	if !p.IsSetSuccess() {
+		return CreateQuestionResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateQuestionResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.CreateQuestionResponse)
+}
+
+func (p *CreateQuestionResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateQuestionResult) GetResult() interface{} {
+	return p.Success
+}
+
+func createAnswerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.CreateAnswerRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).CreateAnswer(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *CreateAnswerArgs:
+		success, err := handler.(review.ReviewService).CreateAnswer(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*CreateAnswerResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newCreateAnswerArgs() interface{} {
+	return &CreateAnswerArgs{}
+}
+
+func newCreateAnswerResult() interface{} {
+	return &CreateAnswerResult{}
+}
+
+type CreateAnswerArgs struct {
+	Req *review.CreateAnswerRequest
+}
+
+func (p *CreateAnswerArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *CreateAnswerArgs) Unmarshal(in []byte) error {
+	msg := new(review.CreateAnswerRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var CreateAnswerArgs_Req_DEFAULT *review.CreateAnswerRequest
+
+func (p *CreateAnswerArgs) GetReq() *review.CreateAnswerRequest {
+	if !p.IsSetReq() {
+		return CreateAnswerArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *CreateAnswerArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *CreateAnswerArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type CreateAnswerResult struct {
+	Success *review.CreateAnswerResponse
+}
+
+var CreateAnswerResult_Success_DEFAULT *review.CreateAnswerResponse
+
+func (p *CreateAnswerResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *CreateAnswerResult) Unmarshal(in []byte) error {
+	msg := new(review.CreateAnswerResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *CreateAnswerResult) GetSuccess() *review.CreateAnswerResponse {
+	if !p.IsSetSuccess() {
+		return CreateAnswerResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *CreateAnswerResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.CreateAnswerResponse)
+}
+
+func (p *CreateAnswerResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *CreateAnswerResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listQuestionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.ListQuestionsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).ListQuestions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListQuestionsArgs:
+		success, err := handler.(review.ReviewService).ListQuestions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListQuestionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListQuestionsArgs() interface{} {
+	return &ListQuestionsArgs{}
+}
+
+func newListQuestionsResult() interface{} {
+	return &ListQuestionsResult{}
+}
+
+type ListQuestionsArgs struct {
+	Req *review.ListQuestionsRequest
+}
+
+func (p *ListQuestionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListQuestionsArgs) Unmarshal(in []byte) error {
+	msg := new(review.ListQuestionsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListQuestionsArgs_Req_DEFAULT *review.ListQuestionsRequest
+
+func (p *ListQuestionsArgs) GetReq() *review.ListQuestionsRequest {
+	if !p.IsSetReq() {
+		return ListQuestionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListQuestionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListQuestionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListQuestionsResult struct {
+	Success *review.ListQuestionsResponse
+}
+
+var ListQuestionsResult_Success_DEFAULT *review.ListQuestionsResponse
+
+func (p *ListQuestionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListQuestionsResult) Unmarshal(in []byte) error {
+	msg := new(review.ListQuestionsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListQuestionsResult) GetSuccess() *review.ListQuestionsResponse {
+	if !p.IsSetSuccess() {
+		return ListQuestionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListQuestionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.ListQuestionsResponse)
+}
+
+func (p *ListQuestionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListQuestionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func listAnswersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.ListAnswersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).ListAnswers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListAnswersArgs:
+		success, err := handler.(review.ReviewService).ListAnswers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListAnswersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListAnswersArgs() interface{} {
+	return &ListAnswersArgs{}
+}
+
+func newListAnswersResult() interface{} {
+	return &ListAnswersResult{}
+}
+
+type ListAnswersArgs struct {
+	Req *review.ListAnswersRequest
+}
+
+func (p *ListAnswersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListAnswersArgs) Unmarshal(in []byte) error {
+	msg := new(review.ListAnswersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListAnswersArgs_Req_DEFAULT *review.ListAnswersRequest
+
+func (p *ListAnswersArgs) GetReq() *review.ListAnswersRequest {
+	if !p.IsSetReq() {
+		return ListAnswersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListAnswersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListAnswersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListAnswersResult struct {
+	Success *review.ListAnswersResponse
+}
+
+var ListAnswersResult_Success_DEFAULT *review.ListAnswersResponse
+
+func (p *ListAnswersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListAnswersResult) Unmarshal(in []byte) error {
+	msg := new(review.ListAnswersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListAnswersResult) GetSuccess() *review.ListAnswersResponse {
+	if !p.IsSetSuccess() {
+		return ListAnswersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListAnswersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.ListAnswersResponse)
+}
+
+func (p *ListAnswersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListAnswersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func upvoteAnswerHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.UpvoteAnswerRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).UpvoteAnswer(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *UpvoteAnswerArgs:
+		success, err := handler.(review.ReviewService).UpvoteAnswer(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*UpvoteAnswerResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newUpvoteAnswerArgs() interface{} {
+	return &UpvoteAnswerArgs{}
+}
+
+func newUpvoteAnswerResult() interface{} {
+	return &UpvoteAnswerResult{}
+}
+
+type UpvoteAnswerArgs struct {
+	Req *review.UpvoteAnswerRequest
+}
+
+func (p *UpvoteAnswerArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *UpvoteAnswerArgs) Unmarshal(in []byte) error {
+	msg := new(review.UpvoteAnswerRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var UpvoteAnswerArgs_Req_DEFAULT *review.UpvoteAnswerRequest
+
+func (p *UpvoteAnswerArgs) GetReq() *review.UpvoteAnswerRequest {
+	if !p.IsSetReq() {
+		return UpvoteAnswerArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *UpvoteAnswerArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UpvoteAnswerArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type UpvoteAnswerResult struct {
+	Success *review.UpvoteAnswerResponse
+}
+
+var UpvoteAnswerResult_Success_DEFAULT *review.UpvoteAnswerResponse
+
+func (p *UpvoteAnswerResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *UpvoteAnswerResult) Unmarshal(in []byte) error {
+	msg := new(review.UpvoteAnswerResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *UpvoteAnswerResult) GetSuccess() *review.UpvoteAnswerResponse {
+	if !p.IsSetSuccess() {
+		return UpvoteAnswerResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *UpvoteAnswerResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.UpvoteAnswerResponse)
+}
+
+func (p *UpvoteAnswerResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UpvoteAnswerResult) GetResult() interface{} {
+	return p.Success
+}
+
+func adminListQuestionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.AdminListQuestionsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).AdminListQuestions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AdminListQuestionsArgs:
+		success, err := handler.(review.ReviewService).AdminListQuestions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AdminListQuestionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAdminListQuestionsArgs() interface{} {
+	return &AdminListQuestionsArgs{}
+}
+
+func newAdminListQuestionsResult() interface{} {
+	return &AdminListQuestionsResult{}
+}
+
+type AdminListQuestionsArgs struct {
+	Req *review.AdminListQuestionsRequest
+}
+
+func (p *AdminListQuestionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AdminListQuestionsArgs) Unmarshal(in []byte) error {
+	msg := new(review.AdminListQuestionsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AdminListQuestionsArgs_Req_DEFAULT *review.AdminListQuestionsRequest
+
+func (p *AdminListQuestionsArgs) GetReq() *review.AdminListQuestionsRequest {
+	if !p.IsSetReq() {
+		return AdminListQuestionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AdminListQuestionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminListQuestionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AdminListQuestionsResult struct {
+	Success *review.AdminListQuestionsResponse
+}
+
+var AdminListQuestionsResult_Success_DEFAULT *review.AdminListQuestionsResponse
+
+func (p *AdminListQuestionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AdminListQuestionsResult) Unmarshal(in []byte) error {
+	msg := new(review.AdminListQuestionsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AdminListQuestionsResult) GetSuccess() *review.AdminListQuestionsResponse {
+	if !p.IsSetSuccess() {
+		return AdminListQuestionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AdminListQuestionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.AdminListQuestionsResponse)
+}
+
+func (p *AdminListQuestionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminListQuestionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func adminListAnswersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.AdminListAnswersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).AdminListAnswers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *AdminListAnswersArgs:
+		success, err := handler.(review.ReviewService).AdminListAnswers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*AdminListAnswersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newAdminListAnswersArgs() interface{} {
+	return &AdminListAnswersArgs{}
+}
+
+func newAdminListAnswersResult() interface{} {
+	return &AdminListAnswersResult{}
+}
+
+type AdminListAnswersArgs struct {
+	Req *review.AdminListAnswersRequest
+}
+
+func (p *AdminListAnswersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *AdminListAnswersArgs) Unmarshal(in []byte) error {
+	msg := new(review.AdminListAnswersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var AdminListAnswersArgs_Req_DEFAULT *review.AdminListAnswersRequest
+
+func (p *AdminListAnswersArgs) GetReq() *review.AdminListAnswersRequest {
+	if !p.IsSetReq() {
+		return AdminListAnswersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *AdminListAnswersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *AdminListAnswersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type AdminListAnswersResult struct {
+	Success *review.AdminListAnswersResponse
+}
+
+var AdminListAnswersResult_Success_DEFAULT *review.AdminListAnswersResponse
+
+func (p *AdminListAnswersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *AdminListAnswersResult) Unmarshal(in []byte) error {
+	msg := new(review.AdminListAnswersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *AdminListAnswersResult) GetSuccess() *review.AdminListAnswersResponse {
+	if !p.IsSetSuccess() {
+		return AdminListAnswersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *AdminListAnswersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.AdminListAnswersResponse)
+}
+
+func (p *AdminListAnswersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *AdminListAnswersResult) GetResult() interface{} {
+	return p.Success
+}
+
+func moderateQuestionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.ModerateQuestionsRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).ModerateQuestions(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ModerateQuestionsArgs:
+		success, err := handler.(review.ReviewService).ModerateQuestions(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ModerateQuestionsResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newModerateQuestionsArgs() interface{} {
+	return &ModerateQuestionsArgs{}
+}
+
+func newModerateQuestionsResult() interface{} {
+	return &ModerateQuestionsResult{}
+}
+
+type ModerateQuestionsArgs struct {
+	Req *review.ModerateQuestionsRequest
+}
+
+func (p *ModerateQuestionsArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ModerateQuestionsArgs) Unmarshal(in []byte) error {
+	msg := new(review.ModerateQuestionsRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ModerateQuestionsArgs_Req_DEFAULT *review.ModerateQuestionsRequest
+
+func (p *ModerateQuestionsArgs) GetReq() *review.ModerateQuestionsRequest {
+	if !p.IsSetReq() {
+		return ModerateQuestionsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ModerateQuestionsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerateQuestionsArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ModerateQuestionsResult struct {
+	Success *review.ModerateQuestionsResponse
+}
+
+var ModerateQuestionsResult_Success_DEFAULT *review.ModerateQuestionsResponse
+
+func (p *ModerateQuestionsResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ModerateQuestionsResult) Unmarshal(in []byte) error {
+	msg := new(review.ModerateQuestionsResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ModerateQuestionsResult) GetSuccess() *review.ModerateQuestionsResponse {
+	if !p.IsSetSuccess() {
+		return ModerateQuestionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ModerateQuestionsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.ModerateQuestionsResponse)
+}
+
+func (p *ModerateQuestionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerateQuestionsResult) GetResult() interface{} {
+	return p.Success
+}
+
+func moderateAnswersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(review.ModerateAnswersRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(review.ReviewService).ModerateAnswers(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ModerateAnswersArgs:
+		success, err := handler.(review.ReviewService).ModerateAnswers(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ModerateAnswersResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newModerateAnswersArgs() interface{} {
+	return &ModerateAnswersArgs{}
+}
+
+func newModerateAnswersResult() interface{} {
+	return &ModerateAnswersResult{}
+}
+
+type ModerateAnswersArgs struct {
+	Req *review.ModerateAnswersRequest
+}
+
+func (p *ModerateAnswersArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ModerateAnswersArgs) Unmarshal(in []byte) error {
+	msg := new(review.ModerateAnswersRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ModerateAnswersArgs_Req_DEFAULT *review.ModerateAnswersRequest
+
+func (p *ModerateAnswersArgs) GetReq() *review.ModerateAnswersRequest {
+	if !p.IsSetReq() {
+		return ModerateAnswersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ModerateAnswersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ModerateAnswersArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ModerateAnswersResult struct {
+	Success *review.ModerateAnswersResponse
+}
+
+var ModerateAnswersResult_Success_DEFAULT *review.ModerateAnswersResponse
+
+func (p *ModerateAnswersResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ModerateAnswersResult) Unmarshal(in []byte) error {
+	msg := new(review.ModerateAnswersResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ModerateAnswersResult) GetSuccess() *review.ModerateAnswersResponse {
+	if !p.IsSetSuccess() {
+		return ModerateAnswersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ModerateAnswersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*review.ModerateAnswersResponse)
+}
+
+func (p *ModerateAnswersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ModerateAnswersResult) GetResult() interface{} {
+	return p.Success
+}
+
+type kClient struct {
+	c client.Client
+}
+
+func newServiceClient(c client.Client) *kClient {
+	return &kClient{
+		c: c,
+	}
+}
+
+func (p *kClient) CreateReview(ctx context.Context, Req *review.CreateReviewRequest) (r *review.CreateReviewResponse, err error) {
+	var _args CreateReviewArgs
+	_args.Req = Req
+	var _result CreateReviewResult
+	if err = p.c.Call(ctx, "CreateReview", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListReviews(ctx context.Context, Req *review.ListReviewsRequest) (r *review.ListReviewsResponse, err error) {
+	var _args ListReviewsArgs
+	_args.Req = Req
+	var _result ListReviewsResult
+	if err = p.c.Call(ctx, "ListReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetRatingSummaries(ctx context.Context, Req *review.GetRatingSummariesRequest) (r *review.GetRatingSummariesResponse, err error) {
+	var _args GetRatingSummariesArgs
+	_args.Req = Req
+	var _result GetRatingSummariesResult
+	if err = p.c.Call(ctx, "GetRatingSummaries", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AdminListReviews(ctx context.Context, Req *review.AdminListReviewsRequest) (r *review.AdminListReviewsResponse, err error) {
+	var _args AdminListReviewsArgs
+	_args.Req = Req
+	var _result AdminListReviewsResult
+	if err = p.c.Call(ctx, "AdminListReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ModerateReviews(ctx context.Context, Req *review.ModerateReviewsRequest) (r *review.ModerateReviewsResponse, err error) {
+	var _args ModerateReviewsArgs
+	_args.Req = Req
+	var _result ModerateReviewsResult
+	if err = p.c.Call(ctx, "ModerateReviews", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateQuestion(ctx context.Context, Req *review.CreateQuestionRequest) (r *review.CreateQuestionResponse, err error) {
+	var _args CreateQuestionArgs
+	_args.Req = Req
+	var _result CreateQuestionResult
+	if err = p.c.Call(ctx, "CreateQuestion", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateAnswer(ctx context.Context, Req *review.CreateAnswerRequest) (r *review.CreateAnswerResponse, err error) {
+	var _args CreateAnswerArgs
+	_args.Req = Req
+	var _result CreateAnswerResult
+	if err = p.c.Call(ctx, "CreateAnswer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListQuestions(ctx context.Context, Req *review.ListQuestionsRequest) (r *review.ListQuestionsResponse, err error) {
+	var _args ListQuestionsArgs
+	_args.Req = Req
+	var _result ListQuestionsResult
+	if err = p.c.Call(ctx, "ListQuestions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListAnswers(ctx context.Context, Req *review.ListAnswersRequest) (r *review.ListAnswersResponse, err error) {
+	var _args ListAnswersArgs
+	_args.Req = Req
+	var _result ListAnswersResult
+	if err = p.c.Call(ctx, "ListAnswers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpvoteAnswer(ctx context.Context, Req *review.UpvoteAnswerRequest) (r *review.UpvoteAnswerResponse, err error) {
+	var _args UpvoteAnswerArgs
+	_args.Req = Req
+	var _result UpvoteAnswerResult
+	if err = p.c.Call(ctx, "UpvoteAnswer", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AdminListQuestions(ctx context.Context, Req *review.AdminListQuestionsRequest) (r *review.AdminListQuestionsResponse, err error) {
+	var _args AdminListQuestionsArgs
+	_args.Req = Req
+	var _result AdminListQuestionsResult
+	if err = p.c.Call(ctx, "AdminListQuestions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AdminListAnswers(ctx context.Context, Req *review.AdminListAnswersRequest) (r *review.AdminListAnswersResponse, err error) {
+	var _args AdminListAnswersArgs
+	_args.Req = Req
+	var _result AdminListAnswersResult
+	if err = p.c.Call(ctx, "AdminListAnswers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ModerateQuestions(ctx context.Context, Req *review.ModerateQuestionsRequest) (r *review.ModerateQuestionsResponse, err error) {
+	var _args ModerateQuestionsArgs
+	_args.Req = Req
+	var _result ModerateQuestionsResult
+	if err = p.c.Call(ctx, "ModerateQuestions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ModerateAnswers(ctx context.Context, Req *review.ModerateAnswersRequest) (r *review.ModerateAnswersResponse, err error) {
+	var _args ModerateAnswersArgs
+	_args.Req = Req
+	var _result ModerateAnswersResult
+	if err = p.c.Call(ctx, "ModerateAnswers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -10,12 +10,13 @@ import (
 
 // 脱敏
 type User struct {
-	Id       uint64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
-	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
-	Email    string `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
-	Phone    string `protobuf:"bytes,4,opt,name=phone" json:"phone,omitempty"`
-	Avatar   string `protobuf:"bytes,5,opt,name=avatar" json:"avatar,omitempty"`
-	Status   int32  `protobuf:"varint,6,opt,name=status" json:"status,omitempty"`
+	Id       uint64   `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Username string   `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	Email    string   `protobuf:"bytes,3,opt,name=email" json:"email,omitempty"`
+	Phone    string   `protobuf:"bytes,4,opt,name=phone" json:"phone,omitempty"`
+	Avatar   string   `protobuf:"bytes,5,opt,name=avatar" json:"avatar,omitempty"`
+	Status   int32    `protobuf:"varint,6,opt,name=status" json:"status,omitempty"`
+	Roles    []string `protobuf:"bytes,7,rep,name=roles" json:"roles,omitempty"` // 后台角色，普通用户为空
 }
 
 func (x *User) Reset() { *x = User{} }
@@ -66,6 +67,13 @@ func (x *User) GetStatus() int32 {
 	return 0
 }
 
+func (x *User) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 type RegisterRequest struct {
 	Username        string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
 	Password        string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
@@ -328,10 +336,114 @@ func (x *GetUserInfoResponse) GetUser() *User {
 	return nil
 }
 
+// 角色及其权限
+type Role struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description" json:"description,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions" json:"permissions,omitempty"`
+}
+
+func (x *Role) Reset() { *x = Role{} }
+
+func (x *Role) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *Role) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type ListRolesRequest struct {
+}
+
+func (x *ListRolesRequest) Reset() { *x = ListRolesRequest{} }
+
+func (x *ListRolesRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListRolesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+type ListRolesResponse struct {
+	Roles []*Role `protobuf:"bytes,1,rep,name=roles" json:"roles,omitempty"`
+}
+
+func (x *ListRolesResponse) Reset() { *x = ListRolesResponse{} }
+
+func (x *ListRolesResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *ListRolesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *ListRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetUserRolesRequest struct {
+	UserId uint64   `protobuf:"varint,1,opt,name=user_id" json:"user_id,omitempty"`
+	Roles  []string `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"` // 整体替换用户角色，为空时移除全部角色
+}
+
+func (x *SetUserRolesRequest) Reset() { *x = SetUserRolesRequest{} }
+
+func (x *SetUserRolesRequest) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SetUserRolesRequest) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetUserRolesRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetUserRolesRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SetUserRolesResponse struct {
+	User *User `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+}
+
+func (x *SetUserRolesResponse) Reset() { *x = SetUserRolesResponse{} }
+
+func (x *SetUserRolesResponse) Marshal(in []byte) ([]byte, error) { return prutal.MarshalAppend(in, x) }
+
+func (x *SetUserRolesResponse) Unmarshal(in []byte) error { return prutal.Unmarshal(in, x) }
+
+func (x *SetUserRolesResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 type UserService interface {
 	Register(ctx context.Context, req *RegisterRequest) (res *RegisterResponse, err error)
 	Login(ctx context.Context, req *LoginRequest) (res *LoginResponse, err error)
 	GetUserInfo(ctx context.Context, req *GetUserInfoRequest) (res *GetUserInfoResponse, err error)
 	UpdateUser(ctx context.Context, req *UpdateUserRequest) (res *UpdateUserResponse, err error)
 	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest) (res *UpdatePasswordResponse, err error)
+	ListRoles(ctx context.Context, req *ListRolesRequest) (res *ListRolesResponse, err error)
+	SetUserRoles(ctx context.Context, req *SetUserRolesRequest) (res *SetUserRolesResponse, err error)
 }
@@ -16,6 +16,8 @@ type Client interface {
 	GetUserInfo(ctx context.Context, Req *user.GetUserInfoRequest, callOptions ...callopt.Option) (r *user.GetUserInfoResponse, err error)
 	UpdateUser(ctx context.Context, Req *user.UpdateUserRequest, callOptions ...callopt.Option) (r *user.UpdateUserResponse, err error)
 	UpdatePassword(ctx context.Context, Req *user.UpdatePasswordRequest, callOptions ...callopt.Option) (r *user.UpdatePasswordResponse, err error)
+	ListRoles(ctx context.Context, Req *user.ListRolesRequest, callOptions ...callopt.Option) (r *user.ListRolesResponse, err error)
+	SetUserRoles(ctx context.Context, Req *user.SetUserRolesRequest, callOptions ...callopt.Option) (r *user.SetUserRolesResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -71,3 +73,13 @@ func (p *kUserServiceClient) UpdatePassword(ctx context.Context, Req *user.Updat
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UpdatePassword(ctx, Req)
 }
+
+func (p *kUserServiceClient) ListRoles(ctx context.Context, Req *user.ListRolesRequest, callOptions ...callopt.Option) (r *user.ListRolesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListRoles(ctx, Req)
+}
+
+func (p *kUserServiceClient) SetUserRoles(ctx context.Context, Req *user.SetUserRolesRequest, callOptions ...callopt.Option) (r *user.SetUserRolesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SetUserRoles(ctx, Req)
+}
@@ -50,6 +50,20 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"ListRoles": kitex.NewMethodInfo(
+		listRolesHandler,
+		newListRolesArgs,
+		newListRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"SetUserRoles": kitex.NewMethodInfo(
+		setUserRolesHandler,
+		newSetUserRolesArgs,
+		newSetUserRolesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -671,6 +685,228 @@ func (p *UpdatePasswordResult) GetResult() interface{} {
 	return p.Success
 }
 
+func listRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.ListRolesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).ListRoles(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *ListRolesArgs:
+		success, err := handler.(user.UserService).ListRoles(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*ListRolesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newListRolesArgs() interface{} {
+	return &ListRolesArgs{}
+}
+
+func newListRolesResult() interface{} {
+	return &ListRolesResult{}
+}
+
+type ListRolesArgs struct {
+	Req *user.ListRolesRequest
+}
+
+func (p *ListRolesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *ListRolesArgs) Unmarshal(in []byte) error {
+	msg := new(user.ListRolesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var ListRolesArgs_Req_DEFAULT *user.ListRolesRequest
+
+func (p *ListRolesArgs) GetReq() *user.ListRolesRequest {
+	if !p.IsSetReq() {
+		return ListRolesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *ListRolesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *ListRolesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type ListRolesResult struct {
+	Success *user.ListRolesResponse
+}
+
+var ListRolesResult_Success_DEFAULT *user.ListRolesResponse
+
+func (p *ListRolesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *ListRolesResult) Unmarshal(in []byte) error {
+	msg := new(user.ListRolesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *ListRolesResult) GetSuccess() *user.ListRolesResponse {
+	if !p.IsSetSuccess() {
+		return ListRolesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *ListRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.ListRolesResponse)
+}
+
+func (p *ListRolesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *ListRolesResult) GetResult() interface{} {
+	return p.Success
+}
+
+func setUserRolesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	switch s := arg.(type) {
+	case *streaming.Args:
+		st := s.Stream
+		req := new(user.SetUserRolesRequest)
+		if err := st.RecvMsg(req); err != nil {
+			return err
+		}
+		resp, err := handler.(user.UserService).SetUserRoles(ctx, req)
+		if err != nil {
+			return err
+		}
+		return st.SendMsg(resp)
+	case *SetUserRolesArgs:
+		success, err := handler.(user.UserService).SetUserRoles(ctx, s.Req)
+		if err != nil {
+			return err
+		}
+		realResult := result.(*SetUserRolesResult)
+		realResult.Success = success
+		return nil
+	default:
+		return errInvalidMessageType
+	}
+}
+func newSetUserRolesArgs() interface{} {
+	return &SetUserRolesArgs{}
+}
+
+func newSetUserRolesResult() interface{} {
+	return &SetUserRolesResult{}
+}
+
+type SetUserRolesArgs struct {
+	Req *user.SetUserRolesRequest
+}
+
+func (p *SetUserRolesArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, nil
+	}
+	return proto.Marshal(p.Req)
+}
+
+func (p *SetUserRolesArgs) Unmarshal(in []byte) error {
+	msg := new(user.SetUserRolesRequest)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+var SetUserRolesArgs_Req_DEFAULT *user.SetUserRolesRequest
+
+func (p *SetUserRolesArgs) GetReq() *user.SetUserRolesRequest {
+	if !p.IsSetReq() {
+		return SetUserRolesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *SetUserRolesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *SetUserRolesArgs) GetFirstArgument() interface{} {
+	return p.Req
+}
+
+type SetUserRolesResult struct {
+	Success *user.SetUserRolesResponse
+}
+
+var SetUserRolesResult_Success_DEFAULT *user.SetUserRolesResponse
+
+func (p *SetUserRolesResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, nil
+	}
+	return proto.Marshal(p.Success)
+}
+
+func (p *SetUserRolesResult) Unmarshal(in []byte) error {
+	msg := new(user.SetUserRolesResponse)
+	if err := proto.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *SetUserRolesResult) GetSuccess() *user.SetUserRolesResponse {
+	if !p.IsSetSuccess() {
+		return SetUserRolesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *SetUserRolesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*user.SetUserRolesResponse)
+}
+
+func (p *SetUserRolesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *SetUserRolesResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -730,3 +966,23 @@ func (p *kClient) UpdatePassword(ctx context.Context, Req *user.UpdatePasswordRe
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ListRoles(ctx context.Context, Req *user.ListRolesRequest) (r *user.ListRolesResponse, err error) {
+	var _args ListRolesArgs
+	_args.Req = Req
+	var _result ListRolesResult
+	if err = p.c.Call(ctx, "ListRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SetUserRoles(ctx context.Context, Req *user.SetUserRolesRequest) (r *user.SetUserRolesResponse, err error) {
+	var _args SetUserRolesArgs
+	_args.Req = Req
+	var _result SetUserRolesResult
+	if err = p.c.Call(ctx, "SetUserRoles", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -7,7 +7,7 @@ pids=()
 
 clean_logs() {
   rm -f "${ROOT_DIR}"/tmp_*.log
-  for svc in user product cart order checkout payment; do
+  for svc in user product cart order checkout payment review; do
     rm -f "${ROOT_DIR}/app/${svc}/log/kitex.log"
   done
 }
@@ -51,6 +51,7 @@ start_service "cart" "${ROOT_DIR}/app/cart"
 start_service "order" "${ROOT_DIR}/app/order"
 start_service "checkout" "${ROOT_DIR}/app/checkout"
 start_service "payment" "${ROOT_DIR}/app/payment"
+start_service "review" "${ROOT_DIR}/app/review"
 # start_service "api" "${ROOT_DIR}/app/api"
 
 echo "All services started. Press Ctrl+C to stop."
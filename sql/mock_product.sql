@@ -46,4 +46,10 @@ INSERT INTO `product_category` (`id`, `parent_id`, `name`, `level`, `sort`, `sho
 INSERT INTO `product_brand` (`id`, `name`, `first_letter`, `logo`, `sort`, `show_status`) VALUES
 (1, '小米', 'X', 'https://example.com/logo/xiaomi.png', 1, 1),
 (2, '红米', 'H', 'https://example.com/logo/redmi.png', 2, 1),
-(3, '米家', 'M', 'https://example.com/logo/mijia.png', 3, 1);
\ No newline at end of file
+(3, '米家', 'M', 'https://example.com/logo/mijia.png', 3, 1);
+
+-- 品牌关联分类（用于前台分类页的品牌筛选）
+INSERT INTO `product_brand_category` (`brand_id`, `category_id`) VALUES
+(1, 1), (1, 2), (1, 4), (1, 5), (1, 6), (1, 7),
+(2, 1), (2, 2), (2, 4),
+(3, 3);
\ No newline at end of file
@@ -42,4 +42,80 @@ CREATE TABLE `order_items` (
   `is_deleted` tinyint DEFAULT '0' COMMENT '逻辑删除标记:0-未删除,1-已删除',
   PRIMARY KEY (`id`),
   KEY `idx_order_items_order_id` (`order_id`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+-- ----------------------------
+-- 3. 归档订单表 (orders_archive)
+-- ----------------------------
+DROP TABLE IF EXISTS `orders_archive`;
+CREATE TABLE `orders_archive` (
+  `id` bigint unsigned NOT NULL COMMENT '订单ID',
+  `order_id` varchar(64) NOT NULL COMMENT '订单号',
+  `user_id` bigint unsigned NOT NULL COMMENT '用户ID',
+  `email` varchar(255) NOT NULL DEFAULT '' COMMENT '邮箱',
+  `shipping_name` varchar(64) NOT NULL DEFAULT '' COMMENT '收货人',
+  `shipping_street_address` varchar(255) NOT NULL DEFAULT '' COMMENT '街道地址',
+  `shipping_city` varchar(64) NOT NULL DEFAULT '' COMMENT '城市',
+  `shipping_zip_code` int NOT NULL DEFAULT 0 COMMENT '邮编',
+  `status` varchar(32) NOT NULL DEFAULT '' COMMENT '归档时的订单状态',
+  `created_at` datetime DEFAULT NULL COMMENT '下单时间',
+  `updated_at` datetime DEFAULT NULL COMMENT '最后更新时间',
+  `is_deleted` tinyint NOT NULL DEFAULT '0' COMMENT '归档前的逻辑删除标记',
+  `archived_at` datetime DEFAULT NULL COMMENT '归档时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_orders_archive_order_id` (`order_id`),
+  KEY `idx_orders_archive_user_id` (`user_id`),
+  KEY `idx_orders_archive_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- ----------------------------
+-- 4. 归档订单项表 (order_items_archive)
+-- ----------------------------
+DROP TABLE IF EXISTS `order_items_archive`;
+CREATE TABLE `order_items_archive` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `order_id` varchar(64) NOT NULL COMMENT '关联 orders_archive 表',
+  `sku_id` bigint unsigned NOT NULL COMMENT 'SKU ID',
+  `sku_name` varchar(255) NOT NULL DEFAULT '' COMMENT 'SKU 名称',
+  `price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '成交价',
+  `quantity` int NOT NULL DEFAULT 1 COMMENT '数量',
+  PRIMARY KEY (`id`),
+  KEY `idx_order_items_archive_order_id` (`order_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- ----------------------------
+-- 5. 发票表 (invoices)，仅在第一个分片创建，保证发票号全局连续
+-- ----------------------------
+DROP TABLE IF EXISTS `invoices`;
+CREATE TABLE `invoices` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `invoice_no` varchar(64) NOT NULL COMMENT '发票号码',
+  `order_id` varchar(64) NOT NULL COMMENT '订单号',
+  `user_id` bigint unsigned NOT NULL COMMENT '用户ID',
+  `seller_name` varchar(255) NOT NULL DEFAULT '' COMMENT '销售方名称',
+  `seller_tax_id` varchar(64) NOT NULL DEFAULT '' COMMENT '销售方税号',
+  `seller_address` varchar(255) NOT NULL DEFAULT '' COMMENT '销售方地址',
+  `buyer_name` varchar(255) NOT NULL DEFAULT '' COMMENT '购买方名称',
+  `buyer_email` varchar(255) NOT NULL DEFAULT '' COMMENT '购买方邮箱',
+  `buyer_address` varchar(512) NOT NULL DEFAULT '' COMMENT '购买方地址',
+  `items` json DEFAULT NULL COMMENT '明细快照，金额单位为分',
+  `tax_rate` decimal(6,4) NOT NULL DEFAULT '0.0000' COMMENT '税率',
+  `net_amount` bigint NOT NULL DEFAULT 0 COMMENT '不含税金额（分）',
+  `tax_amount` bigint NOT NULL DEFAULT 0 COMMENT '税额（分）',
+  `total_amount` bigint NOT NULL DEFAULT 0 COMMENT '价税合计（分）',
+  `issued_at` datetime NOT NULL COMMENT '开票时间',
+  `created_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_invoices_invoice_no` (`invoice_no`),
+  UNIQUE KEY `idx_invoices_order_id` (`order_id`),
+  KEY `idx_invoices_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- ----------------------------
+-- 6. 发票号序列表 (invoice_sequences)
+-- ----------------------------
+DROP TABLE IF EXISTS `invoice_sequences`;
+CREATE TABLE `invoice_sequences` (
+  `name` varchar(32) NOT NULL COMMENT '序列名：<前缀>-<年份>',
+  `value` bigint unsigned NOT NULL DEFAULT 0 COMMENT '已分配的最大序号',
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -11,6 +11,7 @@ CREATE TABLE `product_spu` (
   `name` varchar(255) NOT NULL COMMENT '商品名称',
   `sub_title` varchar(500) DEFAULT NULL COMMENT '商品副标题',
   `main_image` varchar(1000) DEFAULT NULL COMMENT '商品主图',
+  `merchant_id` bigint NOT NULL DEFAULT '0' COMMENT '所属商家用户ID，0为平台商品',
 
   `publish_status` tinyint DEFAULT '0' COMMENT '商品发布状态:0-未发布,1-已发布',
   `verify_status` tinyint DEFAULT '0' COMMENT '商品审核状态:0-未审核,1-审核通过,2-审核不通过',
@@ -34,6 +35,7 @@ CREATE TABLE `product_spu` (
   KEY `idx_sale_count` (`sale_count` DESC),
   KEY `idx_sort` (`sort` DESC),
   KEY `idx_brand_id` (`brand_id`),
+  KEY `idx_merchant_id` (`merchant_id`),
   KEY `idx_is_deleted` (`is_deleted`),
   KEY `idx_price_range` (`low_price`, `high_price`)
 ) ENGINE=InnoDB COMMENT='商品SPU表';
@@ -112,4 +114,196 @@ CREATE TABLE `product_brand` (
   PRIMARY KEY (`id`),
   KEY `idx_first_letter` (`first_letter`, `sort`),
   KEY `idx_show_status` (`show_status`, `sort`)
-) ENGINE=InnoDB COMMENT='商品品牌表';
\ No newline at end of file
+) ENGINE=InnoDB COMMENT='商品品牌表';
+
+DROP TABLE IF EXISTS `product_brand_category`;
+
+CREATE TABLE `product_brand_category` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `brand_id` bigint NOT NULL COMMENT '品牌ID',
+  `category_id` bigint NOT NULL COMMENT '分类ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_brand_category` (`brand_id`, `category_id`),
+  KEY `idx_category_id` (`category_id`)
+) ENGINE=InnoDB COMMENT='品牌分类关联表';
+
+DROP TABLE IF EXISTS `product_audit`;
+
+CREATE TABLE `product_audit` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `spu_id` bigint unsigned NOT NULL COMMENT '商品ID',
+  `verify_status` tinyint NOT NULL DEFAULT '0' COMMENT '0-提交审核,1-审核通过,2-审核不通过',
+  `reason` varchar(255) NOT NULL DEFAULT '' COMMENT '驳回原因',
+  `operator_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '审核人ID，提交审核时为0',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_spu_id` (`spu_id`)
+) ENGINE=InnoDB COMMENT='商品审核记录表';
+
+DROP TABLE IF EXISTS `product_history`;
+
+CREATE TABLE `product_history` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `spu_id` bigint unsigned NOT NULL COMMENT '商品ID',
+  `version` int NOT NULL COMMENT '版本号，每个商品从1开始递增',
+  `operator_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '操作人ID',
+  `action` varchar(16) NOT NULL COMMENT 'baseline/create/update/update_sku/rollback',
+  `source_version` int NOT NULL DEFAULT '0' COMMENT '回滚时为回滚到的版本',
+  `snapshot` mediumtext NOT NULL COMMENT '变更后的商品快照JSON',
+  `changes` text COMMENT '相对上一版本的字段差异JSON',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_spu_version` (`spu_id`, `version`)
+) ENGINE=InnoDB COMMENT='商品版本历史表';
+
+DROP TABLE IF EXISTS `product_schedule`;
+
+CREATE TABLE `product_schedule` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `target_type` varchar(8) NOT NULL COMMENT 'spu/sku',
+  `target_id` bigint unsigned NOT NULL COMMENT 'SPU或SKU ID',
+  `action` varchar(16) NOT NULL COMMENT 'publish/unpublish/price',
+  `price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '改价后的销售价',
+  `market_price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '改价后的市场价，0表示不修改',
+  `run_at` datetime NOT NULL COMMENT '执行时间',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0-待执行,1-执行中,2-已执行,3-执行失败,4-已取消',
+  `error` varchar(255) NOT NULL DEFAULT '' COMMENT '执行失败原因',
+  `executed_at` datetime DEFAULT NULL COMMENT '实际执行时间',
+  `operator_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '创建人ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_target` (`target_type`, `target_id`),
+  KEY `idx_status_run_at` (`status`, `run_at`)
+) ENGINE=InnoDB COMMENT='商品定时任务表';
+
+DROP TABLE IF EXISTS `product_import_job`;
+
+CREATE TABLE `product_import_job` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `operator_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '操作人ID',
+  `merchant_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '导入商品所属商家ID',
+  `format` varchar(8) NOT NULL COMMENT 'csv/xlsx',
+  `dry_run` tinyint(1) NOT NULL DEFAULT '0' COMMENT '只校验不创建商品',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0-待执行,1-执行中,2-已完成,3-失败',
+  `total_rows` bigint NOT NULL DEFAULT '0' COMMENT '数据行数',
+  `error_rows` bigint NOT NULL DEFAULT '0' COMMENT '有错误的行数',
+  `total_products` bigint NOT NULL DEFAULT '0' COMMENT '文件中的商品数',
+  `valid_products` bigint NOT NULL DEFAULT '0' COMMENT '校验通过的商品数',
+  `created_products` bigint NOT NULL DEFAULT '0' COMMENT '实际创建的商品数',
+  `errors` mediumtext COMMENT '逐行错误报告JSON',
+  `error` varchar(255) NOT NULL DEFAULT '' COMMENT '任务失败原因',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `finished_at` datetime DEFAULT NULL COMMENT '结束时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_operator_id` (`operator_id`)
+) ENGINE=InnoDB COMMENT='商品批量导入任务表';
+
+DROP TABLE IF EXISTS `media_asset`;
+
+CREATE TABLE `media_asset` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `kind` varchar(8) NOT NULL COMMENT 'image/video',
+  `content_type` varchar(64) NOT NULL COMMENT 'MIME类型',
+  `size` bigint NOT NULL DEFAULT '0' COMMENT '原文件字节数',
+  `width` int NOT NULL DEFAULT '0' COMMENT '图片宽度',
+  `height` int NOT NULL DEFAULT '0' COMMENT '图片高度',
+  `storage_key` varchar(255) NOT NULL COMMENT '原文件存储key',
+  `url` varchar(1000) NOT NULL COMMENT '原文件地址',
+  `thumbnail_url` varchar(1000) NOT NULL DEFAULT '' COMMENT '缩略图地址',
+  `webp_url` varchar(1000) NOT NULL DEFAULT '' COMMENT 'WebP地址',
+  `original_name` varchar(255) NOT NULL DEFAULT '' COMMENT '上传时的文件名',
+  `operator_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '上传人ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '上传时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_storage_key` (`storage_key`),
+  KEY `idx_kind` (`kind`)
+) ENGINE=InnoDB COMMENT='商品媒体资源表';
+
+DROP TABLE IF EXISTS `sku_price_history`;
+
+CREATE TABLE `sku_price_history` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `sku_id` bigint unsigned NOT NULL COMMENT 'SKU ID',
+  `spu_id` bigint unsigned NOT NULL COMMENT 'SPU ID',
+  `old_price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '变动前售价',
+  `new_price` decimal(10,2) NOT NULL COMMENT '变动后售价',
+  `old_market_price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '变动前市场价',
+  `new_market_price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '变动后市场价',
+  `source` varchar(16) NOT NULL COMMENT 'create/manual/schedule/rollback',
+  `operator_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '操作人ID',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '变动时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_sku_created` (`sku_id`, `created_at`),
+  KEY `idx_spu_created` (`spu_id`, `created_at`)
+) ENGINE=InnoDB COMMENT='SKU价格变动记录表';
+
+DROP TABLE IF EXISTS `price_alert`;
+
+CREATE TABLE `price_alert` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint unsigned NOT NULL COMMENT '用户ID',
+  `sku_id` bigint unsigned NOT NULL COMMENT 'SKU ID',
+  `spu_id` bigint unsigned NOT NULL COMMENT 'SPU ID',
+  `target_price` decimal(10,2) NOT NULL COMMENT '目标价',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '0-等待降价,1-已通知,2-通知中',
+  `notified_price` decimal(10,2) NOT NULL DEFAULT '0.00' COMMENT '触发通知时的售价',
+  `notified_at` datetime DEFAULT NULL COMMENT '通知时间',
+  `retry_count` int NOT NULL DEFAULT '0' COMMENT '连续投递失败次数',
+  `retry_at` datetime DEFAULT NULL COMMENT '投递失败后的下次重试时间，为空表示无需重试',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_user_sku` (`user_id`, `sku_id`),
+  KEY `idx_sku_status` (`sku_id`, `status`),
+  KEY `idx_price_alert_retry_at` (`retry_at`)
+) ENGINE=InnoDB COMMENT='降价提醒表';
+
+DROP TABLE IF EXISTS `product_spec_attr`;
+
+CREATE TABLE `product_spec_attr` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `category_id` bigint unsigned NOT NULL COMMENT '分类ID',
+  `name` varchar(32) NOT NULL COMMENT '属性名',
+  `type` varchar(16) NOT NULL DEFAULT 'enum' COMMENT 'enum/text/number',
+  `values` text COMMENT '可选值JSON数组',
+  `filterable` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否作为搜索筛选项',
+  `sales` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否销售属性',
+  `sort` int NOT NULL DEFAULT '0' COMMENT '排序',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_category_name` (`category_id`, `name`)
+) ENGINE=InnoDB COMMENT='分类规格模板属性表';
+
+DROP TABLE IF EXISTS `product_search_log`;
+
+CREATE TABLE `product_search_log` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `user_id` bigint unsigned NOT NULL DEFAULT '0' COMMENT '用户ID，未登录为0',
+  `keyword` varchar(100) NOT NULL DEFAULT '' COMMENT '规范化后的搜索关键词',
+  `filters` varchar(1000) NOT NULL DEFAULT '' COMMENT '筛选条件JSON',
+  `page` int NOT NULL DEFAULT '1' COMMENT '页码',
+  `result_count` bigint NOT NULL DEFAULT '0' COMMENT '结果总数',
+  `created_at` datetime DEFAULT CURRENT_TIMESTAMP COMMENT '搜索时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_user_id` (`user_id`),
+  KEY `idx_created_at` (`created_at`)
+) ENGINE=InnoDB COMMENT='商品搜索日志表';
+
+DROP TABLE IF EXISTS `product_search_stat`;
+
+CREATE TABLE `product_search_stat` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `granularity` varchar(8) NOT NULL COMMENT '统计粒度 hour/day',
+  `bucket_start` datetime NOT NULL COMMENT '统计周期开始时间',
+  `keyword` varchar(100) NOT NULL,
+  `search_count` bigint NOT NULL DEFAULT '0' COMMENT '搜索次数（只统计首页）',
+  `zero_result_count` bigint NOT NULL DEFAULT '0' COMMENT '零结果次数',
+  `result_total` bigint NOT NULL DEFAULT '0' COMMENT '结果数之和，用于计算平均结果数',
+  `user_count` bigint NOT NULL DEFAULT '0' COMMENT '登录用户去重数',
+  `updated_at` datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_search_stat` (`granularity`, `bucket_start`, `keyword`)
+) ENGINE=InnoDB COMMENT='商品搜索词统计表';
@@ -0,0 +1,93 @@
+DROP DATABASE IF EXISTS `p_review`;
+CREATE DATABASE `p_review` DEFAULT CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+USE `p_review`;
+
+DROP TABLE IF EXISTS `review`;
+
+CREATE TABLE `review` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '评价ID',
+  `spu_id` bigint unsigned NOT NULL COMMENT '商品ID',
+  `sku_id` bigint unsigned NOT NULL COMMENT 'SKU ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '用户ID',
+  `order_id` varchar(64) NOT NULL COMMENT '订单ID',
+  `sku_name` varchar(255) NOT NULL DEFAULT '' COMMENT '下单时的SKU名称',
+  `rating` tinyint NOT NULL COMMENT '星级1-5',
+  `content` varchar(4000) NOT NULL DEFAULT '' COMMENT '评价内容',
+  `images` text COMMENT '图片URL JSON数组',
+  `tags` varchar(512) NOT NULL DEFAULT '' COMMENT '标签JSON数组',
+  `has_images` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否有图',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '审核状态:0-待审核,1-已通过,2-已驳回',
+  `reject_reason` varchar(255) NOT NULL DEFAULT '' COMMENT '驳回原因',
+  `created_at` datetime(3) DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime(3) DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_order_sku` (`order_id`, `sku_id`),
+  KEY `idx_spu_status` (`spu_id`, `status`),
+  KEY `idx_review_user_id` (`user_id`),
+  KEY `idx_review_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商品评价表';
+
+DROP TABLE IF EXISTS `review_rating_summary`;
+
+CREATE TABLE `review_rating_summary` (
+  `spu_id` bigint unsigned NOT NULL COMMENT '商品ID',
+  `review_count` bigint NOT NULL DEFAULT '0' COMMENT '已通过评价数',
+  `rating_sum` bigint NOT NULL DEFAULT '0' COMMENT '星级之和',
+  `rating_1` bigint NOT NULL DEFAULT '0' COMMENT '1星评价数',
+  `rating_2` bigint NOT NULL DEFAULT '0' COMMENT '2星评价数',
+  `rating_3` bigint NOT NULL DEFAULT '0' COMMENT '3星评价数',
+  `rating_4` bigint NOT NULL DEFAULT '0' COMMENT '4星评价数',
+  `rating_5` bigint NOT NULL DEFAULT '0' COMMENT '5星评价数',
+  `updated_at` datetime(3) DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`spu_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商品评分汇总表';
+
+DROP TABLE IF EXISTS `qa_question`;
+
+CREATE TABLE `qa_question` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '问题ID',
+  `spu_id` bigint unsigned NOT NULL COMMENT '商品ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '提问用户ID',
+  `content` varchar(1000) NOT NULL COMMENT '问题内容',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '审核状态:0-待审核,1-已通过,2-已驳回',
+  `reject_reason` varchar(255) NOT NULL DEFAULT '' COMMENT '驳回原因',
+  `answer_count` bigint NOT NULL DEFAULT '0' COMMENT '已通过的回答数',
+  `created_at` datetime(3) DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime(3) DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_spu_status` (`spu_id`, `status`),
+  KEY `idx_qa_question_user_id` (`user_id`),
+  KEY `idx_qa_question_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商品问题表';
+
+DROP TABLE IF EXISTS `qa_answer`;
+
+CREATE TABLE `qa_answer` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT COMMENT '回答ID',
+  `question_id` bigint unsigned NOT NULL COMMENT '问题ID',
+  `spu_id` bigint unsigned NOT NULL COMMENT '商品ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '回答用户ID',
+  `content` varchar(2000) NOT NULL COMMENT '回答内容',
+  `is_merchant` tinyint(1) NOT NULL DEFAULT '0' COMMENT '是否商家回答',
+  `upvotes` bigint NOT NULL DEFAULT '0' COMMENT '点赞数',
+  `status` tinyint NOT NULL DEFAULT '0' COMMENT '审核状态:0-待审核,1-已通过,2-已驳回',
+  `reject_reason` varchar(255) NOT NULL DEFAULT '' COMMENT '驳回原因',
+  `created_at` datetime(3) DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime(3) DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_question_status` (`question_id`, `status`),
+  KEY `idx_qa_answer_spu_id` (`spu_id`),
+  KEY `idx_qa_answer_user_id` (`user_id`),
+  KEY `idx_qa_answer_created_at` (`created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='商品问题回答表';
+
+DROP TABLE IF EXISTS `qa_answer_vote`;
+
+CREATE TABLE `qa_answer_vote` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `answer_id` bigint unsigned NOT NULL COMMENT '回答ID',
+  `user_id` bigint unsigned NOT NULL COMMENT '点赞用户ID',
+  `created_at` datetime(3) DEFAULT NULL COMMENT '点赞时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_answer_user` (`answer_id`, `user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='回答点赞表';
@@ -13,4 +13,48 @@ CREATE TABLE `user` (
   UNIQUE KEY `idx_user_username` (`username`),
   UNIQUE KEY `idx_user_email` (`email`),
   KEY `idx_user_deleted_at` (`deleted_at`)
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='用户表'
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='用户表';
+
+CREATE TABLE `role` (
+  `name` varchar(32) NOT NULL COMMENT '角色名',
+  `description` varchar(255) DEFAULT NULL,
+  `created_at` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='后台角色表';
+
+CREATE TABLE `role_permission` (
+  `role` varchar(32) NOT NULL,
+  `permission` varchar(64) NOT NULL COMMENT '权限点，如 product:write，* 表示全部权限',
+  PRIMARY KEY (`role`, `permission`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='角色权限表';
+
+CREATE TABLE `user_role` (
+  `user_id` bigint NOT NULL,
+  `role` varchar(32) NOT NULL,
+  `created_at` datetime(3) DEFAULT NULL,
+  PRIMARY KEY (`user_id`, `role`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci COMMENT='用户角色表';
+
+-- 内置角色，用户服务启动时也会自动补齐
+INSERT INTO `role` (`name`, `description`, `created_at`) VALUES
+  ('admin', '超级管理员', NOW(3)),
+  ('operator', '运营', NOW(3)),
+  ('merchant', '商家', NOW(3));
+
+INSERT INTO `role_permission` (`role`, `permission`) VALUES
+  ('admin', '*'),
+  ('operator', 'admin:access'),
+  ('operator', 'product:write'),
+  ('operator', 'sku:write'),
+  ('operator', 'order:export'),
+  ('operator', 'search:report'),
+  ('operator', 'review:moderate'),
+  ('operator', 'category:write'),
+  ('operator', 'brand:write'),
+  ('operator', 'product:audit'),
+  ('merchant', 'admin:access'),
+  ('merchant', 'product:write'),
+  ('merchant', 'sku:write');
+
+-- 第一个管理员需手动授权：
+-- INSERT INTO `user_role` (`user_id`, `role`, `created_at`) SELECT `id`, 'admin', NOW(3) FROM `user` WHERE `username` = '<username>';